@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/oyen-bright/goFundIt/config"
+	"github.com/oyen-bright/goFundIt/config/providers"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus/memory"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus/postgres"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+)
+
+// newEventBus builds the bus campaign events are shared on, postgres uses the database config
+func newEventBus(cfg *config.AppConfig, logger logger.Logger) (eventbus.Bus, error) {
+	switch cfg.EventBus.Provider {
+	case providers.EventBusMemory:
+		return memory.NewMemory(), nil
+	case providers.EventBusPostgres:
+		return postgres.NewPostgres(cfg.DBConfig.DSN(), cfg.EventBus.Channel, logger)
+	default:
+		return nil, fmt.Errorf("unknown event bus provider %q", cfg.EventBus.Provider)
+	}
+}
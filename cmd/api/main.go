@@ -85,7 +85,7 @@ import (
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/oyen-bright/goFundIt/pkg/logger"
 	"github.com/oyen-bright/goFundIt/pkg/paystack"
-	"github.com/oyen-bright/goFundIt/pkg/storage/cloudinary"
+	"github.com/oyen-bright/goFundIt/pkg/webhook"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 
 	"gorm.io/gorm"
@@ -132,11 +132,12 @@ func main() {
 
 	// Initialize Websocket Hub
 	websocketHub := websocket.NewHub()
-	go websocketHub.Run()
 	defer websocketHub.Close()
 
-	storage, err := cloudinary.NewCloudinary(cfg.CloudinaryURL)
+	// Initialize Storage, uploads are only kept on the local disk when the provider is local
+	storage, fileServer, err := newStorage(cfg)
 	if err != nil {
+		logger.Error(err, "Failed to initialize storage", map[string]interface{}{"provider": cfg.Storage.Provider})
 		panic(err)
 	}
 
@@ -164,9 +165,25 @@ func main() {
 	paymentRepo := postgress.NewPaymentRepository(db)
 	payoutRepo := postgress.NewPayoutRepository(db)
 	analyticsRepo := postgress.NewAnalyticsRepository(db)
-
-	// initialize the event broadcaster
-	eventBroadcaster := services.NewEventBroadcaster(websocketHub)
+	campaignAccessKeyRepo := postgress.NewCampaignAccessKeyRepository(db)
+	joinRequestRepo := postgress.NewJoinRequestRepository(db)
+	contributorRequestRepo := postgress.NewContributorRequestRepository(db)
+	campaignExtensionRepo := postgress.NewCampaignExtensionRepository(db)
+	campaignRoleRepo := postgress.NewCampaignRoleRepository(db)
+	calendarFeedRepo := postgress.NewCalendarFeedRepository(db)
+	webhookRepo := postgress.NewWebhookRepository(db)
+	pollRepo := postgress.NewPollRepository(db)
+	expenseRepo := postgress.NewExpenseRepository(db)
+	chatRepo := postgress.NewChatRepository(db)
+
+	// initialize the event broadcaster, an instance that can't reach the shared bus would split its clients from the others
+	eventBus, err := newEventBus(cfg, logger)
+	if err != nil {
+		logger.Error(err, "Failed to initialize event bus", map[string]interface{}{"provider": cfg.EventBus.Provider})
+		panic(err)
+	}
+	defer eventBus.Close()
+	eventBroadcaster := services.NewEventBroadcaster(websocketHub, eventBus, logger)
 
 	// Initialize Services
 
@@ -178,20 +195,36 @@ func main() {
 
 	otpService := services.NewOTPService(otpRepo, emailer, logger)
 	authService := services.NewAuthService(authRepo, otpService, encryptor, analyticsService, jwtService, logger)
-	notificationService := services.NewNotificationService(emailer, authService, fcmClient, logger)
-	campaignService := services.NewCampaignService(campaignRepo, authService, analyticsService, notificationService, encryptor, eventBroadcaster, logger)
-	contributorService := services.NewContributorService(contributorRepo, campaignService, analyticsService, authService, notificationService, eventBroadcaster, logger)
-	activityService := services.NewActivityService(activityRepo, authService, campaignService, eventBroadcaster, analyticsService, notificationService, logger)
-	commentService := services.NewCommentService(commentRepo, authService, activityService, notificationService, eventBroadcaster, logger)
+	notificationService := services.NewNotificationService(emailer, authService, fcmClient, cfg.AppURL, logger)
+	campaignAccessService := services.NewCampaignAccessService(campaignAccessKeyRepo, campaignRepo, notificationService, encryptor, cfg.CampaignKeySecret, logger)
+	campaignService := services.NewCampaignService(campaignRepo, authService, analyticsService, notificationService, campaignAccessService, encryptor, storage, eventBroadcaster, logger)
+	contributorService := services.NewContributorService(contributorRepo, campaignService, campaignAccessService, analyticsService, authService, notificationService, eventBroadcaster, logger)
+	campaignRoleService := services.NewCampaignRoleService(campaignRoleRepo, campaignService, campaignAccessService, authService, notificationService, eventBroadcaster, logger)
+	joinRequestService := services.NewJoinRequestService(joinRequestRepo, campaignService, contributorService, notificationService, logger)
+	contributorRequestService := services.NewContributorRequestService(contributorRequestRepo, campaignService, contributorService, eventBroadcaster, logger)
+	activityService := services.NewActivityService(activityRepo, commentRepo, authService, campaignService, eventBroadcaster, analyticsService, notificationService, storage, logger)
+	commentService := services.NewCommentService(commentRepo, authService, activityService, campaignService, notificationService, eventBroadcaster, newContentFilter(cfg.Moderation, aiClient), logger)
 	suggestionService := services.NewSuggestionService(aiClient, campaignService, logger)
 	paymentService := services.NewPaymentService(paymentRepo, contributorService, analyticsService, campaignService, notificationService, paystackClient, storage, eventBroadcaster, logger)
 	payoutService := services.NewPayoutService(payoutRepo, campaignService, notificationService, paystackClient, eventBroadcaster, logger)
 
-	cronService := services.NewCronService(campaignService, notificationService, logger)
+	cronService := services.NewCronService(campaignService, contributorService, notificationService, logger)
 	if err := cronService.StartCronJobs(); err != nil {
 		panic(err)
 	}
 	defer cronService.StopCronJobs()
+	pollService := services.NewPollService(pollRepo, campaignService, eventBroadcaster, logger)
+	expenseService := services.NewExpenseService(expenseRepo, campaignService, storage, eventBroadcaster, logger)
+	imageService := services.NewImageService(campaignRepo, activityRepo, campaignService, storage, eventBroadcaster, logger)
+	calendarService := services.NewCalendarService(calendarFeedRepo, campaignService, encryptor, cfg.CampaignKeySecret, logger)
+	campaignDeadlineService := services.NewCampaignDeadlineService(campaignExtensionRepo, campaignRepo, campaignService, cronService, notificationService, eventBroadcaster, encryptor, logger)
+	chatService := services.NewChatService(chatRepo, campaignService, eventBroadcaster, encryptor, logger)
+
+	webhookService := services.NewWebhookService(webhookRepo, campaignService, eventBus, webhook.NewClient(cfg.Webhooks.Timeout, cfg.Webhooks.AllowInsecure), encryptor, cfg.CampaignKeySecret, cfg.Webhooks.AllowInsecure, logger)
+	if err := webhookService.StartDeliveries(); err != nil {
+		panic(err)
+	}
+	defer webhookService.StopDeliveries()
 
 	// Initialize Handlers
 	authHandler := handlers.NewAuthHandler(authService)
@@ -203,7 +236,25 @@ func main() {
 	paymentHandler := handlers.NewPaymentHandler(paymentService)
 	payoutHandler := handlers.NewPayoutHandler(payoutService)
 	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
-	websocketHandler := handlers.NewWebSocketHandler(websocketHub, campaignService)
+	websocketHandler := handlers.NewWebSocketHandler(websocketHub, campaignService, chatService)
+	eventStreamHandler := handlers.NewEventStreamHandler(websocketHub, campaignService)
+	campaignAccessHandler := handlers.NewCampaignAccessHandler(campaignAccessService)
+	joinRequestHandler := handlers.NewJoinRequestHandler(joinRequestService)
+	contributorRequestHandler := handlers.NewContributorRequestHandler(contributorRequestService)
+	campaignDeadlineHandler := handlers.NewCampaignDeadlineHandler(campaignDeadlineService)
+	campaignRoleHandler := handlers.NewCampaignRoleHandler(campaignRoleService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	pollHandler := handlers.NewPollHandler(pollService)
+	expenseHandler := handlers.NewExpenseHandler(expenseService)
+	imageHandler := handlers.NewImageHandler(imageService)
+	chatHandler := handlers.NewChatHandler(chatService)
+
+	// Local storage serves its own files
+	var fileHandler *handlers.FileHandler
+	if fileServer != nil {
+		fileHandler = handlers.NewFileHandler(fileServer)
+	}
 
 	if cfg.Environment.IsProduction() {
 		gin.SetMode(gin.ReleaseMode)
@@ -232,20 +283,35 @@ func main() {
 
 	// Setup Routes
 	routes.SetupRoutes(routes.Config{
-		Router:             router,
-		AuthHandler:        authHandler,
-		CampaignHandler:    campaignHandler,
-		ContributorHandler: contributorHandler,
-		ActivityHandler:    activityHandler,
-		AnalyticsHandler:   analyticsHandler,
-		CommentHandler:     commentHandler,
-		SuggestionHandler:  suggestionHandler,
-		WebSocketHandler:   websocketHandler,
-		PaymentHandler:     paymentHandler,
-		PayoutHandler:      payoutHandler,
-		PaystackKey:        cfg.PaystackKey,
-		XAPIKey:            cfg.XAPIKey,
-		JWT:                jwtService,
+		Router:                    router,
+		AuthHandler:               authHandler,
+		CampaignHandler:           campaignHandler,
+		ContributorHandler:        contributorHandler,
+		ActivityHandler:           activityHandler,
+		AnalyticsHandler:          analyticsHandler,
+		CommentHandler:            commentHandler,
+		SuggestionHandler:         suggestionHandler,
+		WebSocketHandler:          websocketHandler,
+		EventStreamHandler:        eventStreamHandler,
+		PaymentHandler:            paymentHandler,
+		PayoutHandler:             payoutHandler,
+		CampaignAccessHandler:     campaignAccessHandler,
+		JoinRequestHandler:        joinRequestHandler,
+		ContributorRequestHandler: contributorRequestHandler,
+		CampaignDeadlineHandler:   campaignDeadlineHandler,
+		CampaignRoleHandler:       campaignRoleHandler,
+		CalendarHandler:           calendarHandler,
+		WebhookHandler:            webhookHandler,
+		PollHandler:               pollHandler,
+		ExpenseHandler:            expenseHandler,
+		ImageHandler:              imageHandler,
+		ChatHandler:               chatHandler,
+		FileHandler:               fileHandler,
+		PaystackKey:               cfg.PaystackKey,
+		XAPIKey:                   cfg.XAPIKey,
+		JWT:                       jwtService,
+
+		CampaignKeyVerifier: campaignAccessService,
 	})
 
 	// Start Server
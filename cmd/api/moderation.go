@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/oyen-bright/goFundIt/config"
+	ai "github.com/oyen-bright/goFundIt/internal/ai/interfaces"
+	"github.com/oyen-bright/goFundIt/internal/services"
+	serviceInterfaces "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+// newContentFilter builds the filter comments pass through, the word lists always run and the AI review is optional
+func newContentFilter(cfg config.ModerationConfig, aiClient ai.AIService) serviceInterfaces.ContentFilter {
+	filters := []serviceInterfaces.ContentFilter{
+		services.NewWordListFilter(cfg.BlockedWords, cfg.FlaggedWords),
+	}
+	if cfg.AI && aiClient != nil {
+		filters = append(filters, services.NewAIContentFilter(aiClient))
+	}
+	return services.NewContentFilterChain(filters...)
+}
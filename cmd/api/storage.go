@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/oyen-bright/goFundIt/config"
+	"github.com/oyen-bright/goFundIt/config/providers"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+	"github.com/oyen-bright/goFundIt/pkg/storage/cloudinary"
+	"github.com/oyen-bright/goFundIt/pkg/storage/local"
+	"github.com/oyen-bright/goFundIt/pkg/storage/s3"
+)
+
+// newStorage builds the storage provider selected in the config, the file server is only set for local storage
+func newStorage(cfg *config.AppConfig) (storage.Storage, storage.FileServer, error) {
+	switch cfg.Storage.Provider {
+	case providers.StorageCloudinary:
+		store, err := cloudinary.NewCloudinary(cfg.CloudinaryURL)
+		return store, nil, err
+	case providers.StorageLocal:
+		return newLocalStorage(cfg.Storage.Local)
+	case providers.StorageS3:
+		store, err := s3.NewS3(s3.Config{
+			Endpoint:  cfg.Storage.S3.Endpoint,
+			Region:    cfg.Storage.S3.Region,
+			Bucket:    cfg.Storage.S3.Bucket,
+			AccessKey: cfg.Storage.S3.AccessKey,
+			SecretKey: cfg.Storage.S3.SecretKey,
+			PathStyle: cfg.Storage.S3.PathStyle,
+			PublicURL: cfg.Storage.S3.PublicURL,
+		})
+		return store, nil, err
+	default:
+		return nil, nil, fmt.Errorf("unknown storage provider %q", cfg.Storage.Provider)
+	}
+}
+
+func newLocalStorage(cfg config.LocalStorageConfig) (storage.Storage, storage.FileServer, error) {
+	store, err := local.NewLocal(cfg.Dir, cfg.BaseURL, cfg.SigningSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return store, store, nil
+}
@@ -0,0 +1,56 @@
+// Command webhook-receiver is a local endpoint for testing campaign webhooks.
+// It checks the signature of every delivery and prints the events it receives.
+//
+//	go run ./cmd/webhook-receiver -secret <webhook secret>
+//
+// Register http://localhost:9000/ as the webhook URL, the API only delivers to plain http
+// and local addresses when webhooks.allow_insecure is set
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/webhook"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "Address to listen on")
+	secret := flag.String("secret", os.Getenv("WEBHOOK_SECRET"), "Secret returned when the webhook was registered")
+	failures := flag.Int("fail", 0, "Number of deliveries to answer with a 500, to try out retries")
+	flag.Parse()
+
+	if *secret == "" {
+		log.Fatal("the webhook secret is required, pass -secret or set WEBHOOK_SECRET")
+	}
+
+	receiver := webhook.NewReceiver(*secret, func(delivery webhook.Delivery) {
+		var data bytes.Buffer
+		if err := json.Indent(&data, delivery.Event.Data, "", "  "); err != nil {
+			data.Write(delivery.Event.Data)
+		}
+		log.Printf("delivery %s: %s event %s on campaign %s\n%s", delivery.ID, delivery.Event.Type, delivery.Event.ID, delivery.Event.CampaignID, data.String())
+	})
+
+	var remaining atomic.Int64
+	remaining.Store(int64(*failures))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if left := remaining.Add(-1); left >= 0 {
+			log.Printf("failing delivery %s, %d failures left", r.Header.Get(webhook.DeliveryHeader), left)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		receiver.ServeHTTP(w, r)
+	})
+
+	server := &http.Server{Addr: *addr, Handler: handler, ReadHeaderTimeout: 5 * time.Second}
+	log.Printf("listening for webhook deliveries on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
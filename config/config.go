@@ -3,6 +3,7 @@ package config
 import (
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/oyen-bright/goFundIt/config/environment"
 	"github.com/oyen-bright/goFundIt/config/providers"
@@ -25,6 +26,13 @@ type AppConfig struct {
 	EncryptionKeys                 []string `mapstructure:"encryption_keys"` // Changed from string to []string
 	XAPIKey                        string   `mapstructure:"x_api_key"`
 	JWTSecret                      string   `mapstructure:"jwt_secret"`
+	CampaignKeySecret              string   `mapstructure:"campaign_key_secret"`
+	AppURL                         string   `mapstructure:"app_url"`
+
+	Storage    StorageConfig    `mapstructure:"storage"`
+	Moderation ModerationConfig `mapstructure:"moderation"`
+	EventBus   EventBusConfig   `mapstructure:"event_bus"`
+	Webhooks   WebhookConfig    `mapstructure:"webhooks"`
 }
 
 type EmailConfigYAML struct {
@@ -36,6 +44,53 @@ type EmailConfigYAML struct {
 	SendgridAPIKey string `mapstructure:"sendgrid_api_key"`
 }
 
+// StorageConfig selects the storage backend, cloudinary uses CloudinaryURL
+type StorageConfig struct {
+	Provider providers.StorageProviderType `mapstructure:"provider"`
+	Local    LocalStorageConfig            `mapstructure:"local"`
+	S3       S3StorageConfig               `mapstructure:"s3"`
+}
+
+type LocalStorageConfig struct {
+	Dir           string `mapstructure:"dir"`
+	BaseURL       string `mapstructure:"base_url"`
+	SigningSecret string `mapstructure:"signing_secret"`
+}
+
+type S3StorageConfig struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	Region    string `mapstructure:"region"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	PathStyle bool   `mapstructure:"path_style"`
+	PublicURL string `mapstructure:"public_url"`
+}
+
+// ModerationConfig sets up the content filter comments pass through before they are posted
+type ModerationConfig struct {
+	BlockedWords []string `mapstructure:"blocked_words"`
+	FlaggedWords []string `mapstructure:"flagged_words"`
+	// AI asks the AI client to review comments as well
+	AI bool `mapstructure:"ai"`
+}
+
+// EventBusConfig selects how campaign events reach the websocket clients of every API instance,
+// memory only reaches the clients of this instance and postgres shares events through the database
+type EventBusConfig struct {
+	Provider providers.EventBusProviderType `mapstructure:"provider"`
+	// Channel is the Postgres channel events are sent on
+	Channel string `mapstructure:"channel"`
+}
+
+// WebhookConfig sets up the delivery of campaign events to the endpoints campaign creators register
+type WebhookConfig struct {
+	// Timeout is how long an endpoint has to respond to a delivery
+	Timeout time.Duration `mapstructure:"timeout"`
+	// AllowInsecure accepts plain http URLs and delivers to local addresses, for trying webhooks with the local test receiver
+	AllowInsecure bool `mapstructure:"allow_insecure"`
+}
+
 type DatabaseConfigYAML struct {
 	Name     string `mapstructure:"name"`
 	User     string `mapstructure:"user"`
@@ -76,7 +131,30 @@ func LoadConfig() (*AppConfig, error) {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	// Campaign keys are hashed with this secret, an empty secret would let anyone compute the hashes
+	if config.CampaignKeySecret == "" {
+		return nil, fmt.Errorf("campaign_key_secret must be set")
+	}
+
 	config.Environment = environment
+	if config.Storage.Provider == "" {
+		config.Storage.Provider = providers.StorageCloudinary
+	}
+	if config.Storage.Local.Dir == "" {
+		config.Storage.Local.Dir = "uploads"
+	}
+	if config.Storage.Local.SigningSecret == "" {
+		config.Storage.Local.SigningSecret = config.JWTSecret
+	}
+	if config.EventBus.Provider == "" {
+		config.EventBus.Provider = providers.EventBusMemory
+	}
+	if config.EventBus.Channel == "" {
+		config.EventBus.Channel = "campaign_events"
+	}
+	if config.Webhooks.Timeout <= 0 {
+		config.Webhooks.Timeout = 10 * time.Second
+	}
 	config.EmailProvider = providers.NewEmailProvider(providers.SMTP)
 	config.EmailConfig = email.EmailConfig{
 		Host:           emailCfg.Host,
@@ -9,6 +9,12 @@ type EmailProviderType string
 
 type PhoneProviderType string
 
+// StorageProviderType selects where uploaded files are kept
+type StorageProviderType string
+
+// EventBusProviderType selects how campaign events are shared between API instances
+type EventBusProviderType string
+
 const (
 	// Email providers
 	SMTP     EmailProviderType = "smtp"
@@ -17,6 +23,15 @@ const (
 	// Phone providers
 	ProviderA PhoneProviderType = "A"
 	ProviderB PhoneProviderType = "B"
+
+	// Storage providers
+	StorageCloudinary StorageProviderType = "cloudinary"
+	StorageLocal      StorageProviderType = "local"
+	StorageS3         StorageProviderType = "s3"
+
+	// Event bus providers
+	EventBusMemory   EventBusProviderType = "memory"
+	EventBusPostgres EventBusProviderType = "postgres"
 )
 
 const (
@@ -0,0 +1,56 @@
+package gemini
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+const moderationInstruction = "You review comments posted by members of a group fundraising campaign. " +
+	"Answer blocked for hate speech, threats, harassment, sexual content or scams, " +
+	"flagged for insults, spam or content a campaign organiser should look at, and allowed for everything else. " +
+	"Give a short reason for blocked and flagged comments."
+
+// ModerateContent asks the model whether a comment should be allowed, flagged or blocked
+func (c *geminiClient) ModerateContent(content string) (models.ContentCheck, error) {
+	// The suggestions share c.model, moderation uses its own so the settings don't mix
+	model := c.client.GenerativeModel("gemini-2.0-flash-exp")
+	model.SetTemperature(0)
+	model.SetMaxOutputTokens(256)
+	model.SystemInstruction = genai.NewUserContent(genai.Text(moderationInstruction))
+	model.ResponseMIMEType = "application/json"
+	model.ResponseSchema = &genai.Schema{
+		Type: genai.TypeObject,
+		Properties: map[string]*genai.Schema{
+			"verdict": {
+				Type: genai.TypeString,
+				Enum: []string{string(models.ContentAllowed), string(models.ContentFlagged), string(models.ContentBlocked)},
+			},
+			"reason": {
+				Type: genai.TypeString,
+			},
+		},
+		Required: []string{"verdict"},
+	}
+
+	resp, err := model.GenerateContent(*c.context, genai.Text(content))
+	if err != nil {
+		return models.ContentCheck{}, err
+	}
+	if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+		return models.ContentCheck{}, errors.New("no moderation verdict returned")
+	}
+
+	for _, part := range resp.Candidates[0].Content.Parts {
+		if s, ok := part.(genai.Text); ok {
+			var check models.ContentCheck
+			if err := json.Unmarshal([]byte(s), &check); err != nil {
+				return models.ContentCheck{}, err
+			}
+			return check, nil
+		}
+	}
+	return models.ContentCheck{}, errors.New("no moderation verdict returned")
+}
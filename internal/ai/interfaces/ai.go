@@ -4,4 +4,5 @@ import "github.com/oyen-bright/goFundIt/internal/models"
 
 type AIService interface {
 	GenerateActivitySuggestions(campaignDescription string) ([]models.ActivitySuggestion, error)
+	ModerateContent(content string) (models.ContentCheck, error)
 }
@@ -78,6 +78,62 @@ func (_c *MockAIService_GenerateActivitySuggestions_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ModerateContent provides a mock function with given fields: content
+func (_m *MockAIService) ModerateContent(content string) (models.ContentCheck, error) {
+	ret := _m.Called(content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModerateContent")
+	}
+
+	var r0 models.ContentCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.ContentCheck, error)); ok {
+		return rf(content)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.ContentCheck); ok {
+		r0 = rf(content)
+	} else {
+		r0 = ret.Get(0).(models.ContentCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIService_ModerateContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModerateContent'
+type MockAIService_ModerateContent_Call struct {
+	*mock.Call
+}
+
+// ModerateContent is a helper method to define mock.On call
+//   - content string
+func (_e *MockAIService_Expecter) ModerateContent(content interface{}) *MockAIService_ModerateContent_Call {
+	return &MockAIService_ModerateContent_Call{Call: _e.mock.On("ModerateContent", content)}
+}
+
+func (_c *MockAIService_ModerateContent_Call) Run(run func(content string)) *MockAIService_ModerateContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIService_ModerateContent_Call) Return(_a0 models.ContentCheck, _a1 error) *MockAIService_ModerateContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIService_ModerateContent_Call) RunAndReturn(run func(string) (models.ContentCheck, error)) *MockAIService_ModerateContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAIService creates a new instance of MockAIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAIService(t interface {
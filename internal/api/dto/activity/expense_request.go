@@ -0,0 +1,31 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// RecordExpenseRequest represents the payload to record money spent on an activity, sent as a multipart form with an optional receipt file
+// @Description Activity expense request structure
+type RecordExpenseRequest struct {
+	// Amount spent
+	// @example 45.50
+	Amount float64 `form:"amount" json:"amount" binding:"required,gt=0"`
+	// Optional email of the campaign member who paid, defaults to the user recording the expense
+	// @example "jane@example.com"
+	PaidBy string `form:"paidBy" json:"paidBy" binding:"omitempty,email"`
+	// Optional note on what the money was spent on
+	// @example "Fuel for the boat"
+	Note string `form:"note" json:"note" binding:"max=500"`
+}
+
+// ReconcileActivityRequest represents the payload to settle the over or under spend of an activity
+// @Description Activity reconciliation request structure
+type ReconcileActivityRequest struct {
+	// How the difference is settled: refund shares it between the participants, rollover moves leftovers to another activity and absorb only records it
+	// @example "rollover"
+	Resolution models.ReconciliationResolution `json:"resolution" binding:"required,oneof=refund rollover absorb"`
+	// Activity leftovers are rolled into, required for rollover
+	// @example 12
+	TargetActivityID *uint `json:"targetActivityId"`
+	// Optional note on the reconciliation
+	// @example "Boat tour came in under budget"
+	Note string `json:"note" binding:"max=500"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// ActivitySplitRequest represents the payload to change how an activity's cost is split
+// @Description Activity split request structure
+type ActivitySplitRequest struct {
+	// How the cost is shared by the participants: per_person, equal, weighted or custom
+	// @example "weighted"
+	Strategy models.SplitStrategy `json:"strategy" binding:"required,oneof=per_person equal weighted custom"`
+
+	// Weights or amounts of the participants, only used by weighted and custom splits
+	Shares []ActivityShareRequest `json:"shares" binding:"omitempty,dive"`
+}
+
+// ActivityShareRequest represents a participant's share of an activity
+// @Description Activity share structure
+type ActivityShareRequest struct {
+	// ID of the participating contributor
+	// @example 1
+	ContributorID uint `json:"contributorId" binding:"required"`
+
+	// Weight of the participant in a weighted split
+	// @example 2
+	Weight float64 `json:"weight" binding:"omitempty,gt=0"`
+
+	// Fixed amount of the participant in a custom split
+	// @example 250.00
+	Amount float64 `json:"amount" binding:"omitempty,gte=0"`
+}
+
+// ToShares converts the requested shares to activity shares
+func (r ActivitySplitRequest) ToShares() []models.ActivityShare {
+	shares := make([]models.ActivityShare, len(r.Shares))
+	for i, share := range r.Shares {
+		shares[i] = models.ActivityShare{
+			ContributorID: share.ContributorID,
+			Weight:        share.Weight,
+			Amount:        share.Amount,
+		}
+	}
+	return shares
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // UpdateActivityRequest represents the activity update payload
 // @Description Activity update request structure
 type UpdateActivityRequest struct {
@@ -11,10 +13,6 @@ type UpdateActivityRequest struct {
 	// @example "Phase 1 of reforestation project"
 	Subtitle string `json:"subtitle"`
 
-	// Optional URL for activity image
-	// @example "https://example.com/images/tree-planting.jpg"
-	ImageUrl string `json:"image_url" binding:"omitempty,url" validate:"omitempty,url"`
-
 	// Whether this activity is mandatory for the campaign
 	// @example true
 	IsMandatory bool `json:"is_mandatory" binding:"boolean"`
@@ -23,6 +21,42 @@ type UpdateActivityRequest struct {
 	// @example 1500.50
 	Cost float64 `json:"cost" binding:"required" validate:"required,gt=0"`
 
+	// Optional maximum number of participants, contributors who opt in once it is reached join the waitlist
+	// @example 12
+	Capacity *int `json:"capacity" binding:"omitempty,gt=0"`
+
+	// Optional time after which contributors can no longer opt in
+	// @example "2025-06-01T00:00:00Z"
+	SignUpDeadline *time.Time `json:"signUpDeadline"`
+
+	// Optional time by which participants of a mandatory activity must have paid
+	// @example "2025-06-15T00:00:00Z"
+	PaymentDueDate *time.Time `json:"paymentDueDate"`
+
+	// Optional time the activity starts, only scheduled activities are listed in calendar feeds
+	// @example "2025-06-20T09:00:00Z"
+	StartsAt *time.Time `json:"startsAt"`
+
+	// Optional time the activity ends, must be after it starts
+	// @example "2025-06-20T13:00:00Z"
+	EndsAt *time.Time `json:"endsAt"`
+
+	// Optional place the activity happens at
+	// @example "Pier 4, Victoria Island"
+	Location string `json:"location" binding:"omitempty,max=255"`
+
+	// Optional latitude of the location, set together with longitude
+	// @example 6.4281
+	Latitude *float64 `json:"latitude" binding:"omitempty,gte=-90,lte=90"`
+
+	// Optional longitude of the location, set together with latitude
+	// @example 3.4219
+	Longitude *float64 `json:"longitude" binding:"omitempty,gte=-180,lte=180"`
+
+	// Optional notes for participants
+	// @example "Bring sunscreen and a towel"
+	Notes string `json:"notes" binding:"omitempty,max=2000"`
+
 	// Approval status of the activity
 	// @example false
 	IsApproved bool `json:"is_approved"`
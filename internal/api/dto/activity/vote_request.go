@@ -0,0 +1,9 @@
+package dto
+
+// ActivityVoteRequest represents the payload to vote on a proposed activity
+// @Description Activity vote request structure
+type ActivityVoteRequest struct {
+	// Whether the contributor wants the activity approved
+	// @example true
+	Approve *bool `json:"approve" binding:"required"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// CreateCalendarFeedRequest represents the payload to subscribe to a campaign's calendar
+// @Description Calendar feed request structure
+type CreateCalendarFeedRequest struct {
+	// @Description Activities listed in the feed, campaign lists every scheduled activity and contributor only those you opted into
+	// @example "contributor"
+	Scope models.CalendarFeedScope `json:"scope" binding:"required,oneof=campaign contributor"`
+}
@@ -29,9 +29,6 @@ type CampaignRequest struct {
 	// @example "ETH"
 	CryptoToken *models.CryptoToken `json:"cryptoToken,omitempty" binding:"required_if=PaymentMethod crypto" validate:"required_if=PaymentMethod crypto,omitempty"`
 
-	// @Description Campaign images
-	Images []models.CampaignImage `json:"images" binding:"omitempty,dive,required"`
-
 	// @Description Campaign contributors
 	Contributors []models.Contributor `json:"contributors" binding:"required,gt=0,dive,required" validate:"required,gt=0,dive,required"`
 
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// CampaignExtensionRequest represents the campaign end date extension payload
+// @Description Campaign end date extension request structure
+type CampaignExtensionRequest struct {
+	// @Description New end date of the campaign, must be after the current end date
+	// @example "2025-01-10T00:00:00Z"
+	EndDate time.Time `json:"endDate" binding:"required"`
+}
+
+// ExtensionVoteRequest represents a contributor's vote on a pending extension
+// @Description Campaign extension vote request structure
+type ExtensionVoteRequest struct {
+	// @Description Whether the contributor approves the extension
+	// @example true
+	Approve *bool `json:"approve" binding:"required"`
+}
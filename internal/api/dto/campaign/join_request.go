@@ -0,0 +1,17 @@
+package dto
+
+// JoinCampaignRequest represents the payload to request joining a public campaign
+// @Description Join campaign request structure
+type JoinCampaignRequest struct {
+	// @Description Name to be added to the campaign with
+	// @example "John Doe"
+	Name string `json:"name" binding:"omitempty,gte=3"`
+
+	// @Description Amount the requester wants to contribute
+	// @example 100.50
+	Amount float64 `json:"amount" binding:"required,gte=0"`
+
+	// @Description Optional message to the campaign creator
+	// @example "I would love to help out"
+	Message string `json:"message" binding:"omitempty,max=500"`
+}
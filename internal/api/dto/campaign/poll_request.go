@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// CreatePollRequest represents the payload to create a campaign poll
+// @Description Poll creation request structure
+type CreatePollRequest struct {
+	// @Description Question asked to the contributors
+	// @example "Which weekend works best for the trip?"
+	Question string `json:"question" binding:"required,max=255"`
+
+	// @Description Answers contributors choose from, between 2 and 10
+	// @example ["First weekend of June", "Last weekend of June"]
+	Options []string `json:"options" binding:"required,min=2,max=10,dive,required,max=255"`
+
+	// @Description Lets contributors choose more than one option
+	// @example false
+	MultipleChoice bool `json:"multipleChoice"`
+
+	// @Description Optional time after which the poll stops accepting votes
+	// @example "2025-05-01T00:00:00Z"
+	Deadline *time.Time `json:"deadline"`
+}
+
+// PollVoteRequest represents the payload to vote on a campaign poll
+// @Description Poll vote request structure
+type PollVoteRequest struct {
+	// @Description IDs of the chosen options, single choice polls take exactly one
+	// @example [1]
+	OptionIDs []uint `json:"optionIds" binding:"required,min=1"`
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// PublicCampaignResponse is the read-only summary of a public campaign
+// @Description Public campaign summary, contributor details are not included
+type PublicCampaignResponse struct {
+	Slug              string                  `json:"slug" example:"community-event-x7k2p9"`
+	Title             string                  `json:"title" example:"Community Event"`
+	Description       string                  `json:"description"`
+	Status            string                  `json:"status" example:"active"`
+	PaymentMethod     models.PaymentMethod    `json:"paymentMethod" example:"fiat"`
+	FiatCurrency      *models.FiatCurrency    `json:"fiatCurrency,omitempty"`
+	CryptoToken       *models.CryptoToken     `json:"cryptoToken,omitempty"`
+	TargetAmount      float64                 `json:"targetAmount" example:"1000"`
+	GoalAmount        float64                 `json:"goalAmount" example:"1000"`
+	PledgedAmount     float64                 `json:"pledgedAmount" example:"800"`
+	AmountRaised      float64                 `json:"amountRaised" example:"250"`
+	Progress          float64                 `json:"progress" example:"25"`
+	ContributorsCount int                     `json:"contributorsCount" example:"8"`
+	Images            []PublicCampaignImage   `json:"images"`
+	Activities        []PublicActivitySummary `json:"activities"`
+	StartDate         time.Time               `json:"startDate"`
+	EndDate           time.Time               `json:"endDate"`
+}
+
+type PublicCampaignImage struct {
+	ImageUrl string `json:"imageUrl"`
+}
+
+type PublicActivitySummary struct {
+	Title             string  `json:"title"`
+	Subtitle          string  `json:"subtitle"`
+	ImageUrl          string  `json:"imageUrl"`
+	Cost              float64 `json:"cost"`
+	IsMandatory       bool    `json:"isMandatory"`
+	ParticipantsCount int     `json:"participantsCount"`
+}
+
+// NewPublicCampaignResponse builds the public summary of a decrypted campaign, only approved activities are listed
+func NewPublicCampaignResponse(campaign *models.Campaign) PublicCampaignResponse {
+	response := PublicCampaignResponse{
+		Title:             campaign.Title,
+		Description:       campaign.Description,
+		Status:            campaign.GetStatus(),
+		PaymentMethod:     campaign.PaymentMethod,
+		FiatCurrency:      campaign.FiatCurrency,
+		CryptoToken:       campaign.CryptoToken,
+		TargetAmount:      campaign.TargetAmount,
+		GoalAmount:        campaign.GetGoalAmount(),
+		PledgedAmount:     campaign.GetPledgedAmount(),
+		AmountRaised:      campaign.GetPayoutAmount(),
+		Progress:          campaign.ProgressPercentage(),
+		ContributorsCount: len(campaign.AcceptedContributors()),
+		Images:            make([]PublicCampaignImage, 0, len(campaign.Images)),
+		Activities:        make([]PublicActivitySummary, 0, len(campaign.Activities)),
+		StartDate:         campaign.StartDate,
+		EndDate:           campaign.EndDate,
+	}
+
+	if campaign.Slug != nil {
+		response.Slug = *campaign.Slug
+	}
+
+	for _, image := range campaign.Images {
+		response.Images = append(response.Images, PublicCampaignImage{ImageUrl: image.ImageUrl})
+	}
+
+	for _, activity := range campaign.Activities {
+		if !activity.IsApproved {
+			continue
+		}
+		response.Activities = append(response.Activities, PublicActivitySummary{
+			Title:             activity.Title,
+			Subtitle:          activity.Subtitle,
+			ImageUrl:          activity.ImageUrl,
+			Cost:              activity.Cost,
+			IsMandatory:       activity.IsMandatory,
+			ParticipantsCount: len(activity.Contributors),
+		})
+	}
+
+	return response
+}
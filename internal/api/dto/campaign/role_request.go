@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// AssignRoleRequest represents the campaign role assignment payload
+// @Description Campaign role assignment request structure
+type AssignRoleRequest struct {
+	// @Description Email of the campaign member
+	// @example "member@example.com"
+	Email string `json:"email" binding:"required,email,lowercase"`
+	// @Description Role to give the member, member removes any other role
+	// @example "treasurer"
+	Role models.CampaignRole `json:"role" binding:"required,oneof=co_organiser treasurer member viewer"`
+}
+
+// TransferOwnershipRequest represents the campaign ownership transfer payload
+// @Description Campaign ownership transfer request structure
+type TransferOwnershipRequest struct {
+	// @Description Email of the campaign member who becomes the owner
+	// @example "member@example.com"
+	Email string `json:"email" binding:"required,email,lowercase"`
+}
+
+// TransferOwnershipResponse holds the key issued to the previous owner
+// @Description Campaign ownership transfer response structure
+type TransferOwnershipResponse struct {
+	// @Description Member key of the previous owner, empty when the campaign key is still used
+	AccessKey string `json:"accessKey,omitempty"`
+}
@@ -6,4 +6,8 @@ type CampaignUpdateRequest struct {
 	Title       *string    `json:"title,omitempty"`
 	Description *string    `json:"description,omitempty"`
 	EndDate     *time.Time `json:"endDate,omitempty"`
+	GoalAmount  *float64   `json:"goalAmount,omitempty" binding:"omitempty,gte=0"`
+	Milestones  []int      `json:"milestones,omitempty" binding:"omitempty,dive,gt=0,lte=100"`
+
+	ActivityVoteThreshold *int `json:"activityVoteThreshold,omitempty" binding:"omitempty,gte=0"`
 }
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// CampaignVisibilityRequest represents the campaign visibility update payload
+// @Description Campaign visibility update request structure
+type CampaignVisibilityRequest struct {
+	// @Description Campaign visibility (public/private)
+	// @example "public"
+	Visibility models.CampaignVisibility `json:"visibility" binding:"required,oneof=public private"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// ChatMessageRequest is the data of a chat_message sent over the campaign websocket
+// @Description Chat message sent by a campaign member
+type ChatMessageRequest struct {
+	// Message content
+	// @example "Who is bringing the tent?"
+	Content string `json:"content"`
+	// Optional ID chosen by the client to match the broadcast message with the one it sent, resending it doesn't duplicate the message
+	// @example "b3f1c2"
+	ClientID string `json:"clientId"`
+}
+
+// ChatTypingRequest is the data of a chat_typing sent over the campaign websocket
+// @Description Typing indicator sent by a campaign member
+type ChatTypingRequest struct {
+	// Whether the member is typing
+	// @example true
+	Typing bool `json:"typing"`
+}
+
+// ChatReceiptRequest is the data of a chat_receipt sent over the campaign websocket
+// @Description Delivery or read receipt sent by a campaign member
+type ChatReceiptRequest struct {
+	// ID of the message
+	// @example "MSG1A2B3C4D5E6F"
+	MessageID string `json:"messageId"`
+	// Receipt status, delivered or read
+	// @example "read"
+	Status models.ChatReceiptStatus `json:"status"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// ReportCommentRequest represents the request body for reporting a comment
+// @Description Request structure for reporting a comment to the campaign organisers
+type ReportCommentRequest struct {
+	// Why the comment is reported
+	// @example "Spam"
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// ModerateCommentRequest represents the request body for moderating a comment
+// @Description Request structure for hiding, unhiding or removing a comment
+type ModerateCommentRequest struct {
+	// Moderation action, hide, unhide or remove
+	// @example "hide"
+	Action models.CommentModerationAction `json:"action" binding:"required,oneof=hide unhide remove"`
+	// Why the action was taken, required to hide or remove a comment
+	// @example "Harassment"
+	Reason string `json:"reason" binding:"max=500"`
+}
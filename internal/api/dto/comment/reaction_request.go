@@ -0,0 +1,9 @@
+package dto
+
+// CommentReactionRequest represents the request body for reacting to a comment
+// @Description Request structure for reacting to a comment with an emoji
+type CommentReactionRequest struct {
+	// Emoji to react with
+	// @example "👍"
+	Emoji string `json:"emoji" binding:"required,max=32"`
+}
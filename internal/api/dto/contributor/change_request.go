@@ -0,0 +1,33 @@
+package dto
+
+// LeaveCampaignRequest represents the payload to request leaving a campaign
+// @Description Leave campaign request structure
+type LeaveCampaignRequest struct {
+	// @Description Optional reason for leaving, shown to the campaign organisers
+	// @example "I can no longer make the trip"
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// ChangeAmountRequest represents the payload to request a different contribution amount
+// @Description Change contribution amount request structure
+type ChangeAmountRequest struct {
+	// @Description Amount the contributor wants to contribute instead
+	// @example 150.00
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+
+	// @Description Optional reason for the change, shown to the campaign organisers
+	// @example "I'd like to cover the boat trip too"
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// ReviewContributorRequest represents the payload to approve or reject a contributor request
+// @Description Contributor request review structure
+type ReviewContributorRequest struct {
+	// @Description Optional note to the contributor
+	// @example "Sorry to see you go"
+	Note string `json:"note" binding:"omitempty,max=500"`
+
+	// @Description Confirms the payment of a contributor who is leaving was refunded, required when the contributor has paid
+	// @example true
+	RefundIssued bool `json:"refundIssued"`
+}
@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// ImportContributorRow represents a contributor in a bulk import
+// @Description A contributor to import, activities are the IDs of approved campaign activities to opt the contributor into
+type ImportContributorRow struct {
+	// Optional name of the contributor
+	// @example "John Doe"
+	Name string `json:"name"`
+
+	// Email address of the contributor
+	// @example "john.doe@example.com"
+	Email string `json:"email"`
+
+	// Amount of the contribution
+	// @example 100.50
+	Amount float64 `json:"amount"`
+
+	// IDs of the activities the contributor opts into
+	// @example [1, 2]
+	Activities []uint `json:"activities"`
+}
+
+// ToContributor converts the row to a contributor, activities only carry their ID
+func (r ImportContributorRow) ToContributor() models.Contributor {
+	contributor := models.Contributor{
+		Name:   strings.TrimSpace(r.Name),
+		Email:  strings.TrimSpace(r.Email),
+		Amount: r.Amount,
+	}
+	for _, activityID := range r.Activities {
+		contributor.Activities = append(contributor.Activities, models.Activity{ID: activityID})
+	}
+	return contributor
+}
+
+// ToContributors converts the rows of an import to contributors
+func ToContributors(rows []ImportContributorRow) []models.Contributor {
+	contributors := make([]models.Contributor, len(rows))
+	for i, row := range rows {
+		contributors[i] = row.ToContributor()
+	}
+	return contributors
+}
+
+// csvImportColumns are the columns of a contributor import CSV, activities are separated by ";"
+var csvImportColumns = []string{"name", "email", "amount", "activities"}
+
+// ParseContributorsCSV reads the rows of a contributor import CSV.
+// The first line is a header naming the columns, email and amount are required.
+// Rows that can't be read are returned as import errors.
+func ParseContributorsCSV(r io.Reader) ([]ImportContributorRow, []models.ContributorImportError, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("the CSV file is empty")
+		}
+		return nil, nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, required := range []string{"email", "amount"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("the CSV header must include the columns %s", strings.Join(csvImportColumns, ", "))
+		}
+	}
+
+	var rows []ImportContributorRow
+	var rowErrors []models.ContributorImportError
+	for rowNumber := 1; ; rowNumber++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := ImportContributorRow{Name: field("name"), Email: field("email")}
+		var problems []string
+
+		if amount := field("amount"); amount != "" {
+			if row.Amount, err = strconv.ParseFloat(amount, 64); err != nil {
+				problems = append(problems, fmt.Sprintf("amount %q is not a number", amount))
+			}
+		}
+		for _, activity := range strings.Split(field("activities"), ";") {
+			if activity = strings.TrimSpace(activity); activity == "" {
+				continue
+			}
+			activityID, err := strconv.ParseUint(activity, 10, 64)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("activity %q is not an activity ID", activity))
+				continue
+			}
+			row.Activities = append(row.Activities, uint(activityID))
+		}
+
+		if len(problems) > 0 {
+			rowErrors = append(rowErrors, models.NewContributorImportError(rowNumber, row.Email, problems...))
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
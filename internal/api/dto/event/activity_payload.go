@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// ActivityPayload is an activity as members see it, the participants carry their details
+type ActivityPayload struct {
+	ID             uint                           `json:"id"`
+	CampaignID     string                         `json:"campaignId"`
+	Title          string                         `json:"title"`
+	Subtitle       string                         `json:"subtitle"`
+	ImageUrl       string                         `json:"imageUrl"`
+	ThumbnailUrl   string                         `json:"thumbnailUrl,omitempty"`
+	IsMandatory    bool                           `json:"isMandatory"`
+	Cost           float64                        `json:"cost"`
+	IsApproved     bool                           `json:"isApproved"`
+	Contributors   []ContributorPayload           `json:"contributors"`
+	SplitStrategy  models.SplitStrategy           `json:"splitStrategy,omitempty"`
+	Shares         []models.ActivityShare         `json:"shares,omitempty"`
+	Capacity       *int                           `json:"capacity,omitempty"`
+	Waitlist       []models.ActivityWaitlistEntry `json:"waitlist,omitempty"`
+	SignUpDeadline *time.Time                     `json:"signUpDeadline,omitempty"`
+	PaymentDueDate *time.Time                     `json:"paymentDueDate,omitempty"`
+	StartsAt       *time.Time                     `json:"startsAt,omitempty"`
+	EndsAt         *time.Time                     `json:"endsAt,omitempty"`
+	Location       string                         `json:"location,omitempty"`
+	Latitude       *float64                       `json:"latitude,omitempty"`
+	Longitude      *float64                       `json:"longitude,omitempty"`
+	Notes          string                         `json:"notes,omitempty"`
+	Votes          []models.ActivityVote          `json:"votes,omitempty"`
+}
+
+func NewActivityPayload(activity *models.Activity, privileged bool) ActivityPayload {
+	return ActivityPayload{
+		ID:             activity.ID,
+		CampaignID:     activity.CampaignID,
+		Title:          activity.Title,
+		Subtitle:       activity.Subtitle,
+		ImageUrl:       activity.ImageUrl,
+		ThumbnailUrl:   activity.ThumbnailUrl,
+		IsMandatory:    activity.IsMandatory,
+		Cost:           activity.Cost,
+		IsApproved:     activity.IsApproved,
+		Contributors:   newContributorPayloads(activity.Contributors, privileged),
+		SplitStrategy:  activity.SplitStrategy,
+		Shares:         activity.Shares,
+		Capacity:       activity.Capacity,
+		Waitlist:       activity.Waitlist,
+		SignUpDeadline: activity.SignUpDeadline,
+		PaymentDueDate: activity.PaymentDueDate,
+		StartsAt:       activity.StartsAt,
+		EndsAt:         activity.EndsAt,
+		Location:       activity.Location,
+		Latitude:       activity.Latitude,
+		Longitude:      activity.Longitude,
+		Notes:          activity.Notes,
+		Votes:          activity.Votes,
+	}
+}
@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// CampaignPayload is a campaign as members see it, the contributors and payout carry their details
+// and Payout is only sent to privileged members
+type CampaignPayload struct {
+	ID                        string                     `json:"id"`
+	Title                     string                     `json:"title"`
+	Description               string                     `json:"description"`
+	Status                    string                     `json:"status"`
+	PaymentMethod             models.PaymentMethod       `json:"paymentMethod"`
+	FiatCurrency              *models.FiatCurrency       `json:"fiatCurrency,omitempty"`
+	CryptoToken               *models.CryptoToken        `json:"cryptoToken,omitempty"`
+	TargetAmount              float64                    `json:"targetAmount"`
+	GoalAmount                float64                    `json:"goalAmount"`
+	PledgedAmount             float64                    `json:"pledgedAmount"`
+	AmountRaised              float64                    `json:"amountRaised"`
+	Progress                  float64                    `json:"progress"`
+	Images                    []models.CampaignImage     `json:"images"`
+	Activities                []ActivityPayload          `json:"activities"`
+	Contributors              []ContributorPayload       `json:"contributors"`
+	Milestones                []models.CampaignMilestone `json:"milestones"`
+	Visibility                models.CampaignVisibility  `json:"visibility"`
+	Slug                      *string                    `json:"slug,omitempty"`
+	ExtensionRequiresApproval bool                       `json:"extensionRequiresApproval"`
+	ActivityVoteThreshold     int                        `json:"activityVoteThreshold"`
+	CreatedByHandle           string                     `json:"createdByHandle"`
+	StartDate                 time.Time                  `json:"startDate"`
+	EndDate                   time.Time                  `json:"endDate"`
+	ClosedAt                  *time.Time                 `json:"closedAt,omitempty"`
+
+	Payout *PayoutPayload `json:"payout,omitempty"`
+}
+
+func NewCampaignPayload(campaign *models.Campaign, privileged bool) CampaignPayload {
+	payload := CampaignPayload{
+		ID:                        campaign.ID,
+		Title:                     campaign.Title,
+		Description:               campaign.Description,
+		Status:                    campaign.GetStatus(),
+		PaymentMethod:             campaign.PaymentMethod,
+		FiatCurrency:              campaign.FiatCurrency,
+		CryptoToken:               campaign.CryptoToken,
+		TargetAmount:              campaign.TargetAmount,
+		GoalAmount:                campaign.GetGoalAmount(),
+		PledgedAmount:             campaign.GetPledgedAmount(),
+		AmountRaised:              campaign.GetPayoutAmount(),
+		Progress:                  campaign.ProgressPercentage(),
+		Images:                    campaign.Images,
+		Activities:                make([]ActivityPayload, 0, len(campaign.Activities)),
+		Contributors:              newContributorPayloads(campaign.Contributors, privileged),
+		Milestones:                campaign.Milestones,
+		Visibility:                campaign.Visibility,
+		Slug:                      campaign.Slug,
+		ExtensionRequiresApproval: campaign.ExtensionRequiresApproval,
+		ActivityVoteThreshold:     campaign.ActivityVoteThreshold,
+		CreatedByHandle:           campaign.CreatedByHandle,
+		StartDate:                 campaign.StartDate,
+		EndDate:                   campaign.EndDate,
+		ClosedAt:                  campaign.ClosedAt,
+	}
+
+	for i := range campaign.Activities {
+		payload.Activities = append(payload.Activities, NewActivityPayload(&campaign.Activities[i], privileged))
+	}
+	if privileged && campaign.Payout != nil {
+		payout := NewPayoutPayload(campaign.Payout, true)
+		payload.Payout = &payout
+	}
+	return payload
+}
+
+// PayoutPayload is the campaign payout, members see its progress and privileged members see where it is paid to
+type PayoutPayload struct {
+	CampaignID   string               `json:"campaignId"`
+	Amount       float64              `json:"amount"`
+	PayoutMethod models.PaymentMethod `json:"payoutMethod"`
+	Status       models.PayoutStatus  `json:"status"`
+	ProcessedAt  *string              `json:"processedAt,omitempty"`
+	CompletedAt  *string              `json:"completedAt,omitempty"`
+
+	Reference     string                `json:"reference,omitempty"`
+	FiatAccount   *models.FiatAccount   `json:"fiatAccount,omitempty"`
+	CryptoAccount *models.CryptoAccount `json:"cryptoAccount,omitempty"`
+	FailureReason *string               `json:"failureReason,omitempty"`
+}
+
+func NewPayoutPayload(payout *models.Payout, privileged bool) PayoutPayload {
+	payload := PayoutPayload{
+		CampaignID:   payout.CampaignID,
+		Amount:       payout.Amount,
+		PayoutMethod: payout.PayoutMethod,
+		Status:       payout.Status,
+		ProcessedAt:  payout.ProcessedAt,
+		CompletedAt:  payout.CompletedAt,
+	}
+
+	if privileged {
+		payload.Reference = payout.Reference
+		payload.FiatAccount = payout.FiatAccount
+		payload.CryptoAccount = payout.CryptoAccount
+		payload.FailureReason = payout.FailureReason
+	}
+	return payload
+}
+
+// CampaignRolePayload is a role given to a member, only privileged members see the member's email
+type CampaignRolePayload struct {
+	ID         uint                `json:"id"`
+	CampaignID string              `json:"campaignId"`
+	UserHandle string              `json:"userHandle"`
+	Role       models.CampaignRole `json:"role"`
+	CreatedAt  time.Time           `json:"createdAt"`
+
+	Email string `json:"email,omitempty"`
+}
+
+func NewCampaignRolePayload(role *models.CampaignUserRole, privileged bool) CampaignRolePayload {
+	payload := CampaignRolePayload{
+		ID:         role.ID,
+		CampaignID: role.CampaignID,
+		UserHandle: role.UserHandle,
+		Role:       role.Role,
+		CreatedAt:  role.CreatedAt,
+	}
+	if privileged {
+		payload.Email = role.Email
+	}
+	return payload
+}
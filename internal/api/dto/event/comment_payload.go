@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// CommentPayload is a comment as members see it, the author is named by handle and the content of hidden comments is cleared
+type CommentPayload struct {
+	ID           string                   `json:"id"`
+	ActivityID   uint                     `json:"activityID"`
+	ParentID     *string                  `json:"parentId"`
+	Content      string                   `json:"content"`
+	CreatedBy    CommentAuthor            `json:"createdBy"`
+	CreatedAt    time.Time                `json:"createdAt"`
+	Edited       bool                     `json:"edited"`
+	EditedAt     *time.Time               `json:"editedAt,omitempty"`
+	Reactions    []models.CommentReaction `json:"reactions"`
+	Hidden       bool                     `json:"hidden"`
+	HiddenReason string                   `json:"hiddenReason,omitempty"`
+	Replies      []CommentPayload         `json:"replies"`
+}
+
+// CommentAuthor names the author of a comment without their email
+type CommentAuthor struct {
+	Handle string  `json:"handle"`
+	Name   *string `json:"name,omitempty"`
+}
+
+func NewCommentPayload(comment *models.Comment) CommentPayload {
+	payload := CommentPayload{
+		ID:           comment.ID,
+		ActivityID:   comment.ActivityID,
+		ParentID:     comment.ParentID,
+		Content:      comment.Content,
+		CreatedBy:    CommentAuthor{Handle: comment.CreatedByHandle, Name: comment.CreatedBy.Name},
+		CreatedAt:    comment.CreatedAt,
+		Edited:       comment.Edited,
+		EditedAt:     comment.EditedAt,
+		Reactions:    comment.Reactions,
+		Hidden:       comment.Hidden,
+		HiddenReason: comment.HiddenReason,
+		Replies:      make([]CommentPayload, 0, len(comment.Replies)),
+	}
+	if comment.Hidden {
+		payload.Content = ""
+	}
+
+	for i := range comment.Replies {
+		payload.Replies = append(payload.Replies, NewCommentPayload(&comment.Replies[i]))
+	}
+	return payload
+}
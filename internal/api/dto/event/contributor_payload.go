@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// ContributorPayload is a contributor as members see them, Email and Payment are only sent to privileged members
+type ContributorPayload struct {
+	ID               uint                    `json:"id"`
+	CampaignID       string                  `json:"campaignId"`
+	Name             string                  `json:"name"`
+	Amount           float64                 `json:"amount"`
+	PaymentStatus    models.PaymentStatus    `json:"paymentStatus,omitempty"`
+	InvitationStatus models.InvitationStatus `json:"invitationStatus"`
+	ActivityIDs      []uint                  `json:"activityIds"`
+
+	Email   string          `json:"email,omitempty"`
+	Payment *PaymentPayload `json:"payment,omitempty"`
+}
+
+// PaymentPayload is the payment of a contributor, only privileged members receive it
+type PaymentPayload struct {
+	Reference       string                     `json:"reference"`
+	Amount          float64                    `json:"amount"`
+	PaymentMethod   models.PaymentMethod       `json:"paymentMethod"`
+	PaymentStatus   models.PaymentStatus       `json:"paymentStatus"`
+	GatewayResponse *string                    `json:"gatewayResponse,omitempty"`
+	PaymentProof    *models.ManualPaymentProof `json:"paymentProof,omitempty"`
+	CreatedAt       time.Time                  `json:"createdAt"`
+}
+
+func NewContributorPayload(contributor *models.Contributor, privileged bool) ContributorPayload {
+	payload := ContributorPayload{
+		ID:               contributor.ID,
+		CampaignID:       contributor.CampaignID,
+		Name:             contributor.Name,
+		Amount:           contributor.Amount,
+		InvitationStatus: contributor.Invitation.Status,
+		ActivityIDs:      make([]uint, 0, len(contributor.Activities)),
+	}
+	for _, activity := range contributor.Activities {
+		payload.ActivityIDs = append(payload.ActivityIDs, activity.ID)
+	}
+	if contributor.Payment != nil {
+		payload.PaymentStatus = contributor.Payment.PaymentStatus
+	}
+
+	if !privileged {
+		return payload
+	}
+
+	payload.Email = contributor.Email
+	if payment := contributor.Payment; payment != nil {
+		payload.Payment = &PaymentPayload{
+			Reference:       payment.Reference,
+			Amount:          payment.Amount,
+			PaymentMethod:   payment.PaymentMethod,
+			PaymentStatus:   payment.PaymentStatus,
+			GatewayResponse: payment.GatewayResponse,
+			PaymentProof:    payment.PaymentProof,
+			CreatedAt:       payment.CreatedAt,
+		}
+	}
+	return payload
+}
+
+func newContributorPayloads(contributors []models.Contributor, privileged bool) []ContributorPayload {
+	payloads := make([]ContributorPayload, 0, len(contributors))
+	for i := range contributors {
+		payloads = append(payloads, NewContributorPayload(&contributors[i], privileged))
+	}
+	return payloads
+}
+
+// ContributorRequestPayload is a contributor's request to leave or change their amount,
+// members only see its status and privileged members see the details
+type ContributorRequestPayload struct {
+	ID            uint                            `json:"id"`
+	CampaignID    string                          `json:"campaignId"`
+	ContributorID uint                            `json:"contributorId"`
+	Type          models.ContributorRequestType   `json:"type"`
+	Status        models.ContributorRequestStatus `json:"status"`
+	CreatedAt     time.Time                       `json:"createdAt"`
+	ReviewedAt    *time.Time                      `json:"reviewedAt,omitempty"`
+
+	Email           string   `json:"email,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+	Amount          float64  `json:"amount,omitempty"`
+	RequestedAmount *float64 `json:"requestedAmount,omitempty"`
+	RefundIssued    bool     `json:"refundIssued,omitempty"`
+	ReviewedBy      *string  `json:"reviewedBy,omitempty"`
+	ReviewNote      string   `json:"reviewNote,omitempty"`
+}
+
+func NewContributorRequestPayload(request *models.ContributorRequest, privileged bool) ContributorRequestPayload {
+	payload := ContributorRequestPayload{
+		ID:            request.ID,
+		CampaignID:    request.CampaignID,
+		ContributorID: request.ContributorID,
+		Type:          request.Type,
+		Status:        request.Status,
+		CreatedAt:     request.CreatedAt,
+		ReviewedAt:    request.ReviewedAt,
+	}
+
+	if privileged {
+		payload.Email = request.Email
+		payload.Reason = request.Reason
+		payload.Amount = request.Amount
+		payload.RequestedAmount = request.RequestedAmount
+		payload.RefundIssued = request.RefundIssued
+		payload.ReviewedBy = request.ReviewedByHandle
+		payload.ReviewNote = request.ReviewNote
+	}
+	return payload
+}
@@ -0,0 +1,82 @@
+// Package dto holds the payloads of the realtime campaign events.
+//
+// Models are never broadcast as they are, each is turned into a payload listing what members may see so fields
+// added to a model later aren't broadcast by accident. Events with private details also get a privileged payload,
+// it is sent to the campaign owner and treasurer, who see the contributors' emails and payment details.
+//
+// Payloads by event type:
+//   - campaign_updated: CampaignPayload
+//   - contribution_created, contributor_updated, contributor_deleted: ContributorPayload
+//   - activity_created, activity_updated: ActivityPayload
+//   - payout_created, payout_updated: PayoutPayload
+//   - contributor_request_updated: ContributorRequestPayload
+//   - campaign_role_updated: CampaignRolePayload
+//   - comment_created, comment_updated: CommentPayload
+//   - activity_deleted, comment_deleted: the ID
+//   - the poll, vote, budget, milestone and chat events send their models, they hold nothing private
+//
+// Any other model is refused, it needs a payload before it can be broadcast.
+package dto
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+// modelsPackage is the package of the models, they can't be broadcast without a payload
+var modelsPackage = reflect.TypeOf(models.Campaign{}).PkgPath()
+
+// Payload is the data of an event, Privileged is nil when everyone gets Members
+type Payload struct {
+	Members    interface{}
+	Privileged interface{}
+}
+
+// NewPayload builds the payload of an event from the data a service broadcast.
+// It fails for models without a payload so they are never broadcast as they are
+func NewPayload(data interface{}) (Payload, error) {
+	switch data := data.(type) {
+	case *models.Campaign:
+		return Payload{Members: NewCampaignPayload(data, false), Privileged: NewCampaignPayload(data, true)}, nil
+	case models.Campaign:
+		return NewPayload(&data)
+	case *models.Contributor:
+		return Payload{Members: NewContributorPayload(data, false), Privileged: NewContributorPayload(data, true)}, nil
+	case models.Contributor:
+		return NewPayload(&data)
+	case *models.Activity:
+		return Payload{Members: NewActivityPayload(data, false), Privileged: NewActivityPayload(data, true)}, nil
+	case models.Activity:
+		return NewPayload(&data)
+	case *models.Payout:
+		return Payload{Members: NewPayoutPayload(data, false), Privileged: NewPayoutPayload(data, true)}, nil
+	case models.Payout:
+		return NewPayload(&data)
+	case *models.ContributorRequest:
+		return Payload{Members: NewContributorRequestPayload(data, false), Privileged: NewContributorRequestPayload(data, true)}, nil
+	case *models.CampaignUserRole:
+		return Payload{Members: NewCampaignRolePayload(data, false), Privileged: NewCampaignRolePayload(data, true)}, nil
+	case *models.Comment:
+		return Payload{Members: NewCommentPayload(data)}, nil
+	case models.Comment:
+		return NewPayload(&data)
+	case models.Poll, *models.Poll, models.ActivityVoteTally, models.ActivityBudgetReport, models.CampaignMilestone,
+		*models.ChatMessage, *models.ChatReceipt, models.ChatTyping:
+		return Payload{Members: data}, nil
+	default:
+		if isModel(reflect.TypeOf(data)) {
+			return Payload{}, fmt.Errorf("no event payload for %T", data)
+		}
+		return Payload{Members: data}, nil
+	}
+}
+
+// isModel reports whether t is a model, a pointer to one or a slice of them
+func isModel(t reflect.Type) bool {
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	return t != nil && t.PkgPath() == modelsPackage
+}
@@ -0,0 +1,29 @@
+package dto
+
+// CreateWebhookRequest represents the payload to register a campaign webhook
+// @Description Webhook registration request structure
+type CreateWebhookRequest struct {
+	// @Description HTTPS endpoint the events are posted to
+	// @example "https://example.com/hooks/gofundit"
+	URL string `json:"url" binding:"required,max=2048"`
+
+	// @Description Campaign event types delivered to the endpoint, the same types the websocket sends
+	// @example ["contributor_updated", "comment_created"]
+	EventTypes []string `json:"eventTypes" binding:"required,min=1,dive,required"`
+}
+
+// UpdateWebhookRequest represents the payload to change a campaign webhook, fields left out are kept
+// @Description Webhook update request structure
+type UpdateWebhookRequest struct {
+	// @Description HTTPS endpoint the events are posted to
+	// @example "https://example.com/hooks/gofundit"
+	URL *string `json:"url" binding:"omitempty,max=2048"`
+
+	// @Description Campaign event types delivered to the endpoint
+	// @example ["payout_updated"]
+	EventTypes []string `json:"eventTypes" binding:"omitempty,min=1,dive,required"`
+
+	// @Description Turns the webhook on or off, enabling a webhook that was disabled after failed deliveries clears its failure count
+	// @example true
+	Enabled *bool `json:"enabled"`
+}
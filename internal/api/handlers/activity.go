@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/activity"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
 )
@@ -49,7 +50,7 @@ func (a *ActivityHandler) HandleCreateActivity(c *gin.Context) {
 }
 
 // @Summary Get Activities
-// @Description Retrieves all activities for a campaign
+// @Description Retrieves all activities for a campaign, each with the number of comments the user hasn't read
 // @Tags activity
 // @Accept json
 // @Produce json
@@ -62,8 +63,9 @@ func (a *ActivityHandler) HandleCreateActivity(c *gin.Context) {
 // @Router /activity/{campaignID} [get]
 func (a *ActivityHandler) HandleGetActivitiesByCampaignID(c *gin.Context) {
 	campaignID := GetCampaignID(c)
+	claims := getClaimsFromContext(c)
 
-	activities, err := a.service.GetActivitiesByCampaignID(campaignID)
+	activities, err := a.service.GetActivitiesByCampaignID(campaignID, claims.Handle)
 	if err != nil {
 		FromError(c, err)
 		return
@@ -207,7 +209,7 @@ func (a *ActivityHandler) HandleDeleteActivityByID(c *gin.Context) {
 }
 
 // @Summary Opt In Contributor
-// @Description Opts in a contributor to an activity
+// @Description Opts in a contributor to an activity, contributors join the waitlist of a full activity and get the first free seat. Opt-ins close at the sign-up deadline
 // @Tags activity
 // @Accept json
 // @Produce json
@@ -234,16 +236,21 @@ func (a *ActivityHandler) HandleOptInContributor(c *gin.Context) {
 		return
 	}
 
-	if err := a.service.OptInContributor(campaignID, claims.Email, getCampaignKey(c), activityID, contributorID); err != nil {
+	waitlisted, err := a.service.OptInContributor(campaignID, claims.Email, getCampaignKey(c), activityID, contributorID)
+	if err != nil {
 		FromError(c, err)
 		return
 	}
 
+	if waitlisted {
+		Success(c, "Activity is full, contributor added to the waitlist", nil)
+		return
+	}
 	Success(c, "Contributor opted in successfully", nil)
 }
 
 // @Summary Opt Out Contributor
-// @Description Opts out a contributor from an activity
+// @Description Opts out a contributor from an activity or takes them off its waitlist, the freed seat goes to the first contributor on the waitlist
 // @Tags activity
 // @Accept json
 // @Produce json
@@ -278,6 +285,88 @@ func (a *ActivityHandler) HandleOptOutContributor(c *gin.Context) {
 	Success(c, "Contributor opted out successfully", nil)
 }
 
+// @Summary Vote On Activity
+// @Description Records a contributor's vote on a proposed activity, campaigns with an activity vote threshold approve the activity once enough contributors approve it
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param request body dto.ActivityVoteRequest true "Vote Details"
+// @Success 200 {object} SuccessResponse{data=models.Activity} "Vote recorded successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign contributors can vote on activities"
+// @Failure 404 {object} response "Activity not found"
+// @Router /activity/{campaignID}/{activityID}/votes [post]
+func (a *ActivityHandler) HandleVoteOnActivity(c *gin.Context) {
+	var requestDTO dto.ActivityVoteRequest
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	activity, err := a.service.VoteOnActivity(activityID, GetCampaignID(c), claims.Email, getCampaignKey(c), *requestDTO.Approve)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	message := "Vote recorded successfully"
+	if activity.IsApproved {
+		message = "Vote recorded, the activity has been approved"
+	}
+	Success(c, message, activity)
+}
+
+// @Summary Update Activity Split
+// @Description Changes how the cost of an activity is split among its participants: the full cost per person, equally, by weight or by fixed amounts. The campaign target amount is recalculated
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param request body dto.ActivitySplitRequest true "Split Details"
+// @Success 200 {object} SuccessResponse{data=models.Activity} "Activity split updated successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can change how an activity is split"
+// @Failure 404 {object} response "Activity not found"
+// @Router /activity/{campaignID}/{activityID}/split [put]
+func (a *ActivityHandler) HandleUpdateActivitySplit(c *gin.Context) {
+	var requestDTO dto.ActivitySplitRequest
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	activity, err := a.service.UpdateActivitySplit(activityID, GetCampaignID(c), claims.Handle, getCampaignKey(c), requestDTO.Strategy, requestDTO.ToShares())
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Activity split updated successfully", activity)
+}
+
 // @Summary Get Participants
 // @Description Retrieves all participants for an activity
 // @Tags activity
@@ -56,7 +56,8 @@ func TestHandleGetActivitiesByCampaignID(t *testing.T) {
 					IsApproved:   false,
 					Contributors: nil,
 				}}
-				m.EXPECT().GetActivitiesByCampaignID("campaign123").Return(activities, nil)
+				activities[0].UnreadComments = 2
+				m.EXPECT().GetActivitiesByCampaignID("campaign123", "testuser").Return(activities, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: `{
@@ -69,7 +70,8 @@ func TestHandleGetActivitiesByCampaignID(t *testing.T) {
 					"cost": 0,
 					"isMandatory": false,
 					"isApproved": false,
-					"contributors": null
+					"contributors": null,
+					"unreadComments": 2
 				}],
 				"message": "Activities fetched successfully",
 				"status": "OK"
@@ -79,7 +81,7 @@ func TestHandleGetActivitiesByCampaignID(t *testing.T) {
 			name:       "Service Error",
 			campaignID: "campaign123",
 			setupMock: func(m *mocks.MockActivityService) {
-				m.EXPECT().GetActivitiesByCampaignID("campaign123").Return(nil, assert.AnError)
+				m.EXPECT().GetActivitiesByCampaignID("campaign123", "testuser").Return(nil, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   `{"message":"assert.AnError general error for testing","status":"Internal Server Error"}`,
@@ -272,3 +274,134 @@ func TestHandleGetActivityByID(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUpdateActivitySplit(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockActivityService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"strategy":"weighted","shares":[{"contributorId":1,"weight":2}]}`,
+			setupMock: func(m *mocks.MockActivityService) {
+				shares := []models.ActivityShare{{ContributorID: 1, Weight: 2}}
+				m.EXPECT().UpdateActivitySplit(uint(1), "campaign123", "testuser", "test-campaign-key", models.SplitStrategyWeighted, shares).
+					Return(&models.Activity{ID: 1, SplitStrategy: models.SplitStrategyWeighted, Shares: shares}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown Strategy",
+			body:           `{"strategy":"random"}`,
+			setupMock:      func(m *mocks.MockActivityService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Weight",
+			body:           `{"strategy":"weighted","shares":[{"contributorId":1,"weight":-1}]}`,
+			setupMock:      func(m *mocks.MockActivityService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Service Error",
+			body: `{"strategy":"equal"}`,
+			setupMock: func(m *mocks.MockActivityService) {
+				m.EXPECT().UpdateActivitySplit(uint(1), "campaign123", "testuser", "test-campaign-key", models.SplitStrategyEqual, []models.ActivityShare{}).
+					Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService, handler, router := setupActivityTest()
+			tt.setupMock(mockService)
+
+			router.PUT("/activities/:campaignID/:activityID/split", func(c *gin.Context) {
+				setupAuthMiddleware(c)
+				handler.HandleUpdateActivitySplit(c)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, "/activities/campaign123/1/split", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestHandleVoteOnActivity(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		setupMock       func(*mocks.MockActivityService)
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name: "Success",
+			body: `{"approve":true}`,
+			setupMock: func(m *mocks.MockActivityService) {
+				m.EXPECT().VoteOnActivity(uint(1), "campaign123", "test@example.com", "test-campaign-key", true).
+					Return(&models.Activity{ID: 1}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Vote recorded successfully",
+		},
+		{
+			name: "Vote Approves Activity",
+			body: `{"approve":true}`,
+			setupMock: func(m *mocks.MockActivityService) {
+				m.EXPECT().VoteOnActivity(uint(1), "campaign123", "test@example.com", "test-campaign-key", true).
+					Return(&models.Activity{ID: 1, IsApproved: true}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Vote recorded, the activity has been approved",
+		},
+		{
+			name: "Rejection",
+			body: `{"approve":false}`,
+			setupMock: func(m *mocks.MockActivityService) {
+				m.EXPECT().VoteOnActivity(uint(1), "campaign123", "test@example.com", "test-campaign-key", false).
+					Return(&models.Activity{ID: 1}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Vote recorded successfully",
+		},
+		{
+			name:            "Missing Vote",
+			body:            `{}`,
+			setupMock:       func(m *mocks.MockActivityService) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid inputs, please check and try again",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService, handler, router := setupActivityTest()
+			tt.setupMock(mockService)
+
+			router.POST("/activities/:campaignID/:activityID/votes", func(c *gin.Context) {
+				setupAuthMiddleware(c)
+				handler.HandleVoteOnActivity(c)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/activities/campaign123/1/votes", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedMessage, response["message"])
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/ical"
+)
+
+type CalendarHandler struct {
+	service services.CalendarService
+}
+
+func NewCalendarHandler(service services.CalendarService) *CalendarHandler {
+	return &CalendarHandler{service: service}
+}
+
+// @Summary Create Calendar Feed
+// @Description Creates an iCalendar feed of the campaign's scheduled activities that calendar apps can subscribe to. The feed URL holds a token in place of the JWT, keep it private
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.CreateCalendarFeedRequest true "Calendar Feed Details"
+// @Success 200 {object} SuccessResponse{data=models.CalendarFeed} "Calendar feed created"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can subscribe to its calendar"
+// @Router /campaign/{campaignID}/calendar-feeds [post]
+func (h *CalendarHandler) HandleCreateCalendarFeed(c *gin.Context) {
+	var requestDTO dto.CreateCalendarFeedRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	feed, err := h.service.CreateCalendarFeed(GetCampaignID(c), getCampaignKey(c), claims.Email, requestDTO.Scope)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	feed.URL = calendarFeedURL(c, feed.Token)
+	Success(c, "Calendar feed created", feed)
+}
+
+// @Summary Get Calendar Feeds
+// @Description Retrieves the calendar feeds you created for the campaign that haven't been revoked
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.CalendarFeed} "Calendar feeds retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Router /campaign/{campaignID}/calendar-feeds [get]
+func (h *CalendarHandler) HandleGetCalendarFeeds(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	feeds, err := h.service.GetCalendarFeeds(GetCampaignID(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Calendar feeds retrieved successfully", feeds)
+}
+
+// @Summary Revoke Calendar Feed
+// @Description Revokes one of your calendar feeds, calendar apps subscribed to it stop receiving updates
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param feedID path string true "Calendar Feed ID"
+// @Success 200 {object} SuccessResponse "Calendar feed revoked"
+// @Failure 400 {object} BadRequestResponse "Invalid calendar feed ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Calendar feed not found"
+// @Router /campaign/{campaignID}/calendar-feeds/{feedID} [delete]
+func (h *CalendarHandler) HandleRevokeCalendarFeed(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	feedID, err := parseCalendarFeedID(c)
+	if err != nil {
+		BadRequest(c, "Invalid calendar feed ID", nil)
+		return
+	}
+
+	if err := h.service.RevokeCalendarFeed(feedID, GetCampaignID(c), claims.Email); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Calendar feed revoked", nil)
+}
+
+// @Summary Get Calendar Feed
+// @Description Renders the iCalendar document of a calendar feed, the feed token authenticates the request
+// @Tags campaign
+// @Produce text/calendar
+// @Param token path string true "Calendar feed token, optionally followed by .ics"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 404 {object} response "Calendar feed not found"
+// @Router /calendar/{token} [get]
+func (h *CalendarHandler) HandleGetCalendar(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	calendar, err := h.service.RenderCalendarFeed(token)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, ical.ContentType, []byte(calendar))
+}
+
+// calendarFeedURL builds the address calendar apps subscribe to from the host the request was sent to
+func calendarFeedURL(c *gin.Context, token string) string {
+	scheme := "https"
+	if c.Request.TLS == nil && c.GetHeader("X-Forwarded-Proto") != "https" {
+		scheme = "http"
+	}
+	return scheme + "://" + c.Request.Host + "/calendar/" + token + ".ics"
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/ical"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCalendarTest(t *testing.T) (*gin.Engine, *mocks.MockCalendarService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockCalendarService(t)
+	handler := NewCalendarHandler(mockService)
+
+	router.GET("/calendar/:token", handler.HandleGetCalendar)
+
+	protected := router.Group("/")
+	protected.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+	protected.POST("/campaign/:campaignID/calendar-feeds", handler.HandleCreateCalendarFeed)
+	protected.DELETE("/campaign/:campaignID/calendar-feeds/:feedID", handler.HandleRevokeCalendarFeed)
+
+	return router, mockService
+}
+
+func TestHandleCreateCalendarFeed(t *testing.T) {
+	router, mockService := setupCalendarTest(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockCalendarService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			body: `{"scope":"contributor"}`,
+			setupMock: func(ms *mocks.MockCalendarService) {
+				ms.On("CreateCalendarFeed", "123", "test-key", "test@example.com", models.CalendarFeedScopeContributor).
+					Return(&models.CalendarFeed{ID: 1, Token: "GC-token"}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Calendar feed created",
+		},
+		{
+			name:           "Invalid Scope",
+			body:           `{"scope":"everyone"}`,
+			setupMock:      func(ms *mocks.MockCalendarService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name: "Not A Contributor",
+			body: `{"scope":"contributor"}`,
+			setupMock: func(ms *mocks.MockCalendarService) {
+				ms.On("CreateCalendarFeed", "123", "test-key", "test@example.com", models.CalendarFeedScopeContributor).
+					Return(nil, errs.BadRequest("Only contributors can subscribe to their own activities", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Only contributors can subscribe to their own activities",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/calendar-feeds", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+
+			if tt.expectedCode == http.StatusOK {
+				data := response["data"].(map[string]interface{})
+				assert.Equal(t, "http://example.com/calendar/GC-token.ics", data["url"])
+			}
+		})
+	}
+}
+
+func TestHandleRevokeCalendarFeed(t *testing.T) {
+	router, mockService := setupCalendarTest(t)
+
+	tests := []struct {
+		name           string
+		feedID         string
+		setupMock      func(*mocks.MockCalendarService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:   "Success",
+			feedID: "1",
+			setupMock: func(ms *mocks.MockCalendarService) {
+				ms.On("RevokeCalendarFeed", uint(1), "123", "test@example.com").Return(nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Calendar feed revoked",
+		},
+		{
+			name:           "Invalid ID",
+			feedID:         "invalid",
+			setupMock:      func(ms *mocks.MockCalendarService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid calendar feed ID",
+		},
+		{
+			name:   "Not Found",
+			feedID: "2",
+			setupMock: func(ms *mocks.MockCalendarService) {
+				ms.On("RevokeCalendarFeed", uint(2), "123", "test@example.com").Return(errs.NotFound("Calendar feed not found"))
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedResult: "Calendar feed not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("DELETE", "/campaign/123/calendar-feeds/"+tt.feedID, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleGetCalendar(t *testing.T) {
+	router, mockService := setupCalendarTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		mockService.ExpectedCalls = nil
+		mockService.On("RenderCalendarFeed", "GC-token").Return("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", nil)
+
+		req := httptest.NewRequest("GET", "/calendar/GC-token.ics", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, ical.ContentType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", w.Body.String())
+	})
+
+	t.Run("Revoked Feed", func(t *testing.T) {
+		mockService.ExpectedCalls = nil
+		mockService.On("RenderCalendarFeed", "GC-revoked").Return("", errs.NotFound("Calendar feed not found"))
+
+		req := httptest.NewRequest("GET", "/calendar/GC-revoked", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -92,7 +92,7 @@ func (h *CampaignHandler) HandleUpdateCampaignByID(c *gin.Context) {
 		return
 	}
 
-	campaign, err := h.service.UpdateCampaign(requestDTO, campaignID, userHandle, getCampaignKey(c))
+	campaign, err := h.service.UpdateCampaign(requestDTO, campaignID, getCampaignKey(c), userHandle)
 	if err != nil {
 		FromError(c, err)
 		return
@@ -101,6 +101,59 @@ func (h *CampaignHandler) HandleUpdateCampaignByID(c *gin.Context) {
 	Success(c, "Campaign updated successfully", campaign)
 }
 
+// @Summary Update Campaign Visibility
+// @Description Makes a campaign public with a shareable slug, or private again
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.CampaignVisibilityRequest true "Campaign Visibility"
+// @Success 200 {object} SuccessResponse{data=models.Campaign} "Campaign visibility updated successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can change campaign visibility"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /campaign/{campaignID}/visibility [patch]
+func (h *CampaignHandler) HandleUpdateCampaignVisibility(c *gin.Context) {
+	var requestDTO dto.CampaignVisibilityRequest
+	userHandle := getUserHandle(c)
+	campaignID := GetCampaignID(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	campaign, err := h.service.UpdateCampaignVisibility(requestDTO.Visibility, campaignID, getCampaignKey(c), userHandle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign visibility updated successfully", campaign)
+}
+
+// @Summary Get Public Campaign
+// @Description Retrieves the read-only summary of a public campaign, no authentication or campaign key is required
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param slug path string true "Campaign Slug"
+// @Success 200 {object} SuccessResponse{data=dto.PublicCampaignResponse} "Campaign retrieved successfully"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /public/campaign/{slug} [get]
+func (h *CampaignHandler) HandleGetPublicCampaign(c *gin.Context) {
+	campaign, err := h.service.GetPublicCampaignBySlug(c.Param("slug"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign retrieved successfully", dto.NewPublicCampaignResponse(campaign))
+}
+
 // Helper Functions -----------------------------------------------------------------
 
 func getUserHandle(c *gin.Context) string {
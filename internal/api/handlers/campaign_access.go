@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type CampaignAccessHandler struct {
+	service services.CampaignAccessService
+}
+
+func NewCampaignAccessHandler(service services.CampaignAccessService) *CampaignAccessHandler {
+	return &CampaignAccessHandler{
+		service: service,
+	}
+}
+
+// @Summary Reissue Contributor Campaign Key
+// @Description Revokes a contributor's current campaign key and emails them a new one
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param contributorID path string true "Contributor ID"
+// @Success 200 {object} SuccessResponse "Campaign key reissued"
+// @Failure 400 {object} BadRequestResponse "Invalid contributor ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can manage campaign keys"
+// @Failure 404 {object} response "Contributor or Campaign not found"
+// @Router /campaign/{campaignID}/keys/{contributorID} [post]
+func (h *CampaignAccessHandler) HandleReissueContributorKey(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+	campaignID := GetCampaignID(c)
+
+	contributorID, err := parseContributorID(c)
+	if err != nil {
+		BadRequest(c, "Invalid contributor ID", nil)
+		return
+	}
+
+	if err := h.service.ReissueContributorKey(campaignID, contributorID, getCampaignKey(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Campaign key reissued", nil)
+}
+
+// @Summary Revoke Contributor Campaign Key
+// @Description Revokes a contributor's campaign key without affecting other members
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param contributorID path string true "Contributor ID"
+// @Success 200 {object} SuccessResponse "Campaign key revoked"
+// @Failure 400 {object} BadRequestResponse "Invalid contributor ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can manage campaign keys"
+// @Failure 404 {object} response "Contributor or Campaign not found"
+// @Router /campaign/{campaignID}/keys/{contributorID} [delete]
+func (h *CampaignAccessHandler) HandleRevokeContributorKey(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+	campaignID := GetCampaignID(c)
+
+	contributorID, err := parseContributorID(c)
+	if err != nil {
+		BadRequest(c, "Invalid contributor ID", nil)
+		return
+	}
+
+	if err := h.service.RevokeContributorKey(campaignID, contributorID, claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Campaign key revoked", nil)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCampaignAccessTest(t *testing.T) (*gin.Engine, *mocks.MockCampaignAccessService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockCampaignAccessService(t)
+	handler := NewCampaignAccessHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/campaign/:campaignID/keys/:contributorID", handler.HandleReissueContributorKey)
+	router.DELETE("/campaign/:campaignID/keys/:contributorID", handler.HandleRevokeContributorKey)
+
+	return router, mockService
+}
+
+func TestHandleReissueContributorKey(t *testing.T) {
+	router, mockService := setupCampaignAccessTest(t)
+
+	tests := []struct {
+		name           string
+		contributorID  string
+		setupMock      func(*mocks.MockCampaignAccessService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:          "Success",
+			contributorID: "1",
+			setupMock: func(ms *mocks.MockCampaignAccessService) {
+				ms.On("ReissueContributorKey", "123", uint(1), "test-key", "testuser").Return(nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Campaign key reissued",
+		},
+		{
+			name:           "Invalid ID",
+			contributorID:  "invalid",
+			setupMock:      func(ms *mocks.MockCampaignAccessService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid contributor ID",
+		},
+		{
+			name:          "Not Creator",
+			contributorID: "1",
+			setupMock: func(ms *mocks.MockCampaignAccessService) {
+				ms.On("ReissueContributorKey", "123", uint(1), "test-key", "testuser").
+					Return(errs.Forbidden("Only campaign organisers can manage campaign keys"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign organisers can manage campaign keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/keys/"+tt.contributorID, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleRevokeContributorKey(t *testing.T) {
+	router, mockService := setupCampaignAccessTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockCampaignAccessService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			setupMock: func(ms *mocks.MockCampaignAccessService) {
+				ms.On("RevokeContributorKey", "123", uint(1), "testuser").Return(nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Campaign key revoked",
+		},
+		{
+			name: "Service Error",
+			setupMock: func(ms *mocks.MockCampaignAccessService) {
+				ms.On("RevokeContributorKey", "123", uint(1), "testuser").Return(errors.New("service error"))
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedResult: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("DELETE", "/campaign/123/keys/1", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
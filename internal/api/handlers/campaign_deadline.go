@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type CampaignDeadlineHandler struct {
+	service services.CampaignDeadlineService
+}
+
+func NewCampaignDeadlineHandler(service services.CampaignDeadlineService) *CampaignDeadlineHandler {
+	return &CampaignDeadlineHandler{service: service}
+}
+
+// @Summary Extend Campaign
+// @Description Extends the end date of a campaign, campaigns requiring approval wait for a majority of contributors to approve it
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.CampaignExtensionRequest true "New End Date"
+// @Success 200 {object} SuccessResponse{data=models.CampaignExtension} "Campaign extension created"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can change the campaign end date"
+// @Router /campaign/{campaignID}/extend [post]
+func (h *CampaignDeadlineHandler) HandleExtendCampaign(c *gin.Context) {
+	var requestDTO dto.CampaignExtensionRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	extension, err := h.service.ExtendCampaign(requestDTO.EndDate, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign extension created", extension)
+}
+
+// @Summary Vote on Campaign Extension
+// @Description Records a contributor's vote on an extension awaiting approval
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param extensionID path string true "Extension ID"
+// @Param request body dto.ExtensionVoteRequest true "Vote"
+// @Success 200 {object} SuccessResponse{data=models.CampaignExtension} "Vote recorded"
+// @Failure 400 {object} BadRequestResponse "Invalid extension ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign contributors can vote on extensions"
+// @Failure 404 {object} response "Extension not found"
+// @Router /campaign/{campaignID}/extensions/{extensionID}/vote [post]
+func (h *CampaignDeadlineHandler) HandleVoteOnExtension(c *gin.Context) {
+	var requestDTO dto.ExtensionVoteRequest
+	claims := getClaimsFromContext(c)
+
+	extensionID, err := parseExtensionID(c)
+	if err != nil {
+		BadRequest(c, "Invalid extension ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	extension, err := h.service.VoteOnExtension(extensionID, *requestDTO.Approve, GetCampaignID(c), getCampaignKey(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Vote recorded", extension)
+}
+
+// @Summary Close Campaign Early
+// @Description Ends an active campaign before its end date
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=models.Campaign} "Campaign closed"
+// @Failure 400 {object} BadRequestResponse "Campaign cannot be closed"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can change the campaign end date"
+// @Router /campaign/{campaignID}/close [post]
+func (h *CampaignDeadlineHandler) HandleCloseCampaignEarly(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	campaign, err := h.service.CloseCampaignEarly(GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign closed", campaign)
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCampaignDeadlineTest(t *testing.T) (*gin.Engine, *mocks.MockCampaignDeadlineService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockCampaignDeadlineService(t)
+	handler := NewCampaignDeadlineHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/campaign/:campaignID/extend", handler.HandleExtendCampaign)
+	router.POST("/campaign/:campaignID/extensions/:extensionID/vote", handler.HandleVoteOnExtension)
+	router.POST("/campaign/:campaignID/close", handler.HandleCloseCampaignEarly)
+
+	return router, mockService
+}
+
+func TestHandleExtendCampaign(t *testing.T) {
+	router, mockService := setupCampaignDeadlineTest(t)
+	endDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mocks.MockCampaignDeadlineService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:        "Success",
+			requestBody: map[string]interface{}{"endDate": endDate},
+			setupMock: func(ms *mocks.MockCampaignDeadlineService) {
+				ms.On("ExtendCampaign", mock.MatchedBy(func(d time.Time) bool { return d.Equal(endDate) }), "123", "test-key", "testuser").
+					Return(&models.CampaignExtension{Status: models.CampaignExtensionStatusApproved}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Campaign extension created",
+		},
+		{
+			name:           "Missing End Date",
+			requestBody:    map[string]interface{}{},
+			setupMock:      func(ms *mocks.MockCampaignDeadlineService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name:        "Invalid End Date",
+			requestBody: map[string]interface{}{"endDate": endDate},
+			setupMock: func(ms *mocks.MockCampaignDeadlineService) {
+				ms.On("ExtendCampaign", mock.Anything, "123", "test-key", "testuser").
+					Return(nil, errs.BadRequest("Cannot extend campaign: new end date must be after the current end date", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Cannot extend campaign: new end date must be after the current end date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/campaign/123/extend", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleVoteOnExtension(t *testing.T) {
+	router, mockService := setupCampaignDeadlineTest(t)
+
+	tests := []struct {
+		name           string
+		extensionID    string
+		requestBody    interface{}
+		setupMock      func(*mocks.MockCampaignDeadlineService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:        "Success",
+			extensionID: "1",
+			requestBody: map[string]interface{}{"approve": false},
+			setupMock: func(ms *mocks.MockCampaignDeadlineService) {
+				ms.On("VoteOnExtension", uint(1), false, "123", "test-key", "test@example.com").
+					Return(&models.CampaignExtension{Status: models.CampaignExtensionStatusPending}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Vote recorded",
+		},
+		{
+			name:           "Invalid ID",
+			extensionID:    "invalid",
+			requestBody:    map[string]interface{}{"approve": true},
+			setupMock:      func(ms *mocks.MockCampaignDeadlineService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid extension ID",
+		},
+		{
+			name:        "Not Contributor",
+			extensionID: "1",
+			requestBody: map[string]interface{}{"approve": true},
+			setupMock: func(ms *mocks.MockCampaignDeadlineService) {
+				ms.On("VoteOnExtension", uint(1), true, "123", "test-key", "test@example.com").
+					Return(nil, errs.Forbidden("Only campaign contributors can vote on extensions"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign contributors can vote on extensions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/campaign/123/extensions/"+tt.extensionID+"/vote", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleCloseCampaignEarly(t *testing.T) {
+	router, mockService := setupCampaignDeadlineTest(t)
+
+	mockService.On("CloseCampaignEarly", "123", "test-key", "testuser").
+		Return(&models.Campaign{ID: "123"}, nil)
+
+	req := httptest.NewRequest("POST", "/campaign/123/close", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Campaign closed", response["message"])
+}
@@ -11,6 +11,7 @@ import (
 	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -198,7 +199,7 @@ func TestHandleUpdateCampaignByID(t *testing.T) {
 			campaignID:  "test-campaign",
 			requestBody: updateRequest,
 			setupMock: func(m *mocks.MockCampaignService) {
-				m.EXPECT().UpdateCampaign(mock.AnythingOfType("dto.CampaignUpdateRequest"), "test-campaign", "test-user", "test-key").
+				m.EXPECT().UpdateCampaign(mock.AnythingOfType("dto.CampaignUpdateRequest"), "test-campaign", "test-key", "test-user").
 					Return(&models.Campaign{Title: "Updated Title"}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -217,7 +218,7 @@ func TestHandleUpdateCampaignByID(t *testing.T) {
 			campaignID:  "test-campaign",
 			requestBody: updateRequest,
 			setupMock: func(m *mocks.MockCampaignService) {
-				m.EXPECT().UpdateCampaign(mock.AnythingOfType("dto.CampaignUpdateRequest"), "test-campaign", "test-user", "test-key").
+				m.EXPECT().UpdateCampaign(mock.AnythingOfType("dto.CampaignUpdateRequest"), "test-campaign", "test-key", "test-user").
 					Return(nil, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -249,3 +250,105 @@ func TestHandleUpdateCampaignByID(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUpdateCampaignVisibility(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mocks.MockCampaignService)
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: dto.CampaignVisibilityRequest{Visibility: models.CampaignVisibilityPublic},
+			setupMock: func(m *mocks.MockCampaignService) {
+				m.EXPECT().UpdateCampaignVisibility(models.CampaignVisibilityPublic, "test-campaign", "test-key", "test-user").
+					Return(&models.Campaign{Visibility: models.CampaignVisibilityPublic}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Visibility",
+			requestBody:    map[string]string{"visibility": "hidden"},
+			setupMock:      func(m *mocks.MockCampaignService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockCampaignService(t)
+			tt.setupMock(mockService)
+
+			handler := NewCampaignHandler(mockService)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request, _ = http.NewRequest(http.MethodPatch, "/", bytes.NewBuffer(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = []gin.Param{{Key: "campaignID", Value: "test-campaign"}}
+			c.Set("claims", jwt.Claims{Handle: "test-user"})
+			c.Set("Campaign-Key", "test-key")
+
+			handler.HandleUpdateCampaignVisibility(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestHandleGetPublicCampaign(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockCampaignService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			setupMock: func(m *mocks.MockCampaignService) {
+				m.EXPECT().GetPublicCampaignBySlug("community-event-abc123").
+					Return(&models.Campaign{
+						Title:        "Community Event",
+						CreatedBy:    models.User{Handle: "creator", Email: "creator@example.com"},
+						Contributors: []models.Contributor{{Email: "member@example.com"}},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Campaign Not Found",
+			setupMock: func(m *mocks.MockCampaignService) {
+				m.EXPECT().GetPublicCampaignBySlug("community-event-abc123").
+					Return(nil, errs.NotFound("Campaign not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockCampaignService(t)
+			tt.setupMock(mockService)
+
+			handler := NewCampaignHandler(mockService)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request, _ = http.NewRequest(http.MethodGet, "/", nil)
+			c.Params = []gin.Param{{Key: "slug", Value: "community-event-abc123"}}
+
+			handler.HandleGetPublicCampaign(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			// Contributor emails are never exposed on public pages
+			assert.NotContains(t, w.Body.String(), "@example.com")
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type CampaignRoleHandler struct {
+	service services.CampaignRoleService
+}
+
+func NewCampaignRoleHandler(service services.CampaignRoleService) *CampaignRoleHandler {
+	return &CampaignRoleHandler{service: service}
+}
+
+// @Summary Get Campaign Roles
+// @Description Lists the campaign owner and the members holding a role, other contributors are members
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.CampaignUserRole} "Campaign roles retrieved"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /campaign/{campaignID}/roles [get]
+func (h *CampaignRoleHandler) HandleGetCampaignRoles(c *gin.Context) {
+	roles, err := h.service.GetCampaignRoles(GetCampaignID(c), getCampaignKey(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign roles retrieved", roles)
+}
+
+// @Summary Assign Campaign Role
+// @Description Gives a campaign member the co-organiser, treasurer, member or viewer role, a campaign has a single treasurer
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.AssignRoleRequest true "Role"
+// @Success 200 {object} SuccessResponse{data=models.CampaignUserRole} "Campaign role assigned"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage roles"
+// @Failure 404 {object} response "User is not part of this campaign"
+// @Router /campaign/{campaignID}/roles [put]
+func (h *CampaignRoleHandler) HandleAssignRole(c *gin.Context) {
+	var requestDTO dto.AssignRoleRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	role, err := h.service.AssignRole(requestDTO.Email, requestDTO.Role, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign role assigned", role)
+}
+
+// @Summary Transfer Campaign Ownership
+// @Description Makes a campaign member the owner, the previous owner becomes a co-organiser and is issued a member key
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.TransferOwnershipRequest true "New Owner"
+// @Success 200 {object} SuccessResponse{data=dto.TransferOwnershipResponse} "Campaign ownership transferred"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage roles"
+// @Failure 404 {object} response "User is not part of this campaign"
+// @Router /campaign/{campaignID}/transfer-ownership [post]
+func (h *CampaignRoleHandler) HandleTransferOwnership(c *gin.Context) {
+	var requestDTO dto.TransferOwnershipRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	accessKey, err := h.service.TransferOwnership(requestDTO.Email, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Campaign ownership transferred", dto.TransferOwnershipResponse{AccessKey: accessKey})
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCampaignRoleTest(t *testing.T) (*gin.Engine, *mocks.MockCampaignRoleService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockCampaignRoleService(t)
+	handler := NewCampaignRoleHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.GET("/campaign/:campaignID/roles", handler.HandleGetCampaignRoles)
+	router.PUT("/campaign/:campaignID/roles", handler.HandleAssignRole)
+	router.POST("/campaign/:campaignID/transfer-ownership", handler.HandleTransferOwnership)
+
+	return router, mockService
+}
+
+func TestHandleGetCampaignRoles(t *testing.T) {
+	router, mockService := setupCampaignRoleTest(t)
+
+	mockService.On("GetCampaignRoles", "123", "test-key").
+		Return([]models.CampaignUserRole{{UserHandle: "testuser", Role: models.CampaignRoleOwner}}, nil)
+
+	req := httptest.NewRequest("GET", "/campaign/123/roles", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Campaign roles retrieved", response["message"])
+}
+
+func TestHandleAssignRole(t *testing.T) {
+	router, mockService := setupCampaignRoleTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mocks.MockCampaignRoleService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:        "Success",
+			requestBody: map[string]interface{}{"email": "member@example.com", "role": "treasurer"},
+			setupMock: func(ms *mocks.MockCampaignRoleService) {
+				ms.On("AssignRole", "member@example.com", models.CampaignRoleTreasurer, "123", "test-key", "testuser").
+					Return(&models.CampaignUserRole{Role: models.CampaignRoleTreasurer}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Campaign role assigned",
+		},
+		{
+			name:           "Invalid Role",
+			requestBody:    map[string]interface{}{"email": "member@example.com", "role": "owner"},
+			setupMock:      func(ms *mocks.MockCampaignRoleService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name:        "Not Owner",
+			requestBody: map[string]interface{}{"email": "member@example.com", "role": "viewer"},
+			setupMock: func(ms *mocks.MockCampaignRoleService) {
+				ms.On("AssignRole", "member@example.com", models.CampaignRoleViewer, "123", "test-key", "testuser").
+					Return(nil, errs.Forbidden("Only the campaign owner can manage roles"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only the campaign owner can manage roles",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("PUT", "/campaign/123/roles", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleTransferOwnership(t *testing.T) {
+	router, mockService := setupCampaignRoleTest(t)
+
+	mockService.On("TransferOwnership", "member@example.com", "123", "test-key", "testuser").
+		Return("GM-previous-owner", nil)
+
+	body, _ := json.Marshal(map[string]interface{}{"email": "member@example.com"})
+	req := httptest.NewRequest("POST", "/campaign/123/transfer-ownership", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Campaign ownership transferred", response["message"])
+	assert.Equal(t, "GM-previous-owner", response["data"].(map[string]interface{})["accessKey"])
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type ChatHandler struct {
+	service services.ChatService
+}
+
+func NewChatHandler(service services.ChatService) *ChatHandler {
+	return &ChatHandler{service: service}
+}
+
+// @Summary Get Chat History
+// @Description Retrieves the campaign chat messages oldest first. Reconnecting clients pass the ID of the last message they have as after to fetch what they missed, before pages back through older messages
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param before query string false "Fetch messages sent before this message ID"
+// @Param after query string false "Fetch messages sent after this message ID"
+// @Param limit query int false "Number of messages to fetch, at most 200"
+// @Success 200 {object} SuccessResponse{data=[]models.ChatMessage} "Chat messages retrieved successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid query"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can read the chat"
+// @Failure 404 {object} response "Message not found"
+// @Router /campaign/{campaignID}/chat/messages [get]
+func (h *ChatHandler) HandleGetChatHistory(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	limit, err := parsePageLimit(c)
+	if err != nil {
+		BadRequest(c, "Invalid limit", nil)
+		return
+	}
+
+	messages, err := h.service.GetHistory(GetCampaignID(c), getCampaignKey(c), claims.Email, c.Query("before"), c.Query("after"), limit)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Chat messages retrieved successfully", messages)
+}
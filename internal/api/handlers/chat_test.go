@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupChatTest(t *testing.T) (*gin.Engine, *mocks.MockChatService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockChatService(t)
+	handler := NewChatHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.GET("/campaign/:campaignID/chat/messages", handler.HandleGetChatHistory)
+
+	return router, mockService
+}
+
+func TestHandleGetChatHistory(t *testing.T) {
+	router, mockService := setupChatTest(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockChatService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:  "Success",
+			query: "?after=MSG1&limit=20",
+			setupMock: func(ms *mocks.MockChatService) {
+				ms.On("GetHistory", "123", "test-key", "test@example.com", "", "MSG1", 20).
+					Return([]models.ChatMessage{{ID: "MSG2", Content: "Hello"}}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Chat messages retrieved successfully",
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?limit=ten",
+			setupMock:      func(ms *mocks.MockChatService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid limit",
+		},
+		{
+			name:  "Not A Member",
+			query: "",
+			setupMock: func(ms *mocks.MockChatService) {
+				ms.On("GetHistory", "123", "test-key", "test@example.com", "", "", 0).
+					Return(nil, errs.Forbidden("Only campaign members can read the chat"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign members can read the chat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("GET", "/campaign/123/chat/messages"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
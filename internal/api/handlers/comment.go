@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/comment"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/oyen-bright/goFundIt/internal/services/interfaces"
 )
@@ -76,7 +77,7 @@ func (h *CommentHandler) HandleDeleteComment(c *gin.Context) {
 	commentID := getCommentID(c)
 	claims := getClaimsFromContext(c)
 
-	err := h.CommentService.DeleteComment(commentID, claims.Handle)
+	err := h.CommentService.DeleteComment(commentID, GetCampaignID(c), claims.Handle)
 	if err != nil {
 		FromError(c, err)
 		return
@@ -85,7 +86,7 @@ func (h *CommentHandler) HandleDeleteComment(c *gin.Context) {
 }
 
 // @Summary Get Activity Comments
-// @Description Retrieves all comments associated with a specific activity
+// @Description Retrieves a page of top level comments on an activity, newest first. Replies are fetched separately
 // @Tags comment
 // @Accept json
 // @Produce json
@@ -93,8 +94,10 @@ func (h *CommentHandler) HandleDeleteComment(c *gin.Context) {
 // @Security BearerAuth
 // @Param campaignID path string true "Campaign ID"
 // @Param activityID path string true "Activity ID"
-// @Success 200 {object} SuccessResponse{data=[]models.Comment} "Comments retrieved successfully"
-// @Failure 400 {object} BadRequestResponse "Invalid Activity ID"
+// @Param cursor query string false "Cursor returned with the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} SuccessResponse{data=models.CommentPage} "Comments retrieved successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid Activity ID, cursor or limit"
 // @Failure 401 {object} UnauthorizedResponse "Unauthorized"
 // @Router /activity/{campaignID}/{activityID}/comments [get]
 // HandleGetActivityComments handles the retrieval of comments for a given activity
@@ -105,34 +108,127 @@ func (h *CommentHandler) HandleGetActivityComments(c *gin.Context) {
 		return
 	}
 
-	comments, err := h.CommentService.GetActivityComments(activityID)
+	limit, err := parsePageLimit(c)
+	if err != nil {
+		BadRequest(c, "Invalid limit", nil)
+		return
+	}
+
+	page, err := h.CommentService.GetActivityComments(activityID, c.Query("cursor"), limit)
 	if err != nil {
 		FromError(c, err)
 		return
 	}
-	Success(c, "Comments retrieved successfully", comments)
+	Success(c, "Comments retrieved successfully", page)
 }
 
 // @Summary Get replies for a comment
-// @Description Retrieves all reply comments for a given parent comment ID
+// @Description Retrieves a page of direct replies to a comment, oldest first. Replies at any depth are fetched the same way
 // @Tags comment
 // @Accept json
 // @Produce json
-// @Param comment_id path string true "Comment ID"
-// @Success 200 {object} SuccessResponse{data=[]models.Comment} "Successfully retrieved replies"
-// @Failure 400 {object} BadRequestResponse "Invalid comment ID"
-// @Failure 404 {object} BadRequestResponse "Comment not found"
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Param cursor query string false "Cursor returned with the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} SuccessResponse{data=models.CommentPage} "Successfully retrieved replies"
+// @Failure 400 {object} BadRequestResponse "Invalid cursor or limit"
 // @Failure 500 {object} response "Internal server error"
-// @Router /comments/{comment_id}/replies [get]
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/replies [get]
 // HandleGetCommentReplies handles the retrieval of replies to a comment
 func (h *CommentHandler) HandleGetCommentReplies(c *gin.Context) {
-	commentID := getCommentID(c)
-	comments, err := h.CommentService.GetCommentReplies(commentID)
+	limit, err := parsePageLimit(c)
+	if err != nil {
+		BadRequest(c, "Invalid limit", nil)
+		return
+	}
+
+	page, err := h.CommentService.GetCommentReplies(getCommentID(c), c.Query("cursor"), limit)
 	if err != nil {
 		FromError(c, err)
 		return
 	}
-	Success(c, "Replies retrieved successfully", comments)
+	Success(c, "Replies retrieved successfully", page)
+}
+
+// @Summary Get Comment Thread
+// @Description Retrieves every reply under a comment as a flat list in thread order, each reply has its depth and path
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Success 200 {object} SuccessResponse{data=[]models.Comment} "Thread retrieved successfully"
+// @Failure 400 {object} BadRequestResponse "Comment not found"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/thread [get]
+// HandleGetCommentThread handles the retrieval of a flattened comment thread
+func (h *CommentHandler) HandleGetCommentThread(c *gin.Context) {
+	thread, err := h.CommentService.GetCommentThread(getCommentID(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Thread retrieved successfully", thread)
+}
+
+// @Summary Mark Comments Read
+// @Description Marks every comment on the activity as read by the user
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Success 200 {object} SuccessResponse "Comments marked as read"
+// @Failure 400 {object} BadRequestResponse "Invalid Activity ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Activity not found"
+// @Router /activity/{campaignID}/{activityID}/comments/read [post]
+// HandleMarkCommentsRead handles marking the comments of an activity as read
+func (h *CommentHandler) HandleMarkCommentsRead(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	if err := h.CommentService.MarkCommentsRead(activityID, GetCampaignID(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Comments marked as read", nil)
+}
+
+// @Summary Get Unread Comments
+// @Description Counts the comments the user hasn't read on each activity of the campaign
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=models.CampaignUnreadComments} "Unread comments retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Router /campaign/{campaignID}/comments/unread [get]
+// HandleGetUnreadSummary handles the retrieval of a campaign's unread comment counts
+func (h *CommentHandler) HandleGetUnreadSummary(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	summary, err := h.CommentService.GetUnreadSummary(GetCampaignID(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Unread comments retrieved successfully", summary)
 }
 
 // @Summary Update Comment
@@ -163,10 +259,210 @@ func (h *CommentHandler) HandleUpdateComment(c *gin.Context) {
 	}
 	comment.ID = commentID
 
-	err := h.CommentService.UpdateComment(comment, claims.Handle)
+	updatedComment, err := h.CommentService.UpdateComment(comment, GetCampaignID(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Comment updated successfully", updatedComment)
+}
+
+// @Summary Get Comment Revisions
+// @Description Retrieves the previous contents of an edited comment, newest first
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Success 200 {object} SuccessResponse{data=[]models.CommentRevision} "Revisions retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/revisions [get]
+// HandleGetCommentRevisions handles the retrieval of a comment's edit history
+func (h *CommentHandler) HandleGetCommentRevisions(c *gin.Context) {
+	revisions, err := h.CommentService.GetCommentRevisions(getCommentID(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Revisions retrieved successfully", revisions)
+}
+
+// @Summary React to Comment
+// @Description Reacts to a comment with an emoji, each member can react once with each emoji
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Param request body dto.CommentReactionRequest true "Reaction"
+// @Success 200 {object} SuccessResponse{data=models.Comment} "Reaction added successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/reactions [post]
+// HandleAddReaction handles reacting to a comment
+func (h *CommentHandler) HandleAddReaction(c *gin.Context) {
+	var request dto.CommentReactionRequest
+	claims := getClaimsFromContext(c)
+
+	if err := c.BindJSON(&request); err != nil {
+		BadRequest(c, "Invalid inputs", ExtractValidationErrors(err))
+		return
+	}
+
+	comment, err := h.CommentService.AddReaction(getCommentID(c), GetCampaignID(c), claims.Handle, request.Emoji)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Reaction added successfully", comment)
+}
+
+// @Summary Remove Comment Reaction
+// @Description Removes the user's emoji reaction from a comment
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Param emoji path string true "Emoji"
+// @Success 200 {object} SuccessResponse{data=models.Comment} "Reaction removed successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid emoji"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Reaction not found"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/reactions/{emoji} [delete]
+// HandleRemoveReaction handles removing a reaction from a comment
+func (h *CommentHandler) HandleRemoveReaction(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	comment, err := h.CommentService.RemoveReaction(getCommentID(c), GetCampaignID(c), claims.Handle, c.Param("emoji"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Reaction removed successfully", comment)
+}
+
+// @Summary Report Comment
+// @Description Reports a comment to the campaign organisers, each member can report a comment once
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Param request body dto.ReportCommentRequest true "Report"
+// @Success 200 {object} SuccessResponse{data=models.CommentReport} "Comment reported successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can report comments"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/report [post]
+// HandleReportComment handles reporting a comment
+func (h *CommentHandler) HandleReportComment(c *gin.Context) {
+	var request dto.ReportCommentRequest
+	claims := getClaimsFromContext(c)
+
+	if err := c.BindJSON(&request); err != nil {
+		BadRequest(c, "Invalid inputs", ExtractValidationErrors(err))
+		return
+	}
+
+	report, err := h.CommentService.ReportComment(getCommentID(c), GetCampaignID(c), claims.Handle, request.Reason)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Comment reported successfully", report)
+}
+
+// @Summary Moderate Comment
+// @Description Hides, unhides or removes any comment in the campaign, only the creator and co-organisers can moderate. Hidden comments keep their place in the thread without their content
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param commentID path string true "Comment ID"
+// @Param request body dto.ModerateCommentRequest true "Moderation action"
+// @Success 200 {object} SuccessResponse{data=models.Comment} "Comment moderated successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can moderate comments"
+// @Router /activity/{campaignID}/{activityID}/comments/{commentID}/moderation [post]
+// HandleModerateComment handles moderating a comment
+func (h *CommentHandler) HandleModerateComment(c *gin.Context) {
+	var request dto.ModerateCommentRequest
+	claims := getClaimsFromContext(c)
+
+	if err := c.BindJSON(&request); err != nil {
+		BadRequest(c, "Invalid inputs", ExtractValidationErrors(err))
+		return
+	}
+
+	comment, err := h.CommentService.ModerateComment(getCommentID(c), GetCampaignID(c), claims.Handle, request.Action, request.Reason)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Comment moderated successfully", comment)
+}
+
+// @Summary Get Comment Reports
+// @Description Lists the comments members reported in the campaign, newest first, only the creator and co-organisers can see them
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.CommentReport} "Comment reports retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can moderate comments"
+// @Router /campaign/{campaignID}/comments/reports [get]
+// HandleGetCommentReports handles the retrieval of a campaign's comment reports
+func (h *CommentHandler) HandleGetCommentReports(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	reports, err := h.CommentService.GetCommentReports(GetCampaignID(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Comment reports retrieved successfully", reports)
+}
+
+// @Summary Get Comment Moderation Log
+// @Description Lists the moderation actions taken in the campaign by organisers and the content filter, newest first
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.CommentModerationLog} "Moderation log retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can moderate comments"
+// @Router /campaign/{campaignID}/comments/moderation-log [get]
+// HandleGetModerationLog handles the retrieval of a campaign's comment moderation log
+func (h *CommentHandler) HandleGetModerationLog(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	logs, err := h.CommentService.GetModerationLog(GetCampaignID(c), claims.Handle)
 	if err != nil {
 		FromError(c, err)
 		return
 	}
-	Success(c, "Comment updated successfully", comment)
+	Success(c, "Moderation log retrieved successfully", logs)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -93,22 +94,38 @@ func TestHandleGetActivityComments(t *testing.T) {
 
 	tests := []struct {
 		name           string
+		query          string
 		setupMock      func(*mocks.MockCommentService)
 		expectedStatus int
 	}{
 		{
 			name: "Success",
 			setupMock: func(m *mocks.MockCommentService) {
-				m.EXPECT().GetActivityComments(uint(1)).
-					Return([]models.Comment{{Content: "Test comment"}}, nil)
+				m.EXPECT().GetActivityComments(uint(1), "", 0).
+					Return(models.CommentPage{Comments: []models.Comment{{Content: "Test comment"}}, NextCursor: "next"}, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:  "Next Page",
+			query: "?cursor=next&limit=10",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetActivityComments(uint(1), "next", 10).
+					Return(models.CommentPage{Comments: []models.Comment{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?limit=ten",
+			setupMock:      func(m *mocks.MockCommentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Service Error",
 			setupMock: func(m *mocks.MockCommentService) {
-				m.EXPECT().GetActivityComments(uint(1)).
-					Return(nil, assert.AnError)
+				m.EXPECT().GetActivityComments(uint(1), "", 0).
+					Return(models.CommentPage{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -125,6 +142,7 @@ func TestHandleGetActivityComments(t *testing.T) {
 
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/"+tt.query, nil)
 			c.Set("claims", jwt.Claims{Handle: "test-user"})
 
 			c.Params = []gin.Param{
@@ -150,7 +168,7 @@ func TestHandleDeleteComment(t *testing.T) {
 		{
 			name: "Success",
 			setupMock: func(m *mocks.MockCommentService) {
-				m.EXPECT().DeleteComment("1", "test-user").
+				m.EXPECT().DeleteComment("1", "test-campaign", "test-user").
 					Return(nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -158,7 +176,7 @@ func TestHandleDeleteComment(t *testing.T) {
 		{
 			name: "Service Error",
 			setupMock: func(m *mocks.MockCommentService) {
-				m.EXPECT().DeleteComment("1", "test-user").
+				m.EXPECT().DeleteComment("1", "test-campaign", "test-user").
 					Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -192,3 +210,258 @@ func TestHandleDeleteComment(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUpdateComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*mocks.MockCommentService)
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: map[string]interface{}{"content": "Updated comment"},
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().UpdateComment(mock.MatchedBy(func(c models.Comment) bool {
+					return c.ID == "1" && c.Content == "Updated comment"
+				}), "test-campaign", "test-user").
+					Return(&models.Comment{ID: "1", Content: "Updated comment", Edited: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Request Body",
+			requestBody:    map[string]interface{}{"content": ""},
+			setupMock:      func(m *mocks.MockCommentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockCommentService(t)
+			tt.setupMock(mockService)
+
+			handler := &CommentHandler{
+				CommentService: mockService,
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request, _ = http.NewRequest(http.MethodPatch, "/", bytes.NewBuffer(body))
+
+			c.Set("claims", jwt.Claims{Handle: "test-user"})
+			c.Params = []gin.Param{
+				{Key: "campaignID", Value: "test-campaign"},
+				{Key: "commentID", Value: "1"},
+			}
+
+			handler.HandleUpdateComment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Contains(t, w.Body.String(), `"edited":true`)
+			}
+		})
+	}
+}
+
+func TestHandleCommentRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		requestBody    map[string]interface{}
+		setupMock      func(*mocks.MockCommentService)
+		expectedStatus int
+	}{
+		{
+			name:        "Add Reaction",
+			method:      http.MethodPost,
+			url:         "/activity/test-campaign/1/comments/CMT1/reactions",
+			requestBody: map[string]interface{}{"emoji": "👍"},
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().AddReaction("CMT1", "test-campaign", "test-user", "👍").
+					Return(&models.Comment{ID: "CMT1"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Emoji",
+			method:         http.MethodPost,
+			url:            "/activity/test-campaign/1/comments/CMT1/reactions",
+			requestBody:    map[string]interface{}{},
+			setupMock:      func(m *mocks.MockCommentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Remove Reaction",
+			method: http.MethodDelete,
+			url:    "/activity/test-campaign/1/comments/CMT1/reactions/%F0%9F%8E%89",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().RemoveReaction("CMT1", "test-campaign", "test-user", "🎉").
+					Return(&models.Comment{ID: "CMT1"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Get Replies",
+			method: http.MethodGet,
+			url:    "/activity/test-campaign/1/comments/CMT1/replies?cursor=abc&limit=5",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetCommentReplies("CMT1", "abc", 5).
+					Return(models.CommentPage{Comments: []models.Comment{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Get Thread",
+			method: http.MethodGet,
+			url:    "/activity/test-campaign/1/comments/CMT1/thread",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetCommentThread("CMT1").
+					Return([]models.Comment{{ID: "CMT2", Depth: 1, Path: "CMT1/CMT2"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Mark Read",
+			method: http.MethodPost,
+			url:    "/activity/test-campaign/1/comments/read",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().MarkCommentsRead(uint(1), "test-campaign", "test-user").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Unread Summary",
+			method: http.MethodGet,
+			url:    "/campaign/test-campaign/comments/unread",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetUnreadSummary("test-campaign", "test-user").
+					Return(&models.CampaignUnreadComments{CampaignID: "test-campaign", Total: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Get Revisions",
+			method: http.MethodGet,
+			url:    "/activity/test-campaign/1/comments/CMT1/revisions",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetCommentRevisions("CMT1").
+					Return([]models.CommentRevision{{CommentID: "CMT1", Content: "Original"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Report Comment",
+			method:      http.MethodPost,
+			url:         "/activity/test-campaign/1/comments/CMT1/report",
+			requestBody: map[string]interface{}{"reason": "Spam"},
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().ReportComment("CMT1", "test-campaign", "test-user", "Spam").
+					Return(&models.CommentReport{ID: 1, CommentID: "CMT1"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Report Without Reason",
+			method:         http.MethodPost,
+			url:            "/activity/test-campaign/1/comments/CMT1/report",
+			requestBody:    map[string]interface{}{},
+			setupMock:      func(m *mocks.MockCommentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Hide Comment",
+			method:      http.MethodPost,
+			url:         "/activity/test-campaign/1/comments/CMT1/moderation",
+			requestBody: map[string]interface{}{"action": "hide", "reason": "Harassment"},
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().ModerateComment("CMT1", "test-campaign", "test-user", models.CommentModerationHide, "Harassment").
+					Return(&models.Comment{ID: "CMT1", Hidden: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown Moderation Action",
+			method:         http.MethodPost,
+			url:            "/activity/test-campaign/1/comments/CMT1/moderation",
+			requestBody:    map[string]interface{}{"action": "block", "reason": "Spam"},
+			setupMock:      func(m *mocks.MockCommentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Moderation Not Allowed",
+			method:      http.MethodPost,
+			url:         "/activity/test-campaign/1/comments/CMT1/moderation",
+			requestBody: map[string]interface{}{"action": "remove", "reason": "Spam"},
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().ModerateComment("CMT1", "test-campaign", "test-user", models.CommentModerationRemove, "Spam").
+					Return(nil, errs.Forbidden("Only campaign organisers can moderate comments"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Comment Reports",
+			method: http.MethodGet,
+			url:    "/campaign/test-campaign/comments/reports",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetCommentReports("test-campaign", "test-user").
+					Return([]models.CommentReport{{ID: 1, CommentID: "CMT1"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Moderation Log",
+			method: http.MethodGet,
+			url:    "/campaign/test-campaign/comments/moderation-log",
+			setupMock: func(m *mocks.MockCommentService) {
+				m.EXPECT().GetModerationLog("test-campaign", "test-user").
+					Return([]models.CommentModerationLog{{ID: 1, Action: models.CommentModerationHide}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockCommentService(t)
+			tt.setupMock(mockService)
+
+			handler := &CommentHandler{
+				CommentService: mockService,
+			}
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("claims", jwt.Claims{Handle: "test-user"})
+			})
+			router.GET("/campaign/:campaignID/comments/unread", handler.HandleGetUnreadSummary)
+			router.GET("/campaign/:campaignID/comments/reports", handler.HandleGetCommentReports)
+			router.GET("/campaign/:campaignID/comments/moderation-log", handler.HandleGetModerationLog)
+			comments := router.Group("/activity/:campaignID/:activityID/comments")
+			comments.GET("/:commentID/revisions", handler.HandleGetCommentRevisions)
+			comments.GET("/:commentID/replies", handler.HandleGetCommentReplies)
+			comments.GET("/:commentID/thread", handler.HandleGetCommentThread)
+			comments.POST("/read", handler.HandleMarkCommentsRead)
+			comments.POST("/:commentID/reactions", handler.HandleAddReaction)
+			comments.DELETE("/:commentID/reactions/:emoji", handler.HandleRemoveReaction)
+			comments.POST("/:commentID/report", handler.HandleReportComment)
+			comments.POST("/:commentID/moderation", handler.HandleModerateComment)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req, _ := http.NewRequest(tt.method, tt.url, bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -51,6 +51,45 @@ func (h *ContributorHandler) HandleAddContributor(c *gin.Context) {
 
 }
 
+// @Summary Import Contributors
+// @Description Adds contributors to the campaign in bulk from a JSON array or a CSV file with the columns name, email, amount and activities.
+// @Description Activities are separated by ";". Every row is validated, if a row is invalid no contributors are added and the errors of each row are returned.
+// @Tags contributor
+// @Accept json,mpfd,text/csv
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body []dto.ImportContributorRow false "Contributors to import"
+// @Param file formData file false "CSV file of contributors"
+// @Success 200 {object} SuccessResponse{data=[]models.Contributor} "Contributors imported to Campaign"
+// @Failure 400 {object} BadRequestResponse{errors=[]models.ContributorImportError} "Invalid contributors"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /contributor/{campaignID}/import [post]
+func (h *ContributorHandler) HandleImportContributors(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+	campaignID := GetCampaignID(c)
+	campaignKey := getCampaignKey(c)
+
+	rows, rowErrors, err := bindImportContributorRows(c)
+	if err != nil {
+		BadRequest(c, "Invalid inputs, please check and try again", ExtractValidationErrors(err))
+		return
+	}
+	if len(rowErrors) > 0 {
+		BadRequest(c, "Some contributors are invalid, no contributors were imported", rowErrors)
+		return
+	}
+
+	contributors, err := h.service.ImportContributors(dto.ToContributors(rows), campaignID, campaignKey, claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Contributors imported to Campaign", contributors)
+}
+
 // @Summary Remove Contributor
 // @Description Removes a contributor from the campaign
 // @Tags contributor
@@ -179,3 +218,42 @@ func (h *ContributorHandler) HandleGetContributorByID(c *gin.Context) {
 	Success(c, "Contributor retrieved successfully", contributor)
 
 }
+
+// @Summary Accept Invitation
+// @Description Accepts a campaign invitation, the contributor joins the campaign and counts toward its target amount
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param token path string true "Invitation token"
+// @Success 200 {object} SuccessResponse{data=models.Contributor} "Invitation accepted"
+// @Failure 400 {object} BadRequestResponse "Invitation already answered or expired"
+// @Failure 404 {object} response "Invitation not found"
+// @Router /invitations/{token}/accept [post]
+func (h *ContributorHandler) HandleAcceptInvitation(c *gin.Context) {
+	contributor, err := h.service.AcceptInvitation(getInvitationToken(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Invitation accepted", contributor)
+}
+
+// @Summary Decline Invitation
+// @Description Declines a campaign invitation, the campaign creator is notified
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param token path string true "Invitation token"
+// @Success 200 {object} SuccessResponse "Invitation declined"
+// @Failure 400 {object} BadRequestResponse "Invitation already answered or expired"
+// @Failure 404 {object} response "Invitation not found"
+// @Router /invitations/{token}/decline [post]
+func (h *ContributorHandler) HandleDeclineInvitation(c *gin.Context) {
+	if err := h.service.DeclineInvitation(getInvitationToken(c)); err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Invitation declined", nil)
+}
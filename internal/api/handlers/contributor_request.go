@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/contributor"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type ContributorRequestHandler struct {
+	service services.ContributorRequestService
+}
+
+func NewContributorRequestHandler(service services.ContributorRequestService) *ContributorRequestHandler {
+	return &ContributorRequestHandler{service: service}
+}
+
+// @Summary Request to Leave Campaign
+// @Description Asks the campaign organisers to remove the contributor from the campaign, contributors who paid are refunded before they leave
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.LeaveCampaignRequest true "Leave Request Details"
+// @Success 200 {object} SuccessResponse{data=models.ContributorRequest} "Leave request sent"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only contributors of this campaign can make requests"
+// @Router /contributor/{campaignID}/requests/leave [post]
+func (h *ContributorRequestHandler) HandleRequestToLeave(c *gin.Context) {
+	var requestDTO dto.LeaveCampaignRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	request, err := h.service.RequestToLeave(GetCampaignID(c), getCampaignKey(c), claims.Email, requestDTO.Reason)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Leave request sent", request)
+}
+
+// @Summary Request Amount Change
+// @Description Asks the campaign organisers to change the contributor's contribution amount
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.ChangeAmountRequest true "Amount Change Details"
+// @Success 200 {object} SuccessResponse{data=models.ContributorRequest} "Amount change request sent"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only contributors of this campaign can make requests"
+// @Router /contributor/{campaignID}/requests/amount [post]
+func (h *ContributorRequestHandler) HandleRequestAmountChange(c *gin.Context) {
+	var requestDTO dto.ChangeAmountRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	request, err := h.service.RequestAmountChange(GetCampaignID(c), getCampaignKey(c), claims.Email, requestDTO.Amount, requestDTO.Reason)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Amount change request sent", request)
+}
+
+// @Summary Get Contributor Requests
+// @Description Retrieves the history of contributor requests and their decisions, contributors only see their own requests
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.ContributorRequest} "Contributor requests retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers and contributors can view contributor requests"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /contributor/{campaignID}/requests [get]
+func (h *ContributorRequestHandler) HandleGetContributorRequests(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requests, err := h.service.GetContributorRequests(GetCampaignID(c), getCampaignKey(c), claims.Handle, claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Contributor requests retrieved successfully", requests)
+}
+
+// @Summary Approve Contributor Request
+// @Description Applies a contributor's request and recalculates the campaign target amount. The leave request of a contributor who paid is approved by the treasurer, who confirms the refund, or by the owner while the campaign has no treasurer
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param requestID path string true "Contributor Request ID"
+// @Param request body dto.ReviewContributorRequest false "Review Details"
+// @Success 200 {object} SuccessResponse{data=models.ContributorRequest} "Contributor request approved"
+// @Failure 400 {object} BadRequestResponse "Invalid contributor request ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can review contributor requests"
+// @Failure 404 {object} response "Contributor request or Campaign not found"
+// @Router /contributor/{campaignID}/requests/{requestID}/approve [post]
+func (h *ContributorRequestHandler) HandleApproveContributorRequest(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requestID, review, ok := bindContributorRequestReview(c)
+	if !ok {
+		return
+	}
+
+	request, err := h.service.ApproveContributorRequest(requestID, GetCampaignID(c), getCampaignKey(c), claims.Handle, review.Note, review.RefundIssued)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Contributor request approved", request)
+}
+
+// @Summary Reject Contributor Request
+// @Description Declines a contributor's request, the contributor is left unchanged
+// @Tags contributor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param requestID path string true "Contributor Request ID"
+// @Param request body dto.ReviewContributorRequest false "Review Details"
+// @Success 200 {object} SuccessResponse{data=models.ContributorRequest} "Contributor request rejected"
+// @Failure 400 {object} BadRequestResponse "Invalid contributor request ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can review contributor requests"
+// @Failure 404 {object} response "Contributor request or Campaign not found"
+// @Router /contributor/{campaignID}/requests/{requestID}/reject [post]
+func (h *ContributorRequestHandler) HandleRejectContributorRequest(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requestID, review, ok := bindContributorRequestReview(c)
+	if !ok {
+		return
+	}
+
+	request, err := h.service.RejectContributorRequest(requestID, GetCampaignID(c), getCampaignKey(c), claims.Handle, review.Note)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Contributor request rejected", request)
+}
+
+// bindContributorRequestReview reads the request ID and the optional review body, an error response is sent if either is invalid
+func bindContributorRequestReview(c *gin.Context) (uint, dto.ReviewContributorRequest, bool) {
+	var review dto.ReviewContributorRequest
+
+	requestID, err := parseContributorRequestID(c)
+	if err != nil {
+		BadRequest(c, "Invalid contributor request ID", nil)
+		return 0, review, false
+	}
+
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &review); err != nil {
+			return 0, review, false
+		}
+	}
+	return requestID, review, true
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupContributorRequestTest(t *testing.T) (*gin.Engine, *mocks.MockContributorRequestService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockContributorRequestService(t)
+	handler := NewContributorRequestHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.GET("/contributor/:campaignID/requests", handler.HandleGetContributorRequests)
+	router.POST("/contributor/:campaignID/requests/leave", handler.HandleRequestToLeave)
+	router.POST("/contributor/:campaignID/requests/amount", handler.HandleRequestAmountChange)
+	router.POST("/contributor/:campaignID/requests/:requestID/approve", handler.HandleApproveContributorRequest)
+	router.POST("/contributor/:campaignID/requests/:requestID/reject", handler.HandleRejectContributorRequest)
+
+	return router, mockService
+}
+
+func TestHandleContributorRequests(t *testing.T) {
+	router, mockService := setupContributorRequestTest(t)
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		requestBody     interface{}
+		setupMock       func(*mocks.MockContributorRequestService)
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name:        "Request to leave",
+			method:      "POST",
+			path:        "/contributor/123/requests/leave",
+			requestBody: map[string]interface{}{"reason": "Can't make it"},
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().RequestToLeave("123", "test-key", "test@example.com", "Can't make it").Return(&models.ContributorRequest{ID: 1}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Leave request sent",
+		},
+		{
+			name:        "Request amount change",
+			method:      "POST",
+			path:        "/contributor/123/requests/amount",
+			requestBody: map[string]interface{}{"amount": 80},
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().RequestAmountChange("123", "test-key", "test@example.com", 80.0, "").Return(&models.ContributorRequest{ID: 1}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Amount change request sent",
+		},
+		{
+			name:            "Request amount change without amount",
+			method:          "POST",
+			path:            "/contributor/123/requests/amount",
+			requestBody:     map[string]interface{}{"reason": "More"},
+			setupMock:       func(ms *mocks.MockContributorRequestService) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Invalid inputs, please check and try again",
+		},
+		{
+			name:   "Get requests",
+			method: "GET",
+			path:   "/contributor/123/requests",
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().GetContributorRequests("123", "test-key", "testuser", "test@example.com").Return([]models.ContributorRequest{}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Contributor requests retrieved successfully",
+		},
+		{
+			name:        "Approve request with refund",
+			method:      "POST",
+			path:        "/contributor/123/requests/5/approve",
+			requestBody: map[string]interface{}{"note": "Refunded", "refundIssued": true},
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().ApproveContributorRequest(uint(5), "123", "test-key", "testuser", "Refunded", true).Return(&models.ContributorRequest{ID: 5}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Contributor request approved",
+		},
+		{
+			name:   "Approve request without a body",
+			method: "POST",
+			path:   "/contributor/123/requests/5/approve",
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().ApproveContributorRequest(uint(5), "123", "test-key", "testuser", "", false).
+					Return(nil, errs.BadRequest("Contributor has paid, issue a refund before approving their request to leave", nil))
+			},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Contributor has paid, issue a refund before approving their request to leave",
+		},
+		{
+			name:            "Approve request with invalid ID",
+			method:          "POST",
+			path:            "/contributor/123/requests/abc/approve",
+			setupMock:       func(ms *mocks.MockContributorRequestService) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Invalid contributor request ID",
+		},
+		{
+			name:   "Reject request",
+			method: "POST",
+			path:   "/contributor/123/requests/5/reject",
+			setupMock: func(ms *mocks.MockContributorRequestService) {
+				ms.EXPECT().RejectContributorRequest(uint(5), "123", "test-key", "testuser", "").
+					Return(nil, errs.Forbidden("Only campaign organisers can review contributor requests"))
+			},
+			expectedCode:    http.StatusForbidden,
+			expectedMessage: "Only campaign organisers can review contributor requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			tt.setupMock(mockService)
+
+			body := bytes.NewBuffer(nil)
+			if tt.requestBody != nil {
+				jsonBody, _ := json.Marshal(tt.requestBody)
+				body = bytes.NewBuffer(jsonBody)
+			}
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedMessage, response["message"])
+		})
+	}
+}
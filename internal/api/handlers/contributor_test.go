@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -32,10 +33,13 @@ func setupContributorTest(t *testing.T) (*gin.Engine, *mocks.MockContributorServ
 	})
 
 	router.POST("/contributor/:campaignID", handler.HandleAddContributor)
+	router.POST("/contributor/:campaignID/import", handler.HandleImportContributors)
 	router.DELETE("/contributor/:campaignID/:contributorID", handler.HandleRemoveContributor)
 	router.PATCH("/contributor/:campaignID/:contributorID", handler.HandleEditContributor)
 	router.GET("/contributor/:campaignID", handler.HandleGetContributorsByCampaignID)
 	router.GET("/contributor/:campaignID/:contributorID", handler.HandleGetContributorByID)
+	router.POST("/invitations/:token/accept", handler.HandleAcceptInvitation)
+	router.POST("/invitations/:token/decline", handler.HandleDeclineInvitation)
 
 	return router, mockService
 }
@@ -250,3 +254,178 @@ func TestHandleGetContributorsByCampaignID(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleAcceptInvitation(t *testing.T) {
+	router, mockService := setupContributorTest(t)
+
+	tests := []struct {
+		name            string
+		setupMock       func(*mocks.MockContributorService)
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name: "Success",
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().AcceptInvitation("GI-token").Return(&models.Contributor{ID: 1, CampaignID: "123"}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Invitation accepted",
+		},
+		{
+			name: "Invitation not found",
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().AcceptInvitation("GI-token").Return(nil, errs.NotFound("Invitation not found"))
+			},
+			expectedCode:    http.StatusNotFound,
+			expectedMessage: "Invitation not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/invitations/GI-token/accept", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedMessage, response["message"])
+		})
+	}
+}
+
+func TestHandleDeclineInvitation(t *testing.T) {
+	router, mockService := setupContributorTest(t)
+
+	tests := []struct {
+		name            string
+		setupMock       func(*mocks.MockContributorService)
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name: "Success",
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().DeclineInvitation("GI-token").Return(nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Invitation declined",
+		},
+		{
+			name: "Invitation expired",
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().DeclineInvitation("GI-token").Return(errs.BadRequest("Invitation has expired", nil))
+			},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Invitation has expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/invitations/GI-token/decline", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedMessage, response["message"])
+		})
+	}
+}
+
+func TestHandleImportContributors(t *testing.T) {
+	router, mockService := setupContributorTest(t)
+
+	tests := []struct {
+		name            string
+		contentType     string
+		body            string
+		setupMock       func(*mocks.MockContributorService)
+		expectedCode    int
+		expectedMessage string
+	}{
+		{
+			name:        "JSON array",
+			contentType: "application/json",
+			body:        `[{"name":"Jane Doe","email":"jane@example.com","amount":100,"activities":[1]}]`,
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().ImportContributors(mock.MatchedBy(func(contributors []models.Contributor) bool {
+					return len(contributors) == 1 && contributors[0].Email == "jane@example.com" && contributors[0].Activities[0].ID == 1
+				}), "123", "test-key", "testuser").Return([]models.Contributor{{Email: "jane@example.com"}}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Contributors imported to Campaign",
+		},
+		{
+			name:        "CSV",
+			contentType: "text/csv",
+			body:        "name,email,amount,activities\nJane Doe,jane@example.com,100,1;2\nJohn Doe,john@example.com,50,\n",
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().ImportContributors(mock.MatchedBy(func(contributors []models.Contributor) bool {
+					return len(contributors) == 2 && len(contributors[0].Activities) == 2 && contributors[1].Amount == 50
+				}), "123", "test-key", "testuser").Return([]models.Contributor{}, nil)
+			},
+			expectedCode:    http.StatusOK,
+			expectedMessage: "Contributors imported to Campaign",
+		},
+		{
+			name:            "CSV with unreadable rows",
+			contentType:     "text/csv",
+			body:            "name,email,amount\nJane Doe,jane@example.com,lots\n",
+			setupMock:       func(ms *mocks.MockContributorService) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Some contributors are invalid, no contributors were imported",
+		},
+		{
+			name:            "CSV without required columns",
+			contentType:     "text/csv",
+			body:            "name,email\nJane Doe,jane@example.com\n",
+			setupMock:       func(ms *mocks.MockContributorService) {},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Invalid inputs, please check and try again",
+		},
+		{
+			name:        "Invalid contributors",
+			contentType: "application/json",
+			body:        `[{"email":"jane@example.com"}]`,
+			setupMock: func(ms *mocks.MockContributorService) {
+				ms.EXPECT().ImportContributors(mock.Anything, "123", "test-key", "testuser").Return(nil,
+					errs.ValidationFailed("Some contributors are invalid, no contributors were imported", []models.ContributorImportError{
+						models.NewContributorImportError(1, "jane@example.com", "amount must be greater than 0"),
+					}))
+			},
+			expectedCode:    http.StatusBadRequest,
+			expectedMessage: "Some contributors are invalid, no contributors were imported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/contributor/123/import", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedMessage, response["message"])
+			if tt.expectedCode == http.StatusBadRequest {
+				assert.NotEmpty(t, response["errors"])
+			}
+		})
+	}
+}
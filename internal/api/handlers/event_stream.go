@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+// EventStreamHandler streams campaign events as Server-Sent Events for clients that can't hold a websocket
+type EventStreamHandler struct {
+	hub             *websocket.Hub
+	campaignService interfaces.CampaignService
+}
+
+// NewEventStreamHandler creates a new instance of EventStreamHandler
+func NewEventStreamHandler(hub *websocket.Hub, campaignService interfaces.CampaignService) *EventStreamHandler {
+	return &EventStreamHandler{
+		hub:             hub,
+		campaignService: campaignService,
+	}
+}
+
+// @Summary Campaign Event Stream
+// @Description Streams the campaign events as Server-Sent Events, the same events sent over the campaign WebSocket.
+// @Description Each event is named after its type and carries its seq number as the event ID, a reconnecting client sends the last one
+// @Description it received as Last-Event-ID to get the events it missed or a resync_required event when they are no longer kept.
+// @Description Clients receive every event unless they list the types they want in types.
+// @Description Only the campaign owner and treasurer receive the contributors' emails and payment details
+// @Tags websocket
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param Last-Event-ID header int false "Sequence number of the last event received"
+// @Param since query int false "Sequence number of the last event received, used without Last-Event-ID"
+// @Param types query string false "Comma separated event types to receive"
+// @Success 200 {string} string "Event stream"
+// @Failure 400 {object} BadRequestResponse "Invalid campaign ID, Last-Event-ID or types"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /events/campaign/{campaignID} [get]
+// HandleCampaignEvents handles streaming the campaign events
+func (h *EventStreamHandler) HandleCampaignEvents(c *gin.Context) {
+	campaignID := GetCampaignID(c)
+	claims := getClaimsFromContext(c)
+
+	since, resume, err := parseLastEventID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Last-Event-ID", nil)
+		return
+	}
+
+	eventTypes, err := parseEventTypes(c)
+	if err != nil {
+		BadRequest(c, "Invalid types", err.Error())
+		return
+	}
+
+	// Verify campaign
+	campaign, err := h.campaignService.GetCampaignByID(campaignID, getCampaignKey(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	client := websocket.NewStreamClient(h.hub, campaignID, claims.Handle)
+	client.SetPrivileged(campaign.SeesPaymentDetails(claims.Handle))
+	h.hub.SetEventTypes(client, eventTypes)
+	if resume {
+		h.hub.RegisterSince(client, since)
+	} else {
+		h.hub.Register(client)
+	}
+	defer h.hub.Unregister(client)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Stops proxies such as nginx from buffering the stream
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	// Comments keep idle connections from being closed by proxies
+	ticker := time.NewTicker(h.hub.PingPeriod())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case message, ok := <-client.Messages():
+			if !ok || writeServerSentEvent(c.Writer, message) != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := io.WriteString(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeServerSentEvent writes the message as an event named after its type, broadcasts use their seq number as the event ID
+func writeServerSentEvent(w io.Writer, message websocket.Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	if message.Seq > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", message.Seq); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", message.Type, data)
+	return err
+}
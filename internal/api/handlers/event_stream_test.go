@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupEventStreamTest(t *testing.T) (*gin.Engine, *websocket.Hub, *mocks.MockCampaignService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	hub := websocket.NewHub()
+	t.Cleanup(hub.Close)
+	mockService := mocks.NewMockCampaignService(t)
+	handler := NewEventStreamHandler(hub, mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.GET("/events/campaign/:campaignID", handler.HandleCampaignEvents)
+
+	return router, hub, mockService
+}
+
+// readEvent reads the lines of the next event in the stream
+func readEvent(t *testing.T, stream *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := stream.ReadString('\n')
+		require.NoError(t, err)
+		if line == "\n" {
+			return lines
+		}
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+}
+
+func TestHandleCampaignEvents(t *testing.T) {
+	t.Run("Streams Broadcasts", func(t *testing.T) {
+		router, hub, mockService := setupEventStreamTest(t)
+		mockService.EXPECT().GetCampaignByID("123", "test-key").Return(&models.Campaign{ID: "123"}, nil)
+
+		// Events broadcast while the client was away
+		for seq := range uint64(3) {
+			hub.BroadcastToCampaign("123", websocket.Message{Seq: seq + 1, Type: websocket.EventTypeCampaignUpdated, Data: "missed"})
+		}
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		request, err := http.NewRequest(http.MethodGet, server.URL+"/events/campaign/123", nil)
+		require.NoError(t, err)
+		request.Header.Set("Last-Event-ID", "2")
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+		stream := bufio.NewReader(response.Body)
+		assert.Equal(t, []string{"id: 3", "event: campaign_updated", `data: {"seq":3,"type":"campaign_updated","data":"missed"}`}, readEvent(t, stream))
+
+		hub.BroadcastToCampaign("123", websocket.Message{Seq: 4, Type: websocket.EventTypeCommentCreated, Data: "new"})
+		assert.Equal(t, []string{"id: 4", "event: comment_created", `data: {"seq":4,"type":"comment_created","data":"new"}`}, readEvent(t, stream))
+
+		response.Body.Close()
+		assert.Eventually(t, func() bool { return hub.ClientCount("123") == 0 }, time.Second, 10*time.Millisecond,
+			"expected the stream to be unregistered when the client disconnects")
+	})
+
+	t.Run("Resync Required", func(t *testing.T) {
+		router, _, mockService := setupEventStreamTest(t)
+		mockService.EXPECT().GetCampaignByID("123", "test-key").Return(&models.Campaign{ID: "123"}, nil)
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		response, err := http.Get(server.URL + "/events/campaign/123?since=7")
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		lines := readEvent(t, bufio.NewReader(response.Body))
+		assert.Equal(t, []string{"event: resync_required", `data: {"type":"resync_required","data":{"latestSeq":0}}`}, lines)
+	})
+
+	t.Run("Filters Event Types", func(t *testing.T) {
+		router, hub, mockService := setupEventStreamTest(t)
+		mockService.EXPECT().GetCampaignByID("123", "test-key").Return(&models.Campaign{ID: "123"}, nil)
+
+		hub.BroadcastToCampaign("123", websocket.Message{Seq: 1, Type: websocket.EventTypeCampaignUpdated, Data: "update"})
+		hub.BroadcastToCampaign("123", websocket.Message{Seq: 2, Type: websocket.EventTypeCommentCreated, Data: "comment"})
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		response, err := http.Get(server.URL + "/events/campaign/123?since=0&types=comment_created")
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		lines := readEvent(t, bufio.NewReader(response.Body))
+		assert.Equal(t, []string{"id: 2", "event: comment_created", `data: {"seq":2,"type":"comment_created","data":"comment"}`}, lines)
+	})
+
+	t.Run("Privileged Data", func(t *testing.T) {
+		router, hub, mockService := setupEventStreamTest(t)
+		mockService.EXPECT().GetCampaignByID("123", "test-key").Return(&models.Campaign{ID: "123", CreatedByHandle: "testuser"}, nil)
+
+		hub.BroadcastToCampaign("123", websocket.Message{Seq: 1, Type: websocket.EventTypeContributorUpdated, Data: "status", PrivilegedData: "payment"})
+
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		response, err := http.Get(server.URL + "/events/campaign/123?since=0")
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		lines := readEvent(t, bufio.NewReader(response.Body))
+		assert.Equal(t, `data: {"seq":1,"type":"contributor_updated","data":"payment"}`, lines[2], "expected the campaign owner to receive the payment details")
+	})
+
+	t.Run("Invalid Types", func(t *testing.T) {
+		router, _, _ := setupEventStreamTest(t)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/events/campaign/123?types=everything", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Invalid types")
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		router, _, _ := setupEventStreamTest(t)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/events/campaign/123", nil)
+		req.Header.Set("Last-Event-ID", "abc")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Invalid Last-Event-ID")
+	})
+
+	t.Run("Campaign Not Found", func(t *testing.T) {
+		router, _, mockService := setupEventStreamTest(t)
+		mockService.EXPECT().GetCampaignByID("123", "test-key").Return(nil, errs.NotFound("Campaign not found"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/events/campaign/123", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Campaign not found")
+	})
+}
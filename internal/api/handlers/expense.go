@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/activity"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+// maxReceiptBytes is the largest receipt accepted
+const maxReceiptBytes = 10 << 20
+
+// receiptExtensions maps the content types accepted for receipts to the extension they are stored with
+var receiptExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+type ExpenseHandler struct {
+	service services.ExpenseService
+}
+
+func NewExpenseHandler(service services.ExpenseService) *ExpenseHandler {
+	return &ExpenseHandler{service: service}
+}
+
+// @Summary Record Activity Expense
+// @Description Records money actually spent on an approved activity with an optional receipt, only campaign organisers and the treasurer can record expenses. Receipts are JPEG, PNG, WebP or PDF files of at most 10MB and are stored privately
+// @Tags activity
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param amount formData number true "Amount spent"
+// @Param paidBy formData string false "Email of the campaign member who paid"
+// @Param note formData string false "Note on what the money was spent on"
+// @Param receipt formData file false "Receipt of the expense, a JPEG, PNG, WebP or PDF file"
+// @Success 200 {object} SuccessResponse{data=models.ActivityExpense} "Expense recorded successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers and the treasurer can record expenses"
+// @Failure 404 {object} response "Activity not found or not approved"
+// @Router /activity/{campaignID}/{activityID}/expenses [post]
+func (h *ExpenseHandler) HandleRecordExpense(c *gin.Context) {
+	var requestDTO dto.RecordExpenseRequest
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	if err := bindForm(c, &requestDTO); err != nil {
+		return
+	}
+
+	receipt, cleanup, ok := bindReceiptFile(c)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	expense, err := h.service.RecordExpense(activityID, GetCampaignID(c), getCampaignKey(c), claims.Handle, claims.Email, requestDTO.Amount, requestDTO.PaidBy, requestDTO.Note, receipt)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Expense recorded successfully", expense)
+}
+
+// @Summary Delete Activity Expense
+// @Description Deletes an expense recorded by mistake along with its receipt, expenses of reconciled activities cannot be deleted
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param expenseID path string true "Expense ID"
+// @Success 200 {object} SuccessResponse "Expense deleted successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid request"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers and the treasurer can delete expenses"
+// @Failure 404 {object} response "Expense not found"
+// @Router /activity/{campaignID}/{activityID}/expenses/{expenseID} [delete]
+func (h *ExpenseHandler) HandleDeleteExpense(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+	expenseID, err := parseExpenseID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Expense ID", nil)
+		return
+	}
+
+	if err := h.service.DeleteExpense(expenseID, activityID, GetCampaignID(c), getCampaignKey(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Expense deleted successfully", nil)
+}
+
+// @Summary Get Expense Receipt
+// @Description Returns a signed link to the receipt of an expense, the link expires after a few minutes. Only campaign members can view it
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param expenseID path string true "Expense ID"
+// @Success 200 {object} SuccessResponse{data=models.DocumentLink} "Receipt retrieved"
+// @Failure 400 {object} BadRequestResponse "Invalid request"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can view receipts"
+// @Failure 404 {object} response "Expense not found"
+// @Router /activity/{campaignID}/{activityID}/expenses/{expenseID}/receipt [get]
+func (h *ExpenseHandler) HandleGetExpenseReceipt(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+	expenseID, err := parseExpenseID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Expense ID", nil)
+		return
+	}
+
+	link, err := h.service.GetExpenseReceipt(expenseID, activityID, GetCampaignID(c), getCampaignKey(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Receipt retrieved", link)
+}
+
+// @Summary Get Activity Budget
+// @Description Compares the budget of an approved activity with its recorded expenses
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Success 200 {object} SuccessResponse{data=models.ActivityBudgetReport} "Budget retrieved successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid request"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can view budgets"
+// @Failure 404 {object} response "Activity not found or not approved"
+// @Router /activity/{campaignID}/{activityID}/budget [get]
+func (h *ExpenseHandler) HandleGetActivityBudget(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	report, err := h.service.GetActivityBudget(activityID, GetCampaignID(c), getCampaignKey(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Budget retrieved successfully", report)
+}
+
+// @Summary Get Campaign Budget
+// @Description Compares the budget of every approved activity of a campaign with its recorded expenses
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=models.CampaignBudgetReport} "Budget retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can view budgets"
+// @Router /campaign/{campaignID}/budget [get]
+func (h *ExpenseHandler) HandleGetCampaignBudget(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	report, err := h.service.GetCampaignBudget(GetCampaignID(c), getCampaignKey(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Budget retrieved successfully", report)
+}
+
+// @Summary Reconcile Activity
+// @Description Settles the over or under spend of an activity once the campaign has ended or been paid out, only the campaign treasurer can reconcile activities
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param request body dto.ReconcileActivityRequest true "Reconciliation Details"
+// @Success 200 {object} SuccessResponse{data=models.ActivityReconciliation} "Activity reconciled successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign treasurer can reconcile activities"
+// @Failure 404 {object} response "Activity not found or not approved"
+// @Router /activity/{campaignID}/{activityID}/reconcile [post]
+func (h *ExpenseHandler) HandleReconcileActivity(c *gin.Context) {
+	var requestDTO dto.ReconcileActivityRequest
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	reconciliation, err := h.service.ReconcileActivity(activityID, GetCampaignID(c), getCampaignKey(c), claims.Handle, requestDTO.Resolution, requestDTO.TargetActivityID, requestDTO.Note)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Activity reconciled successfully", reconciliation)
+}
+
+// bindReceiptFile checks the size and type of the uploaded receipt and copies it to a temporary file,
+// cleanup removes it once the request is done. The path is empty when no receipt was uploaded
+func bindReceiptFile(c *gin.Context) (string, func(), bool) {
+	file, err := c.FormFile("receipt")
+	if err != nil {
+		return "", func() {}, true
+	}
+	if file.Size > maxReceiptBytes {
+		BadRequest(c, "Receipt must be at most 10MB", nil)
+		return "", nil, false
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		BadRequest(c, "Error processing receipt file", err.Error())
+		return "", nil, false
+	}
+	defer src.Close()
+
+	// The content type is sniffed from the file, the name and header sent by the client can't be trusted
+	head := make([]byte, 512)
+	n, err := io.ReadFull(src, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		BadRequest(c, "Error processing receipt file", err.Error())
+		return "", nil, false
+	}
+	extension, ok := receiptExtensions[http.DetectContentType(head[:n])]
+	if !ok {
+		BadRequest(c, "Receipt must be a JPEG, PNG, WebP or PDF file", nil)
+		return "", nil, false
+	}
+
+	tmpFile, err := os.CreateTemp("", "receipt-*"+extension)
+	if err != nil {
+		BadRequest(c, "Error processing receipt file", err.Error())
+		return "", nil, false
+	}
+	defer tmpFile.Close()
+	cleanup := func() { os.Remove(tmpFile.Name()) }
+
+	if _, err := io.Copy(tmpFile, io.MultiReader(bytes.NewReader(head[:n]), src)); err != nil {
+		cleanup()
+		BadRequest(c, "Error processing receipt file", err.Error())
+		return "", nil, false
+	}
+
+	return tmpFile.Name(), cleanup, true
+}
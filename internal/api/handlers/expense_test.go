@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupExpenseTest(t *testing.T) (*gin.Engine, *mocks.MockExpenseService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockExpenseService(t)
+	handler := NewExpenseHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/activity/:campaignID/:activityID/expenses", handler.HandleRecordExpense)
+	router.DELETE("/activity/:campaignID/:activityID/expenses/:expenseID", handler.HandleDeleteExpense)
+	router.GET("/activity/:campaignID/:activityID/expenses/:expenseID/receipt", handler.HandleGetExpenseReceipt)
+	router.POST("/activity/:campaignID/:activityID/reconcile", handler.HandleReconcileActivity)
+	router.GET("/campaign/:campaignID/budget", handler.HandleGetCampaignBudget)
+
+	return router, mockService
+}
+
+// pngReceipt starts with the PNG signature so it is sniffed as an image
+var pngReceipt = append([]byte("\x89PNG\r\n\x1a\n"), []byte("receipt")...)
+
+func newExpenseForm(t *testing.T, fields map[string]string, receipt []byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		assert.NoError(t, writer.WriteField(key, value))
+	}
+	if receipt != nil {
+		part, err := writer.CreateFormFile("receipt", "receipt.png")
+		assert.NoError(t, err)
+		_, err = part.Write(receipt)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestHandleRecordExpense(t *testing.T) {
+	router, mockService := setupExpenseTest(t)
+
+	tests := []struct {
+		name           string
+		activityID     string
+		fields         map[string]string
+		receipt        []byte
+		setupMock      func(*mocks.MockExpenseService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:       "Success With Receipt",
+			activityID: "1",
+			fields:     map[string]string{"amount": "45.5", "paidBy": "payer@example.com", "note": "Fuel"},
+			receipt:    pngReceipt,
+			setupMock: func(ms *mocks.MockExpenseService) {
+				ms.On("RecordExpense", uint(1), "123", "test-key", "testuser", "test@example.com", 45.5, "payer@example.com", "Fuel",
+					mock.MatchedBy(func(receipt string) bool { return strings.HasSuffix(receipt, ".png") })).
+					Return(&models.ActivityExpense{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Expense recorded successfully",
+		},
+		{
+			name:       "Success Without Receipt",
+			activityID: "1",
+			fields:     map[string]string{"amount": "10"},
+			setupMock: func(ms *mocks.MockExpenseService) {
+				ms.On("RecordExpense", uint(1), "123", "test-key", "testuser", "test@example.com", 10.0, "", "", "").
+					Return(&models.ActivityExpense{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Expense recorded successfully",
+		},
+		{
+			name:           "Receipt Not An Image Or PDF",
+			activityID:     "1",
+			fields:         map[string]string{"amount": "10"},
+			receipt:        []byte("#!/bin/sh"),
+			setupMock:      func(ms *mocks.MockExpenseService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Receipt must be a JPEG, PNG, WebP or PDF file",
+		},
+		{
+			name:           "Receipt Too Large",
+			activityID:     "1",
+			fields:         map[string]string{"amount": "10"},
+			receipt:        append(pngReceipt, make([]byte, maxReceiptBytes)...),
+			setupMock:      func(ms *mocks.MockExpenseService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Receipt must be at most 10MB",
+		},
+		{
+			name:           "Missing Amount",
+			activityID:     "1",
+			fields:         map[string]string{"note": "Fuel"},
+			setupMock:      func(ms *mocks.MockExpenseService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name:           "Invalid Activity ID",
+			activityID:     "invalid",
+			fields:         map[string]string{"amount": "10"},
+			setupMock:      func(ms *mocks.MockExpenseService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid Activity ID",
+		},
+		{
+			name:       "Not Allowed",
+			activityID: "1",
+			fields:     map[string]string{"amount": "10"},
+			setupMock: func(ms *mocks.MockExpenseService) {
+				ms.On("RecordExpense", uint(1), "123", "test-key", "testuser", "test@example.com", 10.0, "", "", "").
+					Return(nil, errs.Forbidden("Only campaign organisers and the treasurer can record expenses"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign organisers and the treasurer can record expenses",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, contentType := newExpenseForm(t, tt.fields, tt.receipt)
+			req := httptest.NewRequest("POST", "/activity/123/"+tt.activityID+"/expenses", body)
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleDeleteExpense(t *testing.T) {
+	router, mockService := setupExpenseTest(t)
+
+	mockService.On("DeleteExpense", uint(2), uint(1), "123", "test-key", "testuser").Return(nil).Once()
+
+	req := httptest.NewRequest("DELETE", "/activity/123/1/expenses/2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req = httptest.NewRequest("DELETE", "/activity/123/1/expenses/invalid", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandleGetExpenseReceipt(t *testing.T) {
+	router, mockService := setupExpenseTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		expiresAt := time.Now().Add(15 * time.Minute)
+		mockService.On("GetExpenseReceipt", uint(2), uint(1), "123", "test-key", "test@example.com").
+			Return(&models.DocumentLink{URL: "https://files/receipt.png?signature=abc", ExpiresAt: &expiresAt}, nil).Once()
+
+		req := httptest.NewRequest("GET", "/activity/123/1/expenses/2/receipt", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "https://files/receipt.png?signature=abc", response["data"].(map[string]interface{})["url"])
+	})
+
+	t.Run("Not A Member", func(t *testing.T) {
+		mockService.On("GetExpenseReceipt", uint(2), uint(1), "123", "test-key", "test@example.com").
+			Return(nil, errs.Forbidden("Only campaign members can view receipts")).Once()
+
+		req := httptest.NewRequest("GET", "/activity/123/1/expenses/2/receipt", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Invalid Expense ID", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/activity/123/1/expenses/invalid/receipt", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandleReconcileActivity(t *testing.T) {
+	router, mockService := setupExpenseTest(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockExpenseService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			body: `{"resolution":"rollover","targetActivityId":2,"note":"Under budget"}`,
+			setupMock: func(ms *mocks.MockExpenseService) {
+				ms.On("ReconcileActivity", uint(1), "123", "test-key", "testuser", models.ReconciliationResolutionRollover,
+					mock.MatchedBy(func(target *uint) bool { return target != nil && *target == 2 }), "Under budget").
+					Return(&models.ActivityReconciliation{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Activity reconciled successfully",
+		},
+		{
+			name:           "Invalid Resolution",
+			body:           `{"resolution":"spend"}`,
+			setupMock:      func(ms *mocks.MockExpenseService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name: "Campaign Still Running",
+			body: `{"resolution":"absorb"}`,
+			setupMock: func(ms *mocks.MockExpenseService) {
+				ms.On("ReconcileActivity", uint(1), "123", "test-key", "testuser", models.ReconciliationResolutionAbsorb, (*uint)(nil), "").
+					Return(nil, errs.BadRequest("Activities can only be reconciled once the campaign has ended or been paid out", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Activities can only be reconciled once the campaign has ended or been paid out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/activity/123/1/reconcile", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleGetCampaignBudget(t *testing.T) {
+	router, mockService := setupExpenseTest(t)
+
+	mockService.On("GetCampaignBudget", "123", "test-key", "test@example.com").
+		Return(&models.CampaignBudgetReport{CampaignID: "123", Budget: 100, Spent: 60, Variance: 40}, nil).Once()
+
+	req := httptest.NewRequest("GET", "/campaign/123/budget", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 40.0, response["data"].(map[string]interface{})["variance"])
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+)
+
+type FileHandler struct {
+	files storage.FileServer
+}
+
+func NewFileHandler(files storage.FileServer) *FileHandler {
+	return &FileHandler{files: files}
+}
+
+// @Summary Download File
+// @Description Serves a file kept by the local storage, the signature in the link authenticates the request. Links to private documents expire
+// @Tags file
+// @Produce octet-stream
+// @Param path path string true "File ID"
+// @Param expires query string true "Unix time the link expires at, 0 for links that don't expire"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} file "File contents"
+// @Failure 403 {object} response "Invalid or expired download link"
+// @Failure 404 {object} response "File not found"
+// @Router /files/{path} [get]
+func (h *FileHandler) HandleDownloadFile(c *gin.Context) {
+	id := strings.TrimPrefix(c.Param("path"), "/")
+
+	path, err := h.files.Resolve(id, c.Query("expires"), c.Query("signature"))
+	if errors.Is(err, storage.ErrLinkExpired) {
+		Forbidden(c, "Download link has expired", nil)
+		return
+	}
+	if err != nil {
+		Forbidden(c, "Invalid download link", nil)
+		return
+	}
+
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		NotFound(c, "File not found", nil)
+		return
+	}
+
+	// Private documents must not be kept by shared caches
+	if storage.IsPrivate(id) {
+		c.Header("Cache-Control", "private, no-store")
+	} else {
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	c.File(path)
+}
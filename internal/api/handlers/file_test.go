@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+	storageMocks "github.com/oyen-bright/goFundIt/pkg/storage/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupFileTest(t *testing.T) (*gin.Engine, *storageMocks.MockFileServer) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockFiles := storageMocks.NewMockFileServer(t)
+	handler := NewFileHandler(mockFiles)
+
+	router.GET("/files/*path", handler.HandleDownloadFile)
+
+	return router, mockFiles
+}
+
+func TestHandleDownloadFile(t *testing.T) {
+	router, mockFiles := setupFileTest(t)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "photo.png")
+	assert.NoError(t, os.WriteFile(file, []byte("image"), 0o600))
+
+	tests := []struct {
+		name          string
+		url           string
+		setupMock     func(*storageMocks.MockFileServer)
+		expectedCode  int
+		expectedBody  string
+		expectedCache string
+	}{
+		{
+			name: "Public File",
+			url:  "/files/campaign/images/photo.png?expires=0&signature=abc",
+			setupMock: func(ms *storageMocks.MockFileServer) {
+				ms.On("Resolve", "campaign/images/photo.png", "0", "abc").Return(file, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectedBody:  "image",
+			expectedCache: "public, max-age=31536000, immutable",
+		},
+		{
+			name: "Private File",
+			url:  "/files/private/payment/reference/proof.png?expires=1700000000&signature=abc",
+			setupMock: func(ms *storageMocks.MockFileServer) {
+				ms.On("Resolve", "private/payment/reference/proof.png", "1700000000", "abc").Return(file, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectedBody:  "image",
+			expectedCache: "private, no-store",
+		},
+		{
+			name: "Expired Link",
+			url:  "/files/private/payment/reference/proof.png?expires=1&signature=abc",
+			setupMock: func(ms *storageMocks.MockFileServer) {
+				ms.On("Resolve", "private/payment/reference/proof.png", "1", "abc").Return("", storage.ErrLinkExpired)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedBody: "Download link has expired",
+		},
+		{
+			name: "Invalid Signature",
+			url:  "/files/campaign/images/photo.png?expires=0&signature=bad",
+			setupMock: func(ms *storageMocks.MockFileServer) {
+				ms.On("Resolve", "campaign/images/photo.png", "0", "bad").Return("", storage.ErrInvalidSignature)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedBody: "Invalid download link",
+		},
+		{
+			name: "Missing File",
+			url:  "/files/campaign/images/deleted.png?expires=0&signature=abc",
+			setupMock: func(ms *storageMocks.MockFileServer) {
+				ms.On("Resolve", "campaign/images/deleted.png", "0", "abc").Return(filepath.Join(dir, "deleted.png"), nil)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "File not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFiles.ExpectedCalls = nil
+			mockFiles.Calls = nil
+			tt.setupMock(mockFiles)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			if tt.expectedCache != "" {
+				assert.Equal(t, tt.expectedCache, w.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
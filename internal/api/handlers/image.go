@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"os"
+
+	"github.com/gin-gonic/gin"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type ImageHandler struct {
+	service services.ImageService
+}
+
+func NewImageHandler(service services.ImageService) *ImageHandler {
+	return &ImageHandler{service: service}
+}
+
+// @Summary Upload Campaign Image
+// @Description Uploads a JPEG or PNG campaign image of at most 5MB, metadata is stripped and a thumbnail generated. Only campaign organisers can add images
+// @Tags campaign
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param image formData file true "Image file"
+// @Success 200 {object} SuccessResponse{data=models.CampaignImage} "Image uploaded successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid image"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can add campaign images"
+// @Router /campaign/{campaignID}/images [post]
+func (h *ImageHandler) HandleUploadCampaignImage(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	file, cleanup, ok := bindImageFile(c)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	image, err := h.service.UploadCampaignImage(GetCampaignID(c), getCampaignKey(c), claims.Handle, file)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Image uploaded successfully", image)
+}
+
+// @Summary Delete Campaign Image
+// @Description Deletes a campaign image and its files from storage
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param imageID path string true "Image ID"
+// @Success 200 {object} SuccessResponse "Image deleted successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid request"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can delete campaign images"
+// @Failure 404 {object} response "Image not found"
+// @Router /campaign/{campaignID}/images/{imageID} [delete]
+func (h *ImageHandler) HandleDeleteCampaignImage(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	imageID, err := parseImageID(c)
+	if err != nil {
+		BadRequest(c, "Invalid image ID", nil)
+		return
+	}
+
+	if err := h.service.DeleteCampaignImage(imageID, GetCampaignID(c), getCampaignKey(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Image deleted successfully", nil)
+}
+
+// @Summary Upload Activity Image
+// @Description Sets the image of an activity from a JPEG or PNG file of at most 5MB, replacing any previous image. Metadata is stripped and a thumbnail generated
+// @Tags activity
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Param image formData file true "Image file"
+// @Success 200 {object} SuccessResponse{data=models.Activity} "Image uploaded successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid image"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "You are not authorized to modify this activity"
+// @Failure 404 {object} response "Activity not found"
+// @Router /activity/{campaignID}/{activityID}/image [put]
+func (h *ImageHandler) HandleUploadActivityImage(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	file, cleanup, ok := bindImageFile(c)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	activity, err := h.service.UploadActivityImage(activityID, GetCampaignID(c), getCampaignKey(c), claims.Handle, file)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Image uploaded successfully", activity)
+}
+
+// @Summary Delete Activity Image
+// @Description Removes the image of an activity and its files from storage
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param activityID path string true "Activity ID"
+// @Success 200 {object} SuccessResponse{data=models.Activity} "Image deleted successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid request"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "You are not authorized to modify this activity"
+// @Failure 404 {object} response "Activity not found"
+// @Router /activity/{campaignID}/{activityID}/image [delete]
+func (h *ImageHandler) HandleDeleteActivityImage(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	activityID, err := parseActivityID(c)
+	if err != nil {
+		BadRequest(c, "Invalid Activity ID", nil)
+		return
+	}
+
+	activity, err := h.service.DeleteActivityImage(activityID, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Image deleted successfully", activity)
+}
+
+// bindImageFile copies the uploaded image to a temporary file, cleanup removes it once the request is done
+func bindImageFile(c *gin.Context) (string, func(), bool) {
+	file, err := c.FormFile("image")
+	if err != nil {
+		BadRequest(c, "Image file is required", nil)
+		return "", nil, false
+	}
+
+	tmpFile, err := createTempFileFromMultipart(file)
+	if err != nil {
+		BadRequest(c, "Error processing image file", err.Error())
+		return "", nil, false
+	}
+	tmpFile.Close()
+
+	return tmpFile.Name(), func() { os.Remove(tmpFile.Name()) }, true
+}
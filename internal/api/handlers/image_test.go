@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupImageTest(t *testing.T) (*gin.Engine, *mocks.MockImageService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockImageService(t)
+	handler := NewImageHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/campaign/:campaignID/images", handler.HandleUploadCampaignImage)
+	router.DELETE("/campaign/:campaignID/images/:imageID", handler.HandleDeleteCampaignImage)
+	router.PUT("/activity/:campaignID/:activityID/image", handler.HandleUploadActivityImage)
+	router.DELETE("/activity/:campaignID/:activityID/image", handler.HandleDeleteActivityImage)
+
+	return router, mockService
+}
+
+func newImageForm(t *testing.T, field string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "photo.jpg")
+	assert.NoError(t, err)
+	_, err = part.Write([]byte("image"))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestHandleUploadCampaignImage(t *testing.T) {
+	router, mockService := setupImageTest(t)
+
+	tests := []struct {
+		name           string
+		field          string
+		setupMock      func(*mocks.MockImageService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:  "Success",
+			field: "image",
+			setupMock: func(ms *mocks.MockImageService) {
+				ms.On("UploadCampaignImage", "123", "test-key", "testuser", mock.MatchedBy(func(file string) bool { return file != "" })).
+					Return(&models.CampaignImage{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Image uploaded successfully",
+		},
+		{
+			name:           "Missing File",
+			field:          "file",
+			setupMock:      func(ms *mocks.MockImageService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Image file is required",
+		},
+		{
+			name:  "Unsupported Image",
+			field: "image",
+			setupMock: func(ms *mocks.MockImageService) {
+				ms.On("UploadCampaignImage", "123", "test-key", "testuser", mock.Anything).
+					Return(nil, errs.BadRequest("Only JPEG and PNG images are supported", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Only JPEG and PNG images are supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, contentType := newImageForm(t, tt.field)
+			req := httptest.NewRequest("POST", "/campaign/123/images", body)
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleDeleteCampaignImage(t *testing.T) {
+	router, mockService := setupImageTest(t)
+
+	mockService.On("DeleteCampaignImage", uint(4), "123", "test-key", "testuser").Return(nil).Once()
+
+	req := httptest.NewRequest("DELETE", "/campaign/123/images/4", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req = httptest.NewRequest("DELETE", "/campaign/123/images/invalid", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandleActivityImage(t *testing.T) {
+	router, mockService := setupImageTest(t)
+
+	t.Run("Upload", func(t *testing.T) {
+		mockService.On("UploadActivityImage", uint(1), "123", "test-key", "testuser", mock.AnythingOfType("string")).
+			Return(&models.Activity{ID: 1, ImageUrl: "https://cdn/image.jpg"}, nil).Once()
+
+		body, contentType := newImageForm(t, "image")
+		req := httptest.NewRequest("PUT", "/activity/123/1/image", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Upload Invalid Activity ID", func(t *testing.T) {
+		body, contentType := newImageForm(t, "image")
+		req := httptest.NewRequest("PUT", "/activity/123/invalid/image", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mockService.On("DeleteActivityImage", uint(1), "123", "test-key", "testuser").
+			Return(nil, errs.Forbidden("You are not authorized to modify this activity")).Once()
+
+		req := httptest.NewRequest("DELETE", "/activity/123/1/image", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
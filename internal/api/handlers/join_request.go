@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type JoinRequestHandler struct {
+	service services.JoinRequestService
+}
+
+func NewJoinRequestHandler(service services.JoinRequestService) *JoinRequestHandler {
+	return &JoinRequestHandler{service: service}
+}
+
+// @Summary Request to Join Campaign
+// @Description Sends a request to the creator of a public campaign to be added as a contributor
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param slug path string true "Campaign Slug"
+// @Param request body dto.JoinCampaignRequest true "Join Request Details"
+// @Success 200 {object} SuccessResponse{data=models.JoinRequest} "Join request sent"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /public/campaign/{slug}/join [post]
+func (h *JoinRequestHandler) HandleRequestToJoin(c *gin.Context) {
+	var requestDTO dto.JoinCampaignRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	request := models.NewJoinRequest("", requestDTO.Name, claims.Email, requestDTO.Message, requestDTO.Amount)
+	if err := h.service.RequestToJoin(request, c.Param("slug"), claims.Email); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Join request sent", request)
+}
+
+// @Summary Get Join Requests
+// @Description Retrieves the requests to join a campaign
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.JoinRequest} "Join requests retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can manage join requests"
+// @Failure 404 {object} response "Campaign not found"
+// @Router /campaign/{campaignID}/join-requests [get]
+func (h *JoinRequestHandler) HandleGetJoinRequests(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requests, err := h.service.GetJoinRequests(GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Join requests retrieved successfully", requests)
+}
+
+// @Summary Approve Join Request
+// @Description Approves a join request and adds the requester to the campaign as a contributor
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param requestID path string true "Join Request ID"
+// @Success 200 {object} SuccessResponse{data=models.Contributor} "Join request approved"
+// @Failure 400 {object} BadRequestResponse "Invalid join request ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 404 {object} response "Join request or Campaign not found"
+// @Router /campaign/{campaignID}/join-requests/{requestID}/approve [post]
+func (h *JoinRequestHandler) HandleApproveJoinRequest(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requestID, err := parseJoinRequestID(c)
+	if err != nil {
+		BadRequest(c, "Invalid join request ID", nil)
+		return
+	}
+
+	contributor, err := h.service.ApproveJoinRequest(requestID, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Join request approved", contributor)
+}
+
+// @Summary Reject Join Request
+// @Description Declines a join request and notifies the requester
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param requestID path string true "Join Request ID"
+// @Success 200 {object} SuccessResponse "Join request rejected"
+// @Failure 400 {object} BadRequestResponse "Invalid join request ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can manage join requests"
+// @Failure 404 {object} response "Join request or Campaign not found"
+// @Router /campaign/{campaignID}/join-requests/{requestID}/reject [post]
+func (h *JoinRequestHandler) HandleRejectJoinRequest(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	requestID, err := parseJoinRequestID(c)
+	if err != nil {
+		BadRequest(c, "Invalid join request ID", nil)
+		return
+	}
+
+	if err := h.service.RejectJoinRequest(requestID, GetCampaignID(c), getCampaignKey(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Join request rejected", nil)
+}
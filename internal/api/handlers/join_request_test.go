@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupJoinRequestTest(t *testing.T) (*gin.Engine, *mocks.MockJoinRequestService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockJoinRequestService(t)
+	handler := NewJoinRequestHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/public/campaign/:slug/join", handler.HandleRequestToJoin)
+	router.GET("/campaign/:campaignID/join-requests", handler.HandleGetJoinRequests)
+	router.POST("/campaign/:campaignID/join-requests/:requestID/approve", handler.HandleApproveJoinRequest)
+	router.POST("/campaign/:campaignID/join-requests/:requestID/reject", handler.HandleRejectJoinRequest)
+
+	return router, mockService
+}
+
+func TestHandleRequestToJoin(t *testing.T) {
+	router, mockService := setupJoinRequestTest(t)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mocks.MockJoinRequestService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:        "Success",
+			requestBody: map[string]interface{}{"name": "Jane", "amount": 50, "message": "Count me in"},
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("RequestToJoin", mock.AnythingOfType("*models.JoinRequest"), "community-event", "test@example.com").Return(nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Join request sent",
+		},
+		{
+			name:        "Already Pending",
+			requestBody: map[string]interface{}{"name": "Jane", "amount": 50},
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("RequestToJoin", mock.AnythingOfType("*models.JoinRequest"), "community-event", "test@example.com").
+					Return(errs.BadRequest("You already have a pending request to join this campaign", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "You already have a pending request to join this campaign",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/public/campaign/community-event/join", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleGetJoinRequests(t *testing.T) {
+	router, mockService := setupJoinRequestTest(t)
+
+	mockService.On("GetJoinRequests", "123", "test-key", "testuser").
+		Return([]models.JoinRequest{{ID: 1, Name: "Jane"}}, nil)
+
+	req := httptest.NewRequest("GET", "/campaign/123/join-requests", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Join requests retrieved successfully", response["message"])
+	assert.Len(t, response["data"], 1)
+}
+
+func TestHandleApproveJoinRequest(t *testing.T) {
+	router, mockService := setupJoinRequestTest(t)
+
+	tests := []struct {
+		name           string
+		requestID      string
+		setupMock      func(*mocks.MockJoinRequestService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:      "Success",
+			requestID: "1",
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("ApproveJoinRequest", uint(1), "123", "test-key", "testuser").
+					Return(&models.Contributor{Email: "jane@example.com"}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Join request approved",
+		},
+		{
+			name:           "Invalid ID",
+			requestID:      "invalid",
+			setupMock:      func(ms *mocks.MockJoinRequestService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid join request ID",
+		},
+		{
+			name:      "Already Reviewed",
+			requestID: "1",
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("ApproveJoinRequest", uint(1), "123", "test-key", "testuser").
+					Return(nil, errs.BadRequest("Join request has already been reviewed", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Join request has already been reviewed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/join-requests/"+tt.requestID+"/approve", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleRejectJoinRequest(t *testing.T) {
+	router, mockService := setupJoinRequestTest(t)
+
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockJoinRequestService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("RejectJoinRequest", uint(1), "123", "test-key", "testuser").Return(nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Join request rejected",
+		},
+		{
+			name: "Not Creator",
+			setupMock: func(ms *mocks.MockJoinRequestService) {
+				ms.On("RejectJoinRequest", uint(1), "123", "test-key", "testuser").
+					Return(errs.Forbidden("Only campaign organisers can manage join requests"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign organisers can manage join requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/join-requests/1/reject", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
@@ -60,7 +60,7 @@ func (p *PaymentHandler) HandleInitializePayment(c *gin.Context) {
 // @Failure 404 {object} response "Contributor not found"
 // @Router /payment/manual/contributor/{contributorID} [post]
 func (p *PaymentHandler) HandleInitializeManualPayment(c *gin.Context) {
-	userEmail := getClaimsFromContext(c).Email
+	claims := getClaimsFromContext(c)
 	contributorID, err := parseContributorID(c)
 	if err != nil {
 		BadRequest(c, "Invalid contributor ID", nil)
@@ -81,7 +81,7 @@ func (p *PaymentHandler) HandleInitializeManualPayment(c *gin.Context) {
 		reference = tmpFile.Name()
 	}
 
-	payment, err := p.service.InitializeManualPayment(contributorID, reference, userEmail, getCampaignKey(c))
+	payment, err := p.service.InitializeManualPayment(contributorID, reference, claims.Email, claims.Handle, getCampaignKey(c))
 	if err != nil {
 		FromError(c, err)
 		return
@@ -137,6 +137,30 @@ func (p *PaymentHandler) HandleVerifyManualPayment(c *gin.Context) {
 	Success(c, "Manual Payment verified", nil)
 }
 
+// @Summary Get Payment Proof
+// @Description Returns a signed link to the proof of a manual payment, the link expires after a few minutes. Only the contributor and the campaign treasurer can view it
+// @Tags payment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param reference path string true "Payment reference"
+// @Success 200 {object} SuccessResponse{data=models.DocumentLink} "Payment proof retrieved"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the contributor and the campaign treasurer can view the payment proof"
+// @Failure 404 {object} response "Payment not found"
+// @Router /payment/proof/{reference} [get]
+func (p *PaymentHandler) HandleGetPaymentProof(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	link, err := p.service.GetPaymentProof(c.Param("reference"), claims.Email, claims.Handle, getCampaignKey(c))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+	Success(c, "Payment proof retrieved", link)
+}
+
 // @Summary Handle Paystack Webhook
 // @Description Processes incoming Paystack webhook events
 // @Tags payment
@@ -11,6 +11,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/oyen-bright/goFundIt/pkg/paystack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -129,6 +131,58 @@ func TestPaymentHandler_HandleVerifyPayment(t *testing.T) {
 	}
 }
 
+func TestPaymentHandler_HandleGetPaymentProof(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		setupMock          func(*mocks.MockPaymentService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name: "Success",
+			setupMock: func(mockService *mocks.MockPaymentService) {
+				mockService.On("GetPaymentProof", "ref123", "test@example.com", "testuser", "123").
+					Return(&models.DocumentLink{URL: "https://files.example.com/proof"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Payment proof retrieved",
+		},
+		{
+			name: "Not Allowed",
+			setupMock: func(mockService *mocks.MockPaymentService) {
+				mockService.On("GetPaymentProof", "ref123", "test@example.com", "testuser", "123").
+					Return(nil, errs.Forbidden("Only the contributor and the campaign treasurer can view the payment proof"))
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Only the contributor and the campaign treasurer can view the payment proof",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockPaymentService(t)
+			tt.setupMock(mockService)
+			handler := NewPaymentHandler(mockService)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("claims", jwt.Claims{Handle: "testuser", Email: "test@example.com"})
+			c.Set("Campaign-Key", "123")
+			c.Params = []gin.Param{{Key: "reference", Value: "ref123"}}
+
+			handler.HandleGetPaymentProof(c)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMessage, response["message"])
+		})
+	}
+}
+
 func TestPaymentHandler_HandlePayStackWebhook(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/campaign"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type PollHandler struct {
+	service services.PollService
+}
+
+func NewPollHandler(service services.PollService) *PollHandler {
+	return &PollHandler{service: service}
+}
+
+// @Summary Create Poll
+// @Description Asks the contributors of a campaign a single or multiple choice question, results are streamed over the campaign websocket
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.CreatePollRequest true "Poll Details"
+// @Success 200 {object} SuccessResponse{data=models.Poll} "Poll created"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can create polls"
+// @Router /campaign/{campaignID}/polls [post]
+func (h *PollHandler) HandleCreatePoll(c *gin.Context) {
+	var requestDTO dto.CreatePollRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	poll, err := h.service.CreatePoll(GetCampaignID(c), getCampaignKey(c), claims.Handle, requestDTO.Question, requestDTO.Options, requestDTO.MultipleChoice, requestDTO.Deadline)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Poll created", poll)
+}
+
+// @Summary Get Polls
+// @Description Retrieves the polls of a campaign with their results
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.Poll} "Polls retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign members can view polls"
+// @Router /campaign/{campaignID}/polls [get]
+func (h *PollHandler) HandleGetPolls(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	polls, err := h.service.GetPolls(GetCampaignID(c), getCampaignKey(c), claims.Email)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Polls retrieved successfully", polls)
+}
+
+// @Summary Vote On Poll
+// @Description Records a contributor's choice on an open poll, contributors can vote once
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param pollID path string true "Poll ID"
+// @Param request body dto.PollVoteRequest true "Vote Details"
+// @Success 200 {object} SuccessResponse{data=models.Poll} "Vote recorded successfully"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign contributors can vote on polls"
+// @Failure 404 {object} response "Poll not found"
+// @Router /campaign/{campaignID}/polls/{pollID}/votes [post]
+func (h *PollHandler) HandleVoteOnPoll(c *gin.Context) {
+	var requestDTO dto.PollVoteRequest
+	claims := getClaimsFromContext(c)
+
+	pollID, err := parsePollID(c)
+	if err != nil {
+		BadRequest(c, "Invalid poll ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	poll, err := h.service.VoteOnPoll(pollID, GetCampaignID(c), getCampaignKey(c), claims.Email, requestDTO.OptionIDs)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Vote recorded successfully", poll)
+}
+
+// @Summary Close Poll
+// @Description Stops a poll from accepting votes before its deadline
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param pollID path string true "Poll ID"
+// @Success 200 {object} SuccessResponse{data=models.Poll} "Poll closed"
+// @Failure 400 {object} BadRequestResponse "Invalid poll ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only campaign organisers can close polls"
+// @Failure 404 {object} response "Poll not found"
+// @Router /campaign/{campaignID}/polls/{pollID}/close [post]
+func (h *PollHandler) HandleClosePoll(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	pollID, err := parsePollID(c)
+	if err != nil {
+		BadRequest(c, "Invalid poll ID", nil)
+		return
+	}
+
+	poll, err := h.service.ClosePoll(pollID, GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Poll closed", poll)
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupPollTest(t *testing.T) (*gin.Engine, *mocks.MockPollService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockPollService(t)
+	handler := NewPollHandler(mockService)
+
+	router.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+
+	router.POST("/campaign/:campaignID/polls", handler.HandleCreatePoll)
+	router.POST("/campaign/:campaignID/polls/:pollID/votes", handler.HandleVoteOnPoll)
+	router.POST("/campaign/:campaignID/polls/:pollID/close", handler.HandleClosePoll)
+
+	return router, mockService
+}
+
+func TestHandleCreatePoll(t *testing.T) {
+	router, mockService := setupPollTest(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockPollService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			body: `{"question":"Where should we eat?","options":["Pizza","Sushi"],"multipleChoice":true}`,
+			setupMock: func(ms *mocks.MockPollService) {
+				ms.On("CreatePoll", "123", "test-key", "testuser", "Where should we eat?", []string{"Pizza", "Sushi"}, true, mock.Anything).
+					Return(&models.Poll{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Poll created",
+		},
+		{
+			name:           "Single Option",
+			body:           `{"question":"Where should we eat?","options":["Pizza"]}`,
+			setupMock:      func(ms *mocks.MockPollService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name: "Not An Organiser",
+			body: `{"question":"Where should we eat?","options":["Pizza","Sushi"]}`,
+			setupMock: func(ms *mocks.MockPollService) {
+				ms.On("CreatePoll", "123", "test-key", "testuser", "Where should we eat?", []string{"Pizza", "Sushi"}, false, mock.Anything).
+					Return(nil, errs.Forbidden("Only campaign organisers can create polls"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only campaign organisers can create polls",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/polls", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleVoteOnPoll(t *testing.T) {
+	router, mockService := setupPollTest(t)
+
+	tests := []struct {
+		name           string
+		pollID         string
+		body           string
+		setupMock      func(*mocks.MockPollService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:   "Success",
+			pollID: "1",
+			body:   `{"optionIds":[2]}`,
+			setupMock: func(ms *mocks.MockPollService) {
+				ms.On("VoteOnPoll", uint(1), "123", "test-key", "test@example.com", []uint{2}).Return(&models.Poll{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Vote recorded successfully",
+		},
+		{
+			name:           "Invalid ID",
+			pollID:         "invalid",
+			body:           `{"optionIds":[2]}`,
+			setupMock:      func(ms *mocks.MockPollService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid poll ID",
+		},
+		{
+			name:   "Closed Poll",
+			pollID: "1",
+			body:   `{"optionIds":[2]}`,
+			setupMock: func(ms *mocks.MockPollService) {
+				ms.On("VoteOnPoll", uint(1), "123", "test-key", "test@example.com", []uint{2}).Return(nil, errs.BadRequest("Poll is closed", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Poll is closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/polls/"+tt.pollID+"/votes", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleClosePoll(t *testing.T) {
+	router, mockService := setupPollTest(t)
+
+	mockService.On("ClosePoll", uint(1), "123", "test-key", "testuser").Return(&models.Poll{ID: 1}, nil)
+
+	req := httptest.NewRequest("POST", "/campaign/123/polls/1/close", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Poll closed", response["message"])
+}
@@ -15,6 +15,15 @@ func bindJSON(c *gin.Context, obj interface{}) error {
 	return nil
 }
 
+// bindForm binds a multipart form or JSON body, the content type decides which
+func bindForm(c *gin.Context, obj interface{}) error {
+	if err := c.ShouldBind(obj); err != nil {
+		BadRequest(c, "Invalid inputs, please check and try again", ExtractValidationErrors(err))
+		return err
+	}
+	return nil
+}
+
 func ExtractValidationErrors(err error) []ValidationError {
 	var errors []ValidationError
 
@@ -7,7 +7,10 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/contributor"
+	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
 
 // Helper functions
@@ -32,6 +35,11 @@ func getCommentID(c *gin.Context) string {
 	return c.Param("commentID")
 }
 
+// getInvitationToken extracts the invitation token form the requestParam
+func getInvitationToken(c *gin.Context) string {
+	return c.Param("token")
+}
+
 // parseActivityID converts the activity ID from the URL parameter to uint
 func parseActivityID(c *gin.Context) (uint, error) {
 	id, err := strconv.ParseUint(c.Param("activityID"), 10, 64)
@@ -41,6 +49,69 @@ func parseActivityID(c *gin.Context) (uint, error) {
 	return uint(id), nil
 }
 
+// parsePageLimit converts the optional limit query parameter to int, 0 when it isn't set
+func parsePageLimit(c *gin.Context) (int, error) {
+	limit := c.Query("limit")
+	if limit == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(limit)
+}
+
+// parseEventSequence parses the sequence number of the last event a reconnecting client received,
+// ok is false when the client didn't send one
+func parseEventSequence(c *gin.Context) (seq uint64, ok bool, err error) {
+	since := c.Query("since")
+	if since == "" {
+		return 0, false, nil
+	}
+	seq, err = strconv.ParseUint(since, 10, 64)
+	return seq, err == nil, err
+}
+
+// parseEventTypes parses the comma separated event types a client subscribes to, no types subscribes to every event
+func parseEventTypes(c *gin.Context) ([]websocket.EventType, error) {
+	return websocket.ParseEventTypes(c.Query("types"))
+}
+
+// parseLastEventID parses the Last-Event-ID header browsers send when an event stream reconnects,
+// the since query is used when the header isn't set
+func parseLastEventID(c *gin.Context) (uint64, bool, error) {
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		return parseEventSequence(c)
+	}
+	seq, err := strconv.ParseUint(lastEventID, 10, 64)
+	return seq, err == nil, err
+}
+
+// parseJoinRequestID converts the join request ID from the URL parameter to uint
+func parseJoinRequestID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("requestID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parseContributorRequestID converts the contributor request ID from the URL parameter to uint
+func parseContributorRequestID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("requestID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parseExtensionID converts the campaign extension ID from the URL parameter to uint
+func parseExtensionID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("extensionID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
 // parseContributorID converts the contributor ID from the URL parameter to uint
 func parseContributorID(c *gin.Context) (uint, error) {
 	id, err := strconv.ParseUint(c.Param("contributorID"), 10, 64)
@@ -50,6 +121,76 @@ func parseContributorID(c *gin.Context) (uint, error) {
 	return uint(id), nil
 }
 
+// parseCalendarFeedID converts the calendar feed ID from the URL parameter to uint
+func parseCalendarFeedID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("feedID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parsePollID converts the poll ID from the URL parameter to uint
+func parsePollID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("pollID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parseExpenseID converts the expense ID from the URL parameter to uint
+func parseExpenseID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("expenseID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parseImageID converts the image ID from the URL parameter to uint
+func parseImageID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("imageID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// parseWebhookID converts the webhook ID from the URL parameter to uint
+func parseWebhookID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("webhookID"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// bindImportContributorRows reads the contributors of an import from a CSV file, a CSV body or a JSON array
+func bindImportContributorRows(c *gin.Context) ([]dto.ImportContributorRow, []models.ContributorImportError, error) {
+	switch c.ContentType() {
+	case gin.MIMEMultipartPOSTForm:
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return nil, nil, err
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer file.Close()
+		return dto.ParseContributorsCSV(file)
+	case "text/csv":
+		return dto.ParseContributorsCSV(c.Request.Body)
+	default:
+		var rows []dto.ImportContributorRow
+		if err := c.ShouldBindJSON(&rows); err != nil {
+			return nil, nil, err
+		}
+		return rows, nil, nil
+	}
+}
+
 // CreateTempFileFromMultipart creates a temporary file from multipart form data
 func createTempFileFromMultipart(file *multipart.FileHeader) (*os.File, error) {
 	tempFile, err := os.CreateTemp("", "upload-*.png")
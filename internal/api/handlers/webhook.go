@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/webhook"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type WebhookHandler struct {
+	service services.WebhookService
+}
+
+func NewWebhookHandler(service services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// @Summary Create Webhook
+// @Description Registers an HTTPS endpoint that campaign events are posted to. Deliveries are signed with the returned secret, the X-GoFundIt-Signature header holds the hex encoded HMAC-SHA512 of the body. The secret is only returned once, keep it private
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param request body dto.CreateWebhookRequest true "Webhook Details"
+// @Success 200 {object} SuccessResponse{data=models.Webhook} "Webhook created"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Router /campaign/{campaignID}/webhooks [post]
+func (h *WebhookHandler) HandleCreateWebhook(c *gin.Context) {
+	var requestDTO dto.CreateWebhookRequest
+	claims := getClaimsFromContext(c)
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	webhook, err := h.service.CreateWebhook(GetCampaignID(c), getCampaignKey(c), claims.Handle, requestDTO.URL, requestDTO.EventTypes)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhook created", webhook)
+}
+
+// @Summary Get Webhooks
+// @Description Retrieves the webhooks of the campaign, webhooks disabled after failed deliveries have disabledAt set
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=[]models.Webhook} "Webhooks retrieved successfully"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Router /campaign/{campaignID}/webhooks [get]
+func (h *WebhookHandler) HandleGetWebhooks(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	webhooks, err := h.service.GetWebhooks(GetCampaignID(c), getCampaignKey(c), claims.Handle)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhooks retrieved successfully", webhooks)
+}
+
+// @Summary Update Webhook
+// @Description Changes the URL or event types of a webhook, or turns it on or off. Enabling a webhook that was disabled after failed deliveries clears its failure count
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param webhookID path string true "Webhook ID"
+// @Param request body dto.UpdateWebhookRequest true "Webhook Changes"
+// @Success 200 {object} SuccessResponse{data=models.Webhook} "Webhook updated"
+// @Failure 400 {object} BadRequestResponse{errors=[]ValidationError} "Invalid inputs"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Failure 404 {object} response "Webhook not found"
+// @Router /campaign/{campaignID}/webhooks/{webhookID} [patch]
+func (h *WebhookHandler) HandleUpdateWebhook(c *gin.Context) {
+	var requestDTO dto.UpdateWebhookRequest
+	claims := getClaimsFromContext(c)
+
+	webhookID, err := parseWebhookID(c)
+	if err != nil {
+		BadRequest(c, "Invalid webhook ID", nil)
+		return
+	}
+
+	if err := bindJSON(c, &requestDTO); err != nil {
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhook(webhookID, GetCampaignID(c), getCampaignKey(c), claims.Handle, requestDTO.URL, requestDTO.EventTypes, requestDTO.Enabled)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhook updated", webhook)
+}
+
+// @Summary Delete Webhook
+// @Description Removes a webhook along with its delivery log
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param webhookID path string true "Webhook ID"
+// @Success 200 {object} SuccessResponse "Webhook deleted"
+// @Failure 400 {object} BadRequestResponse "Invalid webhook ID"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Failure 404 {object} response "Webhook not found"
+// @Router /campaign/{campaignID}/webhooks/{webhookID} [delete]
+func (h *WebhookHandler) HandleDeleteWebhook(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	webhookID, err := parseWebhookID(c)
+	if err != nil {
+		BadRequest(c, "Invalid webhook ID", nil)
+		return
+	}
+
+	if err := h.service.DeleteWebhook(webhookID, GetCampaignID(c), getCampaignKey(c), claims.Handle); err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhook deleted", nil)
+}
+
+// @Summary Get Webhook Deliveries
+// @Description Retrieves the delivery log of a webhook newest first, with the payload sent and what the endpoint answered. Pending deliveries are retried with a growing wait until they have been tried 6 times
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param webhookID path string true "Webhook ID"
+// @Param status query string false "Only fetch deliveries with this status, pending, succeeded or failed"
+// @Param limit query int false "Number of deliveries to fetch, at most 200"
+// @Success 200 {object} SuccessResponse{data=[]models.WebhookDelivery} "Webhook deliveries retrieved successfully"
+// @Failure 400 {object} BadRequestResponse "Invalid query"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Failure 404 {object} response "Webhook not found"
+// @Router /campaign/{campaignID}/webhooks/{webhookID}/deliveries [get]
+func (h *WebhookHandler) HandleGetWebhookDeliveries(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	webhookID, err := parseWebhookID(c)
+	if err != nil {
+		BadRequest(c, "Invalid webhook ID", nil)
+		return
+	}
+
+	limit, err := parsePageLimit(c)
+	if err != nil {
+		BadRequest(c, "Invalid limit", nil)
+		return
+	}
+
+	status := models.WebhookDeliveryStatus(c.Query("status"))
+	deliveries, err := h.service.GetDeliveries(webhookID, GetCampaignID(c), getCampaignKey(c), claims.Handle, status, limit)
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhook deliveries retrieved successfully", deliveries)
+}
+
+// @Summary Replay Webhook Delivery
+// @Description Sends the event of a delivery again as a new delivery. The event keeps its id so the endpoint can tell it is a repeat
+// @Tags campaign
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Param campaignID path string true "Campaign ID"
+// @Param webhookID path string true "Webhook ID"
+// @Param deliveryID path string true "Delivery ID"
+// @Success 200 {object} SuccessResponse{data=models.WebhookDelivery} "Webhook delivery replayed"
+// @Failure 400 {object} BadRequestResponse "Enable the webhook before replaying its deliveries"
+// @Failure 401 {object} UnauthorizedResponse "Unauthorized"
+// @Failure 403 {object} response "Only the campaign owner can manage webhooks"
+// @Failure 404 {object} response "Webhook delivery not found"
+// @Router /campaign/{campaignID}/webhooks/{webhookID}/deliveries/{deliveryID}/replay [post]
+func (h *WebhookHandler) HandleReplayWebhookDelivery(c *gin.Context) {
+	claims := getClaimsFromContext(c)
+
+	webhookID, err := parseWebhookID(c)
+	if err != nil {
+		BadRequest(c, "Invalid webhook ID", nil)
+		return
+	}
+
+	delivery, err := h.service.ReplayDelivery(webhookID, GetCampaignID(c), getCampaignKey(c), claims.Handle, c.Param("deliveryID"))
+	if err != nil {
+		FromError(c, err)
+		return
+	}
+
+	Success(c, "Webhook delivery replayed", delivery)
+}
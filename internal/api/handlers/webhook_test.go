@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupWebhookTest(t *testing.T) (*gin.Engine, *mocks.MockWebhookService) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockService := mocks.NewMockWebhookService(t)
+	handler := NewWebhookHandler(mockService)
+
+	protected := router.Group("/")
+	protected.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.Claims{
+			Handle: "testuser",
+			Email:  "test@example.com",
+		})
+		c.Set("Campaign-Key", "test-key")
+		c.Next()
+	})
+	protected.POST("/campaign/:campaignID/webhooks", handler.HandleCreateWebhook)
+	protected.GET("/campaign/:campaignID/webhooks", handler.HandleGetWebhooks)
+	protected.PATCH("/campaign/:campaignID/webhooks/:webhookID", handler.HandleUpdateWebhook)
+	protected.DELETE("/campaign/:campaignID/webhooks/:webhookID", handler.HandleDeleteWebhook)
+	protected.GET("/campaign/:campaignID/webhooks/:webhookID/deliveries", handler.HandleGetWebhookDeliveries)
+	protected.POST("/campaign/:campaignID/webhooks/:webhookID/deliveries/:deliveryID/replay", handler.HandleReplayWebhookDelivery)
+
+	return router, mockService
+}
+
+func TestHandleCreateWebhook(t *testing.T) {
+	router, mockService := setupWebhookTest(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockWebhookService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name: "Success",
+			body: `{"url":"https://example.com/hooks","eventTypes":["campaign_updated"]}`,
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("CreateWebhook", "123", "test-key", "testuser", "https://example.com/hooks", []string{"campaign_updated"}).
+					Return(&models.Webhook{ID: 1, URL: "https://example.com/hooks", Secret: "whsec_secret"}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Webhook created",
+		},
+		{
+			name:           "Missing Event Types",
+			body:           `{"url":"https://example.com/hooks","eventTypes":[]}`,
+			setupMock:      func(ms *mocks.MockWebhookService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid inputs, please check and try again",
+		},
+		{
+			name: "Not Owner",
+			body: `{"url":"https://example.com/hooks","eventTypes":["campaign_updated"]}`,
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("CreateWebhook", "123", "test-key", "testuser", "https://example.com/hooks", []string{"campaign_updated"}).
+					Return(nil, errs.Forbidden("Only the campaign owner can manage webhooks"))
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedResult: "Only the campaign owner can manage webhooks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("POST", "/campaign/123/webhooks", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+
+			if tt.expectedCode == http.StatusOK {
+				data := response["data"].(map[string]interface{})
+				assert.Equal(t, "whsec_secret", data["secret"])
+			}
+		})
+	}
+}
+
+func TestHandleUpdateWebhook(t *testing.T) {
+	router, mockService := setupWebhookTest(t)
+
+	tests := []struct {
+		name           string
+		webhookID      string
+		body           string
+		setupMock      func(*mocks.MockWebhookService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:      "Enable",
+			webhookID: "1",
+			body:      `{"enabled":true}`,
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("UpdateWebhook", uint(1), "123", "test-key", "testuser", (*string)(nil), []string(nil), mock.MatchedBy(func(enabled *bool) bool {
+					return enabled != nil && *enabled
+				})).Return(&models.Webhook{ID: 1}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Webhook updated",
+		},
+		{
+			name:           "Invalid ID",
+			webhookID:      "invalid",
+			body:           `{"enabled":true}`,
+			setupMock:      func(ms *mocks.MockWebhookService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid webhook ID",
+		},
+		{
+			name:      "Not Found",
+			webhookID: "2",
+			body:      `{"enabled":false}`,
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("UpdateWebhook", uint(2), "123", "test-key", "testuser", (*string)(nil), []string(nil), mock.Anything).
+					Return(nil, errs.NotFound("Webhook not found"))
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedResult: "Webhook not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("PATCH", "/campaign/123/webhooks/"+tt.webhookID, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleDeleteWebhook(t *testing.T) {
+	router, mockService := setupWebhookTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		mockService.ExpectedCalls = nil
+		mockService.On("DeleteWebhook", uint(1), "123", "test-key", "testuser").Return(nil)
+
+		req := httptest.NewRequest("DELETE", "/campaign/123/webhooks/1", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestHandleGetWebhookDeliveries(t *testing.T) {
+	router, mockService := setupWebhookTest(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockWebhookService)
+		expectedCode   int
+		expectedResult string
+	}{
+		{
+			name:  "Success",
+			query: "?status=failed&limit=10",
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("GetDeliveries", uint(1), "123", "test-key", "testuser", models.WebhookDeliveryFailed, 10).
+					Return([]models.WebhookDelivery{{ID: "WHD1", Status: models.WebhookDeliveryFailed}}, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedResult: "Webhook deliveries retrieved successfully",
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?limit=ten",
+			setupMock:      func(ms *mocks.MockWebhookService) {},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid limit",
+		},
+		{
+			name:  "Invalid Status",
+			query: "?status=lost",
+			setupMock: func(ms *mocks.MockWebhookService) {
+				ms.On("GetDeliveries", uint(1), "123", "test-key", "testuser", models.WebhookDeliveryStatus("lost"), 0).
+					Return(nil, errs.BadRequest("Invalid delivery status", nil))
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedResult: "Invalid delivery status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+			tt.setupMock(mockService)
+
+			req := httptest.NewRequest("GET", "/campaign/123/webhooks/1/deliveries"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, response["message"])
+		})
+	}
+}
+
+func TestHandleReplayWebhookDelivery(t *testing.T) {
+	router, mockService := setupWebhookTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		mockService.ExpectedCalls = nil
+		replayOf := "WHD1"
+		mockService.On("ReplayDelivery", uint(1), "123", "test-key", "testuser", "WHD1").
+			Return(&models.WebhookDelivery{ID: "WHD2", ReplayOf: &replayOf, Status: models.WebhookDeliveryPending}, nil)
+
+		req := httptest.NewRequest("POST", "/campaign/123/webhooks/1/deliveries/WHD1/replay", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		data := response["data"].(map[string]interface{})
+		assert.Equal(t, "WHD1", data["replayOf"])
+	})
+
+	t.Run("Disabled Webhook", func(t *testing.T) {
+		mockService.ExpectedCalls = nil
+		mockService.On("ReplayDelivery", uint(1), "123", "test-key", "testuser", "WHD1").
+			Return(nil, errs.BadRequest("Enable the webhook before replaying its deliveries", nil))
+
+		req := httptest.NewRequest("POST", "/campaign/123/webhooks/1/deliveries/WHD1/replay", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
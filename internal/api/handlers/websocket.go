@@ -1,12 +1,15 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 
 	gorilla "github.com/gorilla/websocket"
 
 	"github.com/gin-gonic/gin"
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/chat"
 	"github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
@@ -15,6 +18,7 @@ import (
 type WebSocketHandler struct {
 	hub             *websocket.Hub
 	campaignService interfaces.CampaignService
+	chatService     interfaces.ChatService
 }
 
 var upgrader = gorilla.Upgrader{
@@ -27,23 +31,31 @@ var upgrader = gorilla.Upgrader{
 }
 
 // NewWebSocketHandler creates a new instance of WebSocketHandler
-func NewWebSocketHandler(hub *websocket.Hub, campaignService interfaces.CampaignService) *WebSocketHandler {
+func NewWebSocketHandler(hub *websocket.Hub, campaignService interfaces.CampaignService, chatService interfaces.ChatService) *WebSocketHandler {
 	return &WebSocketHandler{
 		hub:             hub,
 		campaignService: campaignService,
+		chatService:     chatService,
 	}
 }
 
 // @Summary Campaign WebSocket Connection
-// @Description Establishes a WebSocket connection for real-time updates about campaign activities
+// @Description Establishes a WebSocket connection for real-time updates about campaign activities.
+// @Description Campaign members can also send chat_message, chat_typing and chat_receipt messages to take part in the campaign chat.
+// @Description Broadcasts carry a seq number, a reconnecting client passes the last one it received as since to get the events it missed
+// @Description or a resync_required message when they are no longer kept. chat_typing updates are not numbered or replayed.
+// @Description Clients receive every event unless they list the types they want in types, or later send a subscribe message with the types.
+// @Description Only the campaign owner and treasurer receive the contributors' emails and payment details
 // @Tags websocket
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
 // @Security BearerAuth
 // @Param campaignID path string true "Campaign ID"
+// @Param since query int false "Sequence number of the last event received"
+// @Param types query string false "Comma separated event types to receive"
 // @Success 101 {string} string "Switching Protocols"
-// @Failure 400 {object} BadRequestResponse "Invalid campaign ID"
+// @Failure 400 {object} BadRequestResponse "Invalid campaign ID, since or types"
 // @Failure 401 {object} UnauthorizedResponse "Unauthorized"
 // @Failure 404 {object} response "Campaign not found"
 // @Router /ws/campaign/{campaignID} [get]
@@ -51,8 +63,23 @@ func (h *WebSocketHandler) HandleCampaignWebSocket(c *gin.Context) {
 	campaignID := GetCampaignID(c)
 	claims := c.MustGet("claims").(jwt.Claims)
 
+	key := getCampaignKey(c)
+
+	since, resume, err := parseEventSequence(c)
+	if err != nil {
+		BadRequest(c, "Invalid since", nil)
+		return
+	}
+
+	eventTypes, err := parseEventTypes(c)
+	if err != nil {
+		BadRequest(c, "Invalid types", err.Error())
+		return
+	}
+
 	// Verify campaign
-	if _, err := h.campaignService.GetCampaignByID(campaignID, getCampaignKey(c)); err != nil {
+	campaign, err := h.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
 		FromError(c, err)
 		return
 	}
@@ -63,9 +90,70 @@ func (h *WebSocketHandler) HandleCampaignWebSocket(c *gin.Context) {
 	}
 
 	client := websocket.NewClient(h.hub, conn, campaignID, claims.Handle)
+	client.SetPrivileged(campaign.SeesPaymentDetails(claims.Handle))
+	client.Hub.SetEventTypes(client, eventTypes)
+	client.OnMessage(func(client *websocket.Client, message websocket.IncomingMessage) {
+		if !h.isCampaignMember(campaignID, key, claims.Email) {
+			client.Send(websocket.NewErrorMessage("Only campaign members can chat"))
+			return
+		}
+		h.handleChatMessage(client, key, message)
+	})
 
-	client.Hub.Register(client)
+	// A reconnecting client gets the broadcasts it missed before any new ones
+	if resume {
+		client.Hub.RegisterSince(client, since)
+	} else {
+		client.Hub.Register(client)
+	}
 
-	// Start write pump in a goroutine
+	// Start the pumps in goroutines
 	go client.WritePump()
+	go client.ReadPump()
+}
+
+// isCampaignMember checks membership each time a client writes to the chat,
+// so members who leave or are removed can't keep chatting on a connection opened before
+func (h *WebSocketHandler) isCampaignMember(campaignID, key, email string) bool {
+	campaign, err := h.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return false
+	}
+	return campaign.EmailIsPartOfCampaign(email)
+}
+
+// handleChatMessage routes a message a member sent over the websocket to the chat service,
+// failures are sent back to the member only
+func (h *WebSocketHandler) handleChatMessage(client *websocket.Client, key string, message websocket.IncomingMessage) {
+	var err error
+
+	switch message.Type {
+	case websocket.EventTypeChatMessage:
+		var request dto.ChatMessageRequest
+		if err = json.Unmarshal(message.Data, &request); err == nil {
+			_, err = h.chatService.SendMessage(client.CampaignID(), key, client.UserHandle(), request.Content, request.ClientID)
+		}
+	case websocket.EventTypeChatTyping:
+		var request dto.ChatTypingRequest
+		if err = json.Unmarshal(message.Data, &request); err == nil {
+			h.chatService.SetTyping(client.CampaignID(), client.UserHandle(), request.Typing)
+		}
+	case websocket.EventTypeChatReceipt:
+		var request dto.ChatReceiptRequest
+		if err = json.Unmarshal(message.Data, &request); err == nil {
+			_, err = h.chatService.RecordReceipt(client.CampaignID(), client.UserHandle(), request.MessageID, request.Status)
+		}
+	default:
+		client.Send(websocket.NewErrorMessage("Unsupported message type"))
+		return
+	}
+
+	if err == nil {
+		return
+	}
+	if e, ok := err.(errs.Error); ok {
+		client.Send(websocket.NewErrorMessage(e.Message()))
+		return
+	}
+	client.Send(websocket.NewErrorMessage("Invalid message data"))
 }
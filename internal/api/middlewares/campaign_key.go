@@ -3,9 +3,13 @@ package middlewares
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/oyen-bright/goFundIt/internal/api/handlers"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/jwt"
 )
 
-func CampaignKey() gin.HandlerFunc {
+// CampaignKey verifies the Campaign-Key header against the campaign in the route,
+// routes without a :campaignID param must send the Campaign-ID header instead
+func CampaignKey(verifier services.CampaignAccessService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		campaignKey := c.GetHeader("Campaign-Key")
 
@@ -14,7 +18,31 @@ func CampaignKey() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		c.Set("Campaign-Key", campaignKey)
+
+		campaignID := c.Param("campaignID")
+		if campaignID == "" {
+			campaignID = c.GetHeader("Campaign-ID")
+		}
+		if campaignID == "" {
+			handlers.BadRequest(c, "Campaign ID is required", nil)
+			c.Abort()
+			return
+		}
+
+		claims, ok := c.Get("claims")
+		if !ok {
+			handlers.Unauthorized(c, "Unauthorized", nil)
+			c.Abort()
+			return
+		}
+
+		key, err := verifier.VerifyCampaignKey(campaignID, campaignKey, claims.(jwt.Claims).Email)
+		if err != nil {
+			handlers.FromError(c, err)
+			c.Abort()
+			return
+		}
+		c.Set("Campaign-Key", key)
 
 		c.Next()
 	}
@@ -8,24 +8,41 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oyen-bright/goFundIt/internal/api/handlers"
 	"github.com/oyen-bright/goFundIt/internal/api/middlewares"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
 	"github.com/oyen-bright/goFundIt/pkg/jwt"
+	"github.com/oyen-bright/goFundIt/pkg/storage/local"
 )
 
 type Config struct {
-	Router             *gin.Engine
-	AuthHandler        *handlers.AuthHandler
-	CampaignHandler    *handlers.CampaignHandler
-	SuggestionHandler  *handlers.SuggestionHandler
-	ContributorHandler *handlers.ContributorHandler
-	CommentHandler     *handlers.CommentHandler
-	ActivityHandler    *handlers.ActivityHandler
-	WebSocketHandler   *handlers.WebSocketHandler
-	PaymentHandler     *handlers.PaymentHandler
-	PayoutHandler      *handlers.PayoutHandler
-	AnalyticsHandler   *handlers.AnalyticsHandler
-	PaystackKey        string
-	XAPIKey            string
-	JWT                jwt.Jwt
+	Router                    *gin.Engine
+	AuthHandler               *handlers.AuthHandler
+	CampaignHandler           *handlers.CampaignHandler
+	SuggestionHandler         *handlers.SuggestionHandler
+	ContributorHandler        *handlers.ContributorHandler
+	CommentHandler            *handlers.CommentHandler
+	ActivityHandler           *handlers.ActivityHandler
+	WebSocketHandler          *handlers.WebSocketHandler
+	EventStreamHandler        *handlers.EventStreamHandler
+	PaymentHandler            *handlers.PaymentHandler
+	PayoutHandler             *handlers.PayoutHandler
+	AnalyticsHandler          *handlers.AnalyticsHandler
+	CampaignAccessHandler     *handlers.CampaignAccessHandler
+	JoinRequestHandler        *handlers.JoinRequestHandler
+	ContributorRequestHandler *handlers.ContributorRequestHandler
+	CampaignDeadlineHandler   *handlers.CampaignDeadlineHandler
+	CampaignRoleHandler       *handlers.CampaignRoleHandler
+	CalendarHandler           *handlers.CalendarHandler
+	WebhookHandler            *handlers.WebhookHandler
+	PollHandler               *handlers.PollHandler
+	ExpenseHandler            *handlers.ExpenseHandler
+	ImageHandler              *handlers.ImageHandler
+	ChatHandler               *handlers.ChatHandler
+	FileHandler               *handlers.FileHandler
+	PaystackKey               string
+	XAPIKey                   string
+	JWT                       jwt.Jwt
+
+	CampaignKeyVerifier services.CampaignAccessService
 }
 
 func SetupRoutes(cfg Config) {
@@ -34,7 +51,7 @@ func SetupRoutes(cfg Config) {
 	cfg.Router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "Campaign-Key", "Campaign-ID", "Last-Event-ID"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -50,16 +67,31 @@ func SetupRoutes(cfg Config) {
 	// Webhook route
 	cfg.Router.POST("/payment/paystack/webhook", cfg.PaymentHandler.HandlePayStackWebhook, middlewares.PaystackSignature(cfg.PaystackKey))
 
+	// Calendar feed route, calendar apps can't send the API key so the feed token authenticates the request
+	cfg.Router.GET("/calendar/:token", cfg.CalendarHandler.HandleGetCalendar)
+
+	// Local storage download route, browsers open these links directly so the link signature authenticates the request
+	if cfg.FileHandler != nil {
+		cfg.Router.GET(local.DownloadPath+"*path", cfg.FileHandler.HandleDownloadFile)
+	}
+
 	// API Key Middleware
 	cfg.Router.Use(middlewares.APIKey(cfg.XAPIKey))
 
 	// Websocket Routes
 	ws := cfg.Router.Group("/ws")
-	ws.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey())
+	ws.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 	{
 		ws.GET("/campaign/:campaignID", cfg.WebSocketHandler.HandleCampaignWebSocket)
 	}
 
+	// Server-Sent Events Routes, the same campaign events for clients that can't hold a websocket
+	events := cfg.Router.Group("/events")
+	events.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
+	{
+		events.GET("/campaign/:campaignID", cfg.EventStreamHandler.HandleCampaignEvents)
+	}
+
 	// Auth Routes
 	authGroup := cfg.Router.Group("/auth")
 	{
@@ -82,16 +114,72 @@ func SetupRoutes(cfg Config) {
 	{
 		campaignGroup.POST("/create", cfg.CampaignHandler.HandleCreateCampaign)
 
-		protected := campaignGroup.Use(middlewares.CampaignKey())
+		protected := campaignGroup.Use(middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 		{
 			protected.GET("/:campaignID", cfg.CampaignHandler.HandleGetCampaignByID)
 			protected.PATCH("/:campaignID", cfg.CampaignHandler.HandleUpdateCampaignByID)
+
+			protected.POST("/:campaignID/keys/:contributorID", cfg.CampaignAccessHandler.HandleReissueContributorKey)
+			protected.DELETE("/:campaignID/keys/:contributorID", cfg.CampaignAccessHandler.HandleRevokeContributorKey)
+
+			protected.PATCH("/:campaignID/visibility", cfg.CampaignHandler.HandleUpdateCampaignVisibility)
+			protected.GET("/:campaignID/join-requests", cfg.JoinRequestHandler.HandleGetJoinRequests)
+			protected.POST("/:campaignID/join-requests/:requestID/approve", cfg.JoinRequestHandler.HandleApproveJoinRequest)
+			protected.POST("/:campaignID/join-requests/:requestID/reject", cfg.JoinRequestHandler.HandleRejectJoinRequest)
+
+			protected.POST("/:campaignID/extend", cfg.CampaignDeadlineHandler.HandleExtendCampaign)
+			protected.POST("/:campaignID/extensions/:extensionID/vote", cfg.CampaignDeadlineHandler.HandleVoteOnExtension)
+			protected.POST("/:campaignID/close", cfg.CampaignDeadlineHandler.HandleCloseCampaignEarly)
+
+			protected.GET("/:campaignID/roles", cfg.CampaignRoleHandler.HandleGetCampaignRoles)
+			protected.PUT("/:campaignID/roles", cfg.CampaignRoleHandler.HandleAssignRole)
+			protected.POST("/:campaignID/transfer-ownership", cfg.CampaignRoleHandler.HandleTransferOwnership)
+
+			protected.POST("/:campaignID/calendar-feeds", cfg.CalendarHandler.HandleCreateCalendarFeed)
+			protected.GET("/:campaignID/calendar-feeds", cfg.CalendarHandler.HandleGetCalendarFeeds)
+			protected.DELETE("/:campaignID/calendar-feeds/:feedID", cfg.CalendarHandler.HandleRevokeCalendarFeed)
+
+			protected.POST("/:campaignID/webhooks", cfg.WebhookHandler.HandleCreateWebhook)
+			protected.GET("/:campaignID/webhooks", cfg.WebhookHandler.HandleGetWebhooks)
+			protected.PATCH("/:campaignID/webhooks/:webhookID", cfg.WebhookHandler.HandleUpdateWebhook)
+			protected.DELETE("/:campaignID/webhooks/:webhookID", cfg.WebhookHandler.HandleDeleteWebhook)
+			protected.GET("/:campaignID/webhooks/:webhookID/deliveries", cfg.WebhookHandler.HandleGetWebhookDeliveries)
+			protected.POST("/:campaignID/webhooks/:webhookID/deliveries/:deliveryID/replay", cfg.WebhookHandler.HandleReplayWebhookDelivery)
+
+			protected.POST("/:campaignID/polls", cfg.PollHandler.HandleCreatePoll)
+			protected.GET("/:campaignID/polls", cfg.PollHandler.HandleGetPolls)
+			protected.POST("/:campaignID/polls/:pollID/votes", cfg.PollHandler.HandleVoteOnPoll)
+			protected.POST("/:campaignID/polls/:pollID/close", cfg.PollHandler.HandleClosePoll)
+
+			protected.GET("/:campaignID/budget", cfg.ExpenseHandler.HandleGetCampaignBudget)
+			protected.GET("/:campaignID/comments/unread", cfg.CommentHandler.HandleGetUnreadSummary)
+			protected.GET("/:campaignID/comments/reports", cfg.CommentHandler.HandleGetCommentReports)
+			protected.GET("/:campaignID/comments/moderation-log", cfg.CommentHandler.HandleGetModerationLog)
+
+			protected.POST("/:campaignID/images", cfg.ImageHandler.HandleUploadCampaignImage)
+			protected.DELETE("/:campaignID/images/:imageID", cfg.ImageHandler.HandleDeleteCampaignImage)
+
+			protected.GET("/:campaignID/chat/messages", cfg.ChatHandler.HandleGetChatHistory)
 		}
 	}
 
+	// Public Campaign Routes
+	publicGroup := cfg.Router.Group("/public/campaign")
+	{
+		publicGroup.GET("/:slug", cfg.CampaignHandler.HandleGetPublicCampaign)
+		publicGroup.POST("/:slug/join", middlewares.Auth(cfg.JWT), cfg.JoinRequestHandler.HandleRequestToJoin)
+	}
+
+	// Invitation Routes, the invitation token identifies the contributor
+	invitationGroup := cfg.Router.Group("/invitations")
+	{
+		invitationGroup.POST("/:token/accept", cfg.ContributorHandler.HandleAcceptInvitation)
+		invitationGroup.POST("/:token/decline", cfg.ContributorHandler.HandleDeclineInvitation)
+	}
+
 	// Activity Routes
 	activityGroup := cfg.Router.Group("/activity")
-	activityGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey())
+	activityGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 	{
 		activityGroup.GET("/:campaignID", cfg.ActivityHandler.HandleGetActivitiesByCampaignID)
 		activityGroup.GET("/:campaignID/:activityID", cfg.ActivityHandler.HandleGetActivityByID)
@@ -100,6 +188,17 @@ func SetupRoutes(cfg Config) {
 		activityGroup.DELETE("/:campaignID/:activityID", cfg.ActivityHandler.HandleDeleteActivityByID)
 
 		activityGroup.POST("/:campaignID/:activityID/approve", cfg.ActivityHandler.HandleApproveActivity)
+		activityGroup.PUT("/:campaignID/:activityID/split", cfg.ActivityHandler.HandleUpdateActivitySplit)
+		activityGroup.POST("/:campaignID/:activityID/votes", cfg.ActivityHandler.HandleVoteOnActivity)
+
+		activityGroup.POST("/:campaignID/:activityID/expenses", cfg.ExpenseHandler.HandleRecordExpense)
+		activityGroup.DELETE("/:campaignID/:activityID/expenses/:expenseID", cfg.ExpenseHandler.HandleDeleteExpense)
+		activityGroup.GET("/:campaignID/:activityID/expenses/:expenseID/receipt", cfg.ExpenseHandler.HandleGetExpenseReceipt)
+		activityGroup.GET("/:campaignID/:activityID/budget", cfg.ExpenseHandler.HandleGetActivityBudget)
+		activityGroup.POST("/:campaignID/:activityID/reconcile", cfg.ExpenseHandler.HandleReconcileActivity)
+
+		activityGroup.PUT("/:campaignID/:activityID/image", cfg.ImageHandler.HandleUploadActivityImage)
+		activityGroup.DELETE("/:campaignID/:activityID/image", cfg.ImageHandler.HandleDeleteActivityImage)
 
 		participation := activityGroup.Group("/:campaignID/:activityID/participants")
 		{
@@ -114,24 +213,38 @@ func SetupRoutes(cfg Config) {
 			comments.PATCH("/:commentID", cfg.CommentHandler.HandleUpdateComment)
 			comments.GET("/", cfg.CommentHandler.HandleGetActivityComments)
 			comments.GET("/:commentID/replies", cfg.CommentHandler.HandleGetCommentReplies)
+			comments.GET("/:commentID/thread", cfg.CommentHandler.HandleGetCommentThread)
+			comments.POST("/read", cfg.CommentHandler.HandleMarkCommentsRead)
 			comments.DELETE("/:commentID", cfg.CommentHandler.HandleDeleteComment)
+			comments.GET("/:commentID/revisions", cfg.CommentHandler.HandleGetCommentRevisions)
+			comments.POST("/:commentID/reactions", cfg.CommentHandler.HandleAddReaction)
+			comments.DELETE("/:commentID/reactions/:emoji", cfg.CommentHandler.HandleRemoveReaction)
+			comments.POST("/:commentID/report", cfg.CommentHandler.HandleReportComment)
+			comments.POST("/:commentID/moderation", cfg.CommentHandler.HandleModerateComment)
 		}
 	}
 
 	// Contributor Routes
 	contributorGroup := cfg.Router.Group("/contributor")
-	contributorGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey())
+	contributorGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 	{
 		contributorGroup.POST("/:campaignID", cfg.ContributorHandler.HandleAddContributor)
+		contributorGroup.POST("/:campaignID/import", cfg.ContributorHandler.HandleImportContributors)
 		contributorGroup.DELETE("/:campaignID/:contributorID", cfg.ContributorHandler.HandleRemoveContributor)
 		contributorGroup.PATCH("/:campaignID/:contributorID", cfg.ContributorHandler.HandleEditContributor)
 		contributorGroup.GET("/:campaignID", cfg.ContributorHandler.HandleGetContributorsByCampaignID)
 		contributorGroup.GET("/:campaignID/:contributorID", cfg.ContributorHandler.HandleGetContributorByID)
+
+		contributorGroup.GET("/:campaignID/requests", cfg.ContributorRequestHandler.HandleGetContributorRequests)
+		contributorGroup.POST("/:campaignID/requests/leave", cfg.ContributorRequestHandler.HandleRequestToLeave)
+		contributorGroup.POST("/:campaignID/requests/amount", cfg.ContributorRequestHandler.HandleRequestAmountChange)
+		contributorGroup.POST("/:campaignID/requests/:requestID/approve", cfg.ContributorRequestHandler.HandleApproveContributorRequest)
+		contributorGroup.POST("/:campaignID/requests/:requestID/reject", cfg.ContributorRequestHandler.HandleRejectContributorRequest)
 	}
 
 	// Payment Routes
 	paymentGroup := cfg.Router.Group("/payment")
-	paymentGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey())
+	paymentGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 	{
 		//TODO: fix route name
 		paymentGroup.POST("/contributor/:contributorID", cfg.PaymentHandler.HandleInitializePayment)
@@ -139,6 +252,7 @@ func SetupRoutes(cfg Config) {
 		// Payment verification route
 		paymentGroup.POST("/verify/:reference", cfg.PaymentHandler.HandleVerifyPayment)
 		paymentGroup.POST("/manual/verify/:reference", cfg.PaymentHandler.HandleVerifyManualPayment)
+		paymentGroup.GET("/proof/:reference", cfg.PaymentHandler.HandleGetPaymentProof)
 	}
 
 	// Payout Routes
@@ -147,7 +261,7 @@ func SetupRoutes(cfg Config) {
 		payoutGroup.GET("/bank-list", cfg.PayoutHandler.HandleGetBankList)
 		payoutGroup.POST("/verify/bank-account", cfg.PayoutHandler.HandleVerifyAccount)
 	}
-	payoutGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey())
+	payoutGroup.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 	{
 		payoutGroup.POST("/:campaignID", cfg.PayoutHandler.HandleInitializePayout)
 		payoutGroup.POST("manual/:campaignID", cfg.PayoutHandler.HandleInitializeManualPayout)
@@ -161,7 +275,7 @@ func SetupRoutes(cfg Config) {
 	{
 		activitySuggestions.POST("/", cfg.SuggestionHandler.HandleGetActivitySuggestionsViaText)
 
-		activitySuggestions.Use(middlewares.CampaignKey())
+		activitySuggestions.Use(middlewares.Auth(cfg.JWT), middlewares.CampaignKey(cfg.CampaignKeyVerifier))
 		activitySuggestions.GET("/:campaignID", cfg.SuggestionHandler.HandleGetActivitySuggestions)
 	}
 
@@ -20,6 +20,41 @@ type Activity struct {
 	Contributors []Contributor `gorm:"many2many:activities_contributors" binding:"-" json:"contributors"`
 	Comments     []Comment     `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" json:"-" binding:"-"`
 
+	// ImageUrl and ThumbnailUrl point to images uploaded through the API, ImageID and ThumbnailID identify the files in storage
+	ThumbnailUrl string `gorm:"type:text" json:"thumbnailUrl,omitempty"`
+	ImageID      string `gorm:"type:text" json:"-"`
+	ThumbnailID  string `gorm:"type:text" json:"-"`
+
+	// SplitStrategy decides how the cost is shared by the participants, Shares hold the weights or amounts of weighted and custom splits
+	SplitStrategy SplitStrategy   `gorm:"type:varchar(20);not null;default:per_person" validate:"omitempty,oneof=per_person equal weighted custom" json:"splitStrategy,omitempty"`
+	Shares        []ActivityShare `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" binding:"-" validate:"-" json:"shares,omitempty"`
+
+	// Capacity limits the number of participants, contributors who opt in once it is reached join the Waitlist.
+	// SignUpDeadline closes opt-ins, and participants of a mandatory activity must have paid by PaymentDueDate
+	Capacity       *int                    `gorm:"default:null" validate:"omitempty,gt=0" json:"capacity,omitempty"`
+	SignUpDeadline *time.Time              `gorm:"default:null" json:"signUpDeadline,omitempty"`
+	PaymentDueDate *time.Time              `gorm:"default:null" json:"paymentDueDate,omitempty"`
+	Waitlist       []ActivityWaitlistEntry `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" binding:"-" validate:"-" json:"waitlist,omitempty"`
+
+	// StartsAt and EndsAt schedule the activity, only scheduled activities are listed in calendar feeds.
+	// Location is free text, Latitude and Longitude optionally pin it on a map
+	StartsAt  *time.Time `gorm:"default:null" json:"startsAt,omitempty"`
+	EndsAt    *time.Time `gorm:"default:null" json:"endsAt,omitempty"`
+	Location  string     `gorm:"type:varchar(255)" binding:"omitempty,max=255" json:"location,omitempty"`
+	Latitude  *float64   `gorm:"default:null" binding:"omitempty,gte=-90,lte=90" validate:"omitempty,gte=-90,lte=90" json:"latitude,omitempty"`
+	Longitude *float64   `gorm:"default:null" binding:"omitempty,gte=-180,lte=180" validate:"omitempty,gte=-180,lte=180" json:"longitude,omitempty"`
+	Notes     string     `gorm:"type:text" binding:"omitempty,max=2000" json:"notes,omitempty"`
+
+	// Votes are the contributors' votes on a proposed activity, campaigns with an activity vote threshold approve it once enough contributors approve
+	Votes []ActivityVote `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" binding:"-" validate:"-" json:"votes,omitempty"`
+
+	// Expenses are what was actually spent on the activity, Reconciliation settles the difference with its budget
+	Expenses       []ActivityExpense       `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" binding:"-" validate:"-" json:"-"`
+	Reconciliation *ActivityReconciliation `gorm:"foreignKey:ActivityID;constraint:OnDelete:CASCADE" binding:"-" validate:"-" json:"-"`
+
+	// UnreadComments is the number of comments the requesting user hasn't read, it is only set when listing activities and left out when there are none
+	UnreadComments int64 `gorm:"-" binding:"-" validate:"-" json:"unreadComments,omitempty"`
+
 	CreatedByHandle string `gorm:"not null" validate:"required" json:"-"`
 	CreatedBy       User   `gorm:"references:Handle" binding:"-" validate:"-" json:"-"`
 
@@ -94,6 +129,30 @@ func (a *Activity) MarkAsNotMandatory() {
 	a.IsMandatory = false
 }
 
+// SetImage replaces the image of the activity and returns the storage IDs of the files it replaced
+func (a *Activity) SetImage(imageURL, imageID, thumbnailURL, thumbnailID string) []string {
+	replaced := a.ImageFileIDs()
+	a.ImageUrl = imageURL
+	a.ImageID = imageID
+	a.ThumbnailUrl = thumbnailURL
+	a.ThumbnailID = thumbnailID
+	return replaced
+}
+
+// ImageFileIDs returns the storage IDs of the activity image and its thumbnail
+func (a *Activity) ImageFileIDs() []string {
+	return storedFileIDs(a.ImageID, a.ThumbnailID)
+}
+
+// StoredFileIDs returns the storage IDs of every file uploaded for the activity, its image and expense receipts
+func (a *Activity) StoredFileIDs() []string {
+	ids := a.ImageFileIDs()
+	for _, expense := range a.Expenses {
+		ids = append(ids, storedFileIDs(expense.ReceiptID)...)
+	}
+	return ids
+}
+
 // AddContributor adds a contributor to the activity
 func (a *Activity) AddContributor(contributor Contributor) {
 	a.Contributors = append(a.Contributors, contributor)
@@ -119,6 +178,18 @@ func (a *Activity) IsContributorOptedIn(contributorID uint) bool {
 	return false
 }
 
+// Schedule Methods
+
+// IsScheduled checks if the activity has a start time
+func (a *Activity) IsScheduled() bool {
+	return a.StartsAt != nil
+}
+
+// HasCoordinates checks if the location of the activity is pinned on a map
+func (a *Activity) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
+
 // Validation Methods
 
 // Validate performs validation checks on the activity
@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+// ActivityWaitlistEntry is a contributor waiting for a seat in a full activity, seats are given in the order contributors joined the waitlist
+type ActivityWaitlistEntry struct {
+	ID            uint      `gorm:"primaryKey" json:"-"`
+	ActivityID    uint      `gorm:"not null;index:idx_activity_waitlist,unique" json:"-"`
+	ContributorID uint      `gorm:"not null;index:idx_activity_waitlist,unique" json:"contributorId"`
+	CreatedAt     time.Time `gorm:"not null" json:"joinedAt"`
+}
+
+// Capacity Methods
+
+// HasCapacity checks if the number of participants is limited
+func (a *Activity) HasCapacity() bool {
+	return a.Capacity != nil && *a.Capacity > 0
+}
+
+// AvailableSeats returns the number of free seats, or -1 if the number of participants is not limited.
+// Invitees who haven't answered keep their seat until their invitation is declined or expires
+func (a *Activity) AvailableSeats() int {
+	if !a.HasCapacity() {
+		return -1
+	}
+	seatsTaken := 0
+	for _, contributor := range a.Contributors {
+		if contributor.Invitation.IsOpen() {
+			seatsTaken++
+		}
+	}
+	return max(*a.Capacity-seatsTaken, 0)
+}
+
+// IsFull checks if every seat of the activity is taken
+func (a *Activity) IsFull() bool {
+	return a.AvailableSeats() == 0
+}
+
+// HasSignUpClosed checks if the sign-up deadline of the activity has passed
+func (a *Activity) HasSignUpClosed() bool {
+	return a.SignUpDeadline != nil && time.Now().After(*a.SignUpDeadline)
+}
+
+// IsPaymentOverdue checks if the payment due date of a mandatory activity has passed
+func (a *Activity) IsPaymentOverdue() bool {
+	return a.IsMandatory && a.PaymentDueDate != nil && time.Now().After(*a.PaymentDueDate)
+}
+
+// Waitlist Methods
+
+// IsContributorWaitlisted checks if a contributor is waiting for a seat
+func (a *Activity) IsContributorWaitlisted(contributorID uint) bool {
+	return a.WaitlistPosition(contributorID) > 0
+}
+
+// WaitlistPosition returns the contributor's position on the waitlist starting at 1, or 0 if they are not on it
+func (a *Activity) WaitlistPosition(contributorID uint) int {
+	for i, entry := range a.Waitlist {
+		if entry.ContributorID == contributorID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AddToWaitlist adds a contributor to the end of the waitlist
+func (a *Activity) AddToWaitlist(contributorID uint) {
+	a.Waitlist = append(a.Waitlist, ActivityWaitlistEntry{ActivityID: a.ID, ContributorID: contributorID, CreatedAt: time.Now()})
+}
+
+// RemoveFromWaitlist removes a contributor from the waitlist
+func (a *Activity) RemoveFromWaitlist(contributorID uint) {
+	for i, entry := range a.Waitlist {
+		if entry.ContributorID == contributorID {
+			a.Waitlist = append(a.Waitlist[:i], a.Waitlist[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// ActivityExpense is money actually spent on an activity, recorded against the activity budget
+type ActivityExpense struct {
+	ID         uint    `gorm:"primaryKey" json:"id"`
+	ActivityID uint    `gorm:"not null;index" json:"activityId"`
+	CampaignID string  `gorm:"type:text;not null;index" json:"campaignId"`
+	Amount     float64 `gorm:"not null" json:"amount"`
+	// PaidByEmail is the campaign member who paid the expense
+	PaidByEmail string `gorm:"not null" json:"paidBy"`
+	Note        string `gorm:"type:text" json:"note,omitempty"`
+	// ReceiptURL is only set for receipts uploaded before they were private, the others are fetched through a signed link
+	ReceiptURL       string    `gorm:"type:text" json:"receiptUrl,omitempty"`
+	ReceiptID        string    `gorm:"type:text" json:"-"`
+	RecordedByHandle string    `gorm:"not null" json:"recordedBy"`
+	CreatedAt        time.Time `gorm:"not null" json:"createdAt"`
+}
+
+type ReconciliationResolution string
+
+const (
+	// ReconciliationResolutionRefund rebalances the difference back to the participants in proportion to their share,
+	// leftovers are refunded and overspend is owed
+	ReconciliationResolutionRefund ReconciliationResolution = "refund"
+	// ReconciliationResolutionRollover moves leftovers to the budget of another activity
+	ReconciliationResolutionRollover ReconciliationResolution = "rollover"
+	// ReconciliationResolutionAbsorb records the difference without moving any money
+	ReconciliationResolutionAbsorb ReconciliationResolution = "absorb"
+)
+
+// ActivityReconciliation settles the difference between the budget of an activity and what was actually spent.
+// An activity is reconciled once, no expenses can be recorded against it afterwards
+type ActivityReconciliation struct {
+	ID                 uint                       `gorm:"primaryKey" json:"id"`
+	ActivityID         uint                       `gorm:"not null;uniqueIndex" json:"activityId"`
+	CampaignID         string                     `gorm:"type:text;not null;index" json:"campaignId"`
+	Budget             float64                    `gorm:"not null" json:"budget"`
+	Spent              float64                    `gorm:"not null" json:"spent"`
+	Variance           float64                    `gorm:"not null" json:"variance"`
+	Resolution         ReconciliationResolution   `gorm:"type:varchar(10);not null" json:"resolution"`
+	TargetActivityID   *uint                      `json:"targetActivityId,omitempty"`
+	Allocations        []ReconciliationAllocation `gorm:"foreignKey:ReconciliationID;constraint:OnDelete:CASCADE" json:"allocations,omitempty"`
+	Note               string                     `gorm:"type:text" json:"note,omitempty"`
+	ReconciledByHandle string                     `gorm:"not null" json:"reconciledBy"`
+	CreatedAt          time.Time                  `gorm:"not null" json:"createdAt"`
+}
+
+// ReconciliationAllocation is a participant's part of a refund, a negative amount is overspend the participant owes
+type ReconciliationAllocation struct {
+	ID               uint    `gorm:"primaryKey" json:"-"`
+	ReconciliationID uint    `gorm:"not null;index" json:"-"`
+	ContributorID    uint    `gorm:"not null" json:"contributorId"`
+	Amount           float64 `gorm:"not null" json:"amount"`
+}
+
+// ActivityBudgetReport compares the budget of an activity with what was spent on it.
+// RolledIn is leftover budget other activities rolled into this one, Variance is positive when money is left
+type ActivityBudgetReport struct {
+	ActivityID     uint                    `json:"activityId"`
+	Title          string                  `json:"title"`
+	Budget         float64                 `json:"budget"`
+	RolledIn       float64                 `json:"rolledIn"`
+	Spent          float64                 `json:"spent"`
+	Variance       float64                 `json:"variance"`
+	Expenses       []ActivityExpense       `json:"expenses"`
+	Reconciliation *ActivityReconciliation `json:"reconciliation,omitempty"`
+}
+
+// CampaignBudgetReport compares the budget of every approved activity of a campaign with what was spent
+type CampaignBudgetReport struct {
+	CampaignID string                 `json:"campaignId"`
+	Budget     float64                `json:"budget"`
+	Spent      float64                `json:"spent"`
+	Variance   float64                `json:"variance"`
+	Activities []ActivityBudgetReport `json:"activities"`
+}
+
+// Constructors
+
+func NewActivityExpense(activity *Activity, amount float64, paidByEmail, note, recordedByHandle string) *ActivityExpense {
+	return &ActivityExpense{
+		ActivityID:       activity.ID,
+		CampaignID:       activity.CampaignID,
+		Amount:           amount,
+		PaidByEmail:      paidByEmail,
+		Note:             note,
+		RecordedByHandle: recordedByHandle,
+	}
+}
+
+// NewCampaignBudgetReport builds the budget report of the approved activities of a campaign
+func NewCampaignBudgetReport(campaignID string, activities []Activity) *CampaignBudgetReport {
+	report := &CampaignBudgetReport{CampaignID: campaignID, Activities: []ActivityBudgetReport{}}
+
+	rolledIn := map[uint]float64{}
+	for _, activity := range activities {
+		if rec := activity.Reconciliation; rec != nil && rec.Resolution == ReconciliationResolutionRollover && rec.TargetActivityID != nil {
+			rolledIn[*rec.TargetActivityID] += rec.Variance
+		}
+	}
+
+	for _, activity := range activities {
+		if !activity.IsApproved {
+			continue
+		}
+		activityReport := activity.BudgetReport(rolledIn[activity.ID])
+		report.Budget += activityReport.Budget
+		report.Spent += activityReport.Spent
+		report.Activities = append(report.Activities, activityReport)
+	}
+	report.Variance = report.Budget - report.Spent
+	return report
+}
+
+// GetActivityReport returns the report of an activity, or nil if the activity isn't part of the report
+func (r *CampaignBudgetReport) GetActivityReport(activityID uint) *ActivityBudgetReport {
+	for i := range r.Activities {
+		if r.Activities[i].ActivityID == activityID {
+			return &r.Activities[i]
+		}
+	}
+	return nil
+}
+
+// Budget Methods
+
+// Budget returns what the participants of the activity owe for it
+func (a *Activity) Budget() float64 {
+	var budget float64
+	for _, participant := range a.GetParticipants() {
+		budget += a.ShareOf(participant.ID)
+	}
+	return budget
+}
+
+// Spent returns the total of the expenses recorded against the activity
+func (a *Activity) Spent() float64 {
+	var spent float64
+	for _, expense := range a.Expenses {
+		spent += expense.Amount
+	}
+	return spent
+}
+
+// IsReconciled checks if the difference between the budget and the spend of the activity was settled
+func (a *Activity) IsReconciled() bool {
+	return a.Reconciliation != nil
+}
+
+// BudgetReport compares the budget of the activity, including leftovers rolled into it, with what was spent
+func (a *Activity) BudgetReport(rolledIn float64) ActivityBudgetReport {
+	expenses := a.Expenses
+	if expenses == nil {
+		expenses = []ActivityExpense{}
+	}
+
+	report := ActivityBudgetReport{
+		ActivityID:     a.ID,
+		Title:          a.Title,
+		Budget:         a.Budget(),
+		RolledIn:       rolledIn,
+		Spent:          a.Spent(),
+		Expenses:       expenses,
+		Reconciliation: a.Reconciliation,
+	}
+	report.Variance = report.Budget + report.RolledIn - report.Spent
+	return report
+}
+
+// Reconcile settles the variance of a budget report, refunds are split between the participants in proportion to their share
+func (a *Activity) Reconcile(report ActivityBudgetReport, resolution ReconciliationResolution, targetActivityID *uint, note, reconciledByHandle string) *ActivityReconciliation {
+	reconciliation := &ActivityReconciliation{
+		ActivityID:         a.ID,
+		CampaignID:         a.CampaignID,
+		Budget:             report.Budget + report.RolledIn,
+		Spent:              report.Spent,
+		Variance:           report.Variance,
+		Resolution:         resolution,
+		TargetActivityID:   targetActivityID,
+		Note:               note,
+		ReconciledByHandle: reconciledByHandle,
+	}
+
+	if resolution == ReconciliationResolutionRefund && report.Budget > 0 {
+		participants := a.GetParticipants()
+		var allocated float64
+		for i, participant := range participants {
+			amount := math.Round(report.Variance*a.ShareOf(participant.ID)/report.Budget*100) / 100
+			// The last participant takes the rounding difference so the allocations add up to the variance
+			if i == len(participants)-1 {
+				amount = math.Round((report.Variance-allocated)*100) / 100
+			}
+			allocated += amount
+			reconciliation.Allocations = append(reconciliation.Allocations, ReconciliationAllocation{
+				ContributorID: participant.ID,
+				Amount:        amount,
+			})
+		}
+	}
+
+	a.Reconciliation = reconciliation
+	return reconciliation
+}
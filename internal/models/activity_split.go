@@ -0,0 +1,119 @@
+package models
+
+import "slices"
+
+// SplitStrategy decides how the cost of an activity is shared by its participants
+type SplitStrategy string
+
+const (
+	// SplitStrategyPerPerson charges every participant the full cost
+	SplitStrategyPerPerson SplitStrategy = "per_person"
+	// SplitStrategyEqual divides the cost equally among the participants
+	SplitStrategyEqual SplitStrategy = "equal"
+	// SplitStrategyWeighted divides the cost by the weight of each participant, participants without a weight count once
+	SplitStrategyWeighted SplitStrategy = "weighted"
+	// SplitStrategyCustom charges each participant a fixed amount, participants without one share what is left of the cost equally
+	SplitStrategyCustom SplitStrategy = "custom"
+)
+
+var splitStrategies = []SplitStrategy{SplitStrategyPerPerson, SplitStrategyEqual, SplitStrategyWeighted, SplitStrategyCustom}
+
+// IsValid checks if the strategy is a known split strategy
+func (s SplitStrategy) IsValid() bool {
+	return slices.Contains(splitStrategies, s)
+}
+
+// ActivityShare is a participant's weight or fixed amount in a weighted or custom split
+type ActivityShare struct {
+	ID            uint    `gorm:"primaryKey" json:"-"`
+	ActivityID    uint    `gorm:"not null;index:idx_activity_share,unique" json:"-"`
+	ContributorID uint    `gorm:"not null;index:idx_activity_share,unique" json:"contributorId"`
+	Weight        float64 `gorm:"not null;default:0" json:"weight,omitempty"`
+	Amount        float64 `gorm:"not null;default:0" json:"amount,omitempty"`
+}
+
+// Split Methods
+
+// GetSplitStrategy returns the split strategy of the activity, activities without one charge the full cost per person
+func (a *Activity) GetSplitStrategy() SplitStrategy {
+	if a.SplitStrategy == "" {
+		return SplitStrategyPerPerson
+	}
+	return a.SplitStrategy
+}
+
+// GetParticipants returns the opted-in contributors that joined the campaign, invitees who haven't accepted don't share the cost
+func (a *Activity) GetParticipants() []Contributor {
+	participants := make([]Contributor, 0, len(a.Contributors))
+	for _, contributor := range a.Contributors {
+		if contributor.Invitation.IsAccepted() {
+			participants = append(participants, contributor)
+		}
+	}
+	return participants
+}
+
+// GetShare returns the share set for a contributor, or nil if none was set
+func (a *Activity) GetShare(contributorID uint) *ActivityShare {
+	for i := range a.Shares {
+		if a.Shares[i].ContributorID == contributorID {
+			return &a.Shares[i]
+		}
+	}
+	return nil
+}
+
+// ShareOf returns how much of the activity cost a contributor owes, contributors who didn't opt in owe nothing
+func (a *Activity) ShareOf(contributorID uint) float64 {
+	participants := a.GetParticipants()
+	if !slices.ContainsFunc(participants, func(c Contributor) bool { return c.ID == contributorID }) {
+		return 0
+	}
+
+	switch a.GetSplitStrategy() {
+	case SplitStrategyEqual:
+		return a.Cost / float64(len(participants))
+
+	case SplitStrategyWeighted:
+		var totalWeight float64
+		for _, participant := range participants {
+			totalWeight += a.weightOf(participant.ID)
+		}
+		return a.Cost * a.weightOf(contributorID) / totalWeight
+
+	case SplitStrategyCustom:
+		if share := a.GetShare(contributorID); share != nil {
+			return share.Amount
+		}
+		remaining, withoutShare := a.Cost, 0
+		for _, participant := range participants {
+			if share := a.GetShare(participant.ID); share != nil {
+				remaining -= share.Amount
+			} else {
+				withoutShare++
+			}
+		}
+		return max(remaining, 0) / float64(withoutShare)
+
+	default:
+		return a.Cost
+	}
+}
+
+// SetSplit replaces the split strategy and the shares of the activity
+func (a *Activity) SetSplit(strategy SplitStrategy, shares []ActivityShare) {
+	a.SplitStrategy = strategy
+	a.Shares = make([]ActivityShare, len(shares))
+	for i, share := range shares {
+		share.ID = 0
+		share.ActivityID = a.ID
+		a.Shares[i] = share
+	}
+}
+
+func (a *Activity) weightOf(contributorID uint) float64 {
+	if share := a.GetShare(contributorID); share != nil && share.Weight > 0 {
+		return share.Weight
+	}
+	return 1
+}
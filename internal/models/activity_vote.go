@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// ActivityVote is a contributor's vote on a proposed activity
+type ActivityVote struct {
+	ID            uint      `gorm:"primaryKey" json:"-"`
+	ActivityID    uint      `gorm:"not null;uniqueIndex:idx_activity_vote" json:"-"`
+	ContributorID uint      `gorm:"not null;uniqueIndex:idx_activity_vote" json:"contributorId"`
+	Approve       bool      `gorm:"not null" json:"approve"`
+	CreatedAt     time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// ActivityVoteTally is the result of the vote on a proposed activity, it is streamed to the campaign after every vote
+type ActivityVoteTally struct {
+	ActivityID uint `json:"activityId"`
+	Approvals  int  `json:"approvals"`
+	Rejections int  `json:"rejections"`
+	// Threshold is the number of approvals that approve the activity, 0 when the campaign doesn't approve activities by vote
+	Threshold  int  `json:"threshold"`
+	IsApproved bool `json:"isApproved"`
+}
+
+// Vote Methods
+
+// HasVoted checks if a contributor has voted on the activity
+func (a *Activity) HasVoted(contributorID uint) bool {
+	for _, vote := range a.Votes {
+		if vote.ContributorID == contributorID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddVote records a contributor's vote on the activity
+func (a *Activity) AddVote(contributorID uint, approve bool) *ActivityVote {
+	vote := ActivityVote{
+		ActivityID:    a.ID,
+		ContributorID: contributorID,
+		Approve:       approve,
+	}
+	a.Votes = append(a.Votes, vote)
+	return &vote
+}
+
+// Tally counts the votes on the activity, threshold is the number of approvals that approve it
+func (a *Activity) Tally(threshold int) ActivityVoteTally {
+	tally := ActivityVoteTally{ActivityID: a.ID, Threshold: threshold, IsApproved: a.IsApproved}
+	for _, vote := range a.Votes {
+		if vote.Approve {
+			tally.Approvals++
+		} else {
+			tally.Rejections++
+		}
+	}
+	return tally
+}
+
+// HasReachedThreshold checks if enough contributors approved the activity to approve it, a threshold of 0 never does
+func (t ActivityVoteTally) HasReachedThreshold() bool {
+	return t.Threshold > 0 && t.Approvals >= t.Threshold
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/utils"
+)
+
+type CalendarFeedScope string
+
+const (
+	// CalendarFeedScopeCampaign lists every scheduled activity of the campaign
+	CalendarFeedScopeCampaign CalendarFeedScope = "campaign"
+	// CalendarFeedScopeContributor lists the scheduled activities the contributor opted into
+	CalendarFeedScopeContributor CalendarFeedScope = "contributor"
+)
+
+// CalendarFeed is a subscription to the activities of a campaign from a calendar app.
+// Calendar apps can't send the JWT or campaign key, so the feed token in the URL authenticates the feed.
+// Only a keyed hash of the token is stored, alongside the campaign key sealed with the token.
+type CalendarFeed struct {
+	ID                   uint              `gorm:"primaryKey" json:"id"`
+	CampaignID           string            `gorm:"type:text;not null;index" json:"campaignId"`
+	Email                string            `gorm:"not null;index" json:"email"`
+	Scope                CalendarFeedScope `gorm:"type:varchar(20);not null" json:"scope"`
+	ContributorID        *uint             `json:"contributorId,omitempty"`
+	TokenHash            string            `gorm:"not null;uniqueIndex" json:"-"`
+	EncryptedCampaignKey string            `gorm:"type:text;not null" json:"-"`
+	RevokedAt            *time.Time        `json:"revokedAt,omitempty"`
+	CreatedAt            time.Time         `gorm:"not null" json:"createdAt"`
+	UpdatedAt            time.Time         `json:"-"`
+
+	// Token is the plain feed token and URL the address calendar apps subscribe to, both are only populated when the feed is created
+	Token string `gorm:"-" json:"token,omitempty"`
+	URL   string `gorm:"-" json:"url,omitempty"`
+}
+
+// Constructor
+
+// NewCalendarFeed creates a feed of a campaign's activities for email, contributor feeds only list the activities of the contributor
+func NewCalendarFeed(e encryption.Encryptor, secret, campaignID, campaignKey, email string, contributorID *uint) (*CalendarFeed, error) {
+	token := generateCalendarFeedToken()
+	sealed, err := e.Encrypt(encryption.Data{Data: campaignKey, Key: token})
+	if err != nil {
+		return nil, err
+	}
+
+	scope := CalendarFeedScopeCampaign
+	if contributorID != nil {
+		scope = CalendarFeedScopeContributor
+	}
+
+	return &CalendarFeed{
+		CampaignID:           campaignID,
+		Email:                email,
+		Scope:                scope,
+		ContributorID:        contributorID,
+		TokenHash:            encryption.HashKey(secret, token),
+		EncryptedCampaignKey: sealed,
+		Token:                token,
+	}, nil
+}
+
+// Methods
+
+// IsRevoked checks if the feed has been revoked
+func (f *CalendarFeed) IsRevoked() bool {
+	return f.RevokedAt != nil
+}
+
+// Revoke marks the feed as revoked, calendar apps subscribed to it stop receiving updates
+func (f *CalendarFeed) Revoke() {
+	now := time.Now().UTC()
+	f.RevokedAt = &now
+}
+
+// IsContributorFeed checks if the feed only lists the activities of a contributor
+func (f *CalendarFeed) IsContributorFeed() bool {
+	return f.Scope == CalendarFeedScopeContributor && f.ContributorID != nil
+}
+
+// CampaignKey unseals the campaign key using the feed token
+func (f *CalendarFeed) CampaignKey(e encryption.Encryptor, token string) (string, error) {
+	return e.Decrypt(encryption.Data{Data: f.EncryptedCampaignKey, Key: token})
+}
+
+// Helper Functions --------------------------------------------------
+
+func generateCalendarFeedToken() string {
+	return utils.GenerateRandomAlphaNumeric("GC-", 32)
+}
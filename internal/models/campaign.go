@@ -1,6 +1,8 @@
 package models
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -16,13 +18,25 @@ const (
 	CampaignStatusUpcoming = "upcoming"
 )
 
+type CampaignVisibility string
+
+const (
+	CampaignVisibilityPrivate CampaignVisibility = "private"
+	CampaignVisibilityPublic  CampaignVisibility = "public"
+)
+
 // Struct
 type Campaign struct {
-	ID           string  `gorm:"type:text;primaryKey" validate:"-" binding:"-" json:"id"`
-	Key          string  `gorm:"-" validate:"-" binding:"-" json:"key"`
-	Title        string  `gorm:"type:varchar(255);not null" encrypt:"true" validate:"required,min=4" binding:"required" json:"title"`
-	Description  string  `gorm:"type:text"  encrypt:"true" validate:"required,min=100" binding:"required,min=100" json:"description"`
-	TargetAmount float64 `gorm:"not null" validate:"required,gt=0" binding:"-" json:"targetAmount"`
+	ID          string `gorm:"type:text;primaryKey" validate:"-" binding:"-" json:"id"`
+	Key         string `gorm:"-" validate:"-" binding:"-" json:"key"`
+	Title       string `gorm:"type:varchar(255);not null" encrypt:"true" validate:"required,min=4" binding:"required" json:"title"`
+	Description string `gorm:"type:text"  encrypt:"true" validate:"required,min=100" binding:"required,min=100" json:"description"`
+	// TargetAmount is the sum of the accepted contributor amounts, it is kept up to date on every save
+	TargetAmount float64 `gorm:"not null" validate:"gte=0" binding:"-" json:"targetAmount"`
+	// GoalAmount is set by the creator independently of the contributors, milestones fall back to TargetAmount without it
+	GoalAmount    float64 `gorm:"not null;default:0" validate:"gte=0" binding:"omitempty,gte=0" json:"goalAmount"`
+	PledgedAmount float64 `gorm:"-" validate:"-" binding:"-" json:"pledgedAmount"`
+	RaisedAmount  float64 `gorm:"-" validate:"-" binding:"-" json:"raisedAmount"`
 
 	//Payment
 	PaymentMethod PaymentMethod `gorm:"type:varchar(10);not null" validate:"required,oneof=fiat crypto manual" binding:"required,oneof=fiat crypto manual" json:"paymentMethod"`
@@ -30,19 +44,35 @@ type Campaign struct {
 	CryptoToken   *CryptoToken  `gorm:"type:varchar(10)" validate:"required_if=PaymentMethod crypto,omitempty" binding:"required_if=PaymentMethod crypto" json:"cryptoToken,omitempty"`
 
 	//Relations
-	Images       []CampaignImage `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"omitempty,dive,required" json:"images"`
-	Activities   []Activity      `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"omitempty,dive,required"`
-	Contributors []Contributor   `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"required,gt=0,dive,required" binding:"required,gt=0,dive,required" json:"contributors"`
+	Images       []CampaignImage     `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"omitempty,dive,required" json:"images"`
+	Activities   []Activity          `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"omitempty,dive,required"`
+	Contributors []Contributor       `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"required,gt=0,dive,required" binding:"required,gt=0,dive,required" json:"contributors"`
+	Milestones   []CampaignMilestone `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"omitempty,dive" binding:"omitempty,dive" json:"milestones"`
+	Roles        []CampaignUserRole  `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"-" json:"roles"`
+	// AccessKeys is only set when the campaign is created so its keys are stored along with it
+	AccessKeys []CampaignAccessKey `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"-" json:"-"`
 
 	//Payout
 	Payout *Payout `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE" validate:"-" binding:"-" json:"payout"`
 
-	StartDate time.Time `gorm:"not null" validate:"required" binding:"required" json:"startDate"`
-	EndDate   time.Time `gorm:"not null" validate:"required,gtfield=StartDate" binding:"required,gtfield=StartDate" json:"endDate"`
+	StartDate time.Time  `gorm:"not null" validate:"required" binding:"required" json:"startDate"`
+	EndDate   time.Time  `gorm:"not null" validate:"required,gtfield=StartDate" binding:"required,gtfield=StartDate" json:"endDate"`
+	ClosedAt  *time.Time `validate:"-" binding:"-" json:"closedAt,omitempty"`
+
+	// ExtensionRequiresApproval makes end date extensions wait for a majority of contributors to approve them
+	ExtensionRequiresApproval bool `gorm:"not null;default:false" validate:"-" binding:"-" json:"extensionRequiresApproval"`
+	// ActivityVoteThreshold is the number of contributor approvals that approve a proposed activity, 0 leaves approval to the organisers
+	ActivityVoteThreshold int `gorm:"not null;default:0" validate:"gte=0" binding:"omitempty,gte=0" json:"activityVoteThreshold"`
 
 	CreatedByHandle string `gorm:"not null" validate:"required" binding:"-" json:"createdByHandle"`
 	CreatedBy       User   `gorm:"references:Handle" validate:"-" binding:"-" json:"-"`
 
+	//Visibility
+	Visibility CampaignVisibility `gorm:"type:varchar(10);not null;default:private" validate:"-" binding:"-" json:"visibility"`
+	Slug       *string            `gorm:"type:varchar(255);uniqueIndex" validate:"-" binding:"-" json:"slug,omitempty"`
+	// PublicKey is the campaign key sealed with the server keys, only set while the campaign is public
+	PublicKey string `gorm:"type:text" validate:"-" binding:"-" json:"-"`
+
 	CreatedAt time.Time `gorm:"not null" validate:"-" binding:"-" json:"-"`
 	UpdatedAt time.Time `validate:"-" binding:"-" json:"-"`
 }
@@ -50,14 +80,16 @@ type Campaign struct {
 // NewCampaign
 func NewCampaign(title, description string, targetAmount float64, startDate, endDate time.Time,
 	images []CampaignImage, activities []Activity, contributors []Contributor, CreatedBy User) *Campaign {
+	id := generateCampaignId(title)
 	return &Campaign{
-		ID:              generateCampaignId(title),
+		ID:              id,
 		Key:             generateKey(),
 		Title:           title,
 		Description:     description,
 		Images:          images,
 		Activities:      activities,
 		Contributors:    contributors,
+		Milestones:      NewCampaignMilestones(id, nil),
 		TargetAmount:    targetAmount,
 		StartDate:       startDate,
 		EndDate:         endDate,
@@ -75,15 +107,21 @@ func (c *Campaign) FromBinding(CreatedBy User) {
 		c.Activities[i].UpdateCampaignId(c.ID)
 		c.Activities[i].ApproveActivity()
 		c.Activities[i].UpdateCreatedBy(CreatedBy)
+		c.Activities[i].SetImage("", "", "", "")
 	}
 
 	for i := range c.Contributors {
 		c.Contributors[i].UpdateCampaignId(c.ID)
 	}
 
-	for i := range c.Images {
-		c.Images[i].UpdateCampaignId(c.ID)
+	// Images are uploaded once the campaign exists, URLs sent by the client are not trusted
+	c.Images = nil
+
+	percentages := make([]int, len(c.Milestones))
+	for i, milestone := range c.Milestones {
+		percentages[i] = milestone.Percentage
 	}
+	c.Milestones = NewCampaignMilestones(c.ID, percentages)
 
 	c.CreatedByHandle = CreatedBy.Handle
 	c.CreatedBy = CreatedBy
@@ -163,8 +201,20 @@ func (c *Campaign) GetContributorByID(ID uint) *Contributor {
 	return nil
 }
 
-func (c *Campaign) CanInitiatePayout() bool {
+// AcceptedContributors returns the contributors that accepted their invitation
+func (c *Campaign) AcceptedContributors() []Contributor {
+	accepted := make([]Contributor, 0, len(c.Contributors))
 	for _, contributor := range c.Contributors {
+		if contributor.Invitation.IsAccepted() {
+			accepted = append(accepted, contributor)
+		}
+	}
+	return accepted
+}
+
+// CanInitiatePayout checks if every accepted contributor has paid
+func (c *Campaign) CanInitiatePayout() bool {
+	for _, contributor := range c.AcceptedContributors() {
 		if !contributor.HasPaid() {
 			return false
 		}
@@ -182,10 +232,66 @@ func (c *Campaign) GetPayoutAmount() float64 {
 	return amount
 }
 
-func (c *Campaign) HasReached50PercentMilestone() bool {
-	c.UpdateTotalContributionsAmount()
-	percentage := (c.GetPayoutAmount() / c.TargetAmount) * 100
-	return percentage >= 50
+// GetGoalAmount returns the goal set by the creator, or the sum of the contributor amounts if none was set
+func (c *Campaign) GetGoalAmount() float64 {
+	if c.GoalAmount > 0 {
+		return c.GoalAmount
+	}
+	return c.GetPledgedAmount()
+}
+
+// GetPledgedAmount returns the sum of the accepted contributor amounts
+func (c *Campaign) GetPledgedAmount() float64 {
+	amount := 0.0
+	for _, contributor := range c.AcceptedContributors() {
+		amount += c.GetAmountOwed(&contributor)
+	}
+	return amount
+}
+
+// HasOverduePayment checks if the contributor hasn't paid and the payment due date of a mandatory activity they take part in has passed
+func (c *Campaign) HasOverduePayment(contributor *Contributor) bool {
+	if contributor.HasPaid() {
+		return false
+	}
+	for _, activity := range c.Activities {
+		if activity.IsPaymentOverdue() && activity.IsContributorOptedIn(contributor.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAmountOwed returns the contributor's amount plus their share of each campaign activity they opted into
+func (c *Campaign) GetAmountOwed(contributor *Contributor) float64 {
+	total := contributor.Amount
+	for _, activity := range c.Activities {
+		total += activity.ShareOf(contributor.ID)
+	}
+	return total
+}
+
+// ProgressPercentage returns how much of the goal has been raised
+func (c *Campaign) ProgressPercentage() float64 {
+	goal := c.GetGoalAmount()
+	if goal <= 0 {
+		return 0
+	}
+	return c.GetPayoutAmount() / goal * 100
+}
+
+// DueMilestones returns the milestones that have been reached but not marked yet
+func (c *Campaign) DueMilestones() []*CampaignMilestone {
+	progress := c.ProgressPercentage()
+
+	var due []*CampaignMilestone
+	for i := range c.Milestones {
+		milestone := &c.Milestones[i]
+		if !milestone.IsReached() && progress >= float64(milestone.Percentage) {
+			due = append(due, milestone)
+		}
+	}
+	return due
 }
 
 func (c *Campaign) EmailIsPartOfCampaign(email string) bool {
@@ -193,11 +299,48 @@ func (c *Campaign) EmailIsPartOfCampaign(email string) bool {
 		return true
 	}
 
-	for _, contributor := range c.Contributors {
+	// Invited contributors join the campaign once they accept
+	for _, contributor := range c.AcceptedContributors() {
 		if contributor.Email == email {
 			return true
 		}
 	}
+
+	// A previous owner keeps a role without being a contributor
+	for _, role := range c.Roles {
+		if role.Email == email {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleOf returns the role a user holds on the campaign, users without a stored role are members
+func (c *Campaign) RoleOf(userHandle string) CampaignRole {
+	if c.CreatedBy.Handle == userHandle {
+		return CampaignRoleOwner
+	}
+	for _, role := range c.Roles {
+		if role.UserHandle == userHandle {
+			return role.Role
+		}
+	}
+	return CampaignRoleMember
+}
+
+// SeesPaymentDetails checks if the user can see the contributors' emails and payment details, the owner and treasurer can
+func (c *Campaign) SeesPaymentDetails(userHandle string) bool {
+	role := c.RoleOf(userHandle)
+	return c.CreatedByHandle == userHandle || role == CampaignRoleOwner || role == CampaignRoleTreasurer
+}
+
+// HasTreasurer checks if a user has been made treasurer of the campaign
+func (c *Campaign) HasTreasurer() bool {
+	for _, role := range c.Roles {
+		if role.Role == CampaignRoleTreasurer {
+			return true
+		}
+	}
 	return false
 }
 
@@ -210,6 +353,28 @@ func (c *Campaign) GetActivityById(ID uint) *Activity {
 	return nil
 }
 
+// GetImageByID returns the campaign image with the given ID, or nil if the campaign has no such image
+func (c *Campaign) GetImageByID(ID uint) *CampaignImage {
+	for i := range c.Images {
+		if c.Images[i].ID == ID {
+			return &c.Images[i]
+		}
+	}
+	return nil
+}
+
+// StoredFileIDs returns the storage IDs of every file uploaded for the campaign and its activities
+func (c *Campaign) StoredFileIDs() []string {
+	var ids []string
+	for _, image := range c.Images {
+		ids = append(ids, image.StoredFileIDs()...)
+	}
+	for _, activity := range c.Activities {
+		ids = append(ids, activity.StoredFileIDs()...)
+	}
+	return ids
+}
+
 func (c *Campaign) Validate() error {
 	v := validator.New()
 
@@ -220,23 +385,100 @@ func (c *Campaign) Validate() error {
 	return nil
 }
 
-func (c *Campaign) Update(title, description *string, endDate *time.Time) {
+func (c *Campaign) Update(title, description *string) {
 	if title != nil {
 		c.Title = *title
 	}
 	if description != nil {
 		c.Description = *description
 	}
-	if endDate != nil {
-		c.EndDate = *endDate
+}
+
+func (c *Campaign) IsClosedEarly() bool {
+	return c.ClosedAt != nil
+}
+
+// ValidateExtension checks the campaign end date can be moved to endDate
+func (c *Campaign) ValidateExtension(endDate time.Time) error {
+	if c.HasEnded() {
+		return errors.New("campaign has ended")
+	}
+	if c.Payout != nil {
+		return errors.New("campaign payout has already been initiated")
 	}
+	if !endDate.After(c.EndDate) {
+		return errors.New("new end date must be after the current end date")
+	}
+	if !endDate.After(c.StartDate) {
+		return errors.New("new end date must be after the start date")
+	}
+	return nil
 }
-func (c *Campaign) UpdateTotalContributionsAmount() {
-	var totalAmount float64
-	for _, contributor := range c.Contributors {
-		totalAmount += contributor.GetAmountTotal()
+
+func (c *Campaign) ExtendEndDate(endDate time.Time) {
+	c.EndDate = endDate
+}
+
+// CloseEarly ends an active campaign now
+func (c *Campaign) CloseEarly() error {
+	if c.HasEnded() {
+		return errors.New("campaign has already ended")
+	}
+	if !c.HasStarted() {
+		return errors.New("campaign has not started yet")
 	}
-	c.TargetAmount = totalAmount
+
+	now := time.Now().UTC()
+	c.EndDate = now
+	c.ClosedAt = &now
+	return nil
+}
+
+func (c *Campaign) IsPublic() bool {
+	return c.Visibility == CampaignVisibilityPublic
+}
+
+// MakePublic seals the campaign key with the server keys so the campaign can be read
+// without it, and assigns a slug the first time the campaign is made public
+func (c *Campaign) MakePublic(e encryption.Encryptor) error {
+	sealed, err := e.Encrypt(encryption.Data{Data: c.Key, Key: c.ID})
+	if err != nil {
+		return err
+	}
+
+	if c.Slug == nil {
+		slug := generateSlug(c.Title)
+		c.Slug = &slug
+	}
+	c.PublicKey = sealed
+	c.Visibility = CampaignVisibilityPublic
+	return nil
+}
+
+// MakePrivate discards the sealed campaign key, the slug is kept so it can be reused
+func (c *Campaign) MakePrivate() {
+	c.PublicKey = ""
+	c.Visibility = CampaignVisibilityPrivate
+}
+
+// UnsealPublicKey recovers the campaign key of a public campaign
+func (c *Campaign) UnsealPublicKey(e encryption.Encryptor) error {
+	if !c.IsPublic() || c.PublicKey == "" {
+		return errors.New("campaign is not public")
+	}
+
+	key, err := e.Decrypt(encryption.Data{Data: c.PublicKey, Key: c.ID})
+	if err != nil {
+		return err
+	}
+	c.Key = key
+	return nil
+}
+
+func (c *Campaign) UpdateTotalContributionsAmount() {
+	c.PledgedAmount = c.GetPledgedAmount()
+	c.RaisedAmount = c.GetPayoutAmount()
+	c.TargetAmount = c.PledgedAmount
 }
 
 // GORM Hooks ---------------------------------------------------------
@@ -258,6 +500,14 @@ func generateKey() string {
 	return utils.GenerateRandomString("GC-", 8)
 }
 
+func generateSlug(title string) string {
+	base := utils.Slugify(title)
+	if base == "" {
+		base = "campaign"
+	}
+	return base + "-" + strings.ToLower(utils.GenerateRandomAlphaNumeric("", 6))
+}
+
 func generateCampaignId(title string) string {
 	return utils.GenerateRandomString(title[:2], 9)
 }
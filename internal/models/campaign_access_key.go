@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/utils"
+)
+
+// CampaignAccessKey is a per-member key that grants access to a campaign.
+// Only a keyed hash of the member key is stored, alongside the campaign key
+// sealed with the member key so it can be recovered once the member key is verified.
+type CampaignAccessKey struct {
+	ID                   uint       `gorm:"primaryKey" json:"id"`
+	CampaignID           string     `gorm:"type:text;not null;index" json:"campaignId"`
+	Email                string     `gorm:"not null;index" json:"email"`
+	KeyHash              string     `gorm:"not null;uniqueIndex" json:"-"`
+	EncryptedCampaignKey string     `gorm:"type:text;not null" json:"-"`
+	IsOwner              bool       `gorm:"not null;default:false" json:"isOwner"`
+	RevokedAt            *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt            time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt            time.Time  `json:"-"`
+
+	// Key is the plain member key, only populated when the key is issued
+	Key string `gorm:"-" json:"-"`
+}
+
+// OwnerKeyTransfer holds the key changes of an ownership transfer so they are stored along with the new owner
+type OwnerKeyTransfer struct {
+	// OwnerKey is the campaign key registered to the new owner, it is created when it has no ID
+	OwnerKey *CampaignAccessKey
+	// PreviousOwnerKey is the member key issued to the previous owner
+	PreviousOwnerKey *CampaignAccessKey
+}
+
+// Constructor
+
+// NewCampaignAccessKey creates a new member key for email and seals the campaign key with it
+func NewCampaignAccessKey(e encryption.Encryptor, secret, campaignID, campaignKey, email string) (*CampaignAccessKey, error) {
+	return newCampaignAccessKey(e, secret, campaignID, campaignKey, email, generateMemberKey())
+}
+
+// NewOwnerAccessKey registers the campaign key itself as the owner's access key
+func NewOwnerAccessKey(e encryption.Encryptor, secret, campaignID, campaignKey, email string) (*CampaignAccessKey, error) {
+	accessKey, err := newCampaignAccessKey(e, secret, campaignID, campaignKey, email, campaignKey)
+	if err != nil {
+		return nil, err
+	}
+	accessKey.IsOwner = true
+	return accessKey, nil
+}
+
+func newCampaignAccessKey(e encryption.Encryptor, secret, campaignID, campaignKey, email, memberKey string) (*CampaignAccessKey, error) {
+	sealed, err := e.Encrypt(encryption.Data{Data: campaignKey, Key: memberKey})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CampaignAccessKey{
+		CampaignID:           campaignID,
+		Email:                email,
+		KeyHash:              encryption.HashKey(secret, memberKey),
+		EncryptedCampaignKey: sealed,
+		Key:                  memberKey,
+	}, nil
+}
+
+// Methods
+
+// IsRevoked checks if the key has been revoked
+func (k *CampaignAccessKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Revoke marks the key as revoked
+func (k *CampaignAccessKey) Revoke() {
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+}
+
+// Matches compares the stored hash against the hash of the presented key in constant time
+func (k *CampaignAccessKey) Matches(secret, key string) bool {
+	return encryption.CompareHash(k.KeyHash, encryption.HashKey(secret, key))
+}
+
+// CampaignKey unseals the campaign key using the presented member key
+func (k *CampaignAccessKey) CampaignKey(e encryption.Encryptor, memberKey string) (string, error) {
+	return e.Decrypt(encryption.Data{Data: k.EncryptedCampaignKey, Key: memberKey})
+}
+
+// Helper Functions --------------------------------------------------
+
+func generateMemberKey() string {
+	return utils.GenerateRandomAlphaNumeric("GM-", 20)
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"time"
+)
+
+type CampaignExtensionStatus string
+
+const (
+	CampaignExtensionStatusPending  CampaignExtensionStatus = "pending"
+	CampaignExtensionStatusApproved CampaignExtensionStatus = "approved"
+	CampaignExtensionStatusRejected CampaignExtensionStatus = "rejected"
+)
+
+// CampaignExtension is a change of a campaign end date, it is applied straight away
+// unless the campaign requires a majority of contributors to approve extensions
+type CampaignExtension struct {
+	ID         uint                    `gorm:"primaryKey" json:"id"`
+	CampaignID string                  `gorm:"type:text;not null;index" json:"campaignId"`
+	FromDate   time.Time               `gorm:"not null" json:"fromDate"`
+	ToDate     time.Time               `gorm:"not null" json:"toDate"`
+	Status     CampaignExtensionStatus `gorm:"type:varchar(10);not null;default:pending" json:"status"`
+	Votes      []CampaignExtensionVote `gorm:"foreignKey:ExtensionID;constraint:OnDelete:CASCADE" json:"votes"`
+	ResolvedAt *time.Time              `json:"resolvedAt,omitempty"`
+	CreatedAt  time.Time               `gorm:"not null" json:"createdAt"`
+	UpdatedAt  time.Time               `json:"-"`
+}
+
+// CampaignExtensionVote is a contributor's vote on a pending extension
+type CampaignExtensionVote struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	ExtensionID   uint      `gorm:"not null;uniqueIndex:idx_extension_vote" json:"extensionId"`
+	ContributorID uint      `gorm:"not null;uniqueIndex:idx_extension_vote" json:"contributorId"`
+	Approve       bool      `gorm:"not null" json:"approve"`
+	CreatedAt     time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// Constructor
+func NewCampaignExtension(campaignID string, fromDate, toDate time.Time) *CampaignExtension {
+	return &CampaignExtension{
+		CampaignID: campaignID,
+		FromDate:   fromDate,
+		ToDate:     toDate,
+		Status:     CampaignExtensionStatusPending,
+	}
+}
+
+// Methods
+
+func (e *CampaignExtension) IsPending() bool {
+	return e.Status == CampaignExtensionStatusPending
+}
+
+func (e *CampaignExtension) HasVoted(contributorID uint) bool {
+	for _, vote := range e.Votes {
+		if vote.ContributorID == contributorID {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *CampaignExtension) AddVote(contributorID uint, approve bool) *CampaignExtensionVote {
+	vote := CampaignExtensionVote{
+		ExtensionID:   e.ID,
+		ContributorID: contributorID,
+		Approve:       approve,
+	}
+	e.Votes = append(e.Votes, vote)
+	return &vote
+}
+
+// Tally resolves the extension once a majority of the voters approved it,
+// or once enough of them rejected it that a majority can no longer be reached
+func (e *CampaignExtension) Tally(totalVoters int) {
+	approvals, rejections := 0, 0
+	for _, vote := range e.Votes {
+		if vote.Approve {
+			approvals++
+		} else {
+			rejections++
+		}
+	}
+
+	switch {
+	case approvals*2 > totalVoters:
+		e.resolve(CampaignExtensionStatusApproved)
+	case rejections*2 >= totalVoters:
+		e.resolve(CampaignExtensionStatusRejected)
+	}
+}
+
+func (e *CampaignExtension) Approve() {
+	e.resolve(CampaignExtensionStatusApproved)
+}
+
+func (e *CampaignExtension) Reject() {
+	e.resolve(CampaignExtensionStatusRejected)
+}
+
+func (e *CampaignExtension) resolve(status CampaignExtensionStatus) {
+	now := time.Now().UTC()
+	e.Status = status
+	e.ResolvedAt = &now
+}
@@ -11,8 +11,15 @@ type CampaignImage struct {
 	ID         uint   `gorm:"primaryKey;autoIncrement;not null" json:"id"`
 	CampaignID string `gorm:"not null;foreignKey:CampaignID" validate:"required" json:"-"`
 	ImageUrl   string `gorm:"type:varchar(255);not null" encrypt:"true" validate:"required,url" binding:"required,url" json:"imageUrl"`
+	// ImageID and ThumbnailID identify the uploaded files in storage
+	ImageID      string `gorm:"type:text" json:"-"`
+	ThumbnailUrl string `gorm:"type:text" encrypt:"true" json:"thumbnailUrl,omitempty"`
+	ThumbnailID  string `gorm:"type:text" json:"-"`
 }
 
+// MaxCampaignImages is the number of images a campaign can have
+const MaxCampaignImages = 10
+
 // Constructor
 
 func NewImage(campaignID, imageURL string) *CampaignImage {
@@ -22,8 +29,24 @@ func NewImage(campaignID, imageURL string) *CampaignImage {
 	}
 }
 
+// NewUploadedImage creates a campaign image from files uploaded to storage
+func NewUploadedImage(campaignID, imageURL, imageID, thumbnailURL, thumbnailID string) *CampaignImage {
+	return &CampaignImage{
+		CampaignID:   campaignID,
+		ImageUrl:     imageURL,
+		ImageID:      imageID,
+		ThumbnailUrl: thumbnailURL,
+		ThumbnailID:  thumbnailID,
+	}
+}
+
 // Methods
 
+// StoredFileIDs returns the storage IDs of the image and its thumbnail
+func (c *CampaignImage) StoredFileIDs() []string {
+	return storedFileIDs(c.ImageID, c.ThumbnailID)
+}
+
 func (c *CampaignImage) ToJSON() map[string]interface{} {
 	return ToJSON(*c)
 }
@@ -74,3 +97,14 @@ func (c *CampaignImage) Decrypt(e encryption.Encryptor, key string) error {
 	}
 	return nil
 }
+
+// storedFileIDs drops the IDs of files that were never uploaded
+func storedFileIDs(ids ...string) []string {
+	stored := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" {
+			stored = append(stored, id)
+		}
+	}
+	return stored
+}
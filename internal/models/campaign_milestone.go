@@ -0,0 +1,51 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultMilestonePercentages are used when a campaign is created without milestones
+var DefaultMilestonePercentages = []int{25, 50, 75, 100}
+
+// CampaignMilestone is a percentage of the campaign goal, it is reached at most once
+type CampaignMilestone struct {
+	ID         uint       `gorm:"primaryKey" validate:"-" binding:"-" json:"id"`
+	CampaignID string     `gorm:"type:text;not null;uniqueIndex:idx_campaign_milestone" validate:"-" binding:"-" json:"campaignId"`
+	Percentage int        `gorm:"not null;uniqueIndex:idx_campaign_milestone" validate:"required,gt=0,lte=100" binding:"required,gt=0,lte=100" json:"percentage"`
+	ReachedAt  *time.Time `validate:"-" binding:"-" json:"reachedAt,omitempty"`
+	CreatedAt  time.Time  `validate:"-" binding:"-" json:"-"`
+}
+
+// NewCampaignMilestones creates the milestones of a campaign, sorted and without duplicates
+func NewCampaignMilestones(campaignID string, percentages []int) []CampaignMilestone {
+	if len(percentages) == 0 {
+		percentages = DefaultMilestonePercentages
+	}
+
+	unique := make(map[int]bool, len(percentages))
+	milestones := make([]CampaignMilestone, 0, len(percentages))
+	for _, percentage := range percentages {
+		if unique[percentage] {
+			continue
+		}
+		unique[percentage] = true
+		milestones = append(milestones, CampaignMilestone{CampaignID: campaignID, Percentage: percentage})
+	}
+
+	sort.Slice(milestones, func(i, j int) bool {
+		return milestones[i].Percentage < milestones[j].Percentage
+	})
+	return milestones
+}
+
+// Methods
+
+func (m *CampaignMilestone) IsReached() bool {
+	return m.ReachedAt != nil
+}
+
+func (m *CampaignMilestone) MarkReached() {
+	now := time.Now().UTC()
+	m.ReachedAt = &now
+}
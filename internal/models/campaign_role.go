@@ -0,0 +1,102 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+type CampaignRole string
+
+const (
+	CampaignRoleOwner       CampaignRole = "owner"
+	CampaignRoleCoOrganiser CampaignRole = "co_organiser"
+	CampaignRoleTreasurer   CampaignRole = "treasurer"
+	CampaignRoleMember      CampaignRole = "member"
+	CampaignRoleViewer      CampaignRole = "viewer"
+)
+
+// CampaignAction is an operation on a campaign that requires a role
+type CampaignAction string
+
+const (
+	CampaignActionUpdate             CampaignAction = "update_campaign"
+	CampaignActionManageContributors CampaignAction = "manage_contributors"
+	CampaignActionManageActivities   CampaignAction = "manage_activities"
+	CampaignActionParticipate        CampaignAction = "participate"
+	CampaignActionApprovePayment     CampaignAction = "approve_payment"
+	CampaignActionManagePayout       CampaignAction = "manage_payout"
+	CampaignActionManageRoles        CampaignAction = "manage_roles"
+	CampaignActionTransferOwnership  CampaignAction = "transfer_ownership"
+	CampaignActionModerateComments   CampaignAction = "moderate_comments"
+	CampaignActionManageWebhooks     CampaignAction = "manage_webhooks"
+)
+
+// rolePermissions lists the actions each role can perform, money actions are held by the treasurer
+var rolePermissions = map[CampaignRole][]CampaignAction{
+	CampaignRoleOwner: {
+		CampaignActionUpdate,
+		CampaignActionManageContributors,
+		CampaignActionManageActivities,
+		CampaignActionParticipate,
+		CampaignActionManageRoles,
+		CampaignActionTransferOwnership,
+		CampaignActionModerateComments,
+		CampaignActionManageWebhooks,
+	},
+	CampaignRoleCoOrganiser: {
+		CampaignActionUpdate,
+		CampaignActionManageContributors,
+		CampaignActionManageActivities,
+		CampaignActionParticipate,
+		CampaignActionModerateComments,
+	},
+	CampaignRoleTreasurer: {
+		CampaignActionParticipate,
+		CampaignActionApprovePayment,
+		CampaignActionManagePayout,
+	},
+	CampaignRoleMember: {
+		CampaignActionParticipate,
+	},
+	CampaignRoleViewer: {},
+}
+
+// CampaignUserRole is the role a user holds on a campaign, contributors without a stored role are members
+// and the owner is always the campaign creator
+type CampaignUserRole struct {
+	ID         uint         `gorm:"primaryKey" json:"id"`
+	CampaignID string       `gorm:"type:text;not null;uniqueIndex:idx_campaign_user_role" json:"campaignId"`
+	UserHandle string       `gorm:"not null;uniqueIndex:idx_campaign_user_role" json:"userHandle"`
+	Email      string       `gorm:"not null" json:"email"`
+	Role       CampaignRole `gorm:"type:varchar(20);not null" json:"role"`
+	CreatedAt  time.Time    `gorm:"not null" json:"createdAt"`
+	UpdatedAt  time.Time    `json:"-"`
+}
+
+// Constructor
+func NewCampaignUserRole(campaignID string, user User, role CampaignRole) *CampaignUserRole {
+	return &CampaignUserRole{
+		CampaignID: campaignID,
+		UserHandle: user.Handle,
+		Email:      user.Email,
+		Role:       role,
+	}
+}
+
+// Methods
+
+// IsValid checks if the role exists
+func (r CampaignRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// IsAssignable checks if the role can be given to a user, ownership can only be transferred
+func (r CampaignRole) IsAssignable() bool {
+	return r.IsValid() && r != CampaignRoleOwner
+}
+
+// Can checks if the role allows the action
+func (r CampaignRole) Can(action CampaignAction) bool {
+	return slices.Contains(rolePermissions[r], action)
+}
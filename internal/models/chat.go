@@ -0,0 +1,134 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+)
+
+const (
+	// MaxChatMessageLength is the longest chat message in characters
+	MaxChatMessageLength = 2000
+	// DefaultChatHistorySize is the number of messages fetched when no limit is requested
+	DefaultChatHistorySize = 50
+	// MaxChatHistorySize is the most messages that can be fetched at once
+	MaxChatHistorySize = 200
+)
+
+var (
+	ErrEmptyChatMessage   = errors.New("chat message is empty")
+	ErrChatMessageTooLong = errors.New("chat message is too long")
+)
+
+// ChatReceiptStatus tells how far a message got to a member
+type ChatReceiptStatus string
+
+const (
+	ChatReceiptDelivered ChatReceiptStatus = "delivered"
+	ChatReceiptRead      ChatReceiptStatus = "read"
+)
+
+// IsValid checks if the status is a known receipt status
+func (s ChatReceiptStatus) IsValid() bool {
+	return s == ChatReceiptDelivered || s == ChatReceiptRead
+}
+
+// ChatMessage is a message in the campaign group chat, the content is encrypted with the campaign key
+type ChatMessage struct {
+	ID           string `gorm:"type:text;primaryKey" json:"id"`
+	CampaignID   string `gorm:"type:text;not null;index:idx_chat_campaign_created" json:"campaignId"`
+	SenderHandle string `gorm:"type:text;not null" json:"senderHandle"`
+	Content      string `gorm:"type:text;not null" encrypt:"true" json:"content"`
+	// ClientID is set by the sending client so it can match the broadcast message with the one it sent, resending it doesn't duplicate the message
+	ClientID  string        `gorm:"type:text;index" json:"clientId,omitempty"`
+	Receipts  []ChatReceipt `gorm:"foreignKey:MessageID;constraint:OnDelete:CASCADE" json:"receipts"`
+	CreatedAt time.Time     `gorm:"not null;index:idx_chat_campaign_created" json:"createdAt"`
+}
+
+// ChatReceipt records when a member received and read a message
+type ChatReceipt struct {
+	ID          uint       `gorm:"primaryKey" json:"-"`
+	MessageID   string     `gorm:"type:text;not null;uniqueIndex:idx_chat_receipt" json:"messageId"`
+	UserHandle  string     `gorm:"type:text;not null;uniqueIndex:idx_chat_receipt" json:"userHandle"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+	ReadAt      *time.Time `json:"readAt,omitempty"`
+	UpdatedAt   time.Time  `json:"-"`
+}
+
+// ChatTyping tells the other members that a member started or stopped typing
+type ChatTyping struct {
+	UserHandle string `json:"userHandle"`
+	Typing     bool   `json:"typing"`
+}
+
+// NewChatMessage creates a chat message, the content is trimmed and must not be empty or too long
+func NewChatMessage(campaignID, senderHandle, content, clientID string) (*ChatMessage, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, ErrEmptyChatMessage
+	}
+	if utf8.RuneCountInString(content) > MaxChatMessageLength {
+		return nil, ErrChatMessageTooLong
+	}
+
+	return &ChatMessage{
+		ID:           "MSG" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:12]),
+		CampaignID:   campaignID,
+		SenderHandle: senderHandle,
+		Content:      content,
+		ClientID:     clientID,
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// NewChatReceipt creates a receipt for a message, reading a message also delivers it
+func NewChatReceipt(messageID, userHandle string, status ChatReceiptStatus) *ChatReceipt {
+	now := time.Now()
+	receipt := &ChatReceipt{
+		MessageID:   messageID,
+		UserHandle:  userHandle,
+		DeliveredAt: &now,
+	}
+	if status == ChatReceiptRead {
+		receipt.ReadAt = &now
+	}
+	return receipt
+}
+
+// ChatHistorySize returns the requested number of messages within the allowed range
+func ChatHistorySize(limit int) int {
+	if limit <= 0 {
+		return DefaultChatHistorySize
+	}
+	return min(limit, MaxChatHistorySize)
+}
+
+// Encryption Methods ----------------------------------------------------
+
+func (m *ChatMessage) Encrypt(e encryption.Encryptor, key string) error {
+	encrypted, err := e.EncryptStruct(m, key)
+	if err != nil {
+		return err
+	}
+
+	if message, ok := encrypted.(*ChatMessage); ok {
+		*m = *message
+	}
+	return nil
+}
+
+func (m *ChatMessage) Decrypt(e encryption.Encryptor, key string) error {
+	decrypted, err := e.DecryptStruct(m, key)
+	if err != nil {
+		return err
+	}
+
+	if message, ok := decrypted.(*ChatMessage); ok {
+		*m = *message
+	}
+	return nil
+}
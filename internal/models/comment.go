@@ -1,12 +1,20 @@
 package models
 
 import (
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// MaxCommentMentions limits how many members a comment notifies
+const MaxCommentMentions = 10
+
+// mentionPattern matches @handle mentions that aren't part of an email address
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9_]+)`)
+
 // TODO: implement  better way of handling the replies of comment
 type Comment struct {
 	ID              string    `gorm:"type:text;primaryKey" json:"id" binding:"-"`
@@ -18,6 +26,34 @@ type Comment struct {
 	CreatedBy       User      `gorm:"references:Handle;foreignKey:CreatedByHandle" json:"createdBy" binding:"-"`
 	CreatedAt       time.Time `gorm:"not null" json:"createdAt"`
 	UpdatedAt       time.Time `json:"-"`
+
+	// Edits keep the previous content as revisions
+	Edited    bool              `gorm:"not null;default:false" json:"edited" binding:"-"`
+	EditedAt  *time.Time        `json:"editedAt,omitempty" binding:"-"`
+	Revisions []CommentRevision `gorm:"foreignKey:CommentID;constraint:OnDelete:CASCADE" json:"-" binding:"-"`
+	Reactions []CommentReaction `gorm:"foreignKey:CommentID;constraint:OnDelete:CASCADE" json:"reactions" binding:"-"`
+
+	// Moderation, a hidden comment keeps its place in the thread without its content
+	Flagged        bool       `gorm:"not null;default:false" json:"flagged" binding:"-"`
+	FlagReason     string     `gorm:"type:text" json:"flagReason,omitempty" binding:"-"`
+	Hidden         bool       `gorm:"not null;default:false" json:"hidden" binding:"-"`
+	HiddenReason   string     `gorm:"type:text" json:"hiddenReason,omitempty" binding:"-"`
+	HiddenByHandle string     `gorm:"type:text" json:"-" binding:"-"`
+	HiddenAt       *time.Time `json:"hiddenAt,omitempty" binding:"-"`
+
+	// ReplyCount is loaded with listings so replies can be fetched lazily, Depth and Path place a reply in a flattened thread
+	ReplyCount int64  `gorm:"->;-:migration" json:"replyCount" binding:"-"`
+	Depth      int    `gorm:"-" json:"depth,omitempty" binding:"-"`
+	Path       string `gorm:"-" json:"path,omitempty" binding:"-"`
+}
+
+// CommentRevision is the content a comment had before an edit
+type CommentRevision struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	CommentID      string    `gorm:"type:text;not null;index" json:"commentId"`
+	Content        string    `gorm:"type:text;not null" json:"content"`
+	EditedByHandle string    `gorm:"type:text;not null" json:"editedBy"`
+	CreatedAt      time.Time `gorm:"not null" json:"createdAt"`
 }
 
 // Constructor and Initialization Methods
@@ -41,10 +77,72 @@ func (c *Comment) FromBinding(CreatedBy User, activityID uint) {
 	c.CreatedByHandle = CreatedBy.Handle
 	c.CreatedBy = CreatedBy
 	c.ActivityID = activityID
+	c.Edited = false
+	c.EditedAt = nil
+	c.Revisions = nil
+	c.Reactions = nil
+	c.ReplyCount = 0
+	c.Flagged = false
+	c.FlagReason = ""
+	c.Hidden = false
+	c.HiddenReason = ""
+	c.HiddenByHandle = ""
+	c.HiddenAt = nil
+}
+
+// Edit replaces the content and returns a revision holding the previous content, nothing changes when the content is the same
+func (c *Comment) Edit(content, userHandle string) *CommentRevision {
+	if content == c.Content {
+		return nil
+	}
+
+	revision := &CommentRevision{
+		CommentID:      c.ID,
+		Content:        c.Content,
+		EditedByHandle: userHandle,
+	}
+	now := time.Now()
+	c.Content = content
+	c.Edited = true
+	c.EditedAt = &now
+	return revision
+}
+
+// Mentions returns the handles mentioned in the comment, upper cased like user handles
+func (c *Comment) Mentions() []string {
+	return parseMentions(c.Content)
+}
+
+// NewMentions returns the handles mentioned in the comment that weren't mentioned in the previous content
+func (c *Comment) NewMentions(previousContent string) []string {
+	previous := parseMentions(previousContent)
+
+	var mentions []string
+	for _, handle := range c.Mentions() {
+		if !slices.Contains(previous, handle) {
+			mentions = append(mentions, handle)
+		}
+	}
+	return mentions
 }
 
 // Helper function -------------------------------------------------
 
+// parseMentions returns the distinct handles mentioned in content, at most MaxCommentMentions of them
+func parseMentions(content string) []string {
+	var handles []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		handle := strings.ToUpper(match[1])
+		if !slices.Contains(handles, handle) {
+			handles = append(handles, handle)
+		}
+		if len(handles) == MaxCommentMentions {
+			break
+		}
+	}
+	return handles
+}
+
 // generateId generates a new id for Comment
 func generateID() string {
 	return "CMT" + strings.ToUpper(uuid.NewString()[:5])
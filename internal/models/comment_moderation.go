@@ -0,0 +1,170 @@
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ContentVerdict is the outcome of checking content against the content filters
+type ContentVerdict string
+
+const (
+	ContentAllowed ContentVerdict = "allowed"
+	ContentFlagged ContentVerdict = "flagged"
+	ContentBlocked ContentVerdict = "blocked"
+)
+
+// severity orders the verdicts from allowed to blocked
+func (v ContentVerdict) severity() int {
+	switch v {
+	case ContentFlagged:
+		return 1
+	case ContentBlocked:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// IsValid checks if the verdict is a known verdict
+func (v ContentVerdict) IsValid() bool {
+	return v == ContentAllowed || v == ContentFlagged || v == ContentBlocked
+}
+
+// ContentCheck is the verdict of a content filter with the reason for it
+type ContentCheck struct {
+	Verdict ContentVerdict `json:"verdict"`
+	Reason  string         `json:"reason"`
+}
+
+// Stricter returns the check with the stricter verdict, the receiver wins a tie
+func (c ContentCheck) Stricter(other ContentCheck) ContentCheck {
+	if other.Verdict.severity() > c.Verdict.severity() {
+		return other
+	}
+	return c
+}
+
+// CommentModerationAction is an action taken on a comment by a moderator or the content filter
+type CommentModerationAction string
+
+const (
+	CommentModerationHide   CommentModerationAction = "hide"
+	CommentModerationUnhide CommentModerationAction = "unhide"
+	CommentModerationRemove CommentModerationAction = "remove"
+	CommentModerationFlag   CommentModerationAction = "flag"
+	CommentModerationBlock  CommentModerationAction = "block"
+)
+
+// IsManual checks if the action can be taken by a moderator, flagging and blocking are done by the content filter
+func (a CommentModerationAction) IsManual() bool {
+	return a == CommentModerationHide || a == CommentModerationUnhide || a == CommentModerationRemove
+}
+
+// NeedsReason checks if the moderator has to give a reason for the action
+func (a CommentModerationAction) NeedsReason() bool {
+	return a == CommentModerationHide || a == CommentModerationRemove
+}
+
+// CommentReport is a member reporting a comment to the campaign moderators, a member reports a comment once
+type CommentReport struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	CommentID      string    `gorm:"type:text;not null;uniqueIndex:idx_comment_report" json:"commentId"`
+	CampaignID     string    `gorm:"type:text;not null;index" json:"campaignId"`
+	ReporterHandle string    `gorm:"type:text;not null;uniqueIndex:idx_comment_report" json:"reporterHandle"`
+	Reason         string    `gorm:"type:text;not null" json:"reason"`
+	CreatedAt      time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// CommentModerationLog records a moderation action, the moderator is empty for actions of the content filter.
+// The comment is empty when a comment was blocked before it was created
+type CommentModerationLog struct {
+	ID              uint                    `gorm:"primaryKey" json:"id"`
+	CampaignID      string                  `gorm:"type:text;not null;index" json:"campaignId"`
+	CommentID       string                  `gorm:"type:text" json:"commentId,omitempty"`
+	ModeratorHandle string                  `gorm:"type:text" json:"moderatorHandle,omitempty"`
+	Action          CommentModerationAction `gorm:"type:varchar(20);not null" json:"action"`
+	Reason          string                  `gorm:"type:text" json:"reason"`
+	CreatedAt       time.Time               `gorm:"not null" json:"createdAt"`
+}
+
+// Constructors
+
+func NewCommentReport(commentID, campaignID, reporterHandle, reason string) *CommentReport {
+	return &CommentReport{
+		CommentID:      commentID,
+		CampaignID:     campaignID,
+		ReporterHandle: reporterHandle,
+		Reason:         reason,
+	}
+}
+
+func NewCommentModerationLog(campaignID, commentID, moderatorHandle string, action CommentModerationAction, reason string) *CommentModerationLog {
+	return &CommentModerationLog{
+		CampaignID:      campaignID,
+		CommentID:       commentID,
+		ModeratorHandle: moderatorHandle,
+		Action:          action,
+		Reason:          reason,
+	}
+}
+
+// Methods
+
+// Flag marks the comment for the moderators to review
+func (c *Comment) Flag(reason string) {
+	c.Flagged = true
+	c.FlagReason = reason
+}
+
+// Hide hides the content of the comment from the campaign members
+func (c *Comment) Hide(reason, moderatorHandle string) {
+	now := time.Now()
+	c.Hidden = true
+	c.HiddenReason = reason
+	c.HiddenByHandle = moderatorHandle
+	c.HiddenAt = &now
+}
+
+// Unhide shows the content of a hidden comment again
+func (c *Comment) Unhide() {
+	c.Hidden = false
+	c.HiddenReason = ""
+	c.HiddenByHandle = ""
+	c.HiddenAt = nil
+}
+
+// Redact clears the content of a hidden comment so it isn't sent to the members
+func (c *Comment) Redact() {
+	if c.Hidden {
+		c.Content = ""
+	}
+}
+
+// RedactComments redacts the hidden comments in a listing
+func RedactComments(comments []Comment) {
+	for i := range comments {
+		comments[i].Redact()
+	}
+}
+
+// ContainsTerm checks if content contains the term as whole words, case is ignored
+// and terms of several words match across any punctuation or spacing
+func ContainsTerm(content, term string) bool {
+	term = normalizeWords(term)
+	if term == " " {
+		return false
+	}
+	return strings.Contains(normalizeWords(content), term)
+}
+
+// Helper function -------------------------------------------------
+
+// normalizeWords lower cases the words of text and joins them with single spaces, padded so whole words can be matched
+func normalizeWords(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return " " + strings.Join(words, " ") + " "
+}
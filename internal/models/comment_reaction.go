@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+	"unicode"
+)
+
+// maxEmojiLength is the longest emoji sequence a reaction can hold, in bytes
+const maxEmojiLength = 32
+
+// zeroWidthJoiner joins emoji into a single sequence such as family emoji
+const zeroWidthJoiner = '‍'
+
+// CommentReaction is an emoji a member reacted to a comment with, a member reacts with each emoji once
+type CommentReaction struct {
+	ID         uint      `gorm:"primaryKey" json:"-"`
+	CommentID  string    `gorm:"type:text;not null;uniqueIndex:idx_comment_reaction" json:"-"`
+	UserHandle string    `gorm:"type:text;not null;uniqueIndex:idx_comment_reaction" json:"userHandle"`
+	Emoji      string    `gorm:"size:32;not null;uniqueIndex:idx_comment_reaction" json:"emoji"`
+	CreatedAt  time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// NewCommentReaction creates a new CommentReaction instance
+func NewCommentReaction(commentID, userHandle, emoji string) *CommentReaction {
+	return &CommentReaction{
+		CommentID:  commentID,
+		UserHandle: userHandle,
+		Emoji:      emoji,
+	}
+}
+
+// HasReaction checks if the user already reacted to the comment with the emoji
+func (c *Comment) HasReaction(userHandle, emoji string) bool {
+	for _, reaction := range c.Reactions {
+		if reaction.UserHandle == userHandle && reaction.Emoji == emoji {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidEmoji checks that the value is a single emoji or emoji sequence and not text
+func IsValidEmoji(value string) bool {
+	if value == "" || len(value) > maxEmojiLength {
+		return false
+	}
+
+	hasSymbol := false
+	for _, r := range value {
+		switch {
+		case unicode.Is(unicode.So, r):
+			hasSymbol = true
+		case unicode.In(r, unicode.Sk, unicode.Me, unicode.Mn), r == zeroWidthJoiner:
+		default:
+			return false
+		}
+	}
+	return hasSymbol
+}
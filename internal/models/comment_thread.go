@@ -0,0 +1,149 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultCommentPageSize is the number of comments in a page when no limit is requested
+	DefaultCommentPageSize = 20
+	// MaxCommentPageSize is the largest page of comments that can be requested
+	MaxCommentPageSize = 100
+)
+
+var ErrInvalidCommentCursor = errors.New("invalid comment cursor")
+
+// CommentCursor marks the last comment of a page, the next page starts after it
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// CommentPage is a page of comments, NextCursor is empty on the last page
+type CommentPage struct {
+	Comments   []Comment `json:"comments"`
+	NextCursor string    `json:"nextCursor,omitempty"`
+}
+
+// NewCommentPage builds a page from up to limit+1 comments, the extra comment only tells that another page exists
+func NewCommentPage(comments []Comment, limit int) CommentPage {
+	if comments == nil {
+		comments = []Comment{}
+	}
+	if len(comments) <= limit {
+		return CommentPage{Comments: comments}
+	}
+
+	comments = comments[:limit]
+	last := comments[len(comments)-1]
+	return CommentPage{
+		Comments:   comments,
+		NextCursor: CommentCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode(),
+	}
+}
+
+// Encode returns the cursor as an opaque string
+func (c CommentCursor) Encode() string {
+	value := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// ParseCommentCursor reads a cursor returned with a page, an empty cursor starts from the first page
+func ParseCommentCursor(value string) (*CommentCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCommentCursor
+	}
+
+	nanos, id, found := strings.Cut(string(decoded), ":")
+	if !found || id == "" {
+		return nil, ErrInvalidCommentCursor
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCommentCursor
+	}
+
+	return &CommentCursor{CreatedAt: time.Unix(0, unixNano).UTC(), ID: id}, nil
+}
+
+// CommentPageSize returns the requested page size within the allowed range
+func CommentPageSize(limit int) int {
+	if limit <= 0 {
+		return DefaultCommentPageSize
+	}
+	return min(limit, MaxCommentPageSize)
+}
+
+// FlattenThread orders the replies under a comment depth first, oldest first among siblings, and sets their Depth and Path.
+// Path holds the IDs from the root comment down to the reply, separated by slashes
+func FlattenThread(rootID string, replies []Comment) []Comment {
+	children := make(map[string][]Comment)
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+	for _, siblings := range children {
+		slices.SortFunc(siblings, func(a, b Comment) int {
+			if cmp := a.CreatedAt.Compare(b.CreatedAt); cmp != 0 {
+				return cmp
+			}
+			return strings.Compare(a.ID, b.ID)
+		})
+	}
+
+	thread := make([]Comment, 0, len(replies))
+	var walk func(parentID, parentPath string, depth int)
+	walk = func(parentID, parentPath string, depth int) {
+		for _, reply := range children[parentID] {
+			reply.Depth = depth
+			reply.Path = parentPath + "/" + reply.ID
+			thread = append(thread, reply)
+			walk(reply.ID, reply.Path, depth+1)
+		}
+	}
+	walk(rootID, rootID, 1)
+	return thread
+}
+
+// CommentReadMarker is the last time a user read the comments of an activity
+type CommentReadMarker struct {
+	ID         uint      `gorm:"primaryKey" json:"-"`
+	UserHandle string    `gorm:"type:text;not null;uniqueIndex:idx_comment_read_marker" json:"-"`
+	ActivityID uint      `gorm:"not null;uniqueIndex:idx_comment_read_marker" json:"activityId"`
+	LastReadAt time.Time `gorm:"not null" json:"lastReadAt"`
+	UpdatedAt  time.Time `json:"-"`
+}
+
+// NewCommentReadMarker creates a marker for the comments the user read up to now
+func NewCommentReadMarker(userHandle string, activityID uint) *CommentReadMarker {
+	return &CommentReadMarker{
+		UserHandle: userHandle,
+		ActivityID: activityID,
+		LastReadAt: time.Now(),
+	}
+}
+
+// ActivityUnreadComments is the number of unread comments on an activity
+type ActivityUnreadComments struct {
+	ActivityID uint   `json:"activityId"`
+	Title      string `json:"title"`
+	Unread     int64  `json:"unread"`
+}
+
+// CampaignUnreadComments sums up the unread comments of a campaign's activities
+type CampaignUnreadComments struct {
+	CampaignID string                   `json:"campaignId"`
+	Total      int64                    `json:"total"`
+	Activities []ActivityUnreadComments `json:"activities"`
+}
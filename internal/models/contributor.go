@@ -34,9 +34,16 @@ type Contributor struct {
 
 	Payment *Payment `gorm:"foreignKey:ContributorID" json:"payment"`
 
-	Email     string    `gorm:"not null;foreignKey:Email;index:idx_campaign_user,unique" json:"email" binding:"-"`
+	Email string `gorm:"not null;foreignKey:Email;index:idx_campaign_user,unique" json:"email" binding:"-"`
+
+	// Invitation tracks whether the contributor agreed to join the campaign
+	Invitation ContributorInvitation `gorm:"embedded;embeddedPrefix:invitation_" validate:"-" binding:"-" json:"invitation"`
+
 	CreatedAt time.Time `gorm:"not null" json:"-"`
 	UpdatedAt time.Time `json:"-"`
+
+	// AccessKey is the member's campaign key, only populated when it is issued
+	AccessKey string `gorm:"-" json:"-" binding:"-"`
 }
 
 // Constructor
@@ -72,12 +79,29 @@ func (c *Contributor) HasFailed() bool {
 	return c.Payment.PaymentStatus == PaymentStatusFailed
 }
 
+// HasLeft checks if the contributor left the campaign
+func (c *Contributor) HasLeft() bool {
+	return c.Invitation.Status == InvitationStatusLeft
+}
+
+// Leave removes the contributor from the campaign, the contributor is kept so their payment stays on record
+func (c *Contributor) Leave(refunded bool) {
+	c.Invitation.Leave()
+	if refunded && c.Payment != nil {
+		c.Payment.SetPaymentStatusToRefunded()
+	}
+}
+
 // Amount Methods
+
+// GetAmountTotal returns the amount the contributor owes, their amount plus their share of each activity they opted into.
+// The activities must be loaded with their contributors and shares
 func (c *Contributor) GetAmountTotal() float64 {
+	total := c.Amount
 	for _, activity := range c.Activities {
-		c.Amount += activity.Cost
+		total += activity.ShareOf(c.ID)
 	}
-	return c.Amount
+	return total
 }
 
 // Update Methods
@@ -0,0 +1,20 @@
+package models
+
+// MaxContributorImportRows is the most contributors that can be imported at once
+const MaxContributorImportRows = 500
+
+// ContributorImportError lists why a row of a contributor import was rejected, rows are counted from 1
+type ContributorImportError struct {
+	Row    int      `json:"row"`
+	Email  string   `json:"email,omitempty"`
+	Errors []string `json:"errors"`
+}
+
+// NewContributorImportError creates the error of an import row
+func NewContributorImportError(row int, email string, errors ...string) ContributorImportError {
+	return ContributorImportError{
+		Row:    row,
+		Email:  email,
+		Errors: errors,
+	}
+}
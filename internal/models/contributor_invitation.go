@@ -0,0 +1,164 @@
+package models
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/utils"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusExpired  InvitationStatus = "expired"
+	InvitationStatusLeft     InvitationStatus = "left"
+)
+
+const (
+	// InvitationTTL is how long an invitation can be answered
+	InvitationTTL = 14 * 24 * time.Hour
+	// InvitationReminderInterval is how long an unanswered invitation waits between reminders
+	InvitationReminderInterval = 3 * 24 * time.Hour
+)
+
+// ContributorInvitation tracks whether a contributor agreed to join a campaign.
+// Only a keyed hash of the invitation token is stored, along with the seed the token is derived
+// from with the server secret so reminders can send the same link. Contributors created before
+// invitations existed default to accepted.
+type ContributorInvitation struct {
+	Status      InvitationStatus `gorm:"type:varchar(10);not null;default:accepted" json:"status"`
+	TokenHash   *string          `gorm:"index" json:"-"`
+	TokenSeed   *string          `json:"-"`
+	InvitedAt   *time.Time       `json:"invitedAt,omitempty"`
+	ExpiresAt   *time.Time       `json:"expiresAt,omitempty"`
+	RemindedAt  *time.Time       `json:"-"`
+	RespondedAt *time.Time       `json:"respondedAt,omitempty"`
+
+	// Token is the plain invitation token, only populated when the invitation is issued
+	Token string `gorm:"-" json:"-"`
+}
+
+// UnmarshalJSON ignores the invitation sent by clients, the invitation is only changed by the server
+func (i *ContributorInvitation) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// Methods
+
+func (i *ContributorInvitation) IsPending() bool {
+	return i.Status == InvitationStatusPending
+}
+
+// IsAccepted checks if the contributor joined the campaign, contributors without a status predate invitations
+func (i *ContributorInvitation) IsAccepted() bool {
+	return i.Status == InvitationStatusAccepted || i.Status == ""
+}
+
+// HasResponded checks if the contributor answered the invitation
+func (i *ContributorInvitation) HasResponded() bool {
+	return i.RespondedAt != nil
+}
+
+// IsOpen checks if the invitation still holds a place in the campaign
+func (i *ContributorInvitation) IsOpen() bool {
+	return i.IsPending() || i.IsAccepted()
+}
+
+// HasExpired checks if a pending invitation can no longer be answered
+func (i *ContributorInvitation) HasExpired() bool {
+	return i.IsPending() && i.ExpiresAt != nil && time.Now().After(*i.ExpiresAt)
+}
+
+// IsDueForReminder checks if a pending invitation has waited a reminder interval since it was last sent
+func (i *ContributorInvitation) IsDueForReminder() bool {
+	if !i.IsPending() || i.HasExpired() {
+		return false
+	}
+	lastSent := i.InvitedAt
+	if i.RemindedAt != nil {
+		lastSent = i.RemindedAt
+	}
+	return lastSent == nil || time.Since(*lastSent) >= InvitationReminderInterval
+}
+
+// Issue makes the invitation pending with a new token, any previous token stops working
+func (i *ContributorInvitation) Issue(secret string) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(InvitationTTL)
+	i.issueToken(secret)
+	i.Status = InvitationStatusPending
+	i.InvitedAt = &now
+	i.ExpiresAt = &expiresAt
+	i.RemindedAt = nil
+	i.RespondedAt = nil
+}
+
+// Remind restores the token of a pending invitation so the link in the invitation email keeps working.
+// Invitations issued before tokens had seeds get a new token.
+func (i *ContributorInvitation) Remind(secret string) {
+	now := time.Now().UTC()
+	if i.TokenSeed != nil {
+		i.Token = deriveInvitationToken(secret, *i.TokenSeed)
+	} else {
+		i.issueToken(secret)
+	}
+	i.RemindedAt = &now
+}
+
+func (i *ContributorInvitation) Accept() {
+	i.respond(InvitationStatusAccepted)
+}
+
+func (i *ContributorInvitation) Decline() {
+	i.respond(InvitationStatusDeclined)
+}
+
+func (i *ContributorInvitation) Expire() {
+	i.Status = InvitationStatusExpired
+	i.clearToken()
+}
+
+// Leave closes the place of a contributor who left the campaign
+func (i *ContributorInvitation) Leave() {
+	i.Status = InvitationStatusLeft
+	i.clearToken()
+}
+
+func (i *ContributorInvitation) issueToken(secret string) {
+	seed := generateInvitationTokenSeed()
+	i.Token = deriveInvitationToken(secret, seed)
+	tokenHash := HashInvitationToken(secret, i.Token)
+	i.TokenHash = &tokenHash
+	i.TokenSeed = &seed
+}
+
+func (i *ContributorInvitation) clearToken() {
+	i.TokenHash = nil
+	i.TokenSeed = nil
+}
+
+func (i *ContributorInvitation) respond(status InvitationStatus) {
+	now := time.Now().UTC()
+	i.Status = status
+	i.RespondedAt = &now
+	i.clearToken()
+}
+
+// Helper Functions --------------------------------------------------
+
+// HashInvitationToken returns the keyed hash an invitation token is looked up by
+func HashInvitationToken(secret, token string) string {
+	return encryption.HashKey(secret, token)
+}
+
+// deriveInvitationToken computes the token of an invitation from its seed, the token can't be derived without the secret
+func deriveInvitationToken(secret, seed string) string {
+	return "GI-" + encryption.HashKey(secret, "invitation:"+seed)[:32]
+}
+
+func generateInvitationTokenSeed() string {
+	return utils.GenerateRandomAlphaNumeric("", 32)
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+)
+
+type ContributorRequestType string
+
+const (
+	ContributorRequestTypeLeave        ContributorRequestType = "leave"
+	ContributorRequestTypeChangeAmount ContributorRequestType = "change_amount"
+)
+
+type ContributorRequestStatus string
+
+const (
+	ContributorRequestStatusPending  ContributorRequestStatus = "pending"
+	ContributorRequestStatusApproved ContributorRequestStatus = "approved"
+	ContributorRequestStatusRejected ContributorRequestStatus = "rejected"
+)
+
+// ContributorRequest is a contributor's request to leave their campaign or to change their contribution amount.
+// Requests are never deleted, they are the history of the changes contributors asked for and how they were decided
+type ContributorRequest struct {
+	ID            uint                     `gorm:"primaryKey" json:"id"`
+	CampaignID    string                   `gorm:"type:text;not null;index" json:"campaignId"`
+	ContributorID uint                     `gorm:"not null;index" json:"contributorId"`
+	Email         string                   `gorm:"not null" json:"email"`
+	Type          ContributorRequestType   `gorm:"type:varchar(20);not null" json:"type"`
+	Reason        string                   `gorm:"type:text" json:"reason"`
+	Status        ContributorRequestStatus `gorm:"type:varchar(10);not null;default:pending" json:"status"`
+
+	// Amount is the contribution amount when the request was made, RequestedAmount is only set for amount changes
+	Amount          float64  `gorm:"not null" json:"amount"`
+	RequestedAmount *float64 `json:"requestedAmount,omitempty"`
+
+	// RefundIssued records that the contributor's payment was refunded before they left
+	RefundIssued     bool       `gorm:"not null;default:false" json:"refundIssued"`
+	ReviewedByHandle *string    `json:"reviewedBy,omitempty"`
+	ReviewNote       string     `gorm:"type:text" json:"reviewNote,omitempty"`
+	ReviewedAt       *time.Time `json:"reviewedAt,omitempty"`
+
+	CreatedAt time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Constructors
+
+func NewLeaveRequest(contributor *Contributor, reason string) *ContributorRequest {
+	return newContributorRequest(contributor, ContributorRequestTypeLeave, reason)
+}
+
+func NewAmountChangeRequest(contributor *Contributor, amount float64, reason string) *ContributorRequest {
+	request := newContributorRequest(contributor, ContributorRequestTypeChangeAmount, reason)
+	request.RequestedAmount = &amount
+	return request
+}
+
+func newContributorRequest(contributor *Contributor, requestType ContributorRequestType, reason string) *ContributorRequest {
+	return &ContributorRequest{
+		CampaignID:    contributor.CampaignID,
+		ContributorID: contributor.ID,
+		Email:         contributor.Email,
+		Type:          requestType,
+		Reason:        reason,
+		Status:        ContributorRequestStatusPending,
+		Amount:        contributor.Amount,
+	}
+}
+
+// Methods
+
+func (r *ContributorRequest) IsPending() bool {
+	return r.Status == ContributorRequestStatusPending
+}
+
+func (r *ContributorRequest) IsLeave() bool {
+	return r.Type == ContributorRequestTypeLeave
+}
+
+func (r *ContributorRequest) Approve(reviewerHandle, note string, refundIssued bool) {
+	r.RefundIssued = refundIssued
+	r.review(ContributorRequestStatusApproved, reviewerHandle, note)
+}
+
+func (r *ContributorRequest) Reject(reviewerHandle, note string) {
+	r.review(ContributorRequestStatusRejected, reviewerHandle, note)
+}
+
+func (r *ContributorRequest) review(status ContributorRequestStatus, reviewerHandle, note string) {
+	now := time.Now().UTC()
+	r.Status = status
+	r.ReviewedByHandle = &reviewerHandle
+	r.ReviewNote = note
+	r.ReviewedAt = &now
+}
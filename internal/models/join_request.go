@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+)
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestStatusPending  JoinRequestStatus = "pending"
+	JoinRequestStatusApproved JoinRequestStatus = "approved"
+	JoinRequestStatusRejected JoinRequestStatus = "rejected"
+)
+
+// JoinRequest is a request from a user outside a public campaign to be added as a contributor
+type JoinRequest struct {
+	ID         uint              `gorm:"primaryKey" json:"id"`
+	CampaignID string            `gorm:"type:text;not null;index" json:"campaignId"`
+	Name       string            `gorm:"type:varchar(255)" json:"name"`
+	Email      string            `gorm:"not null;index" json:"email"`
+	Amount     float64           `gorm:"not null" json:"amount"`
+	Message    string            `gorm:"type:text" json:"message"`
+	Status     JoinRequestStatus `gorm:"type:varchar(10);not null;default:pending" json:"status"`
+	ReviewedAt *time.Time        `json:"reviewedAt,omitempty"`
+	CreatedAt  time.Time         `gorm:"not null" json:"createdAt"`
+	UpdatedAt  time.Time         `json:"-"`
+}
+
+// Constructor
+func NewJoinRequest(campaignID, name, email, message string, amount float64) *JoinRequest {
+	return &JoinRequest{
+		CampaignID: campaignID,
+		Name:       name,
+		Email:      email,
+		Amount:     amount,
+		Message:    message,
+		Status:     JoinRequestStatusPending,
+	}
+}
+
+// Methods
+
+func (j *JoinRequest) IsPending() bool {
+	return j.Status == JoinRequestStatusPending
+}
+
+func (j *JoinRequest) Approve() {
+	j.review(JoinRequestStatusApproved)
+}
+
+func (j *JoinRequest) Reject() {
+	j.review(JoinRequestStatusRejected)
+}
+
+// ToContributor creates the contributor the request is approved as, the requester asked to join so no invitation is needed
+func (j *JoinRequest) ToContributor() *Contributor {
+	contributor := NewContributor(j.CampaignID, j.Email, j.Amount)
+	contributor.Name = j.Name
+	contributor.Invitation.Accept()
+	return contributor
+}
+
+func (j *JoinRequest) review(status JoinRequestStatus) {
+	now := time.Now().UTC()
+	j.Status = status
+	j.ReviewedAt = &now
+}
@@ -15,6 +15,7 @@ const (
 	PaymentStatusSucceeded       PaymentStatus = "succeeded"
 	PaymentStatusFailed          PaymentStatus = "failed"
 	PaymentStatusPendingApproval PaymentStatus = "pending_approval"
+	PaymentStatusRefunded        PaymentStatus = "refunded"
 )
 
 type PaymentMethod string
@@ -64,10 +65,17 @@ type Payment struct {
 	AuthorizationURL string `gorm:"-" json:"authorization_url"`
 }
 
-// ManualPaymentProof represents proof of payment for manual payments
+// ManualPaymentProof represents proof of payment for manual payments.
+// Proofs are private documents, DocumentURL is only set for proofs uploaded before they were and links are signed on request
 type ManualPaymentProof struct {
 	DocumentID  string `json:"-"`
-	DocumentURL string `json:"url"`
+	DocumentURL string `json:"url,omitempty"`
+}
+
+// DocumentLink is a signed link to a private document that stops working at ExpiresAt
+type DocumentLink struct {
+	URL       string     `json:"url"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 }
 
 // Constructor
@@ -122,6 +130,11 @@ func (p *Payment) SetPaymentStatusToSuccess() {
 	p.PaymentStatus = PaymentStatusSucceeded
 }
 
+// SetPaymentStatusToRefunded updates the payment status to refunded
+func (p *Payment) SetPaymentStatusToRefunded() {
+	p.PaymentStatus = PaymentStatusRefunded
+}
+
 // GetPaymentLink returns the payment link for the payment
 func (p *Payment) GetPaymentLink() interface{} {
 	return map[string]interface{}{
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// Poll is a question the campaign organisers ask the contributors, contributors vote
+// for one option or, on multiple choice polls, for as many options as they like
+type Poll struct {
+	ID              uint         `gorm:"primaryKey" json:"id"`
+	CampaignID      string       `gorm:"type:text;not null;index" json:"campaignId"`
+	Question        string       `gorm:"type:varchar(255);not null" json:"question"`
+	MultipleChoice  bool         `gorm:"not null;default:false" json:"multipleChoice"`
+	Deadline        *time.Time   `json:"deadline,omitempty"`
+	Options         []PollOption `gorm:"foreignKey:PollID;constraint:OnDelete:CASCADE" json:"options"`
+	Votes           []PollVote   `gorm:"foreignKey:PollID;constraint:OnDelete:CASCADE" json:"-"`
+	CreatedByHandle string       `gorm:"not null" json:"createdBy"`
+	ClosedAt        *time.Time   `json:"closedAt,omitempty"`
+	CreatedAt       time.Time    `gorm:"not null" json:"createdAt"`
+	UpdatedAt       time.Time    `json:"-"`
+
+	// TotalVoters is the number of contributors who voted, it is set by Tally
+	TotalVoters int `gorm:"-" json:"totalVoters"`
+}
+
+// PollOption is one of the answers of a poll
+type PollOption struct {
+	ID       uint   `gorm:"primaryKey" json:"id"`
+	PollID   uint   `gorm:"not null;index" json:"-"`
+	Text     string `gorm:"type:varchar(255);not null" json:"text"`
+	Position int    `gorm:"not null" json:"-"`
+
+	// Votes is the number of votes for the option, it is set by Tally
+	Votes int `gorm:"-" json:"votes"`
+}
+
+// PollVote is a contributor's vote for an option, multiple choice polls hold one vote per chosen option
+type PollVote struct {
+	ID            uint      `gorm:"primaryKey" json:"-"`
+	PollID        uint      `gorm:"not null;uniqueIndex:idx_poll_vote" json:"-"`
+	OptionID      uint      `gorm:"not null;uniqueIndex:idx_poll_vote" json:"optionId"`
+	ContributorID uint      `gorm:"not null;uniqueIndex:idx_poll_vote" json:"contributorId"`
+	CreatedAt     time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// Constructor
+func NewPoll(campaignID, question string, options []string, multipleChoice bool, deadline *time.Time, createdByHandle string) *Poll {
+	poll := &Poll{
+		CampaignID:      campaignID,
+		Question:        question,
+		MultipleChoice:  multipleChoice,
+		Deadline:        deadline,
+		CreatedByHandle: createdByHandle,
+	}
+	for i, option := range options {
+		poll.Options = append(poll.Options, PollOption{Text: option, Position: i})
+	}
+	return poll
+}
+
+// Methods
+
+// IsOpen checks if the poll still accepts votes
+func (p *Poll) IsOpen() bool {
+	return p.ClosedAt == nil && (p.Deadline == nil || time.Now().Before(*p.Deadline))
+}
+
+// Close stops the poll from accepting votes
+func (p *Poll) Close() {
+	now := time.Now().UTC()
+	p.ClosedAt = &now
+}
+
+// HasVoted checks if a contributor has voted on the poll
+func (p *Poll) HasVoted(contributorID uint) bool {
+	for _, vote := range p.Votes {
+		if vote.ContributorID == contributorID {
+			return true
+		}
+	}
+	return false
+}
+
+// Vote records a contributor's choice, single choice polls take exactly one option
+func (p *Poll) Vote(contributorID uint, optionIDs []uint) ([]PollVote, error) {
+	if len(optionIDs) == 0 {
+		return nil, errors.New("choose at least one option")
+	}
+	if !p.MultipleChoice && len(optionIDs) > 1 {
+		return nil, errors.New("this poll only takes one option")
+	}
+
+	votes := make([]PollVote, 0, len(optionIDs))
+	for i, optionID := range optionIDs {
+		if slices.Contains(optionIDs[:i], optionID) {
+			return nil, errors.New("options can only be chosen once")
+		}
+		if p.GetOption(optionID) == nil {
+			return nil, errors.New("option is not part of this poll")
+		}
+		votes = append(votes, PollVote{PollID: p.ID, OptionID: optionID, ContributorID: contributorID})
+	}
+
+	p.Votes = append(p.Votes, votes...)
+	return votes, nil
+}
+
+// GetOption returns the option with the given ID, or nil if it isn't part of the poll
+func (p *Poll) GetOption(optionID uint) *PollOption {
+	for i := range p.Options {
+		if p.Options[i].ID == optionID {
+			return &p.Options[i]
+		}
+	}
+	return nil
+}
+
+// Tally counts the votes of every option and the number of contributors who voted
+func (p *Poll) Tally() {
+	voters := map[uint]bool{}
+	for i := range p.Options {
+		p.Options[i].Votes = 0
+	}
+	for _, vote := range p.Votes {
+		voters[vote.ContributorID] = true
+		if option := p.GetOption(vote.OptionID); option != nil {
+			option.Votes++
+		}
+	}
+	p.TotalVoters = len(voters)
+}
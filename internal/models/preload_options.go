@@ -6,7 +6,9 @@ type PreloadOption struct {
 	Activities             bool
 	ActivitiesContributors bool
 	ActivitiesComments     bool
+	ActivitiesExpenses     bool
 	Contributors           bool
 	ContributorsActivities bool
 	CreatedBy              bool
+	Milestones             bool
 }
@@ -53,8 +53,14 @@ func (u *User) BeforeCreate(tx *gorm.DB) (err error) {
 }
 
 // Public methods
+// CanContributeToACampaign checks the user has no open invitation or contribution, declined and expired invitations don't count
 func (u *User) CanContributeToACampaign() bool {
-	return len(u.Contributions) == 0
+	for _, contribution := range u.Contributions {
+		if contribution.Invitation.IsOpen() {
+			return false
+		}
+	}
+	return true
 }
 
 func (u *User) IsVerified() bool {
@@ -0,0 +1,250 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+)
+
+const (
+	// MaxWebhooksPerCampaign is the most endpoints a campaign can deliver events to
+	MaxWebhooksPerCampaign = 5
+	// WebhookFailureLimit is how many failed attempts in a row disable a webhook
+	WebhookFailureLimit = 20
+	// DefaultWebhookDeliveriesSize is the number of deliveries fetched when no limit is requested
+	DefaultWebhookDeliveriesSize = 50
+	// MaxWebhookDeliveriesSize is the most deliveries that can be fetched at once
+	MaxWebhookDeliveriesSize = 200
+)
+
+// webhookRetryDelays is the wait before each retry of a failed delivery, the delivery fails once they are used up
+var webhookRetryDelays = []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute, 2 * time.Hour, 6 * time.Hour}
+
+// MaxWebhookDeliveryAttempts is how many times a delivery is tried before it fails
+var MaxWebhookDeliveryAttempts = len(webhookRetryDelays) + 1
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// IsValid checks if the status is a known delivery status
+func (s WebhookDeliveryStatus) IsValid() bool {
+	return s == WebhookDeliveryPending || s == WebhookDeliverySucceeded || s == WebhookDeliveryFailed
+}
+
+// Webhook is an endpoint the campaign creator registered to receive campaign events.
+// The signing secret is sealed with the server secret so deliveries can be signed without the campaign key
+type Webhook struct {
+	ID                  uint              `gorm:"primaryKey" json:"id"`
+	CampaignID          string            `gorm:"type:text;not null;index" json:"campaignId"`
+	URL                 string            `gorm:"type:text;not null" json:"url"`
+	EventTypes          []string          `gorm:"serializer:json;not null" json:"eventTypes"`
+	EncryptedSecret     string            `gorm:"type:text;not null" json:"-"`
+	CreatedByHandle     string            `gorm:"type:text;not null" json:"createdByHandle"`
+	ConsecutiveFailures int               `gorm:"not null;default:0" json:"consecutiveFailures"`
+	DisabledAt          *time.Time        `json:"disabledAt,omitempty"`
+	Deliveries          []WebhookDelivery `gorm:"foreignKey:WebhookID;constraint:OnDelete:CASCADE" json:"-"`
+	CreatedAt           time.Time         `gorm:"not null" json:"createdAt"`
+	UpdatedAt           time.Time         `json:"updatedAt"`
+
+	// Secret is the plain signing secret, it is only populated when the webhook is created
+	Secret string `gorm:"-" json:"secret,omitempty"`
+}
+
+// WebhookDelivery is an attempt to send an event to a webhook, kept as the delivery log.
+// The payload is encrypted with the webhook's signing secret
+type WebhookDelivery struct {
+	ID             string                `gorm:"type:text;primaryKey" json:"id"`
+	WebhookID      uint                  `gorm:"not null;index:idx_webhook_delivery_created" json:"webhookId"`
+	EventID        string                `gorm:"type:text;not null" json:"eventId"`
+	EventType      string                `gorm:"type:text;not null" json:"eventType"`
+	Payload        string                `gorm:"type:text;not null" encrypt:"true" json:"payload"`
+	ReplayOf       *string               `gorm:"type:text" json:"replayOf,omitempty"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	Attempts       int                   `gorm:"not null;default:0" json:"attempts"`
+	ResponseStatus int                   `json:"responseStatus,omitempty"`
+	ResponseBody   string                `gorm:"type:text" json:"responseBody,omitempty"`
+	Error          string                `gorm:"type:text" json:"error,omitempty"`
+	NextAttemptAt  *time.Time            `gorm:"index" json:"nextAttemptAt,omitempty"`
+	DeliveredAt    *time.Time            `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time             `gorm:"not null;index:idx_webhook_delivery_created" json:"createdAt"`
+	UpdatedAt      time.Time             `json:"-"`
+}
+
+// Constructors
+
+// NewWebhook creates a webhook with a new signing secret, sealed with the server secret
+func NewWebhook(e encryption.Encryptor, serverSecret, campaignID, url, createdByHandle string, eventTypes []string) (*Webhook, error) {
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := e.Encrypt(encryption.Data{Data: secret, Key: serverSecret})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Webhook{
+		CampaignID:      campaignID,
+		URL:             url,
+		EventTypes:      eventTypes,
+		EncryptedSecret: sealed,
+		CreatedByHandle: createdByHandle,
+		Secret:          secret,
+	}, nil
+}
+
+// NewWebhookDelivery creates a delivery of an event due straight away.
+// Its ID is derived from the webhook and event so an event received more than once is only delivered once
+func NewWebhookDelivery(webhookID uint, eventID, eventType, payload string) *WebhookDelivery {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", webhookID, eventID)))
+	return newWebhookDelivery("WHD"+strings.ToUpper(hex.EncodeToString(hash[:8])), webhookID, eventID, eventType, payload)
+}
+
+func newWebhookDelivery(id string, webhookID uint, eventID, eventType, payload string) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhookID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+	}
+}
+
+// WebhookDeliveriesSize returns the requested number of deliveries within the allowed range
+func WebhookDeliveriesSize(limit int) int {
+	if limit <= 0 {
+		return DefaultWebhookDeliveriesSize
+	}
+	return min(limit, MaxWebhookDeliveriesSize)
+}
+
+// Webhook Methods
+
+// IsDisabled checks if the webhook was disabled, disabled webhooks don't receive events
+func (w *Webhook) IsDisabled() bool {
+	return w.DisabledAt != nil
+}
+
+// Subscribes checks if the webhook receives events of the type
+func (w *Webhook) Subscribes(eventType string) bool {
+	return !w.IsDisabled() && slices.Contains(w.EventTypes, eventType)
+}
+
+// SigningSecret unseals the secret deliveries are signed with
+func (w *Webhook) SigningSecret(e encryption.Encryptor, serverSecret string) (string, error) {
+	return e.Decrypt(encryption.Data{Data: w.EncryptedSecret, Key: serverSecret})
+}
+
+// Enable turns a disabled webhook back on with a clean failure count
+func (w *Webhook) Enable() {
+	w.DisabledAt = nil
+	w.ConsecutiveFailures = 0
+}
+
+// Disable stops events from being delivered to the webhook
+func (w *Webhook) Disable() {
+	now := time.Now().UTC()
+	w.DisabledAt = &now
+}
+
+// Webhook Delivery Methods
+
+// IsPending checks if the delivery is still being tried
+func (d *WebhookDelivery) IsPending() bool {
+	return d.Status == WebhookDeliveryPending
+}
+
+// Replay creates a new delivery of the same event, the payload must have been decrypted
+func (d *WebhookDelivery) Replay() *WebhookDelivery {
+	replay := newWebhookDelivery("WHD"+strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:16]), d.WebhookID, d.EventID, d.EventType, d.Payload)
+	replay.ReplayOf = &d.ID
+	return replay
+}
+
+// RecordSuccess marks the delivery as delivered
+func (d *WebhookDelivery) RecordSuccess(responseStatus int, responseBody string) {
+	now := time.Now()
+	d.Attempts++
+	d.Status = WebhookDeliverySucceeded
+	d.ResponseStatus = responseStatus
+	d.ResponseBody = responseBody
+	d.Error = ""
+	d.NextAttemptAt = nil
+	d.DeliveredAt = &now
+}
+
+// RecordFailure schedules the next attempt after a growing wait, the delivery fails once it has been tried the most times
+func (d *WebhookDelivery) RecordFailure(responseStatus int, responseBody string, err error) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.ResponseBody = responseBody
+	d.Error = err.Error()
+
+	if d.Attempts >= MaxWebhookDeliveryAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+	next := time.Now().Add(webhookRetryDelays[d.Attempts-1])
+	d.NextAttemptAt = &next
+}
+
+// Abandon fails a pending delivery without trying it again
+func (d *WebhookDelivery) Abandon(reason string) {
+	d.Status = WebhookDeliveryFailed
+	d.Error = reason
+	d.NextAttemptAt = nil
+}
+
+// Encryption Methods ----------------------------------------------------
+
+func (d *WebhookDelivery) Encrypt(e encryption.Encryptor, key string) error {
+	encrypted, err := e.EncryptStruct(d, key)
+	if err != nil {
+		return err
+	}
+
+	if delivery, ok := encrypted.(*WebhookDelivery); ok {
+		*d = *delivery
+	}
+	return nil
+}
+
+func (d *WebhookDelivery) Decrypt(e encryption.Encryptor, key string) error {
+	decrypted, err := e.DecryptStruct(d, key)
+	if err != nil {
+		return err
+	}
+
+	if delivery, ok := decrypted.(*WebhookDelivery); ok {
+		*d = *delivery
+	}
+	return nil
+}
+
+// Helper Functions --------------------------------------------------
+
+// generateWebhookSecret creates the secret deliveries are signed with
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
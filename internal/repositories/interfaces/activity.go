@@ -9,6 +9,8 @@ type ActivityRepository interface {
 	//TODO: is save redundant ?
 	Save(activity *models.Activity) error
 	Update(activity *models.Activity) error
+	UpdateSplit(activity *models.Activity) error
+	UpdateImage(activity *models.Activity) error
 	Delete(activity *models.Activity) error
 
 	GetByID(activityID uint) (models.Activity, error)
@@ -16,5 +18,12 @@ type ActivityRepository interface {
 	GetParticipants(activityID uint) ([]models.Contributor, error)
 
 	AddContributor(activityID uint, contributorID uint) error
+	OptInContributor(activityID uint, contributorID uint) (waitlisted bool, err error)
 	RemoveContributor(activityID uint, contributorID uint) error
+
+	AddToWaitlist(activityID uint, contributorID uint) error
+	RemoveFromWaitlist(activityID uint, contributorID uint) error
+	PromoteFromWaitlist(activityID uint, contributorID uint) error
+
+	AddVote(vote *models.ActivityVote) error
 }
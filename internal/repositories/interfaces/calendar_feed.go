@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CalendarFeedRepository interface {
+	Create(feed *models.CalendarFeed) error
+	Update(feed *models.CalendarFeed) error
+
+	GetByID(feedID uint) (*models.CalendarFeed, error)
+	GetByTokenHash(tokenHash string) (*models.CalendarFeed, error)
+	GetActiveByEmail(campaignID, email string) ([]models.CalendarFeed, error)
+}
@@ -9,11 +9,18 @@ type CampaignRepository interface {
 	Update(campaign *models.Campaign) (models.Campaign, error)
 	Delete(campaignID string) error
 
+	AddImage(image *models.CampaignImage) error
+	DeleteImage(image *models.CampaignImage) error
+
 	GetByID(id string) (models.Campaign, error)
 	GetByIDWithSelectedData(id string, options models.PreloadOption) (models.Campaign, error)
 	GetByHandle(handle string) (models.Campaign, error)
+	GetBySlug(slug string) (models.Campaign, error)
 
 	GetExpiredCampaigns() ([]models.Campaign, error)
 	GetActiveCampaigns() ([]models.Campaign, error)
 	GetNearEndCampaigns() ([]models.Campaign, error)
+
+	MarkMilestoneReached(milestoneID uint) (bool, error)
+	ReplaceMilestones(campaignID string, milestones []models.CampaignMilestone) ([]models.CampaignMilestone, error)
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CampaignAccessKeyRepository interface {
+	Create(accessKey *models.CampaignAccessKey) error
+	Update(accessKey *models.CampaignAccessKey) error
+	RevokeByEmail(campaignID, email string) error
+
+	GetByKeyHash(campaignID, keyHash string) (*models.CampaignAccessKey, error)
+	GetByCampaignID(campaignID string) ([]models.CampaignAccessKey, error)
+	GetOwnerKey(campaignID string) (*models.CampaignAccessKey, error)
+	HasKeys(campaignID string) (bool, error)
+}
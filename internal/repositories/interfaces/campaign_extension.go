@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CampaignExtensionRepository interface {
+	Create(extension *models.CampaignExtension) error
+	Update(extension *models.CampaignExtension) error
+	AddVote(vote *models.CampaignExtensionVote) error
+
+	GetByID(extensionID uint) (models.CampaignExtension, error)
+	GetPendingByCampaignID(campaignID string) (models.CampaignExtension, error)
+}
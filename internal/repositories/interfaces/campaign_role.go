@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CampaignRoleRepository interface {
+	Assign(role *models.CampaignUserRole) error
+	Remove(campaignID, userHandle string) error
+	TransferOwnership(campaignID string, newOwner models.User, previousOwner *models.CampaignUserRole, keys *models.OwnerKeyTransfer) error
+
+	GetByCampaignID(campaignID string) ([]models.CampaignUserRole, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ChatRepository interface {
+	Create(message *models.ChatMessage) error
+	GetByID(messageID string) (models.ChatMessage, error)
+	GetByClientID(campaignID, senderHandle, clientID string) (models.ChatMessage, error)
+	GetHistory(campaignID string, anchor *models.ChatMessage, newer bool, limit int) ([]models.ChatMessage, error)
+
+	SaveReceipt(receipt *models.ChatReceipt) error
+}
@@ -5,10 +5,24 @@ import "github.com/oyen-bright/goFundIt/internal/models"
 type CommentRepository interface {
 	Create(comment *models.Comment) error
 	Delete(commentID string) error
-	Update(comment *models.Comment) error
+	Update(comment *models.Comment, revision *models.CommentRevision) error
 
 	Get(commentID string) (models.Comment, error)
-	GetByActivityID(activityID uint) ([]models.Comment, error)
+	GetByActivityID(activityID uint, cursor *models.CommentCursor, limit int) ([]models.Comment, error)
 
-	FindReplies(commentID string) ([]models.Comment, error)
+	FindReplies(commentID string, cursor *models.CommentCursor, limit int) ([]models.Comment, error)
+	FindThread(commentID string) ([]models.Comment, error)
+
+	GetRevisions(commentID string) ([]models.CommentRevision, error)
+	AddReaction(reaction *models.CommentReaction) error
+	RemoveReaction(commentID, userHandle, emoji string) error
+
+	MarkRead(marker *models.CommentReadMarker) error
+	CountUnread(userHandle string, activityIDs []uint) (map[uint]int64, error)
+
+	UpdateModeration(comment *models.Comment) error
+	AddReport(report *models.CommentReport) error
+	GetReports(campaignID string) ([]models.CommentReport, error)
+	SaveModerationLog(log *models.CommentModerationLog) error
+	GetModerationLog(campaignID string) ([]models.CommentModerationLog, error)
 }
@@ -5,13 +5,18 @@ import (
 )
 
 type ContributorRepository interface {
-	Create(contribution *models.Contributor) error
+	Create(contribution *models.Contributor, accessKey *models.CampaignAccessKey) error
+	CreateMultiple(contributions []models.Contributor, replaced []models.Contributor, accessKeys []models.CampaignAccessKey) error
 	Update(contribution *models.Contributor) error
 	UpdateName(contributorID uint, name string) error
+	UpdateInvitation(contribution *models.Contributor) error
+	Leave(contribution *models.Contributor) error
 	Delete(contribution *models.Contributor) error
 
 	GetContributorsByCampaignID(campaignID string) ([]models.Contributor, error)
 	GetContributorById(contributorID uint, preload bool) (models.Contributor, error)
 	GetContributorByUserHandle(userHandle uint) (models.Contributor, error)
+	GetContributorByInvitationTokenHash(tokenHash string) (models.Contributor, error)
+	GetPendingInvitations() ([]models.Contributor, error)
 	GetEmailsOfActiveContributors(emails []string) ([]string, error)
 }
@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ContributorRequestRepository interface {
+	Create(request *models.ContributorRequest) error
+	Update(request *models.ContributorRequest) error
+
+	GetByID(requestID uint) (models.ContributorRequest, error)
+	GetByCampaignID(campaignID string, email string) ([]models.ContributorRequest, error)
+	HasPendingRequest(contributorID uint) (bool, error)
+}
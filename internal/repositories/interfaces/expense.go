@@ -0,0 +1,13 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ExpenseRepository interface {
+	Create(expense *models.ActivityExpense) error
+	Delete(expense *models.ActivityExpense) error
+	GetByID(expenseID uint) (models.ActivityExpense, error)
+
+	CreateReconciliation(reconciliation *models.ActivityReconciliation) error
+
+	GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error)
+}
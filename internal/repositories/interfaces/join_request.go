@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type JoinRequestRepository interface {
+	Create(request *models.JoinRequest) error
+	Update(request *models.JoinRequest) error
+
+	GetByID(requestID uint) (models.JoinRequest, error)
+	GetByCampaignID(campaignID string, status models.JoinRequestStatus) ([]models.JoinRequest, error)
+	HasPendingRequest(campaignID, email string) (bool, error)
+}
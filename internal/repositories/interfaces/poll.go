@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type PollRepository interface {
+	Create(poll *models.Poll) error
+	Update(poll *models.Poll) error
+	AddVotes(votes []models.PollVote) error
+
+	GetByID(pollID uint) (models.Poll, error)
+	GetByCampaignID(campaignID string) ([]models.Poll, error)
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+type WebhookRepository interface {
+	Create(webhook *models.Webhook) error
+	Update(webhook *models.Webhook) error
+	Delete(webhook *models.Webhook) error
+
+	GetByID(webhookID uint) (*models.Webhook, error)
+	GetByCampaignID(campaignID string) ([]models.Webhook, error)
+	GetActiveByCampaignID(campaignID string) ([]models.Webhook, error)
+	RecordDeliveryAttempt(webhookID uint, succeeded bool, failureLimit int) (disabled bool, err error)
+
+	CreateDelivery(delivery *models.WebhookDelivery) (created bool, err error)
+	UpdateDelivery(delivery *models.WebhookDelivery) error
+	GetDelivery(webhookID uint, deliveryID string) (*models.WebhookDelivery, error)
+	GetDeliveries(webhookID uint, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error)
+	ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error)
+	AbandonPendingDeliveries(webhookID uint, reason string) error
+}
@@ -67,6 +67,99 @@ func (_c *MockActivityRepository_AddContributor_Call) RunAndReturn(run func(uint
 	return _c
 }
 
+// AddToWaitlist provides a mock function with given fields: activityID, contributorID
+func (_m *MockActivityRepository) AddToWaitlist(activityID uint, contributorID uint) error {
+	ret := _m.Called(activityID, contributorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(activityID, contributorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_AddToWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToWaitlist'
+type MockActivityRepository_AddToWaitlist_Call struct {
+	*mock.Call
+}
+
+// AddToWaitlist is a helper method to define mock.On call
+//   - activityID uint
+//   - contributorID uint
+func (_e *MockActivityRepository_Expecter) AddToWaitlist(activityID interface{}, contributorID interface{}) *MockActivityRepository_AddToWaitlist_Call {
+	return &MockActivityRepository_AddToWaitlist_Call{Call: _e.mock.On("AddToWaitlist", activityID, contributorID)}
+}
+
+func (_c *MockActivityRepository_AddToWaitlist_Call) Run(run func(activityID uint, contributorID uint)) *MockActivityRepository_AddToWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_AddToWaitlist_Call) Return(_a0 error) *MockActivityRepository_AddToWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_AddToWaitlist_Call) RunAndReturn(run func(uint, uint) error) *MockActivityRepository_AddToWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddVote provides a mock function with given fields: vote
+func (_m *MockActivityRepository) AddVote(vote *models.ActivityVote) error {
+	ret := _m.Called(vote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ActivityVote) error); ok {
+		r0 = rf(vote)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_AddVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVote'
+type MockActivityRepository_AddVote_Call struct {
+	*mock.Call
+}
+
+// AddVote is a helper method to define mock.On call
+//   - vote *models.ActivityVote
+func (_e *MockActivityRepository_Expecter) AddVote(vote interface{}) *MockActivityRepository_AddVote_Call {
+	return &MockActivityRepository_AddVote_Call{Call: _e.mock.On("AddVote", vote)}
+}
+
+func (_c *MockActivityRepository_AddVote_Call) Run(run func(vote *models.ActivityVote)) *MockActivityRepository_AddVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ActivityVote))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_AddVote_Call) Return(_a0 error) *MockActivityRepository_AddVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_AddVote_Call) RunAndReturn(run func(*models.ActivityVote) error) *MockActivityRepository_AddVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: activity
 func (_m *MockActivityRepository) Create(activity *models.Activity) (models.Activity, error) {
 	ret := _m.Called(activity)
@@ -341,6 +434,110 @@ func (_c *MockActivityRepository_GetParticipants_Call) RunAndReturn(run func(uin
 	return _c
 }
 
+// OptInContributor provides a mock function with given fields: activityID, contributorID
+func (_m *MockActivityRepository) OptInContributor(activityID uint, contributorID uint) (bool, error) {
+	ret := _m.Called(activityID, contributorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OptInContributor")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(activityID, contributorID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(activityID, contributorID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(activityID, contributorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockActivityRepository_OptInContributor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OptInContributor'
+type MockActivityRepository_OptInContributor_Call struct {
+	*mock.Call
+}
+
+// OptInContributor is a helper method to define mock.On call
+//   - activityID uint
+//   - contributorID uint
+func (_e *MockActivityRepository_Expecter) OptInContributor(activityID interface{}, contributorID interface{}) *MockActivityRepository_OptInContributor_Call {
+	return &MockActivityRepository_OptInContributor_Call{Call: _e.mock.On("OptInContributor", activityID, contributorID)}
+}
+
+func (_c *MockActivityRepository_OptInContributor_Call) Run(run func(activityID uint, contributorID uint)) *MockActivityRepository_OptInContributor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_OptInContributor_Call) Return(waitlisted bool, err error) *MockActivityRepository_OptInContributor_Call {
+	_c.Call.Return(waitlisted, err)
+	return _c
+}
+
+func (_c *MockActivityRepository_OptInContributor_Call) RunAndReturn(run func(uint, uint) (bool, error)) *MockActivityRepository_OptInContributor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteFromWaitlist provides a mock function with given fields: activityID, contributorID
+func (_m *MockActivityRepository) PromoteFromWaitlist(activityID uint, contributorID uint) error {
+	ret := _m.Called(activityID, contributorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteFromWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(activityID, contributorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_PromoteFromWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteFromWaitlist'
+type MockActivityRepository_PromoteFromWaitlist_Call struct {
+	*mock.Call
+}
+
+// PromoteFromWaitlist is a helper method to define mock.On call
+//   - activityID uint
+//   - contributorID uint
+func (_e *MockActivityRepository_Expecter) PromoteFromWaitlist(activityID interface{}, contributorID interface{}) *MockActivityRepository_PromoteFromWaitlist_Call {
+	return &MockActivityRepository_PromoteFromWaitlist_Call{Call: _e.mock.On("PromoteFromWaitlist", activityID, contributorID)}
+}
+
+func (_c *MockActivityRepository_PromoteFromWaitlist_Call) Run(run func(activityID uint, contributorID uint)) *MockActivityRepository_PromoteFromWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_PromoteFromWaitlist_Call) Return(_a0 error) *MockActivityRepository_PromoteFromWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_PromoteFromWaitlist_Call) RunAndReturn(run func(uint, uint) error) *MockActivityRepository_PromoteFromWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveContributor provides a mock function with given fields: activityID, contributorID
 func (_m *MockActivityRepository) RemoveContributor(activityID uint, contributorID uint) error {
 	ret := _m.Called(activityID, contributorID)
@@ -388,6 +585,53 @@ func (_c *MockActivityRepository_RemoveContributor_Call) RunAndReturn(run func(u
 	return _c
 }
 
+// RemoveFromWaitlist provides a mock function with given fields: activityID, contributorID
+func (_m *MockActivityRepository) RemoveFromWaitlist(activityID uint, contributorID uint) error {
+	ret := _m.Called(activityID, contributorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromWaitlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(activityID, contributorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_RemoveFromWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromWaitlist'
+type MockActivityRepository_RemoveFromWaitlist_Call struct {
+	*mock.Call
+}
+
+// RemoveFromWaitlist is a helper method to define mock.On call
+//   - activityID uint
+//   - contributorID uint
+func (_e *MockActivityRepository_Expecter) RemoveFromWaitlist(activityID interface{}, contributorID interface{}) *MockActivityRepository_RemoveFromWaitlist_Call {
+	return &MockActivityRepository_RemoveFromWaitlist_Call{Call: _e.mock.On("RemoveFromWaitlist", activityID, contributorID)}
+}
+
+func (_c *MockActivityRepository_RemoveFromWaitlist_Call) Run(run func(activityID uint, contributorID uint)) *MockActivityRepository_RemoveFromWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_RemoveFromWaitlist_Call) Return(_a0 error) *MockActivityRepository_RemoveFromWaitlist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_RemoveFromWaitlist_Call) RunAndReturn(run func(uint, uint) error) *MockActivityRepository_RemoveFromWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: activity
 func (_m *MockActivityRepository) Save(activity *models.Activity) error {
 	ret := _m.Called(activity)
@@ -480,6 +724,98 @@ func (_c *MockActivityRepository_Update_Call) RunAndReturn(run func(*models.Acti
 	return _c
 }
 
+// UpdateImage provides a mock function with given fields: activity
+func (_m *MockActivityRepository) UpdateImage(activity *models.Activity) error {
+	ret := _m.Called(activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Activity) error); ok {
+		r0 = rf(activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_UpdateImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateImage'
+type MockActivityRepository_UpdateImage_Call struct {
+	*mock.Call
+}
+
+// UpdateImage is a helper method to define mock.On call
+//   - activity *models.Activity
+func (_e *MockActivityRepository_Expecter) UpdateImage(activity interface{}) *MockActivityRepository_UpdateImage_Call {
+	return &MockActivityRepository_UpdateImage_Call{Call: _e.mock.On("UpdateImage", activity)}
+}
+
+func (_c *MockActivityRepository_UpdateImage_Call) Run(run func(activity *models.Activity)) *MockActivityRepository_UpdateImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Activity))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_UpdateImage_Call) Return(_a0 error) *MockActivityRepository_UpdateImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_UpdateImage_Call) RunAndReturn(run func(*models.Activity) error) *MockActivityRepository_UpdateImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSplit provides a mock function with given fields: activity
+func (_m *MockActivityRepository) UpdateSplit(activity *models.Activity) error {
+	ret := _m.Called(activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSplit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Activity) error); ok {
+		r0 = rf(activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockActivityRepository_UpdateSplit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSplit'
+type MockActivityRepository_UpdateSplit_Call struct {
+	*mock.Call
+}
+
+// UpdateSplit is a helper method to define mock.On call
+//   - activity *models.Activity
+func (_e *MockActivityRepository_Expecter) UpdateSplit(activity interface{}) *MockActivityRepository_UpdateSplit_Call {
+	return &MockActivityRepository_UpdateSplit_Call{Call: _e.mock.On("UpdateSplit", activity)}
+}
+
+func (_c *MockActivityRepository_UpdateSplit_Call) Run(run func(activity *models.Activity)) *MockActivityRepository_UpdateSplit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Activity))
+	})
+	return _c
+}
+
+func (_c *MockActivityRepository_UpdateSplit_Call) Return(_a0 error) *MockActivityRepository_UpdateSplit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockActivityRepository_UpdateSplit_Call) RunAndReturn(run func(*models.Activity) error) *MockActivityRepository_UpdateSplit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockActivityRepository creates a new instance of MockActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockActivityRepository(t interface {
@@ -0,0 +1,302 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCalendarFeedRepository is an autogenerated mock type for the CalendarFeedRepository type
+type MockCalendarFeedRepository struct {
+	mock.Mock
+}
+
+type MockCalendarFeedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarFeedRepository) EXPECT() *MockCalendarFeedRepository_Expecter {
+	return &MockCalendarFeedRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: feed
+func (_m *MockCalendarFeedRepository) Create(feed *models.CalendarFeed) error {
+	ret := _m.Called(feed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CalendarFeed) error); ok {
+		r0 = rf(feed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCalendarFeedRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCalendarFeedRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - feed *models.CalendarFeed
+func (_e *MockCalendarFeedRepository_Expecter) Create(feed interface{}) *MockCalendarFeedRepository_Create_Call {
+	return &MockCalendarFeedRepository_Create_Call{Call: _e.mock.On("Create", feed)}
+}
+
+func (_c *MockCalendarFeedRepository_Create_Call) Run(run func(feed *models.CalendarFeed)) *MockCalendarFeedRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CalendarFeed))
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_Create_Call) Return(_a0 error) *MockCalendarFeedRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_Create_Call) RunAndReturn(run func(*models.CalendarFeed) error) *MockCalendarFeedRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveByEmail provides a mock function with given fields: campaignID, email
+func (_m *MockCalendarFeedRepository) GetActiveByEmail(campaignID string, email string) ([]models.CalendarFeed, error) {
+	ret := _m.Called(campaignID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveByEmail")
+	}
+
+	var r0 []models.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.CalendarFeed, error)); ok {
+		return rf(campaignID, email)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.CalendarFeed); ok {
+		r0 = rf(campaignID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CalendarFeed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarFeedRepository_GetActiveByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveByEmail'
+type MockCalendarFeedRepository_GetActiveByEmail_Call struct {
+	*mock.Call
+}
+
+// GetActiveByEmail is a helper method to define mock.On call
+//   - campaignID string
+//   - email string
+func (_e *MockCalendarFeedRepository_Expecter) GetActiveByEmail(campaignID interface{}, email interface{}) *MockCalendarFeedRepository_GetActiveByEmail_Call {
+	return &MockCalendarFeedRepository_GetActiveByEmail_Call{Call: _e.mock.On("GetActiveByEmail", campaignID, email)}
+}
+
+func (_c *MockCalendarFeedRepository_GetActiveByEmail_Call) Run(run func(campaignID string, email string)) *MockCalendarFeedRepository_GetActiveByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetActiveByEmail_Call) Return(_a0 []models.CalendarFeed, _a1 error) *MockCalendarFeedRepository_GetActiveByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetActiveByEmail_Call) RunAndReturn(run func(string, string) ([]models.CalendarFeed, error)) *MockCalendarFeedRepository_GetActiveByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: feedID
+func (_m *MockCalendarFeedRepository) GetByID(feedID uint) (*models.CalendarFeed, error) {
+	ret := _m.Called(feedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*models.CalendarFeed, error)); ok {
+		return rf(feedID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *models.CalendarFeed); ok {
+		r0 = rf(feedID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CalendarFeed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(feedID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarFeedRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCalendarFeedRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - feedID uint
+func (_e *MockCalendarFeedRepository_Expecter) GetByID(feedID interface{}) *MockCalendarFeedRepository_GetByID_Call {
+	return &MockCalendarFeedRepository_GetByID_Call{Call: _e.mock.On("GetByID", feedID)}
+}
+
+func (_c *MockCalendarFeedRepository_GetByID_Call) Run(run func(feedID uint)) *MockCalendarFeedRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetByID_Call) Return(_a0 *models.CalendarFeed, _a1 error) *MockCalendarFeedRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetByID_Call) RunAndReturn(run func(uint) (*models.CalendarFeed, error)) *MockCalendarFeedRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTokenHash provides a mock function with given fields: tokenHash
+func (_m *MockCalendarFeedRepository) GetByTokenHash(tokenHash string) (*models.CalendarFeed, error) {
+	ret := _m.Called(tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTokenHash")
+	}
+
+	var r0 *models.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.CalendarFeed, error)); ok {
+		return rf(tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.CalendarFeed); ok {
+		r0 = rf(tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CalendarFeed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarFeedRepository_GetByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTokenHash'
+type MockCalendarFeedRepository_GetByTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetByTokenHash is a helper method to define mock.On call
+//   - tokenHash string
+func (_e *MockCalendarFeedRepository_Expecter) GetByTokenHash(tokenHash interface{}) *MockCalendarFeedRepository_GetByTokenHash_Call {
+	return &MockCalendarFeedRepository_GetByTokenHash_Call{Call: _e.mock.On("GetByTokenHash", tokenHash)}
+}
+
+func (_c *MockCalendarFeedRepository_GetByTokenHash_Call) Run(run func(tokenHash string)) *MockCalendarFeedRepository_GetByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetByTokenHash_Call) Return(_a0 *models.CalendarFeed, _a1 error) *MockCalendarFeedRepository_GetByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_GetByTokenHash_Call) RunAndReturn(run func(string) (*models.CalendarFeed, error)) *MockCalendarFeedRepository_GetByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: feed
+func (_m *MockCalendarFeedRepository) Update(feed *models.CalendarFeed) error {
+	ret := _m.Called(feed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CalendarFeed) error); ok {
+		r0 = rf(feed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCalendarFeedRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCalendarFeedRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - feed *models.CalendarFeed
+func (_e *MockCalendarFeedRepository_Expecter) Update(feed interface{}) *MockCalendarFeedRepository_Update_Call {
+	return &MockCalendarFeedRepository_Update_Call{Call: _e.mock.On("Update", feed)}
+}
+
+func (_c *MockCalendarFeedRepository_Update_Call) Run(run func(feed *models.CalendarFeed)) *MockCalendarFeedRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CalendarFeed))
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_Update_Call) Return(_a0 error) *MockCalendarFeedRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_Update_Call) RunAndReturn(run func(*models.CalendarFeed) error) *MockCalendarFeedRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCalendarFeedRepository creates a new instance of MockCalendarFeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarFeedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarFeedRepository {
+	mock := &MockCalendarFeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,405 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignAccessKeyRepository is an autogenerated mock type for the CampaignAccessKeyRepository type
+type MockCampaignAccessKeyRepository struct {
+	mock.Mock
+}
+
+type MockCampaignAccessKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignAccessKeyRepository) EXPECT() *MockCampaignAccessKeyRepository_Expecter {
+	return &MockCampaignAccessKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: accessKey
+func (_m *MockCampaignAccessKeyRepository) Create(accessKey *models.CampaignAccessKey) error {
+	ret := _m.Called(accessKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignAccessKey) error); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessKeyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCampaignAccessKeyRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - accessKey *models.CampaignAccessKey
+func (_e *MockCampaignAccessKeyRepository_Expecter) Create(accessKey interface{}) *MockCampaignAccessKeyRepository_Create_Call {
+	return &MockCampaignAccessKeyRepository_Create_Call{Call: _e.mock.On("Create", accessKey)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_Create_Call) Run(run func(accessKey *models.CampaignAccessKey)) *MockCampaignAccessKeyRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignAccessKey))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_Create_Call) Return(_a0 error) *MockCampaignAccessKeyRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_Create_Call) RunAndReturn(run func(*models.CampaignAccessKey) error) *MockCampaignAccessKeyRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockCampaignAccessKeyRepository) GetByCampaignID(campaignID string) ([]models.CampaignAccessKey, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.CampaignAccessKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CampaignAccessKey, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CampaignAccessKey); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignAccessKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessKeyRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockCampaignAccessKeyRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignAccessKeyRepository_Expecter) GetByCampaignID(campaignID interface{}) *MockCampaignAccessKeyRepository_GetByCampaignID_Call {
+	return &MockCampaignAccessKeyRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByCampaignID_Call) Run(run func(campaignID string)) *MockCampaignAccessKeyRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByCampaignID_Call) Return(_a0 []models.CampaignAccessKey, _a1 error) *MockCampaignAccessKeyRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByCampaignID_Call) RunAndReturn(run func(string) ([]models.CampaignAccessKey, error)) *MockCampaignAccessKeyRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByKeyHash provides a mock function with given fields: campaignID, keyHash
+func (_m *MockCampaignAccessKeyRepository) GetByKeyHash(campaignID string, keyHash string) (*models.CampaignAccessKey, error) {
+	ret := _m.Called(campaignID, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByKeyHash")
+	}
+
+	var r0 *models.CampaignAccessKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.CampaignAccessKey, error)); ok {
+		return rf(campaignID, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.CampaignAccessKey); ok {
+		r0 = rf(campaignID, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignAccessKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessKeyRepository_GetByKeyHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByKeyHash'
+type MockCampaignAccessKeyRepository_GetByKeyHash_Call struct {
+	*mock.Call
+}
+
+// GetByKeyHash is a helper method to define mock.On call
+//   - campaignID string
+//   - keyHash string
+func (_e *MockCampaignAccessKeyRepository_Expecter) GetByKeyHash(campaignID interface{}, keyHash interface{}) *MockCampaignAccessKeyRepository_GetByKeyHash_Call {
+	return &MockCampaignAccessKeyRepository_GetByKeyHash_Call{Call: _e.mock.On("GetByKeyHash", campaignID, keyHash)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByKeyHash_Call) Run(run func(campaignID string, keyHash string)) *MockCampaignAccessKeyRepository_GetByKeyHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByKeyHash_Call) Return(_a0 *models.CampaignAccessKey, _a1 error) *MockCampaignAccessKeyRepository_GetByKeyHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetByKeyHash_Call) RunAndReturn(run func(string, string) (*models.CampaignAccessKey, error)) *MockCampaignAccessKeyRepository_GetByKeyHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOwnerKey provides a mock function with given fields: campaignID
+func (_m *MockCampaignAccessKeyRepository) GetOwnerKey(campaignID string) (*models.CampaignAccessKey, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnerKey")
+	}
+
+	var r0 *models.CampaignAccessKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.CampaignAccessKey, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.CampaignAccessKey); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignAccessKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessKeyRepository_GetOwnerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwnerKey'
+type MockCampaignAccessKeyRepository_GetOwnerKey_Call struct {
+	*mock.Call
+}
+
+// GetOwnerKey is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignAccessKeyRepository_Expecter) GetOwnerKey(campaignID interface{}) *MockCampaignAccessKeyRepository_GetOwnerKey_Call {
+	return &MockCampaignAccessKeyRepository_GetOwnerKey_Call{Call: _e.mock.On("GetOwnerKey", campaignID)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetOwnerKey_Call) Run(run func(campaignID string)) *MockCampaignAccessKeyRepository_GetOwnerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetOwnerKey_Call) Return(_a0 *models.CampaignAccessKey, _a1 error) *MockCampaignAccessKeyRepository_GetOwnerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_GetOwnerKey_Call) RunAndReturn(run func(string) (*models.CampaignAccessKey, error)) *MockCampaignAccessKeyRepository_GetOwnerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasKeys provides a mock function with given fields: campaignID
+func (_m *MockCampaignAccessKeyRepository) HasKeys(campaignID string) (bool, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasKeys")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessKeyRepository_HasKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasKeys'
+type MockCampaignAccessKeyRepository_HasKeys_Call struct {
+	*mock.Call
+}
+
+// HasKeys is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignAccessKeyRepository_Expecter) HasKeys(campaignID interface{}) *MockCampaignAccessKeyRepository_HasKeys_Call {
+	return &MockCampaignAccessKeyRepository_HasKeys_Call{Call: _e.mock.On("HasKeys", campaignID)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_HasKeys_Call) Run(run func(campaignID string)) *MockCampaignAccessKeyRepository_HasKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_HasKeys_Call) Return(_a0 bool, _a1 error) *MockCampaignAccessKeyRepository_HasKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_HasKeys_Call) RunAndReturn(run func(string) (bool, error)) *MockCampaignAccessKeyRepository_HasKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByEmail provides a mock function with given fields: campaignID, email
+func (_m *MockCampaignAccessKeyRepository) RevokeByEmail(campaignID string, email string) error {
+	ret := _m.Called(campaignID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(campaignID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessKeyRepository_RevokeByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByEmail'
+type MockCampaignAccessKeyRepository_RevokeByEmail_Call struct {
+	*mock.Call
+}
+
+// RevokeByEmail is a helper method to define mock.On call
+//   - campaignID string
+//   - email string
+func (_e *MockCampaignAccessKeyRepository_Expecter) RevokeByEmail(campaignID interface{}, email interface{}) *MockCampaignAccessKeyRepository_RevokeByEmail_Call {
+	return &MockCampaignAccessKeyRepository_RevokeByEmail_Call{Call: _e.mock.On("RevokeByEmail", campaignID, email)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_RevokeByEmail_Call) Run(run func(campaignID string, email string)) *MockCampaignAccessKeyRepository_RevokeByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_RevokeByEmail_Call) Return(_a0 error) *MockCampaignAccessKeyRepository_RevokeByEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_RevokeByEmail_Call) RunAndReturn(run func(string, string) error) *MockCampaignAccessKeyRepository_RevokeByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: accessKey
+func (_m *MockCampaignAccessKeyRepository) Update(accessKey *models.CampaignAccessKey) error {
+	ret := _m.Called(accessKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignAccessKey) error); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessKeyRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCampaignAccessKeyRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - accessKey *models.CampaignAccessKey
+func (_e *MockCampaignAccessKeyRepository_Expecter) Update(accessKey interface{}) *MockCampaignAccessKeyRepository_Update_Call {
+	return &MockCampaignAccessKeyRepository_Update_Call{Call: _e.mock.On("Update", accessKey)}
+}
+
+func (_c *MockCampaignAccessKeyRepository_Update_Call) Run(run func(accessKey *models.CampaignAccessKey)) *MockCampaignAccessKeyRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignAccessKey))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_Update_Call) Return(_a0 error) *MockCampaignAccessKeyRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessKeyRepository_Update_Call) RunAndReturn(run func(*models.CampaignAccessKey) error) *MockCampaignAccessKeyRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignAccessKeyRepository creates a new instance of MockCampaignAccessKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignAccessKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignAccessKeyRepository {
+	mock := &MockCampaignAccessKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
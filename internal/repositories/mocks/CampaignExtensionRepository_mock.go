@@ -0,0 +1,285 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignExtensionRepository is an autogenerated mock type for the CampaignExtensionRepository type
+type MockCampaignExtensionRepository struct {
+	mock.Mock
+}
+
+type MockCampaignExtensionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignExtensionRepository) EXPECT() *MockCampaignExtensionRepository_Expecter {
+	return &MockCampaignExtensionRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddVote provides a mock function with given fields: vote
+func (_m *MockCampaignExtensionRepository) AddVote(vote *models.CampaignExtensionVote) error {
+	ret := _m.Called(vote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignExtensionVote) error); ok {
+		r0 = rf(vote)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignExtensionRepository_AddVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVote'
+type MockCampaignExtensionRepository_AddVote_Call struct {
+	*mock.Call
+}
+
+// AddVote is a helper method to define mock.On call
+//   - vote *models.CampaignExtensionVote
+func (_e *MockCampaignExtensionRepository_Expecter) AddVote(vote interface{}) *MockCampaignExtensionRepository_AddVote_Call {
+	return &MockCampaignExtensionRepository_AddVote_Call{Call: _e.mock.On("AddVote", vote)}
+}
+
+func (_c *MockCampaignExtensionRepository_AddVote_Call) Run(run func(vote *models.CampaignExtensionVote)) *MockCampaignExtensionRepository_AddVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignExtensionVote))
+	})
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_AddVote_Call) Return(_a0 error) *MockCampaignExtensionRepository_AddVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_AddVote_Call) RunAndReturn(run func(*models.CampaignExtensionVote) error) *MockCampaignExtensionRepository_AddVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: extension
+func (_m *MockCampaignExtensionRepository) Create(extension *models.CampaignExtension) error {
+	ret := _m.Called(extension)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignExtension) error); ok {
+		r0 = rf(extension)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignExtensionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCampaignExtensionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - extension *models.CampaignExtension
+func (_e *MockCampaignExtensionRepository_Expecter) Create(extension interface{}) *MockCampaignExtensionRepository_Create_Call {
+	return &MockCampaignExtensionRepository_Create_Call{Call: _e.mock.On("Create", extension)}
+}
+
+func (_c *MockCampaignExtensionRepository_Create_Call) Run(run func(extension *models.CampaignExtension)) *MockCampaignExtensionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignExtension))
+	})
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_Create_Call) Return(_a0 error) *MockCampaignExtensionRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_Create_Call) RunAndReturn(run func(*models.CampaignExtension) error) *MockCampaignExtensionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: extensionID
+func (_m *MockCampaignExtensionRepository) GetByID(extensionID uint) (models.CampaignExtension, error) {
+	ret := _m.Called(extensionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.CampaignExtension
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.CampaignExtension, error)); ok {
+		return rf(extensionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.CampaignExtension); ok {
+		r0 = rf(extensionID)
+	} else {
+		r0 = ret.Get(0).(models.CampaignExtension)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(extensionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignExtensionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCampaignExtensionRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - extensionID uint
+func (_e *MockCampaignExtensionRepository_Expecter) GetByID(extensionID interface{}) *MockCampaignExtensionRepository_GetByID_Call {
+	return &MockCampaignExtensionRepository_GetByID_Call{Call: _e.mock.On("GetByID", extensionID)}
+}
+
+func (_c *MockCampaignExtensionRepository_GetByID_Call) Run(run func(extensionID uint)) *MockCampaignExtensionRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_GetByID_Call) Return(_a0 models.CampaignExtension, _a1 error) *MockCampaignExtensionRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_GetByID_Call) RunAndReturn(run func(uint) (models.CampaignExtension, error)) *MockCampaignExtensionRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockCampaignExtensionRepository) GetPendingByCampaignID(campaignID string) (models.CampaignExtension, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingByCampaignID")
+	}
+
+	var r0 models.CampaignExtension
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.CampaignExtension, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.CampaignExtension); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Get(0).(models.CampaignExtension)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignExtensionRepository_GetPendingByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingByCampaignID'
+type MockCampaignExtensionRepository_GetPendingByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetPendingByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignExtensionRepository_Expecter) GetPendingByCampaignID(campaignID interface{}) *MockCampaignExtensionRepository_GetPendingByCampaignID_Call {
+	return &MockCampaignExtensionRepository_GetPendingByCampaignID_Call{Call: _e.mock.On("GetPendingByCampaignID", campaignID)}
+}
+
+func (_c *MockCampaignExtensionRepository_GetPendingByCampaignID_Call) Run(run func(campaignID string)) *MockCampaignExtensionRepository_GetPendingByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_GetPendingByCampaignID_Call) Return(_a0 models.CampaignExtension, _a1 error) *MockCampaignExtensionRepository_GetPendingByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_GetPendingByCampaignID_Call) RunAndReturn(run func(string) (models.CampaignExtension, error)) *MockCampaignExtensionRepository_GetPendingByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: extension
+func (_m *MockCampaignExtensionRepository) Update(extension *models.CampaignExtension) error {
+	ret := _m.Called(extension)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignExtension) error); ok {
+		r0 = rf(extension)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignExtensionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCampaignExtensionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - extension *models.CampaignExtension
+func (_e *MockCampaignExtensionRepository_Expecter) Update(extension interface{}) *MockCampaignExtensionRepository_Update_Call {
+	return &MockCampaignExtensionRepository_Update_Call{Call: _e.mock.On("Update", extension)}
+}
+
+func (_c *MockCampaignExtensionRepository_Update_Call) Run(run func(extension *models.CampaignExtension)) *MockCampaignExtensionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignExtension))
+	})
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_Update_Call) Return(_a0 error) *MockCampaignExtensionRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignExtensionRepository_Update_Call) RunAndReturn(run func(*models.CampaignExtension) error) *MockCampaignExtensionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignExtensionRepository creates a new instance of MockCampaignExtensionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignExtensionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignExtensionRepository {
+	mock := &MockCampaignExtensionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,6 +20,52 @@ func (_m *MockCampaignRepository) EXPECT() *MockCampaignRepository_Expecter {
 	return &MockCampaignRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddImage provides a mock function with given fields: image
+func (_m *MockCampaignRepository) AddImage(image *models.CampaignImage) error {
+	ret := _m.Called(image)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignImage) error); ok {
+		r0 = rf(image)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignRepository_AddImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddImage'
+type MockCampaignRepository_AddImage_Call struct {
+	*mock.Call
+}
+
+// AddImage is a helper method to define mock.On call
+//   - image *models.CampaignImage
+func (_e *MockCampaignRepository_Expecter) AddImage(image interface{}) *MockCampaignRepository_AddImage_Call {
+	return &MockCampaignRepository_AddImage_Call{Call: _e.mock.On("AddImage", image)}
+}
+
+func (_c *MockCampaignRepository_AddImage_Call) Run(run func(image *models.CampaignImage)) *MockCampaignRepository_AddImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignImage))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_AddImage_Call) Return(_a0 error) *MockCampaignRepository_AddImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignRepository_AddImage_Call) RunAndReturn(run func(*models.CampaignImage) error) *MockCampaignRepository_AddImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: campaign
 func (_m *MockCampaignRepository) Create(campaign *models.Campaign) (models.Campaign, error) {
 	ret := _m.Called(campaign)
@@ -122,6 +168,52 @@ func (_c *MockCampaignRepository_Delete_Call) RunAndReturn(run func(string) erro
 	return _c
 }
 
+// DeleteImage provides a mock function with given fields: image
+func (_m *MockCampaignRepository) DeleteImage(image *models.CampaignImage) error {
+	ret := _m.Called(image)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignImage) error); ok {
+		r0 = rf(image)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignRepository_DeleteImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteImage'
+type MockCampaignRepository_DeleteImage_Call struct {
+	*mock.Call
+}
+
+// DeleteImage is a helper method to define mock.On call
+//   - image *models.CampaignImage
+func (_e *MockCampaignRepository_Expecter) DeleteImage(image interface{}) *MockCampaignRepository_DeleteImage_Call {
+	return &MockCampaignRepository_DeleteImage_Call{Call: _e.mock.On("DeleteImage", image)}
+}
+
+func (_c *MockCampaignRepository_DeleteImage_Call) Run(run func(image *models.CampaignImage)) *MockCampaignRepository_DeleteImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignImage))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_DeleteImage_Call) Return(_a0 error) *MockCampaignRepository_DeleteImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignRepository_DeleteImage_Call) RunAndReturn(run func(*models.CampaignImage) error) *MockCampaignRepository_DeleteImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetActiveCampaigns provides a mock function with no fields
 func (_m *MockCampaignRepository) GetActiveCampaigns() ([]models.Campaign, error) {
 	ret := _m.Called()
@@ -348,6 +440,62 @@ func (_c *MockCampaignRepository_GetByIDWithSelectedData_Call) RunAndReturn(run
 	return _c
 }
 
+// GetBySlug provides a mock function with given fields: slug
+func (_m *MockCampaignRepository) GetBySlug(slug string) (models.Campaign, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 models.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Campaign, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Campaign); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Get(0).(models.Campaign)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_GetBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySlug'
+type MockCampaignRepository_GetBySlug_Call struct {
+	*mock.Call
+}
+
+// GetBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MockCampaignRepository_Expecter) GetBySlug(slug interface{}) *MockCampaignRepository_GetBySlug_Call {
+	return &MockCampaignRepository_GetBySlug_Call{Call: _e.mock.On("GetBySlug", slug)}
+}
+
+func (_c *MockCampaignRepository_GetBySlug_Call) Run(run func(slug string)) *MockCampaignRepository_GetBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_GetBySlug_Call) Return(_a0 models.Campaign, _a1 error) *MockCampaignRepository_GetBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_GetBySlug_Call) RunAndReturn(run func(string) (models.Campaign, error)) *MockCampaignRepository_GetBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExpiredCampaigns provides a mock function with no fields
 func (_m *MockCampaignRepository) GetExpiredCampaigns() ([]models.Campaign, error) {
 	ret := _m.Called()
@@ -462,6 +610,121 @@ func (_c *MockCampaignRepository_GetNearEndCampaigns_Call) RunAndReturn(run func
 	return _c
 }
 
+// MarkMilestoneReached provides a mock function with given fields: milestoneID
+func (_m *MockCampaignRepository) MarkMilestoneReached(milestoneID uint) (bool, error) {
+	ret := _m.Called(milestoneID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkMilestoneReached")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (bool, error)); ok {
+		return rf(milestoneID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) bool); ok {
+		r0 = rf(milestoneID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(milestoneID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_MarkMilestoneReached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMilestoneReached'
+type MockCampaignRepository_MarkMilestoneReached_Call struct {
+	*mock.Call
+}
+
+// MarkMilestoneReached is a helper method to define mock.On call
+//   - milestoneID uint
+func (_e *MockCampaignRepository_Expecter) MarkMilestoneReached(milestoneID interface{}) *MockCampaignRepository_MarkMilestoneReached_Call {
+	return &MockCampaignRepository_MarkMilestoneReached_Call{Call: _e.mock.On("MarkMilestoneReached", milestoneID)}
+}
+
+func (_c *MockCampaignRepository_MarkMilestoneReached_Call) Run(run func(milestoneID uint)) *MockCampaignRepository_MarkMilestoneReached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_MarkMilestoneReached_Call) Return(_a0 bool, _a1 error) *MockCampaignRepository_MarkMilestoneReached_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_MarkMilestoneReached_Call) RunAndReturn(run func(uint) (bool, error)) *MockCampaignRepository_MarkMilestoneReached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMilestones provides a mock function with given fields: campaignID, milestones
+func (_m *MockCampaignRepository) ReplaceMilestones(campaignID string, milestones []models.CampaignMilestone) ([]models.CampaignMilestone, error) {
+	ret := _m.Called(campaignID, milestones)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMilestones")
+	}
+
+	var r0 []models.CampaignMilestone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []models.CampaignMilestone) ([]models.CampaignMilestone, error)); ok {
+		return rf(campaignID, milestones)
+	}
+	if rf, ok := ret.Get(0).(func(string, []models.CampaignMilestone) []models.CampaignMilestone); ok {
+		r0 = rf(campaignID, milestones)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignMilestone)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []models.CampaignMilestone) error); ok {
+		r1 = rf(campaignID, milestones)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_ReplaceMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMilestones'
+type MockCampaignRepository_ReplaceMilestones_Call struct {
+	*mock.Call
+}
+
+// ReplaceMilestones is a helper method to define mock.On call
+//   - campaignID string
+//   - milestones []models.CampaignMilestone
+func (_e *MockCampaignRepository_Expecter) ReplaceMilestones(campaignID interface{}, milestones interface{}) *MockCampaignRepository_ReplaceMilestones_Call {
+	return &MockCampaignRepository_ReplaceMilestones_Call{Call: _e.mock.On("ReplaceMilestones", campaignID, milestones)}
+}
+
+func (_c *MockCampaignRepository_ReplaceMilestones_Call) Run(run func(campaignID string, milestones []models.CampaignMilestone)) *MockCampaignRepository_ReplaceMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]models.CampaignMilestone))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_ReplaceMilestones_Call) Return(_a0 []models.CampaignMilestone, _a1 error) *MockCampaignRepository_ReplaceMilestones_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_ReplaceMilestones_Call) RunAndReturn(run func(string, []models.CampaignMilestone) ([]models.CampaignMilestone, error)) *MockCampaignRepository_ReplaceMilestones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: campaign
 func (_m *MockCampaignRepository) Update(campaign *models.Campaign) (models.Campaign, error) {
 	ret := _m.Called(campaign)
@@ -0,0 +1,235 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignRoleRepository is an autogenerated mock type for the CampaignRoleRepository type
+type MockCampaignRoleRepository struct {
+	mock.Mock
+}
+
+type MockCampaignRoleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignRoleRepository) EXPECT() *MockCampaignRoleRepository_Expecter {
+	return &MockCampaignRoleRepository_Expecter{mock: &_m.Mock}
+}
+
+// Assign provides a mock function with given fields: role
+func (_m *MockCampaignRoleRepository) Assign(role *models.CampaignUserRole) error {
+	ret := _m.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CampaignUserRole) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignRoleRepository_Assign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assign'
+type MockCampaignRoleRepository_Assign_Call struct {
+	*mock.Call
+}
+
+// Assign is a helper method to define mock.On call
+//   - role *models.CampaignUserRole
+func (_e *MockCampaignRoleRepository_Expecter) Assign(role interface{}) *MockCampaignRoleRepository_Assign_Call {
+	return &MockCampaignRoleRepository_Assign_Call{Call: _e.mock.On("Assign", role)}
+}
+
+func (_c *MockCampaignRoleRepository_Assign_Call) Run(run func(role *models.CampaignUserRole)) *MockCampaignRoleRepository_Assign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CampaignUserRole))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_Assign_Call) Return(_a0 error) *MockCampaignRoleRepository_Assign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_Assign_Call) RunAndReturn(run func(*models.CampaignUserRole) error) *MockCampaignRoleRepository_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockCampaignRoleRepository) GetByCampaignID(campaignID string) ([]models.CampaignUserRole, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.CampaignUserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CampaignUserRole, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CampaignUserRole); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignUserRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRoleRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockCampaignRoleRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignRoleRepository_Expecter) GetByCampaignID(campaignID interface{}) *MockCampaignRoleRepository_GetByCampaignID_Call {
+	return &MockCampaignRoleRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID)}
+}
+
+func (_c *MockCampaignRoleRepository_GetByCampaignID_Call) Run(run func(campaignID string)) *MockCampaignRoleRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_GetByCampaignID_Call) Return(_a0 []models.CampaignUserRole, _a1 error) *MockCampaignRoleRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_GetByCampaignID_Call) RunAndReturn(run func(string) ([]models.CampaignUserRole, error)) *MockCampaignRoleRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: campaignID, userHandle
+func (_m *MockCampaignRoleRepository) Remove(campaignID string, userHandle string) error {
+	ret := _m.Called(campaignID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(campaignID, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignRoleRepository_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockCampaignRoleRepository_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+func (_e *MockCampaignRoleRepository_Expecter) Remove(campaignID interface{}, userHandle interface{}) *MockCampaignRoleRepository_Remove_Call {
+	return &MockCampaignRoleRepository_Remove_Call{Call: _e.mock.On("Remove", campaignID, userHandle)}
+}
+
+func (_c *MockCampaignRoleRepository_Remove_Call) Run(run func(campaignID string, userHandle string)) *MockCampaignRoleRepository_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_Remove_Call) Return(_a0 error) *MockCampaignRoleRepository_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_Remove_Call) RunAndReturn(run func(string, string) error) *MockCampaignRoleRepository_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferOwnership provides a mock function with given fields: campaignID, newOwner, previousOwner, keys
+func (_m *MockCampaignRoleRepository) TransferOwnership(campaignID string, newOwner models.User, previousOwner *models.CampaignUserRole, keys *models.OwnerKeyTransfer) error {
+	ret := _m.Called(campaignID, newOwner, previousOwner, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwnership")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.User, *models.CampaignUserRole, *models.OwnerKeyTransfer) error); ok {
+		r0 = rf(campaignID, newOwner, previousOwner, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignRoleRepository_TransferOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferOwnership'
+type MockCampaignRoleRepository_TransferOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferOwnership is a helper method to define mock.On call
+//   - campaignID string
+//   - newOwner models.User
+//   - previousOwner *models.CampaignUserRole
+//   - keys *models.OwnerKeyTransfer
+func (_e *MockCampaignRoleRepository_Expecter) TransferOwnership(campaignID interface{}, newOwner interface{}, previousOwner interface{}, keys interface{}) *MockCampaignRoleRepository_TransferOwnership_Call {
+	return &MockCampaignRoleRepository_TransferOwnership_Call{Call: _e.mock.On("TransferOwnership", campaignID, newOwner, previousOwner, keys)}
+}
+
+func (_c *MockCampaignRoleRepository_TransferOwnership_Call) Run(run func(campaignID string, newOwner models.User, previousOwner *models.CampaignUserRole, keys *models.OwnerKeyTransfer)) *MockCampaignRoleRepository_TransferOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(models.User), args[2].(*models.CampaignUserRole), args[3].(*models.OwnerKeyTransfer))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_TransferOwnership_Call) Return(_a0 error) *MockCampaignRoleRepository_TransferOwnership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignRoleRepository_TransferOwnership_Call) RunAndReturn(run func(string, models.User, *models.CampaignUserRole, *models.OwnerKeyTransfer) error) *MockCampaignRoleRepository_TransferOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignRoleRepository creates a new instance of MockCampaignRoleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignRoleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignRoleRepository {
+	mock := &MockCampaignRoleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
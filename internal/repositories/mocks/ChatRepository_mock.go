@@ -0,0 +1,302 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockChatRepository is an autogenerated mock type for the ChatRepository type
+type MockChatRepository struct {
+	mock.Mock
+}
+
+type MockChatRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChatRepository) EXPECT() *MockChatRepository_Expecter {
+	return &MockChatRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: message
+func (_m *MockChatRepository) Create(message *models.ChatMessage) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChatMessage) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChatRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockChatRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - message *models.ChatMessage
+func (_e *MockChatRepository_Expecter) Create(message interface{}) *MockChatRepository_Create_Call {
+	return &MockChatRepository_Create_Call{Call: _e.mock.On("Create", message)}
+}
+
+func (_c *MockChatRepository_Create_Call) Run(run func(message *models.ChatMessage)) *MockChatRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ChatMessage))
+	})
+	return _c
+}
+
+func (_c *MockChatRepository_Create_Call) Return(_a0 error) *MockChatRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChatRepository_Create_Call) RunAndReturn(run func(*models.ChatMessage) error) *MockChatRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByClientID provides a mock function with given fields: campaignID, senderHandle, clientID
+func (_m *MockChatRepository) GetByClientID(campaignID string, senderHandle string, clientID string) (models.ChatMessage, error) {
+	ret := _m.Called(campaignID, senderHandle, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByClientID")
+	}
+
+	var r0 models.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (models.ChatMessage, error)); ok {
+		return rf(campaignID, senderHandle, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) models.ChatMessage); ok {
+		r0 = rf(campaignID, senderHandle, clientID)
+	} else {
+		r0 = ret.Get(0).(models.ChatMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, senderHandle, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatRepository_GetByClientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByClientID'
+type MockChatRepository_GetByClientID_Call struct {
+	*mock.Call
+}
+
+// GetByClientID is a helper method to define mock.On call
+//   - campaignID string
+//   - senderHandle string
+//   - clientID string
+func (_e *MockChatRepository_Expecter) GetByClientID(campaignID interface{}, senderHandle interface{}, clientID interface{}) *MockChatRepository_GetByClientID_Call {
+	return &MockChatRepository_GetByClientID_Call{Call: _e.mock.On("GetByClientID", campaignID, senderHandle, clientID)}
+}
+
+func (_c *MockChatRepository_GetByClientID_Call) Run(run func(campaignID string, senderHandle string, clientID string)) *MockChatRepository_GetByClientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockChatRepository_GetByClientID_Call) Return(_a0 models.ChatMessage, _a1 error) *MockChatRepository_GetByClientID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatRepository_GetByClientID_Call) RunAndReturn(run func(string, string, string) (models.ChatMessage, error)) *MockChatRepository_GetByClientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: messageID
+func (_m *MockChatRepository) GetByID(messageID string) (models.ChatMessage, error) {
+	ret := _m.Called(messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.ChatMessage, error)); ok {
+		return rf(messageID)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.ChatMessage); ok {
+		r0 = rf(messageID)
+	} else {
+		r0 = ret.Get(0).(models.ChatMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockChatRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - messageID string
+func (_e *MockChatRepository_Expecter) GetByID(messageID interface{}) *MockChatRepository_GetByID_Call {
+	return &MockChatRepository_GetByID_Call{Call: _e.mock.On("GetByID", messageID)}
+}
+
+func (_c *MockChatRepository_GetByID_Call) Run(run func(messageID string)) *MockChatRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockChatRepository_GetByID_Call) Return(_a0 models.ChatMessage, _a1 error) *MockChatRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatRepository_GetByID_Call) RunAndReturn(run func(string) (models.ChatMessage, error)) *MockChatRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: campaignID, anchor, newer, limit
+func (_m *MockChatRepository) GetHistory(campaignID string, anchor *models.ChatMessage, newer bool, limit int) ([]models.ChatMessage, error) {
+	ret := _m.Called(campaignID, anchor, newer, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []models.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *models.ChatMessage, bool, int) ([]models.ChatMessage, error)); ok {
+		return rf(campaignID, anchor, newer, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *models.ChatMessage, bool, int) []models.ChatMessage); ok {
+		r0 = rf(campaignID, anchor, newer, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *models.ChatMessage, bool, int) error); ok {
+		r1 = rf(campaignID, anchor, newer, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatRepository_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockChatRepository_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - campaignID string
+//   - anchor *models.ChatMessage
+//   - newer bool
+//   - limit int
+func (_e *MockChatRepository_Expecter) GetHistory(campaignID interface{}, anchor interface{}, newer interface{}, limit interface{}) *MockChatRepository_GetHistory_Call {
+	return &MockChatRepository_GetHistory_Call{Call: _e.mock.On("GetHistory", campaignID, anchor, newer, limit)}
+}
+
+func (_c *MockChatRepository_GetHistory_Call) Run(run func(campaignID string, anchor *models.ChatMessage, newer bool, limit int)) *MockChatRepository_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*models.ChatMessage), args[2].(bool), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockChatRepository_GetHistory_Call) Return(_a0 []models.ChatMessage, _a1 error) *MockChatRepository_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatRepository_GetHistory_Call) RunAndReturn(run func(string, *models.ChatMessage, bool, int) ([]models.ChatMessage, error)) *MockChatRepository_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReceipt provides a mock function with given fields: receipt
+func (_m *MockChatRepository) SaveReceipt(receipt *models.ChatReceipt) error {
+	ret := _m.Called(receipt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReceipt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChatReceipt) error); ok {
+		r0 = rf(receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChatRepository_SaveReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReceipt'
+type MockChatRepository_SaveReceipt_Call struct {
+	*mock.Call
+}
+
+// SaveReceipt is a helper method to define mock.On call
+//   - receipt *models.ChatReceipt
+func (_e *MockChatRepository_Expecter) SaveReceipt(receipt interface{}) *MockChatRepository_SaveReceipt_Call {
+	return &MockChatRepository_SaveReceipt_Call{Call: _e.mock.On("SaveReceipt", receipt)}
+}
+
+func (_c *MockChatRepository_SaveReceipt_Call) Run(run func(receipt *models.ChatReceipt)) *MockChatRepository_SaveReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ChatReceipt))
+	})
+	return _c
+}
+
+func (_c *MockChatRepository_SaveReceipt_Call) Return(_a0 error) *MockChatRepository_SaveReceipt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChatRepository_SaveReceipt_Call) RunAndReturn(run func(*models.ChatReceipt) error) *MockChatRepository_SaveReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockChatRepository creates a new instance of MockChatRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChatRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChatRepository {
+	mock := &MockChatRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
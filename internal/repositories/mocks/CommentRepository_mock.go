@@ -20,6 +20,157 @@ func (_m *MockCommentRepository) EXPECT() *MockCommentRepository_Expecter {
 	return &MockCommentRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddReaction provides a mock function with given fields: reaction
+func (_m *MockCommentRepository) AddReaction(reaction *models.CommentReaction) error {
+	ret := _m.Called(reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CommentReaction) error); ok {
+		r0 = rf(reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type MockCommentRepository_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - reaction *models.CommentReaction
+func (_e *MockCommentRepository_Expecter) AddReaction(reaction interface{}) *MockCommentRepository_AddReaction_Call {
+	return &MockCommentRepository_AddReaction_Call{Call: _e.mock.On("AddReaction", reaction)}
+}
+
+func (_c *MockCommentRepository_AddReaction_Call) Run(run func(reaction *models.CommentReaction)) *MockCommentRepository_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CommentReaction))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_AddReaction_Call) Return(_a0 error) *MockCommentRepository_AddReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_AddReaction_Call) RunAndReturn(run func(*models.CommentReaction) error) *MockCommentRepository_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReport provides a mock function with given fields: report
+func (_m *MockCommentRepository) AddReport(report *models.CommentReport) error {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CommentReport) error); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_AddReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReport'
+type MockCommentRepository_AddReport_Call struct {
+	*mock.Call
+}
+
+// AddReport is a helper method to define mock.On call
+//   - report *models.CommentReport
+func (_e *MockCommentRepository_Expecter) AddReport(report interface{}) *MockCommentRepository_AddReport_Call {
+	return &MockCommentRepository_AddReport_Call{Call: _e.mock.On("AddReport", report)}
+}
+
+func (_c *MockCommentRepository_AddReport_Call) Run(run func(report *models.CommentReport)) *MockCommentRepository_AddReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CommentReport))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_AddReport_Call) Return(_a0 error) *MockCommentRepository_AddReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_AddReport_Call) RunAndReturn(run func(*models.CommentReport) error) *MockCommentRepository_AddReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUnread provides a mock function with given fields: userHandle, activityIDs
+func (_m *MockCommentRepository) CountUnread(userHandle string, activityIDs []uint) (map[uint]int64, error) {
+	ret := _m.Called(userHandle, activityIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 map[uint]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []uint) (map[uint]int64, error)); ok {
+		return rf(userHandle, activityIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []uint) map[uint]int64); ok {
+		r0 = rf(userHandle, activityIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []uint) error); ok {
+		r1 = rf(userHandle, activityIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type MockCommentRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - userHandle string
+//   - activityIDs []uint
+func (_e *MockCommentRepository_Expecter) CountUnread(userHandle interface{}, activityIDs interface{}) *MockCommentRepository_CountUnread_Call {
+	return &MockCommentRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", userHandle, activityIDs)}
+}
+
+func (_c *MockCommentRepository_CountUnread_Call) Run(run func(userHandle string, activityIDs []uint)) *MockCommentRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]uint))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_CountUnread_Call) Return(_a0 map[uint]int64, _a1 error) *MockCommentRepository_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_CountUnread_Call) RunAndReturn(run func(string, []uint) (map[uint]int64, error)) *MockCommentRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: comment
 func (_m *MockCommentRepository) Create(comment *models.Comment) error {
 	ret := _m.Called(comment)
@@ -112,14 +263,74 @@ func (_c *MockCommentRepository_Delete_Call) RunAndReturn(run func(string) error
 	return _c
 }
 
-// FindReplies provides a mock function with given fields: commentID
-func (_m *MockCommentRepository) FindReplies(commentID string) ([]models.Comment, error) {
-	ret := _m.Called(commentID)
+// FindReplies provides a mock function with given fields: commentID, cursor, limit
+func (_m *MockCommentRepository) FindReplies(commentID string, cursor *models.CommentCursor, limit int) ([]models.Comment, error) {
+	ret := _m.Called(commentID, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindReplies")
 	}
 
+	var r0 []models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *models.CommentCursor, int) ([]models.Comment, error)); ok {
+		return rf(commentID, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *models.CommentCursor, int) []models.Comment); ok {
+		r0 = rf(commentID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *models.CommentCursor, int) error); ok {
+		r1 = rf(commentID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_FindReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReplies'
+type MockCommentRepository_FindReplies_Call struct {
+	*mock.Call
+}
+
+// FindReplies is a helper method to define mock.On call
+//   - commentID string
+//   - cursor *models.CommentCursor
+//   - limit int
+func (_e *MockCommentRepository_Expecter) FindReplies(commentID interface{}, cursor interface{}, limit interface{}) *MockCommentRepository_FindReplies_Call {
+	return &MockCommentRepository_FindReplies_Call{Call: _e.mock.On("FindReplies", commentID, cursor, limit)}
+}
+
+func (_c *MockCommentRepository_FindReplies_Call) Run(run func(commentID string, cursor *models.CommentCursor, limit int)) *MockCommentRepository_FindReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*models.CommentCursor), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_FindReplies_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentRepository_FindReplies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_FindReplies_Call) RunAndReturn(run func(string, *models.CommentCursor, int) ([]models.Comment, error)) *MockCommentRepository_FindReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindThread provides a mock function with given fields: commentID
+func (_m *MockCommentRepository) FindThread(commentID string) ([]models.Comment, error) {
+	ret := _m.Called(commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindThread")
+	}
+
 	var r0 []models.Comment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) ([]models.Comment, error)); ok {
@@ -142,30 +353,30 @@ func (_m *MockCommentRepository) FindReplies(commentID string) ([]models.Comment
 	return r0, r1
 }
 
-// MockCommentRepository_FindReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReplies'
-type MockCommentRepository_FindReplies_Call struct {
+// MockCommentRepository_FindThread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindThread'
+type MockCommentRepository_FindThread_Call struct {
 	*mock.Call
 }
 
-// FindReplies is a helper method to define mock.On call
+// FindThread is a helper method to define mock.On call
 //   - commentID string
-func (_e *MockCommentRepository_Expecter) FindReplies(commentID interface{}) *MockCommentRepository_FindReplies_Call {
-	return &MockCommentRepository_FindReplies_Call{Call: _e.mock.On("FindReplies", commentID)}
+func (_e *MockCommentRepository_Expecter) FindThread(commentID interface{}) *MockCommentRepository_FindThread_Call {
+	return &MockCommentRepository_FindThread_Call{Call: _e.mock.On("FindThread", commentID)}
 }
 
-func (_c *MockCommentRepository_FindReplies_Call) Run(run func(commentID string)) *MockCommentRepository_FindReplies_Call {
+func (_c *MockCommentRepository_FindThread_Call) Run(run func(commentID string)) *MockCommentRepository_FindThread_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockCommentRepository_FindReplies_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentRepository_FindReplies_Call {
+func (_c *MockCommentRepository_FindThread_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentRepository_FindThread_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentRepository_FindReplies_Call) RunAndReturn(run func(string) ([]models.Comment, error)) *MockCommentRepository_FindReplies_Call {
+func (_c *MockCommentRepository_FindThread_Call) RunAndReturn(run func(string) ([]models.Comment, error)) *MockCommentRepository_FindThread_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -226,9 +437,9 @@ func (_c *MockCommentRepository_Get_Call) RunAndReturn(run func(string) (models.
 	return _c
 }
 
-// GetByActivityID provides a mock function with given fields: activityID
-func (_m *MockCommentRepository) GetByActivityID(activityID uint) ([]models.Comment, error) {
-	ret := _m.Called(activityID)
+// GetByActivityID provides a mock function with given fields: activityID, cursor, limit
+func (_m *MockCommentRepository) GetByActivityID(activityID uint, cursor *models.CommentCursor, limit int) ([]models.Comment, error) {
+	ret := _m.Called(activityID, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByActivityID")
@@ -236,19 +447,19 @@ func (_m *MockCommentRepository) GetByActivityID(activityID uint) ([]models.Comm
 
 	var r0 []models.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) ([]models.Comment, error)); ok {
-		return rf(activityID)
+	if rf, ok := ret.Get(0).(func(uint, *models.CommentCursor, int) ([]models.Comment, error)); ok {
+		return rf(activityID, cursor, limit)
 	}
-	if rf, ok := ret.Get(0).(func(uint) []models.Comment); ok {
-		r0 = rf(activityID)
+	if rf, ok := ret.Get(0).(func(uint, *models.CommentCursor, int) []models.Comment); ok {
+		r0 = rf(activityID, cursor, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Comment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(activityID)
+	if rf, ok := ret.Get(1).(func(uint, *models.CommentCursor, int) error); ok {
+		r1 = rf(activityID, cursor, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -263,13 +474,15 @@ type MockCommentRepository_GetByActivityID_Call struct {
 
 // GetByActivityID is a helper method to define mock.On call
 //   - activityID uint
-func (_e *MockCommentRepository_Expecter) GetByActivityID(activityID interface{}) *MockCommentRepository_GetByActivityID_Call {
-	return &MockCommentRepository_GetByActivityID_Call{Call: _e.mock.On("GetByActivityID", activityID)}
+//   - cursor *models.CommentCursor
+//   - limit int
+func (_e *MockCommentRepository_Expecter) GetByActivityID(activityID interface{}, cursor interface{}, limit interface{}) *MockCommentRepository_GetByActivityID_Call {
+	return &MockCommentRepository_GetByActivityID_Call{Call: _e.mock.On("GetByActivityID", activityID, cursor, limit)}
 }
 
-func (_c *MockCommentRepository_GetByActivityID_Call) Run(run func(activityID uint)) *MockCommentRepository_GetByActivityID_Call {
+func (_c *MockCommentRepository_GetByActivityID_Call) Run(run func(activityID uint, cursor *models.CommentCursor, limit int)) *MockCommentRepository_GetByActivityID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(*models.CommentCursor), args[2].(int))
 	})
 	return _c
 }
@@ -279,22 +492,336 @@ func (_c *MockCommentRepository_GetByActivityID_Call) Return(_a0 []models.Commen
 	return _c
 }
 
-func (_c *MockCommentRepository_GetByActivityID_Call) RunAndReturn(run func(uint) ([]models.Comment, error)) *MockCommentRepository_GetByActivityID_Call {
+func (_c *MockCommentRepository_GetByActivityID_Call) RunAndReturn(run func(uint, *models.CommentCursor, int) ([]models.Comment, error)) *MockCommentRepository_GetByActivityID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: comment
-func (_m *MockCommentRepository) Update(comment *models.Comment) error {
-	ret := _m.Called(comment)
+// GetModerationLog provides a mock function with given fields: campaignID
+func (_m *MockCommentRepository) GetModerationLog(campaignID string) ([]models.CommentModerationLog, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModerationLog")
+	}
+
+	var r0 []models.CommentModerationLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CommentModerationLog, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CommentModerationLog); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentModerationLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_GetModerationLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModerationLog'
+type MockCommentRepository_GetModerationLog_Call struct {
+	*mock.Call
+}
+
+// GetModerationLog is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCommentRepository_Expecter) GetModerationLog(campaignID interface{}) *MockCommentRepository_GetModerationLog_Call {
+	return &MockCommentRepository_GetModerationLog_Call{Call: _e.mock.On("GetModerationLog", campaignID)}
+}
+
+func (_c *MockCommentRepository_GetModerationLog_Call) Run(run func(campaignID string)) *MockCommentRepository_GetModerationLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetModerationLog_Call) Return(_a0 []models.CommentModerationLog, _a1 error) *MockCommentRepository_GetModerationLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetModerationLog_Call) RunAndReturn(run func(string) ([]models.CommentModerationLog, error)) *MockCommentRepository_GetModerationLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReports provides a mock function with given fields: campaignID
+func (_m *MockCommentRepository) GetReports(campaignID string) ([]models.CommentReport, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReports")
+	}
+
+	var r0 []models.CommentReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CommentReport, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CommentReport); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_GetReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReports'
+type MockCommentRepository_GetReports_Call struct {
+	*mock.Call
+}
+
+// GetReports is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCommentRepository_Expecter) GetReports(campaignID interface{}) *MockCommentRepository_GetReports_Call {
+	return &MockCommentRepository_GetReports_Call{Call: _e.mock.On("GetReports", campaignID)}
+}
+
+func (_c *MockCommentRepository_GetReports_Call) Run(run func(campaignID string)) *MockCommentRepository_GetReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetReports_Call) Return(_a0 []models.CommentReport, _a1 error) *MockCommentRepository_GetReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetReports_Call) RunAndReturn(run func(string) ([]models.CommentReport, error)) *MockCommentRepository_GetReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function with given fields: commentID
+func (_m *MockCommentRepository) GetRevisions(commentID string) ([]models.CommentRevision, error) {
+	ret := _m.Called(commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []models.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CommentRevision, error)); ok {
+		return rf(commentID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CommentRevision); ok {
+		r0 = rf(commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockCommentRepository_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - commentID string
+func (_e *MockCommentRepository_Expecter) GetRevisions(commentID interface{}) *MockCommentRepository_GetRevisions_Call {
+	return &MockCommentRepository_GetRevisions_Call{Call: _e.mock.On("GetRevisions", commentID)}
+}
+
+func (_c *MockCommentRepository_GetRevisions_Call) Run(run func(commentID string)) *MockCommentRepository_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetRevisions_Call) Return(_a0 []models.CommentRevision, _a1 error) *MockCommentRepository_GetRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetRevisions_Call) RunAndReturn(run func(string) ([]models.CommentRevision, error)) *MockCommentRepository_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: marker
+func (_m *MockCommentRepository) MarkRead(marker *models.CommentReadMarker) error {
+	ret := _m.Called(marker)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CommentReadMarker) error); ok {
+		r0 = rf(marker)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockCommentRepository_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - marker *models.CommentReadMarker
+func (_e *MockCommentRepository_Expecter) MarkRead(marker interface{}) *MockCommentRepository_MarkRead_Call {
+	return &MockCommentRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", marker)}
+}
+
+func (_c *MockCommentRepository_MarkRead_Call) Run(run func(marker *models.CommentReadMarker)) *MockCommentRepository_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CommentReadMarker))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_MarkRead_Call) Return(_a0 error) *MockCommentRepository_MarkRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_MarkRead_Call) RunAndReturn(run func(*models.CommentReadMarker) error) *MockCommentRepository_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: commentID, userHandle, emoji
+func (_m *MockCommentRepository) RemoveReaction(commentID string, userHandle string, emoji string) error {
+	ret := _m.Called(commentID, userHandle, emoji)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(commentID, userHandle, emoji)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type MockCommentRepository_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - commentID string
+//   - userHandle string
+//   - emoji string
+func (_e *MockCommentRepository_Expecter) RemoveReaction(commentID interface{}, userHandle interface{}, emoji interface{}) *MockCommentRepository_RemoveReaction_Call {
+	return &MockCommentRepository_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", commentID, userHandle, emoji)}
+}
+
+func (_c *MockCommentRepository_RemoveReaction_Call) Run(run func(commentID string, userHandle string, emoji string)) *MockCommentRepository_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_RemoveReaction_Call) Return(_a0 error) *MockCommentRepository_RemoveReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_RemoveReaction_Call) RunAndReturn(run func(string, string, string) error) *MockCommentRepository_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveModerationLog provides a mock function with given fields: log
+func (_m *MockCommentRepository) SaveModerationLog(log *models.CommentModerationLog) error {
+	ret := _m.Called(log)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveModerationLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CommentModerationLog) error); ok {
+		r0 = rf(log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_SaveModerationLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveModerationLog'
+type MockCommentRepository_SaveModerationLog_Call struct {
+	*mock.Call
+}
+
+// SaveModerationLog is a helper method to define mock.On call
+//   - log *models.CommentModerationLog
+func (_e *MockCommentRepository_Expecter) SaveModerationLog(log interface{}) *MockCommentRepository_SaveModerationLog_Call {
+	return &MockCommentRepository_SaveModerationLog_Call{Call: _e.mock.On("SaveModerationLog", log)}
+}
+
+func (_c *MockCommentRepository_SaveModerationLog_Call) Run(run func(log *models.CommentModerationLog)) *MockCommentRepository_SaveModerationLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.CommentModerationLog))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_SaveModerationLog_Call) Return(_a0 error) *MockCommentRepository_SaveModerationLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_SaveModerationLog_Call) RunAndReturn(run func(*models.CommentModerationLog) error) *MockCommentRepository_SaveModerationLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: comment, revision
+func (_m *MockCommentRepository) Update(comment *models.Comment, revision *models.CommentRevision) error {
+	ret := _m.Called(comment, revision)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Comment) error); ok {
-		r0 = rf(comment)
+	if rf, ok := ret.Get(0).(func(*models.Comment, *models.CommentRevision) error); ok {
+		r0 = rf(comment, revision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -309,13 +836,14 @@ type MockCommentRepository_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - comment *models.Comment
-func (_e *MockCommentRepository_Expecter) Update(comment interface{}) *MockCommentRepository_Update_Call {
-	return &MockCommentRepository_Update_Call{Call: _e.mock.On("Update", comment)}
+//   - revision *models.CommentRevision
+func (_e *MockCommentRepository_Expecter) Update(comment interface{}, revision interface{}) *MockCommentRepository_Update_Call {
+	return &MockCommentRepository_Update_Call{Call: _e.mock.On("Update", comment, revision)}
 }
 
-func (_c *MockCommentRepository_Update_Call) Run(run func(comment *models.Comment)) *MockCommentRepository_Update_Call {
+func (_c *MockCommentRepository_Update_Call) Run(run func(comment *models.Comment, revision *models.CommentRevision)) *MockCommentRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.Comment))
+		run(args[0].(*models.Comment), args[1].(*models.CommentRevision))
 	})
 	return _c
 }
@@ -325,7 +853,53 @@ func (_c *MockCommentRepository_Update_Call) Return(_a0 error) *MockCommentRepos
 	return _c
 }
 
-func (_c *MockCommentRepository_Update_Call) RunAndReturn(run func(*models.Comment) error) *MockCommentRepository_Update_Call {
+func (_c *MockCommentRepository_Update_Call) RunAndReturn(run func(*models.Comment, *models.CommentRevision) error) *MockCommentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateModeration provides a mock function with given fields: comment
+func (_m *MockCommentRepository) UpdateModeration(comment *models.Comment) error {
+	ret := _m.Called(comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateModeration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Comment) error); ok {
+		r0 = rf(comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_UpdateModeration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateModeration'
+type MockCommentRepository_UpdateModeration_Call struct {
+	*mock.Call
+}
+
+// UpdateModeration is a helper method to define mock.On call
+//   - comment *models.Comment
+func (_e *MockCommentRepository_Expecter) UpdateModeration(comment interface{}) *MockCommentRepository_UpdateModeration_Call {
+	return &MockCommentRepository_UpdateModeration_Call{Call: _e.mock.On("UpdateModeration", comment)}
+}
+
+func (_c *MockCommentRepository_UpdateModeration_Call) Run(run func(comment *models.Comment)) *MockCommentRepository_UpdateModeration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_UpdateModeration_Call) Return(_a0 error) *MockCommentRepository_UpdateModeration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_UpdateModeration_Call) RunAndReturn(run func(*models.Comment) error) *MockCommentRepository_UpdateModeration_Call {
 	_c.Call.Return(run)
 	return _c
 }
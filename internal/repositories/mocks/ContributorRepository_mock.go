@@ -20,17 +20,17 @@ func (_m *MockContributorRepository) EXPECT() *MockContributorRepository_Expecte
 	return &MockContributorRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: contribution
-func (_m *MockContributorRepository) Create(contribution *models.Contributor) error {
-	ret := _m.Called(contribution)
+// Create provides a mock function with given fields: contribution, accessKey
+func (_m *MockContributorRepository) Create(contribution *models.Contributor, accessKey *models.CampaignAccessKey) error {
+	ret := _m.Called(contribution, accessKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Contributor) error); ok {
-		r0 = rf(contribution)
+	if rf, ok := ret.Get(0).(func(*models.Contributor, *models.CampaignAccessKey) error); ok {
+		r0 = rf(contribution, accessKey)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,13 +45,14 @@ type MockContributorRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - contribution *models.Contributor
-func (_e *MockContributorRepository_Expecter) Create(contribution interface{}) *MockContributorRepository_Create_Call {
-	return &MockContributorRepository_Create_Call{Call: _e.mock.On("Create", contribution)}
+//   - accessKey *models.CampaignAccessKey
+func (_e *MockContributorRepository_Expecter) Create(contribution interface{}, accessKey interface{}) *MockContributorRepository_Create_Call {
+	return &MockContributorRepository_Create_Call{Call: _e.mock.On("Create", contribution, accessKey)}
 }
 
-func (_c *MockContributorRepository_Create_Call) Run(run func(contribution *models.Contributor)) *MockContributorRepository_Create_Call {
+func (_c *MockContributorRepository_Create_Call) Run(run func(contribution *models.Contributor, accessKey *models.CampaignAccessKey)) *MockContributorRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.Contributor))
+		run(args[0].(*models.Contributor), args[1].(*models.CampaignAccessKey))
 	})
 	return _c
 }
@@ -61,7 +62,55 @@ func (_c *MockContributorRepository_Create_Call) Return(_a0 error) *MockContribu
 	return _c
 }
 
-func (_c *MockContributorRepository_Create_Call) RunAndReturn(run func(*models.Contributor) error) *MockContributorRepository_Create_Call {
+func (_c *MockContributorRepository_Create_Call) RunAndReturn(run func(*models.Contributor, *models.CampaignAccessKey) error) *MockContributorRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMultiple provides a mock function with given fields: contributions, replaced, accessKeys
+func (_m *MockContributorRepository) CreateMultiple(contributions []models.Contributor, replaced []models.Contributor, accessKeys []models.CampaignAccessKey) error {
+	ret := _m.Called(contributions, replaced, accessKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMultiple")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Contributor, []models.Contributor, []models.CampaignAccessKey) error); ok {
+		r0 = rf(contributions, replaced, accessKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorRepository_CreateMultiple_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMultiple'
+type MockContributorRepository_CreateMultiple_Call struct {
+	*mock.Call
+}
+
+// CreateMultiple is a helper method to define mock.On call
+//   - contributions []models.Contributor
+//   - replaced []models.Contributor
+//   - accessKeys []models.CampaignAccessKey
+func (_e *MockContributorRepository_Expecter) CreateMultiple(contributions interface{}, replaced interface{}, accessKeys interface{}) *MockContributorRepository_CreateMultiple_Call {
+	return &MockContributorRepository_CreateMultiple_Call{Call: _e.mock.On("CreateMultiple", contributions, replaced, accessKeys)}
+}
+
+func (_c *MockContributorRepository_CreateMultiple_Call) Run(run func(contributions []models.Contributor, replaced []models.Contributor, accessKeys []models.CampaignAccessKey)) *MockContributorRepository_CreateMultiple_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Contributor), args[1].([]models.Contributor), args[2].([]models.CampaignAccessKey))
+	})
+	return _c
+}
+
+func (_c *MockContributorRepository_CreateMultiple_Call) Return(_a0 error) *MockContributorRepository_CreateMultiple_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRepository_CreateMultiple_Call) RunAndReturn(run func([]models.Contributor, []models.Contributor, []models.CampaignAccessKey) error) *MockContributorRepository_CreateMultiple_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -169,6 +218,62 @@ func (_c *MockContributorRepository_GetContributorById_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetContributorByInvitationTokenHash provides a mock function with given fields: tokenHash
+func (_m *MockContributorRepository) GetContributorByInvitationTokenHash(tokenHash string) (models.Contributor, error) {
+	ret := _m.Called(tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContributorByInvitationTokenHash")
+	}
+
+	var r0 models.Contributor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Contributor, error)); ok {
+		return rf(tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Contributor); ok {
+		r0 = rf(tokenHash)
+	} else {
+		r0 = ret.Get(0).(models.Contributor)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRepository_GetContributorByInvitationTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContributorByInvitationTokenHash'
+type MockContributorRepository_GetContributorByInvitationTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetContributorByInvitationTokenHash is a helper method to define mock.On call
+//   - tokenHash string
+func (_e *MockContributorRepository_Expecter) GetContributorByInvitationTokenHash(tokenHash interface{}) *MockContributorRepository_GetContributorByInvitationTokenHash_Call {
+	return &MockContributorRepository_GetContributorByInvitationTokenHash_Call{Call: _e.mock.On("GetContributorByInvitationTokenHash", tokenHash)}
+}
+
+func (_c *MockContributorRepository_GetContributorByInvitationTokenHash_Call) Run(run func(tokenHash string)) *MockContributorRepository_GetContributorByInvitationTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRepository_GetContributorByInvitationTokenHash_Call) Return(_a0 models.Contributor, _a1 error) *MockContributorRepository_GetContributorByInvitationTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRepository_GetContributorByInvitationTokenHash_Call) RunAndReturn(run func(string) (models.Contributor, error)) *MockContributorRepository_GetContributorByInvitationTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetContributorByUserHandle provides a mock function with given fields: userHandle
 func (_m *MockContributorRepository) GetContributorByUserHandle(userHandle uint) (models.Contributor, error) {
 	ret := _m.Called(userHandle)
@@ -341,6 +446,109 @@ func (_c *MockContributorRepository_GetEmailsOfActiveContributors_Call) RunAndRe
 	return _c
 }
 
+// GetPendingInvitations provides a mock function with no fields
+func (_m *MockContributorRepository) GetPendingInvitations() ([]models.Contributor, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingInvitations")
+	}
+
+	var r0 []models.Contributor
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Contributor, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Contributor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Contributor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRepository_GetPendingInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingInvitations'
+type MockContributorRepository_GetPendingInvitations_Call struct {
+	*mock.Call
+}
+
+// GetPendingInvitations is a helper method to define mock.On call
+func (_e *MockContributorRepository_Expecter) GetPendingInvitations() *MockContributorRepository_GetPendingInvitations_Call {
+	return &MockContributorRepository_GetPendingInvitations_Call{Call: _e.mock.On("GetPendingInvitations")}
+}
+
+func (_c *MockContributorRepository_GetPendingInvitations_Call) Run(run func()) *MockContributorRepository_GetPendingInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContributorRepository_GetPendingInvitations_Call) Return(_a0 []models.Contributor, _a1 error) *MockContributorRepository_GetPendingInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRepository_GetPendingInvitations_Call) RunAndReturn(run func() ([]models.Contributor, error)) *MockContributorRepository_GetPendingInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Leave provides a mock function with given fields: contribution
+func (_m *MockContributorRepository) Leave(contribution *models.Contributor) error {
+	ret := _m.Called(contribution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Leave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor) error); ok {
+		r0 = rf(contribution)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorRepository_Leave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Leave'
+type MockContributorRepository_Leave_Call struct {
+	*mock.Call
+}
+
+// Leave is a helper method to define mock.On call
+//   - contribution *models.Contributor
+func (_e *MockContributorRepository_Expecter) Leave(contribution interface{}) *MockContributorRepository_Leave_Call {
+	return &MockContributorRepository_Leave_Call{Call: _e.mock.On("Leave", contribution)}
+}
+
+func (_c *MockContributorRepository_Leave_Call) Run(run func(contribution *models.Contributor)) *MockContributorRepository_Leave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockContributorRepository_Leave_Call) Return(_a0 error) *MockContributorRepository_Leave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRepository_Leave_Call) RunAndReturn(run func(*models.Contributor) error) *MockContributorRepository_Leave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: contribution
 func (_m *MockContributorRepository) Update(contribution *models.Contributor) error {
 	ret := _m.Called(contribution)
@@ -387,6 +595,52 @@ func (_c *MockContributorRepository_Update_Call) RunAndReturn(run func(*models.C
 	return _c
 }
 
+// UpdateInvitation provides a mock function with given fields: contribution
+func (_m *MockContributorRepository) UpdateInvitation(contribution *models.Contributor) error {
+	ret := _m.Called(contribution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor) error); ok {
+		r0 = rf(contribution)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorRepository_UpdateInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInvitation'
+type MockContributorRepository_UpdateInvitation_Call struct {
+	*mock.Call
+}
+
+// UpdateInvitation is a helper method to define mock.On call
+//   - contribution *models.Contributor
+func (_e *MockContributorRepository_Expecter) UpdateInvitation(contribution interface{}) *MockContributorRepository_UpdateInvitation_Call {
+	return &MockContributorRepository_UpdateInvitation_Call{Call: _e.mock.On("UpdateInvitation", contribution)}
+}
+
+func (_c *MockContributorRepository_UpdateInvitation_Call) Run(run func(contribution *models.Contributor)) *MockContributorRepository_UpdateInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockContributorRepository_UpdateInvitation_Call) Return(_a0 error) *MockContributorRepository_UpdateInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRepository_UpdateInvitation_Call) RunAndReturn(run func(*models.Contributor) error) *MockContributorRepository_UpdateInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateName provides a mock function with given fields: contributorID, name
 func (_m *MockContributorRepository) UpdateName(contributorID uint, name string) error {
 	ret := _m.Called(contributorID, name)
@@ -0,0 +1,298 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockContributorRequestRepository is an autogenerated mock type for the ContributorRequestRepository type
+type MockContributorRequestRepository struct {
+	mock.Mock
+}
+
+type MockContributorRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContributorRequestRepository) EXPECT() *MockContributorRequestRepository_Expecter {
+	return &MockContributorRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: request
+func (_m *MockContributorRequestRepository) Create(request *models.ContributorRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ContributorRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockContributorRequestRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request *models.ContributorRequest
+func (_e *MockContributorRequestRepository_Expecter) Create(request interface{}) *MockContributorRequestRepository_Create_Call {
+	return &MockContributorRequestRepository_Create_Call{Call: _e.mock.On("Create", request)}
+}
+
+func (_c *MockContributorRequestRepository_Create_Call) Run(run func(request *models.ContributorRequest)) *MockContributorRequestRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ContributorRequest))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_Create_Call) Return(_a0 error) *MockContributorRequestRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_Create_Call) RunAndReturn(run func(*models.ContributorRequest) error) *MockContributorRequestRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID, email
+func (_m *MockContributorRequestRepository) GetByCampaignID(campaignID string, email string) ([]models.ContributorRequest, error) {
+	ret := _m.Called(campaignID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.ContributorRequest, error)); ok {
+		return rf(campaignID, email)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.ContributorRequest); ok {
+		r0 = rf(campaignID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockContributorRequestRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+//   - email string
+func (_e *MockContributorRequestRepository_Expecter) GetByCampaignID(campaignID interface{}, email interface{}) *MockContributorRequestRepository_GetByCampaignID_Call {
+	return &MockContributorRequestRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID, email)}
+}
+
+func (_c *MockContributorRequestRepository_GetByCampaignID_Call) Run(run func(campaignID string, email string)) *MockContributorRequestRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_GetByCampaignID_Call) Return(_a0 []models.ContributorRequest, _a1 error) *MockContributorRequestRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_GetByCampaignID_Call) RunAndReturn(run func(string, string) ([]models.ContributorRequest, error)) *MockContributorRequestRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: requestID
+func (_m *MockContributorRequestRepository) GetByID(requestID uint) (models.ContributorRequest, error) {
+	ret := _m.Called(requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.ContributorRequest, error)); ok {
+		return rf(requestID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.ContributorRequest); ok {
+		r0 = rf(requestID)
+	} else {
+		r0 = ret.Get(0).(models.ContributorRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockContributorRequestRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - requestID uint
+func (_e *MockContributorRequestRepository_Expecter) GetByID(requestID interface{}) *MockContributorRequestRepository_GetByID_Call {
+	return &MockContributorRequestRepository_GetByID_Call{Call: _e.mock.On("GetByID", requestID)}
+}
+
+func (_c *MockContributorRequestRepository_GetByID_Call) Run(run func(requestID uint)) *MockContributorRequestRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_GetByID_Call) Return(_a0 models.ContributorRequest, _a1 error) *MockContributorRequestRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_GetByID_Call) RunAndReturn(run func(uint) (models.ContributorRequest, error)) *MockContributorRequestRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPendingRequest provides a mock function with given fields: contributorID
+func (_m *MockContributorRequestRepository) HasPendingRequest(contributorID uint) (bool, error) {
+	ret := _m.Called(contributorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPendingRequest")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (bool, error)); ok {
+		return rf(contributorID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) bool); ok {
+		r0 = rf(contributorID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(contributorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestRepository_HasPendingRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPendingRequest'
+type MockContributorRequestRepository_HasPendingRequest_Call struct {
+	*mock.Call
+}
+
+// HasPendingRequest is a helper method to define mock.On call
+//   - contributorID uint
+func (_e *MockContributorRequestRepository_Expecter) HasPendingRequest(contributorID interface{}) *MockContributorRequestRepository_HasPendingRequest_Call {
+	return &MockContributorRequestRepository_HasPendingRequest_Call{Call: _e.mock.On("HasPendingRequest", contributorID)}
+}
+
+func (_c *MockContributorRequestRepository_HasPendingRequest_Call) Run(run func(contributorID uint)) *MockContributorRequestRepository_HasPendingRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_HasPendingRequest_Call) Return(_a0 bool, _a1 error) *MockContributorRequestRepository_HasPendingRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_HasPendingRequest_Call) RunAndReturn(run func(uint) (bool, error)) *MockContributorRequestRepository_HasPendingRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: request
+func (_m *MockContributorRequestRepository) Update(request *models.ContributorRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ContributorRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorRequestRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockContributorRequestRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request *models.ContributorRequest
+func (_e *MockContributorRequestRepository_Expecter) Update(request interface{}) *MockContributorRequestRepository_Update_Call {
+	return &MockContributorRequestRepository_Update_Call{Call: _e.mock.On("Update", request)}
+}
+
+func (_c *MockContributorRequestRepository_Update_Call) Run(run func(request *models.ContributorRequest)) *MockContributorRequestRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ContributorRequest))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_Update_Call) Return(_a0 error) *MockContributorRequestRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRequestRepository_Update_Call) RunAndReturn(run func(*models.ContributorRequest) error) *MockContributorRequestRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockContributorRequestRepository creates a new instance of MockContributorRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContributorRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContributorRequestRepository {
+	mock := &MockContributorRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
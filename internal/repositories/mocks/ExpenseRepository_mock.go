@@ -0,0 +1,287 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExpenseRepository is an autogenerated mock type for the ExpenseRepository type
+type MockExpenseRepository struct {
+	mock.Mock
+}
+
+type MockExpenseRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpenseRepository) EXPECT() *MockExpenseRepository_Expecter {
+	return &MockExpenseRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: expense
+func (_m *MockExpenseRepository) Create(expense *models.ActivityExpense) error {
+	ret := _m.Called(expense)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ActivityExpense) error); ok {
+		r0 = rf(expense)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExpenseRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockExpenseRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - expense *models.ActivityExpense
+func (_e *MockExpenseRepository_Expecter) Create(expense interface{}) *MockExpenseRepository_Create_Call {
+	return &MockExpenseRepository_Create_Call{Call: _e.mock.On("Create", expense)}
+}
+
+func (_c *MockExpenseRepository_Create_Call) Run(run func(expense *models.ActivityExpense)) *MockExpenseRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ActivityExpense))
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Create_Call) Return(_a0 error) *MockExpenseRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Create_Call) RunAndReturn(run func(*models.ActivityExpense) error) *MockExpenseRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliation provides a mock function with given fields: reconciliation
+func (_m *MockExpenseRepository) CreateReconciliation(reconciliation *models.ActivityReconciliation) error {
+	ret := _m.Called(reconciliation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ActivityReconciliation) error); ok {
+		r0 = rf(reconciliation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExpenseRepository_CreateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliation'
+type MockExpenseRepository_CreateReconciliation_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliation is a helper method to define mock.On call
+//   - reconciliation *models.ActivityReconciliation
+func (_e *MockExpenseRepository_Expecter) CreateReconciliation(reconciliation interface{}) *MockExpenseRepository_CreateReconciliation_Call {
+	return &MockExpenseRepository_CreateReconciliation_Call{Call: _e.mock.On("CreateReconciliation", reconciliation)}
+}
+
+func (_c *MockExpenseRepository_CreateReconciliation_Call) Run(run func(reconciliation *models.ActivityReconciliation)) *MockExpenseRepository_CreateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ActivityReconciliation))
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_CreateReconciliation_Call) Return(_a0 error) *MockExpenseRepository_CreateReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExpenseRepository_CreateReconciliation_Call) RunAndReturn(run func(*models.ActivityReconciliation) error) *MockExpenseRepository_CreateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: expense
+func (_m *MockExpenseRepository) Delete(expense *models.ActivityExpense) error {
+	ret := _m.Called(expense)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ActivityExpense) error); ok {
+		r0 = rf(expense)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExpenseRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockExpenseRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - expense *models.ActivityExpense
+func (_e *MockExpenseRepository_Expecter) Delete(expense interface{}) *MockExpenseRepository_Delete_Call {
+	return &MockExpenseRepository_Delete_Call{Call: _e.mock.On("Delete", expense)}
+}
+
+func (_c *MockExpenseRepository_Delete_Call) Run(run func(expense *models.ActivityExpense)) *MockExpenseRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.ActivityExpense))
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Delete_Call) Return(_a0 error) *MockExpenseRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Delete_Call) RunAndReturn(run func(*models.ActivityExpense) error) *MockExpenseRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivitiesByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockExpenseRepository) GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivitiesByCampaignID")
+	}
+
+	var r0 []models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Activity, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Activity); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseRepository_GetActivitiesByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivitiesByCampaignID'
+type MockExpenseRepository_GetActivitiesByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetActivitiesByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockExpenseRepository_Expecter) GetActivitiesByCampaignID(campaignID interface{}) *MockExpenseRepository_GetActivitiesByCampaignID_Call {
+	return &MockExpenseRepository_GetActivitiesByCampaignID_Call{Call: _e.mock.On("GetActivitiesByCampaignID", campaignID)}
+}
+
+func (_c *MockExpenseRepository_GetActivitiesByCampaignID_Call) Run(run func(campaignID string)) *MockExpenseRepository_GetActivitiesByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetActivitiesByCampaignID_Call) Return(_a0 []models.Activity, _a1 error) *MockExpenseRepository_GetActivitiesByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetActivitiesByCampaignID_Call) RunAndReturn(run func(string) ([]models.Activity, error)) *MockExpenseRepository_GetActivitiesByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: expenseID
+func (_m *MockExpenseRepository) GetByID(expenseID uint) (models.ActivityExpense, error) {
+	ret := _m.Called(expenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.ActivityExpense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.ActivityExpense, error)); ok {
+		return rf(expenseID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.ActivityExpense); ok {
+		r0 = rf(expenseID)
+	} else {
+		r0 = ret.Get(0).(models.ActivityExpense)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockExpenseRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - expenseID uint
+func (_e *MockExpenseRepository_Expecter) GetByID(expenseID interface{}) *MockExpenseRepository_GetByID_Call {
+	return &MockExpenseRepository_GetByID_Call{Call: _e.mock.On("GetByID", expenseID)}
+}
+
+func (_c *MockExpenseRepository_GetByID_Call) Run(run func(expenseID uint)) *MockExpenseRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetByID_Call) Return(_a0 models.ActivityExpense, _a1 error) *MockExpenseRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetByID_Call) RunAndReturn(run func(uint) (models.ActivityExpense, error)) *MockExpenseRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpenseRepository creates a new instance of MockExpenseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpenseRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpenseRepository {
+	mock := &MockExpenseRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
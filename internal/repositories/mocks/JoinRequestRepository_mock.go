@@ -0,0 +1,299 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockJoinRequestRepository is an autogenerated mock type for the JoinRequestRepository type
+type MockJoinRequestRepository struct {
+	mock.Mock
+}
+
+type MockJoinRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJoinRequestRepository) EXPECT() *MockJoinRequestRepository_Expecter {
+	return &MockJoinRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: request
+func (_m *MockJoinRequestRepository) Create(request *models.JoinRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JoinRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJoinRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockJoinRequestRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request *models.JoinRequest
+func (_e *MockJoinRequestRepository_Expecter) Create(request interface{}) *MockJoinRequestRepository_Create_Call {
+	return &MockJoinRequestRepository_Create_Call{Call: _e.mock.On("Create", request)}
+}
+
+func (_c *MockJoinRequestRepository_Create_Call) Run(run func(request *models.JoinRequest)) *MockJoinRequestRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.JoinRequest))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_Create_Call) Return(_a0 error) *MockJoinRequestRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_Create_Call) RunAndReturn(run func(*models.JoinRequest) error) *MockJoinRequestRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID, status
+func (_m *MockJoinRequestRepository) GetByCampaignID(campaignID string, status models.JoinRequestStatus) ([]models.JoinRequest, error) {
+	ret := _m.Called(campaignID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.JoinRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.JoinRequestStatus) ([]models.JoinRequest, error)); ok {
+		return rf(campaignID, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.JoinRequestStatus) []models.JoinRequest); ok {
+		r0 = rf(campaignID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JoinRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.JoinRequestStatus) error); ok {
+		r1 = rf(campaignID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJoinRequestRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockJoinRequestRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+//   - status models.JoinRequestStatus
+func (_e *MockJoinRequestRepository_Expecter) GetByCampaignID(campaignID interface{}, status interface{}) *MockJoinRequestRepository_GetByCampaignID_Call {
+	return &MockJoinRequestRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID, status)}
+}
+
+func (_c *MockJoinRequestRepository_GetByCampaignID_Call) Run(run func(campaignID string, status models.JoinRequestStatus)) *MockJoinRequestRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(models.JoinRequestStatus))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_GetByCampaignID_Call) Return(_a0 []models.JoinRequest, _a1 error) *MockJoinRequestRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_GetByCampaignID_Call) RunAndReturn(run func(string, models.JoinRequestStatus) ([]models.JoinRequest, error)) *MockJoinRequestRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: requestID
+func (_m *MockJoinRequestRepository) GetByID(requestID uint) (models.JoinRequest, error) {
+	ret := _m.Called(requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.JoinRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.JoinRequest, error)); ok {
+		return rf(requestID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.JoinRequest); ok {
+		r0 = rf(requestID)
+	} else {
+		r0 = ret.Get(0).(models.JoinRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJoinRequestRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockJoinRequestRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - requestID uint
+func (_e *MockJoinRequestRepository_Expecter) GetByID(requestID interface{}) *MockJoinRequestRepository_GetByID_Call {
+	return &MockJoinRequestRepository_GetByID_Call{Call: _e.mock.On("GetByID", requestID)}
+}
+
+func (_c *MockJoinRequestRepository_GetByID_Call) Run(run func(requestID uint)) *MockJoinRequestRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_GetByID_Call) Return(_a0 models.JoinRequest, _a1 error) *MockJoinRequestRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_GetByID_Call) RunAndReturn(run func(uint) (models.JoinRequest, error)) *MockJoinRequestRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPendingRequest provides a mock function with given fields: campaignID, email
+func (_m *MockJoinRequestRepository) HasPendingRequest(campaignID string, email string) (bool, error) {
+	ret := _m.Called(campaignID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPendingRequest")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(campaignID, email)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(campaignID, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJoinRequestRepository_HasPendingRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPendingRequest'
+type MockJoinRequestRepository_HasPendingRequest_Call struct {
+	*mock.Call
+}
+
+// HasPendingRequest is a helper method to define mock.On call
+//   - campaignID string
+//   - email string
+func (_e *MockJoinRequestRepository_Expecter) HasPendingRequest(campaignID interface{}, email interface{}) *MockJoinRequestRepository_HasPendingRequest_Call {
+	return &MockJoinRequestRepository_HasPendingRequest_Call{Call: _e.mock.On("HasPendingRequest", campaignID, email)}
+}
+
+func (_c *MockJoinRequestRepository_HasPendingRequest_Call) Run(run func(campaignID string, email string)) *MockJoinRequestRepository_HasPendingRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_HasPendingRequest_Call) Return(_a0 bool, _a1 error) *MockJoinRequestRepository_HasPendingRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_HasPendingRequest_Call) RunAndReturn(run func(string, string) (bool, error)) *MockJoinRequestRepository_HasPendingRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: request
+func (_m *MockJoinRequestRepository) Update(request *models.JoinRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JoinRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJoinRequestRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockJoinRequestRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request *models.JoinRequest
+func (_e *MockJoinRequestRepository_Expecter) Update(request interface{}) *MockJoinRequestRepository_Update_Call {
+	return &MockJoinRequestRepository_Update_Call{Call: _e.mock.On("Update", request)}
+}
+
+func (_c *MockJoinRequestRepository_Update_Call) Run(run func(request *models.JoinRequest)) *MockJoinRequestRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.JoinRequest))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_Update_Call) Return(_a0 error) *MockJoinRequestRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJoinRequestRepository_Update_Call) RunAndReturn(run func(*models.JoinRequest) error) *MockJoinRequestRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJoinRequestRepository creates a new instance of MockJoinRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJoinRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJoinRequestRepository {
+	mock := &MockJoinRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
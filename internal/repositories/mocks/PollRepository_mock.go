@@ -0,0 +1,287 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPollRepository is an autogenerated mock type for the PollRepository type
+type MockPollRepository struct {
+	mock.Mock
+}
+
+type MockPollRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPollRepository) EXPECT() *MockPollRepository_Expecter {
+	return &MockPollRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddVotes provides a mock function with given fields: votes
+func (_m *MockPollRepository) AddVotes(votes []models.PollVote) error {
+	ret := _m.Called(votes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVotes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.PollVote) error); ok {
+		r0 = rf(votes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPollRepository_AddVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVotes'
+type MockPollRepository_AddVotes_Call struct {
+	*mock.Call
+}
+
+// AddVotes is a helper method to define mock.On call
+//   - votes []models.PollVote
+func (_e *MockPollRepository_Expecter) AddVotes(votes interface{}) *MockPollRepository_AddVotes_Call {
+	return &MockPollRepository_AddVotes_Call{Call: _e.mock.On("AddVotes", votes)}
+}
+
+func (_c *MockPollRepository_AddVotes_Call) Run(run func(votes []models.PollVote)) *MockPollRepository_AddVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.PollVote))
+	})
+	return _c
+}
+
+func (_c *MockPollRepository_AddVotes_Call) Return(_a0 error) *MockPollRepository_AddVotes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPollRepository_AddVotes_Call) RunAndReturn(run func([]models.PollVote) error) *MockPollRepository_AddVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: poll
+func (_m *MockPollRepository) Create(poll *models.Poll) error {
+	ret := _m.Called(poll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Poll) error); ok {
+		r0 = rf(poll)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPollRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPollRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - poll *models.Poll
+func (_e *MockPollRepository_Expecter) Create(poll interface{}) *MockPollRepository_Create_Call {
+	return &MockPollRepository_Create_Call{Call: _e.mock.On("Create", poll)}
+}
+
+func (_c *MockPollRepository_Create_Call) Run(run func(poll *models.Poll)) *MockPollRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Poll))
+	})
+	return _c
+}
+
+func (_c *MockPollRepository_Create_Call) Return(_a0 error) *MockPollRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPollRepository_Create_Call) RunAndReturn(run func(*models.Poll) error) *MockPollRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockPollRepository) GetByCampaignID(campaignID string) ([]models.Poll, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Poll, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Poll); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockPollRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockPollRepository_Expecter) GetByCampaignID(campaignID interface{}) *MockPollRepository_GetByCampaignID_Call {
+	return &MockPollRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID)}
+}
+
+func (_c *MockPollRepository_GetByCampaignID_Call) Run(run func(campaignID string)) *MockPollRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPollRepository_GetByCampaignID_Call) Return(_a0 []models.Poll, _a1 error) *MockPollRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollRepository_GetByCampaignID_Call) RunAndReturn(run func(string) ([]models.Poll, error)) *MockPollRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: pollID
+func (_m *MockPollRepository) GetByID(pollID uint) (models.Poll, error) {
+	ret := _m.Called(pollID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.Poll, error)); ok {
+		return rf(pollID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.Poll); ok {
+		r0 = rf(pollID)
+	} else {
+		r0 = ret.Get(0).(models.Poll)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(pollID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockPollRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - pollID uint
+func (_e *MockPollRepository_Expecter) GetByID(pollID interface{}) *MockPollRepository_GetByID_Call {
+	return &MockPollRepository_GetByID_Call{Call: _e.mock.On("GetByID", pollID)}
+}
+
+func (_c *MockPollRepository_GetByID_Call) Run(run func(pollID uint)) *MockPollRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockPollRepository_GetByID_Call) Return(_a0 models.Poll, _a1 error) *MockPollRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollRepository_GetByID_Call) RunAndReturn(run func(uint) (models.Poll, error)) *MockPollRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: poll
+func (_m *MockPollRepository) Update(poll *models.Poll) error {
+	ret := _m.Called(poll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Poll) error); ok {
+		r0 = rf(poll)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPollRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPollRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - poll *models.Poll
+func (_e *MockPollRepository_Expecter) Update(poll interface{}) *MockPollRepository_Update_Call {
+	return &MockPollRepository_Update_Call{Call: _e.mock.On("Update", poll)}
+}
+
+func (_c *MockPollRepository_Update_Call) Run(run func(poll *models.Poll)) *MockPollRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Poll))
+	})
+	return _c
+}
+
+func (_c *MockPollRepository_Update_Call) Return(_a0 error) *MockPollRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPollRepository_Update_Call) RunAndReturn(run func(*models.Poll) error) *MockPollRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPollRepository creates a new instance of MockPollRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPollRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPollRepository {
+	mock := &MockPollRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
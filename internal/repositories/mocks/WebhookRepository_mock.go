@@ -0,0 +1,735 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	time "time"
+
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookRepository is an autogenerated mock type for the WebhookRepository type
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+type MockWebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookRepository) EXPECT() *MockWebhookRepository_Expecter {
+	return &MockWebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// AbandonPendingDeliveries provides a mock function with given fields: webhookID, reason
+func (_m *MockWebhookRepository) AbandonPendingDeliveries(webhookID uint, reason string) error {
+	ret := _m.Called(webhookID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbandonPendingDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string) error); ok {
+		r0 = rf(webhookID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookRepository_AbandonPendingDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbandonPendingDeliveries'
+type MockWebhookRepository_AbandonPendingDeliveries_Call struct {
+	*mock.Call
+}
+
+// AbandonPendingDeliveries is a helper method to define mock.On call
+//   - webhookID uint
+//   - reason string
+func (_e *MockWebhookRepository_Expecter) AbandonPendingDeliveries(webhookID interface{}, reason interface{}) *MockWebhookRepository_AbandonPendingDeliveries_Call {
+	return &MockWebhookRepository_AbandonPendingDeliveries_Call{Call: _e.mock.On("AbandonPendingDeliveries", webhookID, reason)}
+}
+
+func (_c *MockWebhookRepository_AbandonPendingDeliveries_Call) Run(run func(webhookID uint, reason string)) *MockWebhookRepository_AbandonPendingDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_AbandonPendingDeliveries_Call) Return(_a0 error) *MockWebhookRepository_AbandonPendingDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookRepository_AbandonPendingDeliveries_Call) RunAndReturn(run func(uint, string) error) *MockWebhookRepository_AbandonPendingDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimDueDeliveries provides a mock function with given fields: now, lease, limit
+func (_m *MockWebhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error) {
+	ret := _m.Called(now, lease, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Duration, int) ([]models.WebhookDelivery, error)); ok {
+		return rf(now, lease, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Duration, int) []models.WebhookDelivery); ok {
+		r0 = rf(now, lease, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Duration, int) error); ok {
+		r1 = rf(now, lease, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_ClaimDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueDeliveries'
+type MockWebhookRepository_ClaimDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueDeliveries is a helper method to define mock.On call
+//   - now time.Time
+//   - lease time.Duration
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) ClaimDueDeliveries(now interface{}, lease interface{}, limit interface{}) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	return &MockWebhookRepository_ClaimDueDeliveries_Call{Call: _e.mock.On("ClaimDueDeliveries", now, lease, limit)}
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Run(run func(now time.Time, lease time.Duration, limit int)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(time.Duration), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Return(_a0 []models.WebhookDelivery, _a1 error) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) RunAndReturn(run func(time.Time, time.Duration, int) ([]models.WebhookDelivery, error)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: webhook
+func (_m *MockWebhookRepository) Create(webhook *models.Webhook) error {
+	ret := _m.Called(webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Webhook) error); ok {
+		r0 = rf(webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebhookRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - webhook *models.Webhook
+func (_e *MockWebhookRepository_Expecter) Create(webhook interface{}) *MockWebhookRepository_Create_Call {
+	return &MockWebhookRepository_Create_Call{Call: _e.mock.On("Create", webhook)}
+}
+
+func (_c *MockWebhookRepository_Create_Call) Run(run func(webhook *models.Webhook)) *MockWebhookRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Create_Call) Return(_a0 error) *MockWebhookRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Create_Call) RunAndReturn(run func(*models.Webhook) error) *MockWebhookRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDelivery provides a mock function with given fields: delivery
+func (_m *MockWebhookRepository) CreateDelivery(delivery *models.WebhookDelivery) (bool, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelivery")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.WebhookDelivery) (bool, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*models.WebhookDelivery) bool); ok {
+		r0 = rf(delivery)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.WebhookDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_CreateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelivery'
+type MockWebhookRepository_CreateDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateDelivery is a helper method to define mock.On call
+//   - delivery *models.WebhookDelivery
+func (_e *MockWebhookRepository_Expecter) CreateDelivery(delivery interface{}) *MockWebhookRepository_CreateDelivery_Call {
+	return &MockWebhookRepository_CreateDelivery_Call{Call: _e.mock.On("CreateDelivery", delivery)}
+}
+
+func (_c *MockWebhookRepository_CreateDelivery_Call) Run(run func(delivery *models.WebhookDelivery)) *MockWebhookRepository_CreateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_CreateDelivery_Call) Return(created bool, err error) *MockWebhookRepository_CreateDelivery_Call {
+	_c.Call.Return(created, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_CreateDelivery_Call) RunAndReturn(run func(*models.WebhookDelivery) (bool, error)) *MockWebhookRepository_CreateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: webhook
+func (_m *MockWebhookRepository) Delete(webhook *models.Webhook) error {
+	ret := _m.Called(webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Webhook) error); ok {
+		r0 = rf(webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWebhookRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - webhook *models.Webhook
+func (_e *MockWebhookRepository_Expecter) Delete(webhook interface{}) *MockWebhookRepository_Delete_Call {
+	return &MockWebhookRepository_Delete_Call{Call: _e.mock.On("Delete", webhook)}
+}
+
+func (_c *MockWebhookRepository_Delete_Call) Run(run func(webhook *models.Webhook)) *MockWebhookRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Delete_Call) Return(_a0 error) *MockWebhookRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Delete_Call) RunAndReturn(run func(*models.Webhook) error) *MockWebhookRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockWebhookRepository) GetActiveByCampaignID(campaignID string) ([]models.Webhook, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveByCampaignID")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Webhook, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Webhook); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_GetActiveByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveByCampaignID'
+type MockWebhookRepository_GetActiveByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetActiveByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockWebhookRepository_Expecter) GetActiveByCampaignID(campaignID interface{}) *MockWebhookRepository_GetActiveByCampaignID_Call {
+	return &MockWebhookRepository_GetActiveByCampaignID_Call{Call: _e.mock.On("GetActiveByCampaignID", campaignID)}
+}
+
+func (_c *MockWebhookRepository_GetActiveByCampaignID_Call) Run(run func(campaignID string)) *MockWebhookRepository_GetActiveByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetActiveByCampaignID_Call) Return(_a0 []models.Webhook, _a1 error) *MockWebhookRepository_GetActiveByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetActiveByCampaignID_Call) RunAndReturn(run func(string) ([]models.Webhook, error)) *MockWebhookRepository_GetActiveByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCampaignID provides a mock function with given fields: campaignID
+func (_m *MockWebhookRepository) GetByCampaignID(campaignID string) ([]models.Webhook, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCampaignID")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Webhook, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Webhook); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_GetByCampaignID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByCampaignID'
+type MockWebhookRepository_GetByCampaignID_Call struct {
+	*mock.Call
+}
+
+// GetByCampaignID is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockWebhookRepository_Expecter) GetByCampaignID(campaignID interface{}) *MockWebhookRepository_GetByCampaignID_Call {
+	return &MockWebhookRepository_GetByCampaignID_Call{Call: _e.mock.On("GetByCampaignID", campaignID)}
+}
+
+func (_c *MockWebhookRepository_GetByCampaignID_Call) Run(run func(campaignID string)) *MockWebhookRepository_GetByCampaignID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetByCampaignID_Call) Return(_a0 []models.Webhook, _a1 error) *MockWebhookRepository_GetByCampaignID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetByCampaignID_Call) RunAndReturn(run func(string) ([]models.Webhook, error)) *MockWebhookRepository_GetByCampaignID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: webhookID
+func (_m *MockWebhookRepository) GetByID(webhookID uint) (*models.Webhook, error) {
+	ret := _m.Called(webhookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*models.Webhook, error)); ok {
+		return rf(webhookID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *models.Webhook); ok {
+		r0 = rf(webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWebhookRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - webhookID uint
+func (_e *MockWebhookRepository_Expecter) GetByID(webhookID interface{}) *MockWebhookRepository_GetByID_Call {
+	return &MockWebhookRepository_GetByID_Call{Call: _e.mock.On("GetByID", webhookID)}
+}
+
+func (_c *MockWebhookRepository_GetByID_Call) Run(run func(webhookID uint)) *MockWebhookRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetByID_Call) Return(_a0 *models.Webhook, _a1 error) *MockWebhookRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetByID_Call) RunAndReturn(run func(uint) (*models.Webhook, error)) *MockWebhookRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveries provides a mock function with given fields: webhookID, status, limit
+func (_m *MockWebhookRepository) GetDeliveries(webhookID uint, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error) {
+	ret := _m.Called(webhookID, status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, models.WebhookDeliveryStatus, int) ([]models.WebhookDelivery, error)); ok {
+		return rf(webhookID, status, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, models.WebhookDeliveryStatus, int) []models.WebhookDelivery); ok {
+		r0 = rf(webhookID, status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, models.WebhookDeliveryStatus, int) error); ok {
+		r1 = rf(webhookID, status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_GetDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveries'
+type MockWebhookRepository_GetDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetDeliveries is a helper method to define mock.On call
+//   - webhookID uint
+//   - status models.WebhookDeliveryStatus
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) GetDeliveries(webhookID interface{}, status interface{}, limit interface{}) *MockWebhookRepository_GetDeliveries_Call {
+	return &MockWebhookRepository_GetDeliveries_Call{Call: _e.mock.On("GetDeliveries", webhookID, status, limit)}
+}
+
+func (_c *MockWebhookRepository_GetDeliveries_Call) Run(run func(webhookID uint, status models.WebhookDeliveryStatus, limit int)) *MockWebhookRepository_GetDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(models.WebhookDeliveryStatus), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetDeliveries_Call) Return(_a0 []models.WebhookDelivery, _a1 error) *MockWebhookRepository_GetDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetDeliveries_Call) RunAndReturn(run func(uint, models.WebhookDeliveryStatus, int) ([]models.WebhookDelivery, error)) *MockWebhookRepository_GetDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelivery provides a mock function with given fields: webhookID, deliveryID
+func (_m *MockWebhookRepository) GetDelivery(webhookID uint, deliveryID string) (*models.WebhookDelivery, error) {
+	ret := _m.Called(webhookID, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 *models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*models.WebhookDelivery, error)); ok {
+		return rf(webhookID, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *models.WebhookDelivery); ok {
+		r0 = rf(webhookID, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(webhookID, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type MockWebhookRepository_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - webhookID uint
+//   - deliveryID string
+func (_e *MockWebhookRepository_Expecter) GetDelivery(webhookID interface{}, deliveryID interface{}) *MockWebhookRepository_GetDelivery_Call {
+	return &MockWebhookRepository_GetDelivery_Call{Call: _e.mock.On("GetDelivery", webhookID, deliveryID)}
+}
+
+func (_c *MockWebhookRepository_GetDelivery_Call) Run(run func(webhookID uint, deliveryID string)) *MockWebhookRepository_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetDelivery_Call) Return(_a0 *models.WebhookDelivery, _a1 error) *MockWebhookRepository_GetDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookRepository_GetDelivery_Call) RunAndReturn(run func(uint, string) (*models.WebhookDelivery, error)) *MockWebhookRepository_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDeliveryAttempt provides a mock function with given fields: webhookID, succeeded, failureLimit
+func (_m *MockWebhookRepository) RecordDeliveryAttempt(webhookID uint, succeeded bool, failureLimit int) (bool, error) {
+	ret := _m.Called(webhookID, succeeded, failureLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDeliveryAttempt")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, int) (bool, error)); ok {
+		return rf(webhookID, succeeded, failureLimit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, int) bool); ok {
+		r0 = rf(webhookID, succeeded, failureLimit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, int) error); ok {
+		r1 = rf(webhookID, succeeded, failureLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookRepository_RecordDeliveryAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDeliveryAttempt'
+type MockWebhookRepository_RecordDeliveryAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordDeliveryAttempt is a helper method to define mock.On call
+//   - webhookID uint
+//   - succeeded bool
+//   - failureLimit int
+func (_e *MockWebhookRepository_Expecter) RecordDeliveryAttempt(webhookID interface{}, succeeded interface{}, failureLimit interface{}) *MockWebhookRepository_RecordDeliveryAttempt_Call {
+	return &MockWebhookRepository_RecordDeliveryAttempt_Call{Call: _e.mock.On("RecordDeliveryAttempt", webhookID, succeeded, failureLimit)}
+}
+
+func (_c *MockWebhookRepository_RecordDeliveryAttempt_Call) Run(run func(webhookID uint, succeeded bool, failureLimit int)) *MockWebhookRepository_RecordDeliveryAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(bool), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_RecordDeliveryAttempt_Call) Return(disabled bool, err error) *MockWebhookRepository_RecordDeliveryAttempt_Call {
+	_c.Call.Return(disabled, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_RecordDeliveryAttempt_Call) RunAndReturn(run func(uint, bool, int) (bool, error)) *MockWebhookRepository_RecordDeliveryAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: webhook
+func (_m *MockWebhookRepository) Update(webhook *models.Webhook) error {
+	ret := _m.Called(webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Webhook) error); ok {
+		r0 = rf(webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWebhookRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - webhook *models.Webhook
+func (_e *MockWebhookRepository_Expecter) Update(webhook interface{}) *MockWebhookRepository_Update_Call {
+	return &MockWebhookRepository_Update_Call{Call: _e.mock.On("Update", webhook)}
+}
+
+func (_c *MockWebhookRepository_Update_Call) Run(run func(webhook *models.Webhook)) *MockWebhookRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Update_Call) Return(_a0 error) *MockWebhookRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Update_Call) RunAndReturn(run func(*models.Webhook) error) *MockWebhookRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function with given fields: delivery
+func (_m *MockWebhookRepository) UpdateDelivery(delivery *models.WebhookDelivery) error {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.WebhookDelivery) error); ok {
+		r0 = rf(delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookRepository_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type MockWebhookRepository_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - delivery *models.WebhookDelivery
+func (_e *MockWebhookRepository_Expecter) UpdateDelivery(delivery interface{}) *MockWebhookRepository_UpdateDelivery_Call {
+	return &MockWebhookRepository_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", delivery)}
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) Run(run func(delivery *models.WebhookDelivery)) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) Return(_a0 error) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) RunAndReturn(run func(*models.WebhookDelivery) error) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookRepository creates a new instance of MockWebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookRepository {
+	mock := &MockWebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type activityRepository struct {
@@ -26,12 +27,31 @@ func (r *activityRepository) Create(activity *models.Activity) (models.Activity,
 func (r *activityRepository) Update(activity *models.Activity) error {
 	return r.db.Model(&models.Activity{}).Where("id = ?", activity.ID).Updates(
 		map[string]interface{}{
-			"title":        activity.Title,
-			"subtitle":     activity.Subtitle,
-			"image_url":    activity.ImageUrl,
-			"is_mandatory": activity.IsMandatory,
-			"cost":         activity.Cost,
-			"is_approved":  activity.IsApproved,
+			"title":            activity.Title,
+			"subtitle":         activity.Subtitle,
+			"is_mandatory":     activity.IsMandatory,
+			"cost":             activity.Cost,
+			"is_approved":      activity.IsApproved,
+			"capacity":         activity.Capacity,
+			"sign_up_deadline": activity.SignUpDeadline,
+			"payment_due_date": activity.PaymentDueDate,
+			"starts_at":        activity.StartsAt,
+			"ends_at":          activity.EndsAt,
+			"location":         activity.Location,
+			"latitude":         activity.Latitude,
+			"longitude":        activity.Longitude,
+			"notes":            activity.Notes,
+		}).Error
+}
+
+// UpdateImage saves the uploaded image of an activity
+func (r *activityRepository) UpdateImage(activity *models.Activity) error {
+	return r.db.Model(&models.Activity{}).Where("id = ?", activity.ID).Updates(
+		map[string]interface{}{
+			"image_url":     activity.ImageUrl,
+			"image_id":      activity.ImageID,
+			"thumbnail_url": activity.ThumbnailUrl,
+			"thumbnail_id":  activity.ThumbnailID,
 		}).Error
 }
 
@@ -48,17 +68,40 @@ func (r *activityRepository) Delete(activity *models.Activity) error {
 // GetActivityByID retrieves a single activity by its ID with contributors
 func (r *activityRepository) GetByID(activityID uint) (models.Activity, error) {
 	var activity models.Activity
-	err := r.db.Preload("Contributors").First(&activity, activityID).Error
+	err := r.db.Preload("Contributors").Preload("Shares").Preload("Waitlist", orderWaitlist).Preload("Votes").First(&activity, activityID).Error
 
 	fmt.Println(activity)
 	return activity, err
 }
 
-// RemoveContributorFromActivity removes a contributor from an activity
+// UpdateSplit replaces the split strategy and the shares of an activity
+func (r *activityRepository) UpdateSplit(activity *models.Activity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Activity{}).Where("id = ?", activity.ID).
+			Update("split_strategy", activity.SplitStrategy).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("activity_id = ?", activity.ID).Delete(&models.ActivityShare{}).Error; err != nil {
+			return err
+		}
+		if len(activity.Shares) == 0 {
+			return nil
+		}
+		return tx.Create(&activity.Shares).Error
+	})
+}
+
+// RemoveContributorFromActivity removes a contributor and their share from an activity
 func (r *activityRepository) RemoveContributor(activityID uint, contributorID uint) error {
-	return r.db.Table("activities_contributors").
-		Where("activity_id = ? AND contributor_id = ?", activityID, contributorID).
-		Delete(nil).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("activities_contributors").
+			Where("activity_id = ? AND contributor_id = ?", activityID, contributorID).
+			Delete(nil).Error; err != nil {
+			return err
+		}
+		return tx.Where("activity_id = ? AND contributor_id = ?", activityID, contributorID).
+			Delete(&models.ActivityShare{}).Error
+	})
 }
 
 // AddContributorToActivity adds a contributor to an activity
@@ -69,10 +112,75 @@ func (r *activityRepository) AddContributor(activityID uint, contributorID uint)
 	}).Error
 }
 
+// OptInContributor adds a contributor to an activity, or to the end of its waitlist once every seat is taken.
+// The activity row stays locked until the contributor is added so concurrent opt-ins can't overbook it
+func (r *activityRepository) OptInContributor(activityID uint, contributorID uint) (waitlisted bool, err error) {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		var activity models.Activity
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "capacity").
+			First(&activity, activityID).Error; err != nil {
+			return err
+		}
+
+		if activity.HasCapacity() {
+			// Invitees who haven't answered keep their seat, like Activity.AvailableSeats
+			var seatsTaken int64
+			if err := tx.Table("activities_contributors").
+				Joins("JOIN contributors ON contributors.id = activities_contributors.contributor_id").
+				Where("activities_contributors.activity_id = ?", activityID).
+				Where("contributors.invitation_status IN ?", []models.InvitationStatus{models.InvitationStatusPending, models.InvitationStatusAccepted, ""}).
+				Count(&seatsTaken).Error; err != nil {
+				return err
+			}
+			if int(seatsTaken) >= *activity.Capacity {
+				waitlisted = true
+				return tx.Create(&models.ActivityWaitlistEntry{ActivityID: activityID, ContributorID: contributorID}).Error
+			}
+		}
+
+		return tx.Table("activities_contributors").Create(map[string]interface{}{
+			"activity_id":    activityID,
+			"contributor_id": contributorID,
+		}).Error
+	})
+	return waitlisted, err
+}
+
+// AddToWaitlist adds a contributor to the end of an activity's waitlist
+func (r *activityRepository) AddToWaitlist(activityID uint, contributorID uint) error {
+	return r.db.Create(&models.ActivityWaitlistEntry{ActivityID: activityID, ContributorID: contributorID}).Error
+}
+
+// RemoveFromWaitlist removes a contributor from an activity's waitlist
+func (r *activityRepository) RemoveFromWaitlist(activityID uint, contributorID uint) error {
+	return r.db.Where("activity_id = ? AND contributor_id = ?", activityID, contributorID).
+		Delete(&models.ActivityWaitlistEntry{}).Error
+}
+
+// PromoteFromWaitlist moves a contributor from an activity's waitlist to its participants
+func (r *activityRepository) PromoteFromWaitlist(activityID uint, contributorID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("activity_id = ? AND contributor_id = ?", activityID, contributorID).
+			Delete(&models.ActivityWaitlistEntry{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("activities_contributors").Create(map[string]interface{}{
+			"activity_id":    activityID,
+			"contributor_id": contributorID,
+		}).Error
+	})
+}
+
+// AddVote stores a contributor's vote on an activity
+func (r *activityRepository) AddVote(vote *models.ActivityVote) error {
+	return r.db.Create(vote).Error
+}
+
 // GetActivitiesByCampaignID fetches all activities for a specific campaign
 func (r *activityRepository) GetByCampaignID(campaignID string) ([]models.Activity, error) {
 	var activities []models.Activity
-	err := r.db.Preload("Contributors").Where("campaign_id = ?", campaignID).Find(&activities).Error
+	err := r.db.Preload("Contributors").Preload("Shares").Preload("Waitlist", orderWaitlist).Preload("Votes").Where("campaign_id = ?", campaignID).Find(&activities).Error
 	return activities, err
 }
 
@@ -84,3 +192,8 @@ func (r *activityRepository) GetParticipants(activityID uint) ([]models.Contribu
 		Where("activities_contributors.activity_id = ?", activityID).Find(&participants).Error
 	return participants, err
 }
+
+// orderWaitlist keeps the waitlist in the order contributors joined it
+func orderWaitlist(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
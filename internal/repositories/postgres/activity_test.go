@@ -66,6 +66,31 @@ func TestActivityRepository_Update(t *testing.T) {
 	assert.Equal(t, "Updated Title", updated.Title)
 }
 
+func TestActivityRepository_UpdateImage(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewActivityRepo(db)
+
+	created, err := repo.Create(createTestActivity())
+	assert.NoError(t, err)
+
+	// Update leaves the image alone, it is only changed by uploading a new one
+	created.ImageUrl = "https://example.com/other.jpg"
+	assert.NoError(t, repo.Update(&created))
+	updated, err := repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://test.com/image.jpg", updated.ImageUrl)
+
+	created.SetImage("https://cdn.example.com/image.jpg", "image-1", "https://cdn.example.com/thumb.jpg", "thumb-1")
+	assert.NoError(t, repo.UpdateImage(&created))
+
+	updated, err = repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/image.jpg", updated.ImageUrl)
+	assert.Equal(t, "https://cdn.example.com/thumb.jpg", updated.ThumbnailUrl)
+	assert.Equal(t, []string{"image-1", "thumb-1"}, updated.ImageFileIDs())
+}
+
 func TestActivityRepository_Delete(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -125,3 +150,155 @@ func TestActivityRepository_AddAndRemoveContributor(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, participants, 0)
 }
+
+func TestActivityRepository_UpdateSplit(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewActivityRepo(db)
+
+	created, err := repo.Create(createTestActivity())
+	assert.NoError(t, err)
+	assert.Equal(t, models.SplitStrategyPerPerson, created.GetSplitStrategy())
+
+	first := &models.Contributor{Name: "First Contributor", Email: "first@example.com", CampaignID: created.CampaignID, Amount: 100}
+	second := &models.Contributor{Name: "Second Contributor", Email: "second@example.com", CampaignID: created.CampaignID, Amount: 100}
+	assert.NoError(t, db.Create(first).Error)
+	assert.NoError(t, db.Create(second).Error)
+	assert.NoError(t, repo.AddContributor(created.ID, first.ID))
+	assert.NoError(t, repo.AddContributor(created.ID, second.ID))
+
+	created.SetSplit(models.SplitStrategyWeighted, []models.ActivityShare{{ContributorID: first.ID, Weight: 3}})
+	assert.NoError(t, repo.UpdateSplit(&created))
+
+	found, err := repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SplitStrategyWeighted, found.SplitStrategy)
+	assert.Len(t, found.Shares, 1)
+	assert.Equal(t, float64(75), found.ShareOf(first.ID))
+	assert.Equal(t, float64(25), found.ShareOf(second.ID))
+
+	// Replacing the split drops the previous shares
+	created.SetSplit(models.SplitStrategyCustom, []models.ActivityShare{{ContributorID: second.ID, Amount: 40}})
+	assert.NoError(t, repo.UpdateSplit(&created))
+
+	found, err = repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Len(t, found.Shares, 1)
+	assert.Equal(t, float64(60), found.ShareOf(first.ID))
+	assert.Equal(t, float64(40), found.ShareOf(second.ID))
+
+	// Opting out removes the contributor's share
+	assert.NoError(t, repo.RemoveContributor(created.ID, second.ID))
+	found, err = repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, found.Shares)
+	assert.Equal(t, float64(100), found.ShareOf(first.ID))
+}
+
+func TestActivityRepository_Waitlist(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewActivityRepo(db)
+
+	capacity := 1
+	activity := createTestActivity()
+	activity.Capacity = &capacity
+	created, err := repo.Create(activity)
+	assert.NoError(t, err)
+
+	var contributors []*models.Contributor
+	for _, email := range []string{"first@example.com", "second@example.com", "third@example.com"} {
+		contributor := &models.Contributor{Name: "Test Contributor", Email: email, CampaignID: created.CampaignID, Amount: 100}
+		assert.NoError(t, db.Create(contributor).Error)
+		contributors = append(contributors, contributor)
+	}
+
+	assert.NoError(t, repo.AddContributor(created.ID, contributors[0].ID))
+	assert.NoError(t, repo.AddToWaitlist(created.ID, contributors[2].ID))
+	assert.NoError(t, repo.AddToWaitlist(created.ID, contributors[1].ID))
+
+	found, err := repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.True(t, found.IsFull())
+	assert.Equal(t, 1, found.WaitlistPosition(contributors[2].ID))
+	assert.Equal(t, 2, found.WaitlistPosition(contributors[1].ID))
+
+	// A contributor can only be on the waitlist once
+	assert.Error(t, repo.AddToWaitlist(created.ID, contributors[1].ID))
+
+	assert.NoError(t, repo.PromoteFromWaitlist(created.ID, contributors[2].ID))
+	assert.NoError(t, repo.RemoveFromWaitlist(created.ID, contributors[1].ID))
+
+	found, err = repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, found.Waitlist)
+	assert.True(t, found.IsContributorOptedIn(contributors[2].ID))
+	assert.Len(t, found.Contributors, 2)
+}
+
+func TestActivityRepository_OptInContributor(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewActivityRepo(db)
+
+	capacity := 2
+	activity := createTestActivity()
+	activity.Capacity = &capacity
+	created, err := repo.Create(activity)
+	assert.NoError(t, err)
+
+	var contributors []*models.Contributor
+	for _, email := range []string{"first@example.com", "declined@example.com", "second@example.com", "third@example.com"} {
+		contributor := &models.Contributor{Name: "Test Contributor", Email: email, CampaignID: created.CampaignID, Amount: 100}
+		assert.NoError(t, db.Create(contributor).Error)
+		contributors = append(contributors, contributor)
+	}
+	assert.NoError(t, db.Model(contributors[1]).Update("invitation_status", models.InvitationStatusDeclined).Error)
+
+	waitlisted, err := repo.OptInContributor(created.ID, contributors[0].ID)
+	assert.NoError(t, err)
+	assert.False(t, waitlisted)
+
+	// Contributors who declined their invitation don't take a seat
+	waitlisted, err = repo.OptInContributor(created.ID, contributors[1].ID)
+	assert.NoError(t, err)
+	assert.False(t, waitlisted)
+
+	waitlisted, err = repo.OptInContributor(created.ID, contributors[2].ID)
+	assert.NoError(t, err)
+	assert.False(t, waitlisted)
+
+	waitlisted, err = repo.OptInContributor(created.ID, contributors[3].ID)
+	assert.NoError(t, err)
+	assert.True(t, waitlisted)
+
+	found, err := repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	assert.True(t, found.IsContributorOptedIn(contributors[2].ID))
+	assert.False(t, found.IsContributorOptedIn(contributors[3].ID))
+	assert.Equal(t, 1, found.WaitlistPosition(contributors[3].ID))
+}
+
+func TestActivityRepository_AddVote(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewActivityRepo(db)
+
+	activity := createTestActivity()
+	activity.IsApproved = false
+	created, err := repo.Create(activity)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.AddVote(created.AddVote(1, true)))
+	assert.NoError(t, repo.AddVote(created.AddVote(2, false)))
+
+	// A contributor can only vote once
+	assert.Error(t, repo.AddVote(&models.ActivityVote{ActivityID: created.ID, ContributorID: 1, Approve: false}))
+
+	found, err := repo.GetByID(created.ID)
+	assert.NoError(t, err)
+	tally := found.Tally(2)
+	assert.Equal(t, 1, tally.Approvals)
+	assert.Equal(t, 1, tally.Rejections)
+	assert.False(t, tally.HasReachedThreshold())
+}
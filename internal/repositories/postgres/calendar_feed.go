@@ -0,0 +1,52 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type calendarFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarFeedRepository creates a new calendar feed repository instance
+func NewCalendarFeedRepository(db *gorm.DB) interfaces.CalendarFeedRepository {
+	return &calendarFeedRepository{db: db}
+}
+
+// Create stores a new calendar feed
+func (r *calendarFeedRepository) Create(feed *models.CalendarFeed) error {
+	return r.db.Create(feed).Error
+}
+
+// Update saves changes to an existing calendar feed
+func (r *calendarFeedRepository) Update(feed *models.CalendarFeed) error {
+	return r.db.Save(feed).Error
+}
+
+// GetByID fetches a calendar feed by its ID
+func (r *calendarFeedRepository) GetByID(feedID uint) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	if err := r.db.First(&feed, feedID).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// GetByTokenHash fetches a calendar feed by the hash of its token
+func (r *calendarFeedRepository) GetByTokenHash(tokenHash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	if err := r.db.Where("token_hash = ?", tokenHash).First(&feed).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// GetActiveByEmail fetches the calendar feeds of a campaign created by email that haven't been revoked
+func (r *calendarFeedRepository) GetActiveByEmail(campaignID, email string) ([]models.CalendarFeed, error) {
+	var feeds []models.CalendarFeed
+	err := r.db.Where("campaign_id = ? AND email = ? AND revoked_at IS NULL", campaignID, email).
+		Order("created_at DESC").Find(&feeds).Error
+	return feeds, err
+}
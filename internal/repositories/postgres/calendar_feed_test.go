@@ -0,0 +1,60 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarFeedRepository_CreateAndGetByTokenHash(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCalendarFeedRepository(db)
+
+	feed := &models.CalendarFeed{
+		CampaignID:           "campaign-1",
+		Email:                "member@example.com",
+		Scope:                models.CalendarFeedScopeCampaign,
+		TokenHash:            "hash-1",
+		EncryptedCampaignKey: "sealed",
+	}
+
+	err := repo.Create(feed)
+	assert.NoError(t, err)
+	assert.NotZero(t, feed.ID)
+
+	found, err := repo.GetByTokenHash("hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, feed.ID, found.ID)
+	assert.Equal(t, "sealed", found.EncryptedCampaignKey)
+
+	_, err = repo.GetByTokenHash("hash-2")
+	assert.Error(t, err)
+}
+
+func TestCalendarFeedRepository_GetActiveByEmail(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCalendarFeedRepository(db)
+
+	active := &models.CalendarFeed{CampaignID: "campaign-1", Email: "member@example.com", Scope: models.CalendarFeedScopeCampaign, TokenHash: "hash-1", EncryptedCampaignKey: "sealed"}
+	revoked := &models.CalendarFeed{CampaignID: "campaign-1", Email: "member@example.com", Scope: models.CalendarFeedScopeCampaign, TokenHash: "hash-2", EncryptedCampaignKey: "sealed"}
+	other := &models.CalendarFeed{CampaignID: "campaign-1", Email: "other@example.com", Scope: models.CalendarFeedScopeCampaign, TokenHash: "hash-3", EncryptedCampaignKey: "sealed"}
+	for _, feed := range []*models.CalendarFeed{active, revoked, other} {
+		assert.NoError(t, repo.Create(feed))
+	}
+
+	revoked.Revoke()
+	assert.NoError(t, repo.Update(revoked))
+
+	feeds, err := repo.GetActiveByEmail("campaign-1", "member@example.com")
+	assert.NoError(t, err)
+	if assert.Len(t, feeds, 1) {
+		assert.Equal(t, active.ID, feeds[0].ID)
+	}
+
+	found, err := repo.GetByID(revoked.ID)
+	assert.NoError(t, err)
+	assert.True(t, found.IsRevoked())
+}
@@ -1,6 +1,7 @@
 package postgress
 
 import (
+	"slices"
 	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
@@ -27,7 +28,8 @@ func (r *campaignRepository) Create(campaign *models.Campaign) (models.Campaign,
 
 // Update updates a campaign
 func (r *campaignRepository) Update(campaign *models.Campaign) (models.Campaign, error) {
-	if err := r.db.Save(campaign).Error; err != nil {
+	// Roles and access keys are changed through their own repositories only
+	if err := r.db.Omit("Roles", "AccessKeys").Save(campaign).Error; err != nil {
 		return models.Campaign{}, err
 	}
 	return *campaign, nil
@@ -38,12 +40,25 @@ func (r *campaignRepository) Delete(campaignID string) error {
 	return r.db.Where("id = ?", campaignID).Delete(&models.Campaign{}).Error
 }
 
+// AddImage stores an uploaded campaign image
+func (r *campaignRepository) AddImage(image *models.CampaignImage) error {
+	return r.db.Create(image).Error
+}
+
+// DeleteImage removes a campaign image
+func (r *campaignRepository) DeleteImage(image *models.CampaignImage) error {
+	return r.db.Delete(image).Error
+}
+
 // TODO: Redundant ? GetByIDWithSelectedData
 func (r *campaignRepository) GetByID(id string) (models.Campaign, error) {
 	var campaign models.Campaign
 
 	query := r.db.Where("id = ?", id)
-	query = query.Preload("Images").Preload("Activities.Contributors").Preload("Activities").Preload("Contributors.Payment").Preload("Contributors").Preload("Payout").Preload("CreatedBy")
+	query = query.Preload("Images").Preload("Activities.Contributors").Preload("Activities.Shares").Preload("Activities.Waitlist", orderWaitlist).Preload("Activities.Votes").Preload("Activities").Preload("Contributors.Payment").Preload("Contributors").Preload("Payout").Preload("CreatedBy").Preload("Roles")
+	query = query.Preload("Milestones", func(db *gorm.DB) *gorm.DB {
+		return db.Order("percentage ASC")
+	})
 	err := query.First(&campaign).Error
 	if err != nil {
 		return models.Campaign{}, err
@@ -72,20 +87,20 @@ func (r *campaignRepository) GetByIDWithSelectedData(id string, options models.P
 		})
 
 		if options.ActivitiesContributors {
-			query = query.Preload("Activities.Contributors")
+			query = query.Preload("Activities.Contributors").Preload("Activities.Shares").Preload("Activities.Waitlist", orderWaitlist).Preload("Activities.Votes")
+		}
+
+		if options.ActivitiesExpenses {
+			query = query.Preload("Activities.Expenses")
 		}
 
 		if options.ActivitiesComments {
-			// Load comments with nested replies and their creators
+			// Load top level comments and their creators, replies are fetched through the comment repository
 			query = query.
 				Preload("Activities.Comments", func(db *gorm.DB) *gorm.DB {
 					return db.Order("created_at DESC").Where("parent_id IS NULL")
 				}).
-				Preload("Activities.Comments.CreatedBy").
-				Preload("Activities.Comments.Replies").
-				Preload("Activities.Comments.Replies.CreatedBy").
-				Preload("Activities.Comments.Replies.Replies").
-				Preload("Activities.Comments.Replies.Replies.CreatedBy")
+				Preload("Activities.Comments.CreatedBy")
 		}
 
 		// Always preload Activity CreatedBy if Activities are loaded
@@ -102,7 +117,13 @@ func (r *campaignRepository) GetByIDWithSelectedData(id string, options models.P
 		}
 	}
 
-	query = query.Preload("CreatedBy").Preload("Payout")
+	if options.Milestones {
+		query = query.Preload("Milestones", func(db *gorm.DB) *gorm.DB {
+			return db.Order("percentage ASC")
+		})
+	}
+
+	query = query.Preload("CreatedBy").Preload("Payout").Preload("Roles")
 	err := query.First(&campaign).Error
 	if err != nil {
 		return campaign, err
@@ -124,6 +145,21 @@ func (r *campaignRepository) GetByHandle(handle string) (models.Campaign, error)
 	return campaign, nil
 }
 
+// GetBySlug fetches a public campaign by its slug
+func (r *campaignRepository) GetBySlug(slug string) (models.Campaign, error) {
+	var campaign models.Campaign
+	query := r.db.Where("slug = ? AND visibility = ?", slug, models.CampaignVisibilityPublic)
+	query = query.Preload("Images").Preload("Activities.Contributors").Preload("Activities.Shares").Preload("Activities.Waitlist", orderWaitlist).Preload("Activities.Votes").Preload("Activities").Preload("Contributors.Payment").Preload("Contributors")
+	query = query.Preload("CreatedBy").Preload("Milestones", func(db *gorm.DB) *gorm.DB {
+		return db.Order("percentage ASC")
+	})
+	err := query.First(&campaign).Error
+	if err != nil {
+		return models.Campaign{}, err
+	}
+	return campaign, nil
+}
+
 // GetExpiredCampaigns fetches all expired campaigns
 func (r *campaignRepository) GetExpiredCampaigns() ([]models.Campaign, error) {
 	var campaigns []models.Campaign
@@ -166,3 +202,43 @@ func (r *campaignRepository) GetNearEndCampaigns() ([]models.Campaign, error) {
 	}
 	return campaigns, nil
 }
+
+// MarkMilestoneReached marks a milestone as reached, it returns false if the milestone was already reached
+func (r *campaignRepository) MarkMilestoneReached(milestoneID uint) (bool, error) {
+	result := r.db.Model(&models.CampaignMilestone{}).
+		Where("id = ? AND reached_at IS NULL", milestoneID).
+		Update("reached_at", time.Now().UTC())
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReplaceMilestones replaces the milestones of a campaign that have not been reached yet
+func (r *campaignRepository) ReplaceMilestones(campaignID string, milestones []models.CampaignMilestone) ([]models.CampaignMilestone, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("campaign_id = ? AND reached_at IS NULL", campaignID).Delete(&models.CampaignMilestone{}).Error; err != nil {
+			return err
+		}
+
+		var reached []int
+		if err := tx.Model(&models.CampaignMilestone{}).Where("campaign_id = ?", campaignID).Pluck("percentage", &reached).Error; err != nil {
+			return err
+		}
+
+		for _, milestone := range milestones {
+			if slices.Contains(reached, milestone.Percentage) {
+				continue
+			}
+			milestone.CampaignID = campaignID
+			if err := tx.Create(&milestone).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []models.CampaignMilestone
+	err = r.db.Where("campaign_id = ?", campaignID).Order("percentage ASC").Find(&updated).Error
+	return updated, err
+}
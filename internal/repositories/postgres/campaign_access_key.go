@@ -0,0 +1,69 @@
+package postgress
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type campaignAccessKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewCampaignAccessKeyRepository creates a new campaign access key repository instance
+func NewCampaignAccessKeyRepository(db *gorm.DB) interfaces.CampaignAccessKeyRepository {
+	return &campaignAccessKeyRepository{db: db}
+}
+
+// Create stores a new access key
+func (r *campaignAccessKeyRepository) Create(accessKey *models.CampaignAccessKey) error {
+	return r.db.Create(accessKey).Error
+}
+
+// Update saves changes to an existing access key
+func (r *campaignAccessKeyRepository) Update(accessKey *models.CampaignAccessKey) error {
+	return r.db.Save(accessKey).Error
+}
+
+// RevokeByEmail revokes every active key issued to email on a campaign
+func (r *campaignAccessKeyRepository) RevokeByEmail(campaignID, email string) error {
+	return r.db.Model(&models.CampaignAccessKey{}).
+		Where("campaign_id = ? AND email = ? AND revoked_at IS NULL", campaignID, email).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+// GetByKeyHash fetches an access key of a campaign by its hash
+func (r *campaignAccessKeyRepository) GetByKeyHash(campaignID, keyHash string) (*models.CampaignAccessKey, error) {
+	var accessKey models.CampaignAccessKey
+	err := r.db.Where("campaign_id = ? AND key_hash = ?", campaignID, keyHash).First(&accessKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &accessKey, nil
+}
+
+// GetByCampaignID fetches all access keys issued for a campaign
+func (r *campaignAccessKeyRepository) GetByCampaignID(campaignID string) ([]models.CampaignAccessKey, error) {
+	var accessKeys []models.CampaignAccessKey
+	err := r.db.Where("campaign_id = ?", campaignID).Order("created_at DESC").Find(&accessKeys).Error
+	return accessKeys, err
+}
+
+// GetOwnerKey fetches the active owner key of a campaign
+func (r *campaignAccessKeyRepository) GetOwnerKey(campaignID string) (*models.CampaignAccessKey, error) {
+	var accessKey models.CampaignAccessKey
+	err := r.db.Where("campaign_id = ? AND is_owner = ? AND revoked_at IS NULL", campaignID, true).First(&accessKey).Error
+	if err != nil {
+		return nil, err
+	}
+	return &accessKey, nil
+}
+
+// HasKeys checks if any access key has been issued for a campaign
+func (r *campaignAccessKeyRepository) HasKeys(campaignID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.CampaignAccessKey{}).Where("campaign_id = ?", campaignID).Count(&count).Error
+	return count > 0, err
+}
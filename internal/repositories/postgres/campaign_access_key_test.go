@@ -0,0 +1,94 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCampaignAccessKeyRepository_CreateAndGetByKeyHash(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignAccessKeyRepository(db)
+
+	accessKey := &models.CampaignAccessKey{
+		CampaignID:           "campaign-1",
+		Email:                "member@example.com",
+		KeyHash:              "hash-1",
+		EncryptedCampaignKey: "sealed",
+	}
+
+	err := repo.Create(accessKey)
+	assert.NoError(t, err)
+	assert.NotZero(t, accessKey.ID)
+
+	found, err := repo.GetByKeyHash("campaign-1", "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "member@example.com", found.Email)
+
+	_, err = repo.GetByKeyHash("campaign-2", "hash-1")
+	assert.Error(t, err)
+}
+
+func TestCampaignAccessKeyRepository_RevokeByEmail(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignAccessKeyRepository(db)
+
+	keys := []models.CampaignAccessKey{
+		{CampaignID: "campaign-1", Email: "member@example.com", KeyHash: "hash-1", EncryptedCampaignKey: "sealed"},
+		{CampaignID: "campaign-1", Email: "other@example.com", KeyHash: "hash-2", EncryptedCampaignKey: "sealed"},
+	}
+	for i := range keys {
+		assert.NoError(t, repo.Create(&keys[i]))
+	}
+
+	err := repo.RevokeByEmail("campaign-1", "member@example.com")
+	assert.NoError(t, err)
+
+	revoked, err := repo.GetByKeyHash("campaign-1", "hash-1")
+	assert.NoError(t, err)
+	assert.True(t, revoked.IsRevoked())
+
+	active, err := repo.GetByKeyHash("campaign-1", "hash-2")
+	assert.NoError(t, err)
+	assert.False(t, active.IsRevoked())
+}
+
+func TestCampaignAccessKeyRepository_HasKeys(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignAccessKeyRepository(db)
+
+	hasKeys, err := repo.HasKeys("campaign-1")
+	assert.NoError(t, err)
+	assert.False(t, hasKeys)
+
+	err = repo.Create(&models.CampaignAccessKey{CampaignID: "campaign-1", Email: "owner@example.com", KeyHash: "hash-1", EncryptedCampaignKey: "sealed", IsOwner: true})
+	assert.NoError(t, err)
+
+	hasKeys, err = repo.HasKeys("campaign-1")
+	assert.NoError(t, err)
+	assert.True(t, hasKeys)
+
+	keys, err := repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
+
+func TestCampaignAccessKeyRepository_GetOwnerKey(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignAccessKeyRepository(db)
+
+	_, err := repo.GetOwnerKey("campaign-1")
+	assert.Error(t, err)
+
+	assert.NoError(t, repo.Create(&models.CampaignAccessKey{CampaignID: "campaign-1", Email: "member@example.com", KeyHash: "hash-1", EncryptedCampaignKey: "sealed"}))
+	assert.NoError(t, repo.Create(&models.CampaignAccessKey{CampaignID: "campaign-1", Email: "owner@example.com", KeyHash: "hash-2", EncryptedCampaignKey: "sealed", IsOwner: true}))
+
+	owner, err := repo.GetOwnerKey("campaign-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "owner@example.com", owner.Email)
+}
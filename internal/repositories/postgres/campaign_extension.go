@@ -0,0 +1,47 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type campaignExtensionRepository struct {
+	db *gorm.DB
+}
+
+// NewCampaignExtensionRepository creates a new campaign extension repository instance
+func NewCampaignExtensionRepository(db *gorm.DB) interfaces.CampaignExtensionRepository {
+	return &campaignExtensionRepository{db: db}
+}
+
+// Create stores a new campaign extension
+func (r *campaignExtensionRepository) Create(extension *models.CampaignExtension) error {
+	return r.db.Create(extension).Error
+}
+
+// Update saves the status of a campaign extension, votes are stored with AddVote
+func (r *campaignExtensionRepository) Update(extension *models.CampaignExtension) error {
+	return r.db.Omit("Votes").Save(extension).Error
+}
+
+// AddVote stores a contributor's vote on an extension
+func (r *campaignExtensionRepository) AddVote(vote *models.CampaignExtensionVote) error {
+	return r.db.Create(vote).Error
+}
+
+// GetByID fetches a campaign extension and its votes by ID
+func (r *campaignExtensionRepository) GetByID(extensionID uint) (models.CampaignExtension, error) {
+	var extension models.CampaignExtension
+	err := r.db.Preload("Votes").First(&extension, extensionID).Error
+	return extension, err
+}
+
+// GetPendingByCampaignID fetches the extension of a campaign awaiting approval
+func (r *campaignExtensionRepository) GetPendingByCampaignID(campaignID string) (models.CampaignExtension, error) {
+	var extension models.CampaignExtension
+	err := r.db.Preload("Votes").
+		Where("campaign_id = ? AND status = ?", campaignID, models.CampaignExtensionStatusPending).
+		First(&extension).Error
+	return extension, err
+}
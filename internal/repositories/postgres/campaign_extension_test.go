@@ -0,0 +1,49 @@
+package postgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCampaignExtensionRepository_CreateAndVote(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignExtensionRepository(db)
+
+	now := time.Now().UTC()
+	extension := models.NewCampaignExtension("campaign-1", now, now.Add(72*time.Hour))
+	assert.NoError(t, repo.Create(extension))
+	assert.NotZero(t, extension.ID)
+
+	assert.NoError(t, repo.AddVote(extension.AddVote(1, true)))
+	assert.Error(t, repo.AddVote(&models.CampaignExtensionVote{ExtensionID: extension.ID, ContributorID: 1}))
+
+	found, err := repo.GetByID(extension.ID)
+	assert.NoError(t, err)
+	assert.Len(t, found.Votes, 1)
+	assert.True(t, found.HasVoted(1))
+}
+
+func TestCampaignExtensionRepository_GetPendingByCampaignID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignExtensionRepository(db)
+
+	now := time.Now().UTC()
+	extension := models.NewCampaignExtension("campaign-1", now, now.Add(72*time.Hour))
+	assert.NoError(t, repo.Create(extension))
+
+	pending, err := repo.GetPendingByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Equal(t, extension.ID, pending.ID)
+
+	extension.Approve()
+	assert.NoError(t, repo.Update(extension))
+
+	_, err = repo.GetPendingByCampaignID("campaign-1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
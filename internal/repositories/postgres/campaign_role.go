@@ -0,0 +1,95 @@
+package postgress
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type campaignRoleRepository struct {
+	db *gorm.DB
+}
+
+// NewCampaignRoleRepository creates a new campaign role repository instance
+func NewCampaignRoleRepository(db *gorm.DB) interfaces.CampaignRoleRepository {
+	return &campaignRoleRepository{db: db}
+}
+
+// Assign stores the role of a user on a campaign, replacing any role the user held.
+// A campaign has a single treasurer so assigning one demotes the previous treasurer to member.
+func (r *campaignRoleRepository) Assign(role *models.CampaignUserRole) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if role.Role == models.CampaignRoleTreasurer {
+			if err := tx.Where("campaign_id = ? AND role = ? AND user_handle <> ?", role.CampaignID, models.CampaignRoleTreasurer, role.UserHandle).
+				Delete(&models.CampaignUserRole{}).Error; err != nil {
+				return err
+			}
+		}
+		return upsertRole(tx, role)
+	})
+}
+
+// Remove deletes the stored role of a user, the user falls back to member
+func (r *campaignRoleRepository) Remove(campaignID, userHandle string) error {
+	return r.db.Where("campaign_id = ? AND user_handle = ?", campaignID, userHandle).Delete(&models.CampaignUserRole{}).Error
+}
+
+// TransferOwnership makes newOwner the creator of the campaign and stores the role the previous owner keeps.
+// The access keys move in the same transaction, keys is nil for campaigns without stored keys.
+func (r *campaignRoleRepository) TransferOwnership(campaignID string, newOwner models.User, previousOwner *models.CampaignUserRole, keys *models.OwnerKeyTransfer) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Campaign{}).Where("id = ?", campaignID).Update("created_by_handle", newOwner.Handle).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("campaign_id = ? AND user_handle = ?", campaignID, newOwner.Handle).Delete(&models.CampaignUserRole{}).Error; err != nil {
+			return err
+		}
+		if err := upsertRole(tx, previousOwner); err != nil {
+			return err
+		}
+		if keys == nil {
+			return nil
+		}
+		return transferOwnerKey(tx, campaignID, newOwner.Email, keys)
+	})
+}
+
+// GetByCampaignID fetches the stored roles of a campaign
+func (r *campaignRoleRepository) GetByCampaignID(campaignID string) ([]models.CampaignUserRole, error) {
+	var roles []models.CampaignUserRole
+	err := r.db.Where("campaign_id = ?", campaignID).Order("created_at ASC").Find(&roles).Error
+	return roles, err
+}
+
+// Helper Functions --------------------------------------------------
+
+// transferOwnerKey revokes the member keys of the new owner, who uses the campaign key from now on,
+// and replaces the keys of the previous owner with a member key
+func transferOwnerKey(tx *gorm.DB, campaignID, newOwnerEmail string, keys *models.OwnerKeyTransfer) error {
+	if err := revokeKeysByEmail(tx, campaignID, newOwnerEmail); err != nil {
+		return err
+	}
+	if err := tx.Save(keys.OwnerKey).Error; err != nil {
+		return err
+	}
+	if err := revokeKeysByEmail(tx, campaignID, keys.PreviousOwnerKey.Email); err != nil {
+		return err
+	}
+	return tx.Create(keys.PreviousOwnerKey).Error
+}
+
+func revokeKeysByEmail(tx *gorm.DB, campaignID, email string) error {
+	return tx.Model(&models.CampaignAccessKey{}).
+		Where("campaign_id = ? AND email = ? AND is_owner = ? AND revoked_at IS NULL", campaignID, email, false).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+func upsertRole(tx *gorm.DB, role *models.CampaignUserRole) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "campaign_id"}, {Name: "user_handle"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "role", "updated_at"}),
+	}).Create(role).Error
+}
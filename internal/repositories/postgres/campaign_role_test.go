@@ -0,0 +1,111 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCampaignRoleRepository_Assign(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignRoleRepository(db)
+
+	first := models.User{Handle: "first", Email: "first@example.com"}
+	second := models.User{Handle: "second", Email: "second@example.com"}
+
+	assert.NoError(t, repo.Assign(models.NewCampaignUserRole("campaign-1", first, models.CampaignRoleCoOrganiser)))
+	assert.NoError(t, repo.Assign(models.NewCampaignUserRole("campaign-1", first, models.CampaignRoleTreasurer)))
+
+	roles, err := repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Len(t, roles, 1)
+	assert.Equal(t, models.CampaignRoleTreasurer, roles[0].Role)
+
+	// A new treasurer replaces the previous one
+	assert.NoError(t, repo.Assign(models.NewCampaignUserRole("campaign-1", second, models.CampaignRoleTreasurer)))
+
+	roles, err = repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Len(t, roles, 1)
+	assert.Equal(t, "second", roles[0].UserHandle)
+}
+
+func TestCampaignRoleRepository_Remove(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignRoleRepository(db)
+
+	user := models.User{Handle: "viewer", Email: "viewer@example.com"}
+	assert.NoError(t, repo.Assign(models.NewCampaignUserRole("campaign-1", user, models.CampaignRoleViewer)))
+	assert.NoError(t, repo.Remove("campaign-1", user.Handle))
+
+	roles, err := repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Empty(t, roles)
+}
+
+func TestCampaignRoleRepository_TransferOwnership(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignRoleRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+
+	newOwner := models.User{Handle: "new-owner", Email: "new@example.com"}
+	assert.NoError(t, repo.Assign(models.NewCampaignUserRole(campaign.ID, newOwner, models.CampaignRoleTreasurer)))
+
+	previousOwner := models.NewCampaignUserRole(campaign.ID, *user, models.CampaignRoleCoOrganiser)
+	assert.NoError(t, repo.TransferOwnership(campaign.ID, newOwner, previousOwner, nil))
+
+	var updated models.Campaign
+	assert.NoError(t, db.First(&updated, "id = ?", campaign.ID).Error)
+	assert.Equal(t, newOwner.Handle, updated.CreatedByHandle)
+
+	roles, err := repo.GetByCampaignID(campaign.ID)
+	assert.NoError(t, err)
+	assert.Len(t, roles, 1)
+	assert.Equal(t, user.Handle, roles[0].UserHandle)
+	assert.Equal(t, models.CampaignRoleCoOrganiser, roles[0].Role)
+}
+
+func TestCampaignRoleRepository_TransferOwnershipWithKeys(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCampaignRoleRepository(db)
+	keyRepo := NewCampaignAccessKeyRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+	newOwner := models.User{Handle: "new-owner", Email: "new@example.com"}
+
+	ownerKey := &models.CampaignAccessKey{CampaignID: campaign.ID, Email: user.Email, KeyHash: "owner", EncryptedCampaignKey: "sealed", IsOwner: true}
+	newOwnerMemberKey := &models.CampaignAccessKey{CampaignID: campaign.ID, Email: newOwner.Email, KeyHash: "new-owner-member", EncryptedCampaignKey: "sealed"}
+	for _, key := range []*models.CampaignAccessKey{ownerKey, newOwnerMemberKey} {
+		assert.NoError(t, keyRepo.Create(key))
+	}
+
+	ownerKey.Email = newOwner.Email
+	keys := &models.OwnerKeyTransfer{
+		OwnerKey:         ownerKey,
+		PreviousOwnerKey: &models.CampaignAccessKey{CampaignID: campaign.ID, Email: user.Email, KeyHash: "previous-owner-member", EncryptedCampaignKey: "sealed"},
+	}
+	previousOwner := models.NewCampaignUserRole(campaign.ID, *user, models.CampaignRoleCoOrganiser)
+	assert.NoError(t, repo.TransferOwnership(campaign.ID, newOwner, previousOwner, keys))
+
+	found, err := keyRepo.GetOwnerKey(campaign.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, newOwner.Email, found.Email)
+
+	found, err = keyRepo.GetByKeyHash(campaign.ID, "new-owner-member")
+	assert.NoError(t, err)
+	assert.True(t, found.IsRevoked(), "expected the new owner's member key to be revoked")
+
+	found, err = keyRepo.GetByKeyHash(campaign.ID, "previous-owner-member")
+	assert.NoError(t, err)
+	assert.False(t, found.IsRevoked())
+}
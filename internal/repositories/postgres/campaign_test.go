@@ -58,6 +58,10 @@ func TestCampaignRepository_Create(t *testing.T) {
 				Amount:     1000,
 			},
 		},
+		AccessKeys: []models.CampaignAccessKey{
+			{Email: user.Email, KeyHash: "owner-hash", EncryptedCampaignKey: "sealed", IsOwner: true},
+			{Email: "test@example.com", KeyHash: "member-hash", EncryptedCampaignKey: "sealed"},
+		},
 		StartDate: time.Now(),
 
 		EndDate: time.Now().Add(24 * time.Hour),
@@ -67,6 +71,11 @@ func TestCampaignRepository_Create(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, campaign.ID, result.ID)
 	assert.Equal(t, campaign.Title, result.Title)
+
+	// The access keys are stored along with the campaign
+	hasKeys, err := NewCampaignAccessKeyRepository(db).HasKeys(campaign.ID)
+	assert.NoError(t, err)
+	assert.True(t, hasKeys)
 }
 
 func TestCampaignRepository_GetByID(t *testing.T) {
@@ -115,6 +124,31 @@ func TestCampaignRepository_Delete(t *testing.T) {
 	assert.Error(t, err) // Should return error as campaign is deleted
 }
 
+func TestCampaignRepository_AddAndDeleteImage(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewCampaignRepository(db)
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+
+	image := models.NewUploadedImage(campaign.ID, "https://cdn.example.com/image.jpg", "image-1", "https://cdn.example.com/thumb.jpg", "thumb-1")
+	assert.NoError(t, repo.AddImage(image))
+	assert.NotZero(t, image.ID)
+
+	found, err := repo.GetByIDWithSelectedData(campaign.ID, models.PreloadOption{Images: true})
+	assert.NoError(t, err)
+	if assert.NotNil(t, found.GetImageByID(image.ID)) {
+		assert.Equal(t, []string{"image-1", "thumb-1"}, found.StoredFileIDs())
+	}
+
+	assert.NoError(t, repo.DeleteImage(image))
+	found, err = repo.GetByIDWithSelectedData(campaign.ID, models.PreloadOption{Images: true})
+	assert.NoError(t, err)
+	assert.Empty(t, found.Images)
+}
+
 func TestCampaignRepository_GetByHandle(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -129,6 +163,31 @@ func TestCampaignRepository_GetByHandle(t *testing.T) {
 	assert.Equal(t, campaign.ID, result.ID)
 }
 
+func TestCampaignRepository_GetBySlug(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewCampaignRepository(db)
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+
+	slug := "test-campaign-abc123"
+	err = db.Model(&campaign).Updates(map[string]interface{}{"slug": slug}).Error
+	assert.NoError(t, err)
+
+	// Private campaigns are not found by slug
+	_, err = repo.GetBySlug(slug)
+	assert.Error(t, err)
+
+	err = db.Model(&campaign).Update("visibility", models.CampaignVisibilityPublic).Error
+	assert.NoError(t, err)
+
+	result, err := repo.GetBySlug(slug)
+	assert.NoError(t, err)
+	assert.Equal(t, campaign.ID, result.ID)
+}
+
 func TestCampaignRepository_GetExpiredCampaigns(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -216,3 +275,49 @@ func TestCampaignRepository_GetNearEndCampaigns(t *testing.T) {
 	assert.GreaterOrEqual(t, len(results), 1)
 	assert.Equal(t, nearEndCampaign.ID, results[0].ID)
 }
+
+func TestCampaignRepository_MarkMilestoneReached(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewCampaignRepository(db)
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+
+	milestone := models.CampaignMilestone{CampaignID: campaign.ID, Percentage: 50}
+	assert.NoError(t, db.Create(&milestone).Error)
+
+	reached, err := repo.MarkMilestoneReached(milestone.ID)
+	assert.NoError(t, err)
+	assert.True(t, reached)
+
+	// A milestone is only marked once
+	reached, err = repo.MarkMilestoneReached(milestone.ID)
+	assert.NoError(t, err)
+	assert.False(t, reached)
+}
+
+func TestCampaignRepository_ReplaceMilestones(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewCampaignRepository(db)
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	campaign := createTestCampaign(db, *user)
+
+	reachedAt := time.Now()
+	assert.NoError(t, db.Create(&[]models.CampaignMilestone{
+		{CampaignID: campaign.ID, Percentage: 25, ReachedAt: &reachedAt},
+		{CampaignID: campaign.ID, Percentage: 50},
+	}).Error)
+
+	result, err := repo.ReplaceMilestones(campaign.ID, models.NewCampaignMilestones(campaign.ID, []int{25, 60, 100}))
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, 25, result[0].Percentage)
+	assert.True(t, result[0].IsReached())
+	assert.Equal(t, 60, result[1].Percentage)
+	assert.Equal(t, 100, result[2].Percentage)
+}
@@ -0,0 +1,84 @@
+package postgress
+
+import (
+	"slices"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type chatRepository struct {
+	db *gorm.DB
+}
+
+// NewChatRepository creates a new chat repository instance
+func NewChatRepository(db *gorm.DB) interfaces.ChatRepository {
+	return &chatRepository{db: db}
+}
+
+// Create stores a new chat message
+func (r *chatRepository) Create(message *models.ChatMessage) error {
+	return r.db.Create(message).Error
+}
+
+// GetByID fetches a chat message with its receipts
+func (r *chatRepository) GetByID(messageID string) (models.ChatMessage, error) {
+	var message models.ChatMessage
+	err := r.db.Preload("Receipts").Where("id = ?", messageID).First(&message).Error
+	return message, err
+}
+
+// GetByClientID fetches the message a client already sent with the same client ID
+func (r *chatRepository) GetByClientID(campaignID, senderHandle, clientID string) (models.ChatMessage, error) {
+	var message models.ChatMessage
+	err := r.db.Preload("Receipts").
+		Where("campaign_id = ? AND sender_handle = ? AND client_id = ?", campaignID, senderHandle, clientID).
+		First(&message).Error
+	return message, err
+}
+
+// GetHistory fetches up to limit messages of a campaign before the anchor, or after it when newer is set.
+// Without an anchor the latest messages are fetched, the messages are always returned oldest first
+func (r *chatRepository) GetHistory(campaignID string, anchor *models.ChatMessage, newer bool, limit int) ([]models.ChatMessage, error) {
+	var messages []models.ChatMessage
+
+	query := r.db.Preload("Receipts").Where("campaign_id = ?", campaignID)
+	switch {
+	case anchor != nil && newer:
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", anchor.CreatedAt, anchor.CreatedAt, anchor.ID).
+			Order("created_at ASC, id ASC")
+	case anchor != nil:
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", anchor.CreatedAt, anchor.CreatedAt, anchor.ID).
+			Order("created_at DESC, id DESC")
+	default:
+		query = query.Order("created_at DESC, id DESC")
+	}
+
+	if err := query.Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	if !newer || anchor == nil {
+		slices.Reverse(messages)
+	}
+	return messages, nil
+}
+
+// SaveReceipt stores a member's receipt for a message, the times a message was first delivered and read are kept
+func (r *chatRepository) SaveReceipt(receipt *models.ChatReceipt) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "message_id"}, {Name: "user_handle"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"delivered_at": gorm.Expr("COALESCE(chat_receipts.delivered_at, excluded.delivered_at)"),
+			"read_at":      gorm.Expr("COALESCE(chat_receipts.read_at, excluded.read_at)"),
+			"updated_at":   gorm.Expr("excluded.updated_at"),
+		}),
+	}).Create(receipt).Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.Where("message_id = ? AND user_handle = ?", receipt.MessageID, receipt.UserHandle).First(receipt).Error
+}
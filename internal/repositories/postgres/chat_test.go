@@ -0,0 +1,102 @@
+package postgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestChatMessages(t *testing.T, repo *chatRepository, campaignID string, count int) []*models.ChatMessage {
+	start := time.Now().Add(-time.Hour)
+	messages := make([]*models.ChatMessage, 0, count)
+	for i := 0; i < count; i++ {
+		message, err := models.NewChatMessage(campaignID, "sender", "Hello", "")
+		require.NoError(t, err)
+		message.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(message))
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func TestChatRepository_CreateAndGet(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewChatRepository(db)
+
+	message, err := models.NewChatMessage("campaign-1", "sender", "Hello everyone", "client-1")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(message))
+
+	fetched, err := repo.GetByID(message.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello everyone", fetched.Content)
+
+	byClient, err := repo.GetByClientID("campaign-1", "sender", "client-1")
+	assert.NoError(t, err)
+	assert.Equal(t, message.ID, byClient.ID)
+
+	_, err = repo.GetByClientID("campaign-1", "other", "client-1")
+	assert.Error(t, err)
+}
+
+func TestChatRepository_GetHistory(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewChatRepository(db).(*chatRepository)
+
+	messages := createTestChatMessages(t, repo, "campaign-1", 5)
+	createTestChatMessages(t, repo, "campaign-2", 2)
+
+	t.Run("latest messages oldest first", func(t *testing.T) {
+		history, err := repo.GetHistory("campaign-1", nil, false, 3)
+		assert.NoError(t, err)
+		require.Len(t, history, 3)
+		assert.Equal(t, messages[2].ID, history[0].ID)
+		assert.Equal(t, messages[4].ID, history[2].ID)
+	})
+
+	t.Run("messages before anchor", func(t *testing.T) {
+		history, err := repo.GetHistory("campaign-1", messages[3], false, 2)
+		assert.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, messages[1].ID, history[0].ID)
+		assert.Equal(t, messages[2].ID, history[1].ID)
+	})
+
+	t.Run("messages after anchor", func(t *testing.T) {
+		history, err := repo.GetHistory("campaign-1", messages[1], true, 2)
+		assert.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, messages[2].ID, history[0].ID)
+		assert.Equal(t, messages[3].ID, history[1].ID)
+	})
+}
+
+func TestChatRepository_SaveReceipt(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewChatRepository(db)
+
+	message, err := models.NewChatMessage("campaign-1", "sender", "Hello", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(message))
+
+	delivered := models.NewChatReceipt(message.ID, "reader", models.ChatReceiptDelivered)
+	require.NoError(t, repo.SaveReceipt(delivered))
+	assert.NotNil(t, delivered.DeliveredAt)
+	assert.Nil(t, delivered.ReadAt)
+	deliveredAt := *delivered.DeliveredAt
+
+	read := models.NewChatReceipt(message.ID, "reader", models.ChatReceiptRead)
+	require.NoError(t, repo.SaveReceipt(read))
+	assert.NotNil(t, read.ReadAt)
+	assert.True(t, deliveredAt.Equal(*read.DeliveredAt))
+
+	fetched, err := repo.GetByID(message.ID)
+	assert.NoError(t, err)
+	assert.Len(t, fetched.Receipts, 1)
+}
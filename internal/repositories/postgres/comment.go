@@ -4,6 +4,7 @@ import (
 	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type commentRepository struct {
@@ -23,20 +24,23 @@ func (c *commentRepository) Get(commentID string) (models.Comment, error) {
 
 	var comment models.Comment
 
-	err := c.db.Where("id = ?", commentID).Preload("CreatedBy").First(&comment).Error
+	err := c.db.Where("id = ?", commentID).Preload("CreatedBy").Preload("Reactions").First(&comment).Error
 	return comment, err
 }
 
-func (r *commentRepository) GetByActivityID(activityID uint) ([]models.Comment, error) {
+// GetByActivityID fetches a page of top level comments on an activity, newest first
+func (r *commentRepository) GetByActivityID(activityID uint, cursor *models.CommentCursor, limit int) ([]models.Comment, error) {
 	var comments []models.Comment
 
-	err := r.db.
+	query := r.withReplyCount().
 		Preload("CreatedBy").
-		Preload("Replies.CreatedBy").
-		Where("activity_id = ? AND parent_id IS NULL", activityID).
-		Order("created_at DESC").
-		Find(&comments).Error
+		Preload("Reactions").
+		Where("activity_id = ? AND parent_id IS NULL", activityID)
+	if cursor != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
 
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&comments).Error
 	return comments, err
 }
 
@@ -44,16 +48,158 @@ func (c *commentRepository) Delete(commentID string) error {
 	return c.db.Where("id = ?", commentID).Delete(&models.Comment{}).Error
 }
 
-func (c *commentRepository) FindReplies(commentID string) ([]models.Comment, error) {
+// FindReplies fetches a page of direct replies to a comment, oldest first
+func (c *commentRepository) FindReplies(commentID string, cursor *models.CommentCursor, limit int) ([]models.Comment, error) {
 	var comments []models.Comment
 
-	err := c.db.Where("parent_id = ?", commentID).Preload("CreatedBy").Find(&comments).Error
+	query := c.withReplyCount().
+		Preload("CreatedBy").
+		Preload("Reactions").
+		Where("parent_id = ?", commentID)
+	if cursor != nil {
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+
+	err := query.Order("created_at ASC, id ASC").Limit(limit).Find(&comments).Error
 	return comments, err
 }
 
-func (c *commentRepository) Update(comment *models.Comment) error {
+// FindThread fetches every reply under a comment whatever its depth, a level at a time
+func (c *commentRepository) FindThread(commentID string) ([]models.Comment, error) {
+	var thread []models.Comment
+
+	parentIDs := []string{commentID}
+	for len(parentIDs) > 0 {
+		var replies []models.Comment
+		err := c.withReplyCount().
+			Preload("CreatedBy").
+			Preload("Reactions").
+			Where("parent_id IN ?", parentIDs).
+			Find(&replies).Error
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs = parentIDs[:0]
+		for _, reply := range replies {
+			parentIDs = append(parentIDs, reply.ID)
+		}
+		thread = append(thread, replies...)
+	}
+
+	return thread, nil
+}
+
+// Update saves the new content of the comment together with the revision holding the previous content
+func (c *commentRepository) Update(comment *models.Comment, revision *models.CommentRevision) error {
+	return c.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Comment{}).Where("id = ?", comment.ID).Updates(
+			map[string]interface{}{
+				"content":     comment.Content,
+				"edited":      comment.Edited,
+				"edited_at":   comment.EditedAt,
+				"flagged":     comment.Flagged,
+				"flag_reason": comment.FlagReason,
+			}).Error; err != nil {
+			return err
+		}
+
+		if revision == nil {
+			return nil
+		}
+		return tx.Create(revision).Error
+	})
+}
+
+func (c *commentRepository) GetRevisions(commentID string) ([]models.CommentRevision, error) {
+	var revisions []models.CommentRevision
+
+	err := c.db.Where("comment_id = ?", commentID).Order("created_at DESC").Find(&revisions).Error
+	return revisions, err
+}
+
+// AddReaction saves the reaction, reacting twice with the same emoji is ignored
+func (c *commentRepository) AddReaction(reaction *models.CommentReaction) error {
+	return c.db.Clauses(clause.OnConflict{DoNothing: true}).Create(reaction).Error
+}
+
+func (c *commentRepository) RemoveReaction(commentID, userHandle, emoji string) error {
+	return c.db.Where("comment_id = ? AND user_handle = ? AND emoji = ?", commentID, userHandle, emoji).
+		Delete(&models.CommentReaction{}).Error
+}
+
+// MarkRead saves the time the user last read the comments of an activity
+func (c *commentRepository) MarkRead(marker *models.CommentReadMarker) error {
+	return c.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_handle"}, {Name: "activity_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_read_at", "updated_at"}),
+	}).Create(marker).Error
+}
+
+// CountUnread counts the comments others posted on each activity since the user last read them
+func (c *commentRepository) CountUnread(userHandle string, activityIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(activityIDs))
+	if len(activityIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ActivityID uint
+		Unread     int64
+	}
+	err := c.db.Model(&models.Comment{}).
+		Select("comments.activity_id, COUNT(*) AS unread").
+		Joins("LEFT JOIN comment_read_markers ON comment_read_markers.activity_id = comments.activity_id AND comment_read_markers.user_handle = ?", userHandle).
+		Where("comments.activity_id IN ? AND comments.created_by_handle <> ?", activityIDs, userHandle).
+		Where("comment_read_markers.last_read_at IS NULL OR comments.created_at > comment_read_markers.last_read_at").
+		Group("comments.activity_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ActivityID] = row.Unread
+	}
+	return counts, nil
+}
+
+// UpdateModeration saves whether the comment is hidden
+func (c *commentRepository) UpdateModeration(comment *models.Comment) error {
 	return c.db.Model(&models.Comment{}).Where("id = ?", comment.ID).Updates(
 		map[string]interface{}{
-			"content": comment.Content,
+			"hidden":           comment.Hidden,
+			"hidden_reason":    comment.HiddenReason,
+			"hidden_by_handle": comment.HiddenByHandle,
+			"hidden_at":        comment.HiddenAt,
 		}).Error
 }
+
+// AddReport saves the report, a repeated report by the same member is ignored and leaves the report ID empty
+func (c *commentRepository) AddReport(report *models.CommentReport) error {
+	return c.db.Clauses(clause.OnConflict{DoNothing: true}).Create(report).Error
+}
+
+func (c *commentRepository) GetReports(campaignID string) ([]models.CommentReport, error) {
+	var reports []models.CommentReport
+
+	err := c.db.Where("campaign_id = ?", campaignID).Order("created_at DESC").Find(&reports).Error
+	return reports, err
+}
+
+func (c *commentRepository) SaveModerationLog(log *models.CommentModerationLog) error {
+	return c.db.Create(log).Error
+}
+
+func (c *commentRepository) GetModerationLog(campaignID string) ([]models.CommentModerationLog, error) {
+	var logs []models.CommentModerationLog
+
+	err := c.db.Where("campaign_id = ?", campaignID).Order("created_at DESC, id DESC").Find(&logs).Error
+	return logs, err
+}
+
+// withReplyCount selects comments together with the number of their direct replies
+func (c *commentRepository) withReplyCount() *gorm.DB {
+	return c.db.Model(&models.Comment{}).
+		Select("comments.*, (SELECT COUNT(*) FROM comments AS replies WHERE replies.parent_id = comments.id) AS reply_count")
+}
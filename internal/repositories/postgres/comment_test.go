@@ -1,7 +1,9 @@
 package postgress
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/stretchr/testify/assert"
@@ -75,7 +77,7 @@ func TestCommentRepository_GetByActivityID(t *testing.T) {
 	err = db.Create(comment2).Error
 	assert.NoError(t, err)
 
-	comments, err := repo.GetByActivityID(1)
+	comments, err := repo.GetByActivityID(1, nil, 10)
 	assert.NoError(t, err)
 	assert.Len(t, comments, 2)
 }
@@ -98,14 +100,61 @@ func TestCommentRepository_Update(t *testing.T) {
 	err = db.Create(comment).Error
 	assert.NoError(t, err)
 
-	comment.Content = "Updated content"
-	err = repo.Update(comment)
+	revision := comment.Edit("Updated content", user.Handle)
+	err = repo.Update(comment, revision)
 	assert.NoError(t, err)
 
 	updatedComment, err := repo.Get(comment.ID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated content", updatedComment.Content)
+	assert.True(t, updatedComment.Edited)
+	assert.NotNil(t, updatedComment.EditedAt)
+
+	revisions, err := repo.GetRevisions(comment.ID)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, "Test comment", revisions[0].Content)
+	assert.Equal(t, user.Handle, revisions[0].EditedByHandle)
+}
+
+func TestCommentRepository_Reactions(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCommentRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+
+	comment := models.NewComment(
+		nil,
+		1,
+		"Test comment",
+		*user,
+	)
+
+	err = db.Create(comment).Error
+	assert.NoError(t, err)
+
+	err = repo.AddReaction(models.NewCommentReaction(comment.ID, user.Handle, "👍"))
+	assert.NoError(t, err)
+	err = repo.AddReaction(models.NewCommentReaction(comment.ID, user.Handle, "👍"))
+	assert.NoError(t, err, "reacting twice with the same emoji is ignored")
+	err = repo.AddReaction(models.NewCommentReaction(comment.ID, user.Handle, "🎉"))
+	assert.NoError(t, err)
+
+	fetchedComment, err := repo.Get(comment.ID)
+	assert.NoError(t, err)
+	assert.Len(t, fetchedComment.Reactions, 2)
+	assert.True(t, fetchedComment.HasReaction(user.Handle, "👍"))
+
+	err = repo.RemoveReaction(comment.ID, user.Handle, "👍")
+	assert.NoError(t, err)
+
+	fetchedComment, err = repo.Get(comment.ID)
+	assert.NoError(t, err)
+	assert.Len(t, fetchedComment.Reactions, 1)
+	assert.False(t, fetchedComment.HasReaction(user.Handle, "👍"))
 }
 
 func TestCommentRepository_Delete(t *testing.T) {
@@ -162,8 +211,143 @@ func TestCommentRepository_FindReplies(t *testing.T) {
 	err = db.Create(reply).Error
 	assert.NoError(t, err)
 
-	replies, err := repo.FindReplies(parentComment.ID)
+	replies, err := repo.FindReplies(parentComment.ID, nil, 10)
 	assert.NoError(t, err)
 	assert.Len(t, replies, 1)
 	assert.Equal(t, reply.Content, replies[0].Content)
 }
+
+func TestCommentRepository_Pagination(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCommentRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var created []*models.Comment
+	for i := 0; i < 5; i++ {
+		comment := models.NewComment(nil, 1, fmt.Sprintf("Comment %d", i), *user)
+		comment.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		assert.NoError(t, db.Create(comment).Error)
+		created = append(created, comment)
+	}
+	reply := models.NewComment(&created[4].ID, 1, "Reply", *user)
+	assert.NoError(t, db.Create(reply).Error)
+
+	firstPage, err := repo.GetByActivityID(1, nil, 2)
+	assert.NoError(t, err)
+	assert.Len(t, firstPage, 2)
+	assert.Equal(t, created[4].ID, firstPage[0].ID)
+	assert.Equal(t, int64(1), firstPage[0].ReplyCount)
+	assert.Equal(t, created[3].ID, firstPage[1].ID)
+
+	cursor := &models.CommentCursor{CreatedAt: firstPage[1].CreatedAt, ID: firstPage[1].ID}
+	secondPage, err := repo.GetByActivityID(1, cursor, 10)
+	assert.NoError(t, err)
+	assert.Len(t, secondPage, 3)
+	assert.Equal(t, created[2].ID, secondPage[0].ID)
+}
+
+func TestCommentRepository_FindThread(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCommentRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+
+	root := models.NewComment(nil, 1, "Root", *user)
+	assert.NoError(t, db.Create(root).Error)
+
+	// Replies nested deeper than any fixed preload
+	parentID := root.ID
+	for i := 0; i < 5; i++ {
+		reply := models.NewComment(&parentID, 1, fmt.Sprintf("Reply %d", i), *user)
+		assert.NoError(t, db.Create(reply).Error)
+		parentID = reply.ID
+	}
+
+	thread, err := repo.FindThread(root.ID)
+	assert.NoError(t, err)
+	assert.Len(t, thread, 5)
+}
+
+func TestCommentRepository_Unread(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCommentRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+	other := models.NewUser("Other User", "other@example.com", true)
+	assert.NoError(t, db.Create(other).Error)
+
+	for _, activityID := range []uint{1, 1, 2} {
+		assert.NoError(t, db.Create(models.NewComment(nil, activityID, "From other", *other)).Error)
+	}
+	assert.NoError(t, db.Create(models.NewComment(nil, 1, "Own comment", *user)).Error)
+
+	counts, err := repo.CountUnread(user.Handle, []uint{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), counts[1], "the user's own comments aren't unread")
+	assert.Equal(t, int64(1), counts[2])
+	assert.Equal(t, int64(0), counts[3])
+
+	assert.NoError(t, repo.MarkRead(models.NewCommentReadMarker(user.Handle, 1)))
+	assert.NoError(t, repo.MarkRead(models.NewCommentReadMarker(user.Handle, 1)), "marking read again updates the marker")
+
+	counts, err = repo.CountUnread(user.Handle, []uint{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), counts[1])
+	assert.Equal(t, int64(1), counts[2])
+}
+
+func TestCommentRepository_Moderation(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewCommentRepository(db)
+
+	user, err := createTestUser(db)
+	assert.NoError(t, err)
+
+	comment := models.NewComment(nil, 1, "Test comment", *user)
+	assert.NoError(t, repo.Create(comment))
+
+	t.Run("hide comment", func(t *testing.T) {
+		comment.Hide("Spam", "moderator")
+		assert.NoError(t, repo.UpdateModeration(comment))
+
+		fetched, err := repo.Get(comment.ID)
+		assert.NoError(t, err)
+		assert.True(t, fetched.Hidden)
+		assert.Equal(t, "Spam", fetched.HiddenReason)
+		assert.Equal(t, "Test comment", fetched.Content)
+	})
+
+	t.Run("repeated report is ignored", func(t *testing.T) {
+		report := models.NewCommentReport(comment.ID, "campaign-1", "reporter", "Spam")
+		assert.NoError(t, repo.AddReport(report))
+		assert.NotZero(t, report.ID)
+
+		repeated := models.NewCommentReport(comment.ID, "campaign-1", "reporter", "Still spam")
+		assert.NoError(t, repo.AddReport(repeated))
+		assert.Zero(t, repeated.ID)
+
+		reports, err := repo.GetReports("campaign-1")
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+	})
+
+	t.Run("moderation log", func(t *testing.T) {
+		assert.NoError(t, repo.SaveModerationLog(models.NewCommentModerationLog("campaign-1", comment.ID, "moderator", models.CommentModerationHide, "Spam")))
+		assert.NoError(t, repo.SaveModerationLog(models.NewCommentModerationLog("campaign-1", "", "", models.CommentModerationBlock, "Contains blocked words")))
+		assert.NoError(t, repo.SaveModerationLog(models.NewCommentModerationLog("campaign-2", comment.ID, "moderator", models.CommentModerationHide, "Spam")))
+
+		logs, err := repo.GetModerationLog("campaign-1")
+		assert.NoError(t, err)
+		assert.Len(t, logs, 2)
+		assert.Equal(t, models.CommentModerationBlock, logs[0].Action)
+	})
+}
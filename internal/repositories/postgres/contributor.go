@@ -14,8 +14,37 @@ func NewContributorRepository(db *gorm.DB) interfaces.ContributorRepository {
 	return &contributorRepository{db: db}
 }
 
-func (r *contributorRepository) Create(contribution *models.Contributor) error {
-	return r.db.Create(contribution).Error
+// Create stores the contributor along with their access key, the keys previously issued to their email are revoked.
+// accessKey is nil for contributors without a key
+func (r *contributorRepository) Create(contribution *models.Contributor, accessKey *models.CampaignAccessKey) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(contribution).Error; err != nil {
+			return err
+		}
+		if accessKey == nil {
+			return nil
+		}
+		return storeMemberKeys(tx, []models.CampaignAccessKey{*accessKey})
+	})
+}
+
+// CreateMultiple creates the contributors and their access keys in one transaction and removes the contributors
+// they replace along with their keys, the contributors are opted into their activities without saving the activities
+func (r *contributorRepository) CreateMultiple(contributions []models.Contributor, replaced []models.Contributor, accessKeys []models.CampaignAccessKey) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range replaced {
+			if err := tx.Delete(&replaced[i]).Error; err != nil {
+				return err
+			}
+			if err := revokeKeysByEmail(tx, replaced[i].CampaignID, replaced[i].Email); err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Activities.*").Create(&contributions).Error; err != nil {
+			return err
+		}
+		return storeMemberKeys(tx, accessKeys)
+	})
 }
 
 // UpdateName updates only the name field for a contributor
@@ -33,6 +62,38 @@ func (r *contributorRepository) Update(contribution *models.Contributor) error {
 	}).Error
 }
 
+// UpdateInvitation updates only the invitation fields for a contributor
+func (r *contributorRepository) UpdateInvitation(contribution *models.Contributor) error {
+	invitation := contribution.Invitation
+	return r.db.Model(&models.Contributor{}).Where("id = ?", contribution.ID).Updates(map[string]interface{}{
+		"invitation_status":       invitation.Status,
+		"invitation_token_hash":   invitation.TokenHash,
+		"invitation_token_seed":   invitation.TokenSeed,
+		"invitation_invited_at":   invitation.InvitedAt,
+		"invitation_expires_at":   invitation.ExpiresAt,
+		"invitation_reminded_at":  invitation.RemindedAt,
+		"invitation_responded_at": invitation.RespondedAt,
+	}).Error
+}
+
+// Leave saves that a contributor left their campaign along with the refund of their payment
+func (r *contributorRepository) Leave(contribution *models.Contributor) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Contributor{}).Where("id = ?", contribution.ID).Updates(map[string]interface{}{
+			"invitation_status":     contribution.Invitation.Status,
+			"invitation_token_hash": contribution.Invitation.TokenHash,
+			"invitation_token_seed": contribution.Invitation.TokenSeed,
+		}).Error; err != nil {
+			return err
+		}
+		if contribution.Payment == nil {
+			return nil
+		}
+		return tx.Model(&models.Payment{}).Where("reference = ?", contribution.Payment.Reference).
+			Update("payment_status", contribution.Payment.PaymentStatus).Error
+	})
+}
+
 func (r *contributorRepository) Delete(contribution *models.Contributor) error {
 	return r.db.Delete(contribution).Error
 }
@@ -48,13 +109,25 @@ func (r *contributorRepository) GetContributorById(contributorID uint, preload b
 
 	if preload {
 		var contributor models.Contributor
-		err := r.db.Preload("Activities.Contributors").Preload("Activities").Preload("Payment").First(&contributor, contributorID).Error
+		err := r.db.Preload("Activities.Contributors").Preload("Activities.Shares").Preload("Activities").Preload("Payment").First(&contributor, contributorID).Error
 		return contributor, err
 	}
 	err := r.db.First(&contributor, contributorID).Error
 	return contributor, err
 }
 
+func (r *contributorRepository) GetContributorByInvitationTokenHash(tokenHash string) (models.Contributor, error) {
+	var contributor models.Contributor
+	err := r.db.Where("invitation_token_hash = ?", tokenHash).First(&contributor).Error
+	return contributor, err
+}
+
+func (r *contributorRepository) GetPendingInvitations() ([]models.Contributor, error) {
+	var contributors []models.Contributor
+	err := r.db.Where("invitation_status = ?", models.InvitationStatusPending).Find(&contributors).Error
+	return contributors, err
+}
+
 func (r *contributorRepository) GetContributorByUserHandle(userHandle uint) (models.Contributor, error) {
 	var contributor models.Contributor
 	err := r.db.Where("user_handle = ?", userHandle).First(&contributor).Error
@@ -85,3 +158,18 @@ func (r *contributorRepository) GetEmailsOfActiveContributors(emails []string) (
 
 	return existingEmails, nil
 }
+
+// Helper Functions --------------------------------------------------
+
+// storeMemberKeys revokes the keys previously issued to each email and stores the new keys
+func storeMemberKeys(tx *gorm.DB, accessKeys []models.CampaignAccessKey) error {
+	if len(accessKeys) == 0 {
+		return nil
+	}
+	for _, accessKey := range accessKeys {
+		if err := revokeKeysByEmail(tx, accessKey.CampaignID, accessKey.Email); err != nil {
+			return err
+		}
+	}
+	return tx.Create(&accessKeys).Error
+}
@@ -0,0 +1,53 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type contributorRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewContributorRequestRepository creates a new contributor request repository instance
+func NewContributorRequestRepository(db *gorm.DB) interfaces.ContributorRequestRepository {
+	return &contributorRequestRepository{db: db}
+}
+
+// Create stores a new contributor request
+func (r *contributorRequestRepository) Create(request *models.ContributorRequest) error {
+	return r.db.Create(request).Error
+}
+
+// Update saves changes to a contributor request
+func (r *contributorRequestRepository) Update(request *models.ContributorRequest) error {
+	return r.db.Save(request).Error
+}
+
+// GetByID fetches a contributor request by ID
+func (r *contributorRequestRepository) GetByID(requestID uint) (models.ContributorRequest, error) {
+	var request models.ContributorRequest
+	err := r.db.First(&request, requestID).Error
+	return request, err
+}
+
+// GetByCampaignID fetches the contributor requests of a campaign, the requests of every contributor are returned if email is empty
+func (r *contributorRequestRepository) GetByCampaignID(campaignID string, email string) ([]models.ContributorRequest, error) {
+	var requests []models.ContributorRequest
+	query := r.db.Where("campaign_id = ?", campaignID)
+	if email != "" {
+		query = query.Where("email = ?", email)
+	}
+	err := query.Order("created_at DESC").Find(&requests).Error
+	return requests, err
+}
+
+// HasPendingRequest checks if a contributor has a request waiting to be reviewed
+func (r *contributorRequestRepository) HasPendingRequest(contributorID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ContributorRequest{}).
+		Where("contributor_id = ? AND status = ?", contributorID, models.ContributorRequestStatusPending).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,81 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContributorRequestRepository_CreateAndGetByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRequestRepository(db)
+
+	contributor := &models.Contributor{ID: 1, CampaignID: "campaign-1", Email: "jane@example.com", Amount: 50}
+	request := models.NewAmountChangeRequest(contributor, 80, "I can put in more")
+	assert.NoError(t, repo.Create(request))
+	assert.NotZero(t, request.ID)
+
+	found, err := repo.GetByID(request.ID)
+	assert.NoError(t, err)
+	assert.True(t, found.IsPending())
+	assert.Equal(t, 50.0, found.Amount)
+	assert.Equal(t, 80.0, *found.RequestedAmount)
+
+	found.Approve("creator", "Thanks", false)
+	assert.NoError(t, repo.Update(&found))
+
+	reviewed, err := repo.GetByID(request.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ContributorRequestStatusApproved, reviewed.Status)
+	assert.Equal(t, "creator", *reviewed.ReviewedByHandle)
+	assert.NotNil(t, reviewed.ReviewedAt)
+}
+
+func TestContributorRequestRepository_GetByCampaignID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRequestRepository(db)
+
+	jane := &models.Contributor{ID: 1, CampaignID: "campaign-1", Email: "jane@example.com", Amount: 50}
+	john := &models.Contributor{ID: 2, CampaignID: "campaign-1", Email: "john@example.com", Amount: 50}
+	jim := &models.Contributor{ID: 3, CampaignID: "campaign-2", Email: "jim@example.com", Amount: 50}
+	for _, request := range []*models.ContributorRequest{
+		models.NewLeaveRequest(jane, ""),
+		models.NewAmountChangeRequest(john, 20, ""),
+		models.NewLeaveRequest(jim, ""),
+	} {
+		assert.NoError(t, repo.Create(request))
+	}
+
+	all, err := repo.GetByCampaignID("campaign-1", "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	own, err := repo.GetByCampaignID("campaign-1", "jane@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, own, 1)
+	assert.True(t, own[0].IsLeave())
+}
+
+func TestContributorRequestRepository_HasPendingRequest(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRequestRepository(db)
+
+	contributor := &models.Contributor{ID: 1, CampaignID: "campaign-1", Email: "jane@example.com", Amount: 50}
+	request := models.NewLeaveRequest(contributor, "")
+	assert.NoError(t, repo.Create(request))
+
+	hasPending, err := repo.HasPendingRequest(1)
+	assert.NoError(t, err)
+	assert.True(t, hasPending)
+
+	request.Reject("creator", "")
+	assert.NoError(t, repo.Update(request))
+
+	hasPending, err = repo.HasPendingRequest(1)
+	assert.NoError(t, err)
+	assert.False(t, hasPending)
+}
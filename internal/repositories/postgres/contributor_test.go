@@ -19,7 +19,7 @@ func TestContributorRepository_Create(t *testing.T) {
 		Amount:     100,
 	}
 
-	err := repo.Create(contributor)
+	err := repo.Create(contributor, nil)
 	assert.NoError(t, err)
 	assert.NotZero(t, contributor.ID)
 }
@@ -36,7 +36,7 @@ func TestContributorRepository_Update(t *testing.T) {
 		CampaignID: "test-campaign",
 		Amount:     100,
 	}
-	err := repo.Create(contributor)
+	err := repo.Create(contributor, nil)
 	assert.NoError(t, err)
 
 	// Update contributor
@@ -65,7 +65,7 @@ func TestContributorRepository_GetContributorsByCampaignID(t *testing.T) {
 	}
 
 	for _, c := range contributors {
-		err := repo.Create(&c)
+		err := repo.Create(&c, nil)
 		assert.NoError(t, err)
 	}
 
@@ -86,7 +86,7 @@ func TestContributorRepository_Delete(t *testing.T) {
 		CampaignID: "test-campaign",
 	}
 
-	err := repo.Create(contributor)
+	err := repo.Create(contributor, nil)
 	assert.NoError(t, err)
 
 	err = repo.Delete(contributor)
@@ -110,7 +110,7 @@ func TestContributorRepository_GetEmailsOfActiveContributors(t *testing.T) {
 	}
 
 	for _, c := range contributors {
-		err := repo.Create(&c)
+		err := repo.Create(&c, nil)
 		assert.NoError(t, err)
 	}
 
@@ -135,7 +135,7 @@ func TestContributorRepository_UpdateName(t *testing.T) {
 		Amount:     100,
 		Email:      "user1@example.com",
 	}
-	err := repo.Create(contributor)
+	err := repo.Create(contributor, nil)
 	assert.NoError(t, err)
 	t.Logf("%+v", contributor)
 
@@ -146,3 +146,145 @@ func TestContributorRepository_UpdateName(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "New Name", updated.Name)
 }
+
+func TestContributorRepository_Invitations(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRepository(db)
+
+	invited := &models.Contributor{Name: "Invited", Email: "invited@example.com", CampaignID: "campaign1", Amount: 100}
+	invited.Invitation.Issue("secret")
+	assert.NoError(t, repo.Create(invited, nil))
+
+	legacy := &models.Contributor{Name: "Legacy", Email: "legacy@example.com", CampaignID: "campaign1", Amount: 100}
+	assert.NoError(t, repo.Create(legacy, nil))
+
+	t.Run("contributors without an invitation default to accepted", func(t *testing.T) {
+		result, err := repo.GetContributorById(legacy.ID, false)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationStatusAccepted, result.Invitation.Status)
+	})
+
+	t.Run("get by invitation token hash", func(t *testing.T) {
+		result, err := repo.GetContributorByInvitationTokenHash(models.HashInvitationToken("secret", invited.Invitation.Token))
+		assert.NoError(t, err)
+		assert.Equal(t, invited.ID, result.ID)
+
+		_, err = repo.GetContributorByInvitationTokenHash(models.HashInvitationToken("secret", "unknown"))
+		assert.Error(t, err)
+	})
+
+	t.Run("get pending invitations", func(t *testing.T) {
+		result, err := repo.GetPendingInvitations()
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, invited.ID, result[0].ID)
+
+		// The stored seed gives back the token of the invitation email
+		result[0].Invitation.Remind("secret")
+		assert.Equal(t, invited.Invitation.Token, result[0].Invitation.Token)
+	})
+
+	t.Run("update invitation", func(t *testing.T) {
+		invited.Invitation.Accept()
+		assert.NoError(t, repo.UpdateInvitation(invited))
+
+		result, err := repo.GetContributorById(invited.ID, false)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvitationStatusAccepted, result.Invitation.Status)
+		assert.Nil(t, result.Invitation.TokenHash)
+		assert.Nil(t, result.Invitation.TokenSeed)
+		assert.NotNil(t, result.Invitation.RespondedAt)
+
+		pending, err := repo.GetPendingInvitations()
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+}
+
+func TestContributorRepository_CreateMultiple(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRepository(db)
+	keyRepo := NewCampaignAccessKeyRepository(db)
+
+	activity := createTestActivity()
+	assert.NoError(t, db.Create(activity).Error)
+
+	declined := &models.Contributor{Name: "Declined", Email: "declined@example.com", CampaignID: "campaign123", Amount: 100}
+	declined.Invitation.Decline()
+	assert.NoError(t, repo.Create(declined, &models.CampaignAccessKey{CampaignID: "campaign123", Email: "declined@example.com", KeyHash: "declined-hash", EncryptedCampaignKey: "sealed"}))
+
+	t.Run("creates contributors and replaces the declined ones", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "First", Email: "first@example.com", CampaignID: "campaign123", Amount: 100, Activities: []models.Activity{{ID: activity.ID}}},
+			{Name: "Declined", Email: "declined@example.com", CampaignID: "campaign123", Amount: 50},
+		}
+
+		accessKeys := []models.CampaignAccessKey{
+			{CampaignID: "campaign123", Email: "first@example.com", KeyHash: "first-hash", EncryptedCampaignKey: "sealed"},
+			{CampaignID: "campaign123", Email: "declined@example.com", KeyHash: "rejoined-hash", EncryptedCampaignKey: "sealed"},
+		}
+		assert.NoError(t, repo.CreateMultiple(contributors, []models.Contributor{*declined}, accessKeys))
+
+		result, err := repo.GetContributorsByCampaignID("campaign123")
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		// The replaced contributor's key is revoked and each contributor's new key is stored
+		previous, err := keyRepo.GetByKeyHash("campaign123", "declined-hash")
+		assert.NoError(t, err)
+		assert.True(t, previous.IsRevoked())
+		for _, hash := range []string{"first-hash", "rejoined-hash"} {
+			accessKey, err := keyRepo.GetByKeyHash("campaign123", hash)
+			assert.NoError(t, err)
+			assert.False(t, accessKey.IsRevoked())
+		}
+
+		first, err := repo.GetContributorById(contributors[0].ID, true)
+		assert.NoError(t, err)
+		assert.Len(t, first.Activities, 1)
+		assert.Equal(t, "Test Activity", first.Activities[0].Title)
+	})
+
+	t.Run("nothing is created when a contributor fails", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "Second", Email: "second@example.com", CampaignID: "campaign123", Amount: 100},
+			{Name: "Invalid", Email: "invalid@example.com", CampaignID: "campaign123"},
+		}
+
+		accessKeys := []models.CampaignAccessKey{
+			{CampaignID: "campaign123", Email: "second@example.com", KeyHash: "second-hash", EncryptedCampaignKey: "sealed"},
+			{CampaignID: "campaign123", Email: "invalid@example.com", KeyHash: "invalid-hash", EncryptedCampaignKey: "sealed"},
+		}
+		assert.Error(t, repo.CreateMultiple(contributors, nil, accessKeys))
+
+		result, err := repo.GetContributorsByCampaignID("campaign123")
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		_, err = keyRepo.GetByKeyHash("campaign123", "second-hash")
+		assert.Error(t, err, "expected no key to be stored")
+	})
+}
+
+func TestContributorRepository_Leave(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewContributorRepository(db)
+
+	contributor := &models.Contributor{Name: "Paid", Email: "paid@example.com", CampaignID: "campaign1", Amount: 100}
+	assert.NoError(t, repo.Create(contributor, nil))
+	payment := models.NewManualPayment(contributor.ID, "campaign1", 100, nil)
+	payment.SetPaymentStatusToSuccess()
+	assert.NoError(t, db.Create(payment).Error)
+
+	contributor.Payment = payment
+	contributor.Leave(true)
+	assert.NoError(t, repo.Leave(contributor))
+
+	result, err := repo.GetContributorById(contributor.ID, true)
+	assert.NoError(t, err)
+	assert.True(t, result.HasLeft())
+	assert.Equal(t, models.PaymentStatusRefunded, result.Payment.PaymentStatus)
+}
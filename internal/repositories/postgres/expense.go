@@ -0,0 +1,48 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type expenseRepository struct {
+	db *gorm.DB
+}
+
+// NewExpenseRepository creates a new activity expense repository instance
+func NewExpenseRepository(db *gorm.DB) interfaces.ExpenseRepository {
+	return &expenseRepository{db: db}
+}
+
+// Create stores a new activity expense
+func (r *expenseRepository) Create(expense *models.ActivityExpense) error {
+	return r.db.Create(expense).Error
+}
+
+// Delete removes an activity expense
+func (r *expenseRepository) Delete(expense *models.ActivityExpense) error {
+	return r.db.Delete(expense).Error
+}
+
+// GetByID fetches an activity expense
+func (r *expenseRepository) GetByID(expenseID uint) (models.ActivityExpense, error) {
+	var expense models.ActivityExpense
+	err := r.db.First(&expense, expenseID).Error
+	return expense, err
+}
+
+// CreateReconciliation stores the reconciliation of an activity and its allocations
+func (r *expenseRepository) CreateReconciliation(reconciliation *models.ActivityReconciliation) error {
+	return r.db.Create(reconciliation).Error
+}
+
+// GetActivitiesByCampaignID fetches the activities of a campaign with everything needed to report on their budget
+func (r *expenseRepository) GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error) {
+	var activities []models.Activity
+	err := r.db.Preload("Contributors").Preload("Shares").
+		Preload("Expenses", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Reconciliation.Allocations").
+		Where("campaign_id = ?", campaignID).Order("id ASC").Find(&activities).Error
+	return activities, err
+}
@@ -0,0 +1,66 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpenseRepository_CreateAndDelete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewExpenseRepository(db)
+
+	activity, err := NewActivityRepo(db).Create(createTestActivity())
+	assert.NoError(t, err)
+
+	expense := models.NewActivityExpense(&activity, 40, "payer@example.com", "Boat rental", "test_handle")
+	expense.ReceiptURL = "https://test.com/receipt.jpg"
+	expense.ReceiptID = "receipt-1"
+	assert.NoError(t, repo.Create(expense))
+	assert.NotZero(t, expense.ID)
+
+	found, err := repo.GetByID(expense.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 40.0, found.Amount)
+	assert.Equal(t, "receipt-1", found.ReceiptID)
+
+	assert.NoError(t, repo.Delete(&found))
+	_, err = repo.GetByID(expense.ID)
+	assert.Error(t, err)
+}
+
+func TestExpenseRepository_GetActivitiesByCampaignID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewExpenseRepository(db)
+	activityRepo := NewActivityRepo(db)
+
+	activity, err := activityRepo.Create(createTestActivity())
+	assert.NoError(t, err)
+	target, err := activityRepo.Create(createTestActivity())
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.Create(models.NewActivityExpense(&activity, 30, "payer@example.com", "", "test_handle")))
+	assert.NoError(t, repo.Create(models.NewActivityExpense(&activity, 20, "payer@example.com", "", "test_handle")))
+
+	reconciliation := activity.Reconcile(activity.BudgetReport(0), models.ReconciliationResolutionRollover, &target.ID, "", "test_handle")
+	reconciliation.Allocations = []models.ReconciliationAllocation{{ContributorID: 1, Amount: 5}}
+	assert.NoError(t, repo.CreateReconciliation(reconciliation))
+
+	activities, err := repo.GetActivitiesByCampaignID(activity.CampaignID)
+	assert.NoError(t, err)
+	if assert.Len(t, activities, 2) {
+		assert.Len(t, activities[0].Expenses, 2)
+		assert.Equal(t, 50.0, activities[0].Spent())
+		if assert.NotNil(t, activities[0].Reconciliation) {
+			assert.Equal(t, target.ID, *activities[0].Reconciliation.TargetActivityID)
+			assert.Len(t, activities[0].Reconciliation.Allocations, 1)
+		}
+		assert.False(t, activities[1].IsReconciled())
+	}
+
+	// An activity is reconciled only once
+	assert.Error(t, repo.CreateReconciliation(&models.ActivityReconciliation{ActivityID: activity.ID, CampaignID: activity.CampaignID, Resolution: models.ReconciliationResolutionAbsorb, ReconciledByHandle: "test_handle"}))
+}
@@ -0,0 +1,53 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type joinRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewJoinRequestRepository creates a new join request repository instance
+func NewJoinRequestRepository(db *gorm.DB) interfaces.JoinRequestRepository {
+	return &joinRequestRepository{db: db}
+}
+
+// Create stores a new join request
+func (r *joinRequestRepository) Create(request *models.JoinRequest) error {
+	return r.db.Create(request).Error
+}
+
+// Update saves changes to a join request
+func (r *joinRequestRepository) Update(request *models.JoinRequest) error {
+	return r.db.Save(request).Error
+}
+
+// GetByID fetches a join request by ID
+func (r *joinRequestRepository) GetByID(requestID uint) (models.JoinRequest, error) {
+	var request models.JoinRequest
+	err := r.db.First(&request, requestID).Error
+	return request, err
+}
+
+// GetByCampaignID fetches the join requests of a campaign, all statuses are returned if status is empty
+func (r *joinRequestRepository) GetByCampaignID(campaignID string, status models.JoinRequestStatus) ([]models.JoinRequest, error) {
+	var requests []models.JoinRequest
+	query := r.db.Where("campaign_id = ?", campaignID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at DESC").Find(&requests).Error
+	return requests, err
+}
+
+// HasPendingRequest checks if email already has a pending request to join a campaign
+func (r *joinRequestRepository) HasPendingRequest(campaignID, email string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JoinRequest{}).
+		Where("campaign_id = ? AND email = ? AND status = ?", campaignID, email, models.JoinRequestStatusPending).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,69 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinRequestRepository_CreateAndGetByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewJoinRequestRepository(db)
+
+	request := models.NewJoinRequest("campaign-1", "Jane", "jane@example.com", "Count me in", 50)
+	err := repo.Create(request)
+	assert.NoError(t, err)
+	assert.NotZero(t, request.ID)
+
+	found, err := repo.GetByID(request.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "jane@example.com", found.Email)
+	assert.True(t, found.IsPending())
+}
+
+func TestJoinRequestRepository_GetByCampaignID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewJoinRequestRepository(db)
+
+	pending := models.NewJoinRequest("campaign-1", "Jane", "jane@example.com", "", 50)
+	rejected := models.NewJoinRequest("campaign-1", "John", "john@example.com", "", 50)
+	other := models.NewJoinRequest("campaign-2", "Jim", "jim@example.com", "", 50)
+	for _, request := range []*models.JoinRequest{pending, rejected, other} {
+		assert.NoError(t, repo.Create(request))
+	}
+
+	rejected.Reject()
+	assert.NoError(t, repo.Update(rejected))
+
+	all, err := repo.GetByCampaignID("campaign-1", "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	pendingOnly, err := repo.GetByCampaignID("campaign-1", models.JoinRequestStatusPending)
+	assert.NoError(t, err)
+	assert.Len(t, pendingOnly, 1)
+	assert.Equal(t, "jane@example.com", pendingOnly[0].Email)
+}
+
+func TestJoinRequestRepository_HasPendingRequest(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewJoinRequestRepository(db)
+
+	request := models.NewJoinRequest("campaign-1", "Jane", "jane@example.com", "", 50)
+	assert.NoError(t, repo.Create(request))
+
+	hasPending, err := repo.HasPendingRequest("campaign-1", "jane@example.com")
+	assert.NoError(t, err)
+	assert.True(t, hasPending)
+
+	request.Approve()
+	assert.NoError(t, repo.Update(request))
+
+	hasPending, err = repo.HasPendingRequest("campaign-1", "jane@example.com")
+	assert.NoError(t, err)
+	assert.False(t, hasPending)
+}
@@ -0,0 +1,51 @@
+package postgress
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+)
+
+type pollRepository struct {
+	db *gorm.DB
+}
+
+// NewPollRepository creates a new poll repository instance
+func NewPollRepository(db *gorm.DB) interfaces.PollRepository {
+	return &pollRepository{db: db}
+}
+
+// Create stores a new poll and its options
+func (r *pollRepository) Create(poll *models.Poll) error {
+	return r.db.Create(poll).Error
+}
+
+// Update saves the deadline and closing time of a poll, votes are stored with AddVotes
+func (r *pollRepository) Update(poll *models.Poll) error {
+	return r.db.Omit("Options", "Votes").Save(poll).Error
+}
+
+// AddVotes stores a contributor's votes on a poll
+func (r *pollRepository) AddVotes(votes []models.PollVote) error {
+	return r.db.Create(&votes).Error
+}
+
+// GetByID fetches a poll with its options and votes
+func (r *pollRepository) GetByID(pollID uint) (models.Poll, error) {
+	var poll models.Poll
+	err := r.db.Preload("Options", orderPollOptions).Preload("Votes").First(&poll, pollID).Error
+	return poll, err
+}
+
+// GetByCampaignID fetches the polls of a campaign, newest first
+func (r *pollRepository) GetByCampaignID(campaignID string) ([]models.Poll, error) {
+	var polls []models.Poll
+	err := r.db.Preload("Options", orderPollOptions).Preload("Votes").
+		Where("campaign_id = ?", campaignID).Order("created_at DESC").Find(&polls).Error
+	return polls, err
+}
+
+// orderPollOptions preloads the options of a poll in the order they were written
+func orderPollOptions(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
@@ -0,0 +1,64 @@
+package postgress
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollRepository_CreateAndVote(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewPollRepository(db)
+
+	poll := models.NewPoll("campaign-1", "Where should we eat?", []string{"Pizza", "Sushi", "Tacos"}, true, nil, "creator")
+	assert.NoError(t, repo.Create(poll))
+	assert.NotZero(t, poll.ID)
+
+	found, err := repo.GetByID(poll.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, found.Options, 3) {
+		assert.Equal(t, "Pizza", found.Options[0].Text)
+		assert.Equal(t, "Tacos", found.Options[2].Text)
+	}
+
+	votes, err := found.Vote(1, []uint{found.Options[0].ID, found.Options[2].ID})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.AddVotes(votes))
+
+	// A contributor can only choose an option once
+	assert.Error(t, repo.AddVotes([]models.PollVote{{PollID: poll.ID, OptionID: found.Options[0].ID, ContributorID: 1}}))
+
+	found, err = repo.GetByID(poll.ID)
+	assert.NoError(t, err)
+	found.Tally()
+	assert.Equal(t, 1, found.TotalVoters)
+	assert.Equal(t, 1, found.Options[0].Votes)
+	assert.Equal(t, 0, found.Options[1].Votes)
+}
+
+func TestPollRepository_CloseAndGetByCampaignID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewPollRepository(db)
+
+	first := models.NewPoll("campaign-1", "First question?", []string{"Yes", "No"}, false, nil, "creator")
+	second := models.NewPoll("campaign-1", "Second question?", []string{"Yes", "No"}, false, nil, "creator")
+	other := models.NewPoll("campaign-2", "Other question?", []string{"Yes", "No"}, false, nil, "creator")
+	for _, poll := range []*models.Poll{first, second, other} {
+		assert.NoError(t, repo.Create(poll))
+	}
+
+	first.Close()
+	assert.NoError(t, repo.Update(first))
+
+	polls, err := repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Len(t, polls, 2)
+
+	found, err := repo.GetByID(first.ID)
+	assert.NoError(t, err)
+	assert.False(t, found.IsOpen())
+	assert.Len(t, found.Options, 2)
+}
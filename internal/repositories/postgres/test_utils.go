@@ -35,6 +35,32 @@ func setupTestDB(t *testing.T) (*gorm.DB, func()) {
 		&models.Payout{},
 		&models.Activity{},
 		&models.CampaignImage{},
+		&models.CampaignAccessKey{},
+		&models.JoinRequest{},
+		&models.ContributorRequest{},
+		&models.CampaignExtension{},
+		&models.CampaignExtensionVote{},
+		&models.CampaignMilestone{},
+		&models.CampaignUserRole{},
+		&models.CalendarFeed{},
+		&models.ActivityVote{},
+		&models.Poll{},
+		&models.PollOption{},
+		&models.PollVote{},
+		&models.ActivityExpense{},
+		&models.ActivityReconciliation{},
+		&models.ReconciliationAllocation{},
+		&models.CommentRevision{},
+		&models.CommentReaction{},
+		&models.CommentReadMarker{},
+		&models.ChatMessage{},
+		&models.ChatReceipt{},
+		&models.CommentReport{},
+		&models.CommentModerationLog{},
+		&models.ActivityShare{},
+		&models.ActivityWaitlistEntry{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
 		&models.Payment{})
 	require.NoError(t, err)
 
@@ -0,0 +1,149 @@
+package postgress
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new webhook repository instance
+func NewWebhookRepository(db *gorm.DB) interfaces.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// Create stores a new webhook
+func (r *webhookRepository) Create(webhook *models.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+// Update saves changes to an existing webhook
+func (r *webhookRepository) Update(webhook *models.Webhook) error {
+	return r.db.Save(webhook).Error
+}
+
+// Delete removes a webhook along with its delivery log
+func (r *webhookRepository) Delete(webhook *models.Webhook) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", webhook.ID).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(webhook).Error
+	})
+}
+
+// GetByID fetches a webhook by its ID
+func (r *webhookRepository) GetByID(webhookID uint) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := r.db.First(&webhook, webhookID).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetByCampaignID fetches the webhooks of a campaign, oldest first
+func (r *webhookRepository) GetByCampaignID(campaignID string) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	err := r.db.Where("campaign_id = ?", campaignID).Order("created_at ASC").Find(&webhooks).Error
+	return webhooks, err
+}
+
+// GetActiveByCampaignID fetches the webhooks of a campaign that haven't been disabled
+func (r *webhookRepository) GetActiveByCampaignID(campaignID string) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	err := r.db.Where("campaign_id = ? AND disabled_at IS NULL", campaignID).Find(&webhooks).Error
+	return webhooks, err
+}
+
+// RecordDeliveryAttempt counts the failed attempts in a row of a webhook and disables it once they reach failureLimit,
+// disabled is true when this attempt disabled it. The count is updated in the database as deliveries are sent in parallel
+func (r *webhookRepository) RecordDeliveryAttempt(webhookID uint, succeeded bool, failureLimit int) (bool, error) {
+	if succeeded {
+		return false, r.db.Model(&models.Webhook{}).Where("id = ? AND consecutive_failures > 0", webhookID).
+			Update("consecutive_failures", 0).Error
+	}
+
+	disabled := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Webhook{}).Where("id = ?", webhookID).
+			Update("consecutive_failures", gorm.Expr("consecutive_failures + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Webhook{}).
+			Where("id = ? AND disabled_at IS NULL AND consecutive_failures >= ?", webhookID, failureLimit).
+			Update("disabled_at", time.Now().UTC())
+		disabled = result.RowsAffected > 0
+		return result.Error
+	})
+	return disabled, err
+}
+
+// CreateDelivery stores a new delivery, created is false when a delivery with the same ID already exists
+func (r *webhookRepository) CreateDelivery(delivery *models.WebhookDelivery) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(delivery)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateDelivery saves the outcome of a delivery attempt, the encrypted payload is left as it is
+func (r *webhookRepository) UpdateDelivery(delivery *models.WebhookDelivery) error {
+	return r.db.Model(delivery).
+		Select("status", "attempts", "response_status", "response_body", "error", "next_attempt_at", "delivered_at", "updated_at").
+		Updates(delivery).Error
+}
+
+// GetDelivery fetches a delivery of a webhook
+func (r *webhookRepository) GetDelivery(webhookID uint, deliveryID string) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := r.db.Where("id = ? AND webhook_id = ?", deliveryID, webhookID).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// GetDeliveries fetches the latest deliveries of a webhook, all statuses are fetched when status is empty
+func (r *webhookRepository) GetDeliveries(webhookID uint, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error) {
+	query := r.db.Where("webhook_id = ?", webhookID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var deliveries []models.WebhookDelivery
+	err := query.Order("created_at DESC").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// ClaimDueDeliveries fetches the pending deliveries due by now and pushes their next attempt back by lease,
+// so other instances skip them while they are sent. A delivery whose sender stops is tried again once the lease runs out
+func (r *webhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at ASC").Limit(limit).Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(lease)).Error
+	})
+	return deliveries, err
+}
+
+// AbandonPendingDeliveries fails the deliveries of a webhook that are still being tried
+func (r *webhookRepository) AbandonPendingDeliveries(webhookID uint, reason string) error {
+	return r.db.Model(&models.WebhookDelivery{}).
+		Where("webhook_id = ? AND status = ?", webhookID, models.WebhookDeliveryPending).
+		Updates(map[string]interface{}{"status": models.WebhookDeliveryFailed, "error": reason, "next_attempt_at": nil}).Error
+}
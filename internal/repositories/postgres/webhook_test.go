@@ -0,0 +1,190 @@
+package postgress
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWebhook(campaignID string) *models.Webhook {
+	return &models.Webhook{
+		CampaignID:      campaignID,
+		URL:             "https://example.com/hooks",
+		EventTypes:      []string{"campaign_updated"},
+		EncryptedSecret: "sealed",
+		CreatedByHandle: "creator",
+	}
+}
+
+func TestWebhookRepository_CreateAndGet(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewWebhookRepository(db)
+
+	active := newTestWebhook("campaign-1")
+	disabled := newTestWebhook("campaign-1")
+	other := newTestWebhook("campaign-2")
+	for _, webhook := range []*models.Webhook{active, disabled, other} {
+		require.NoError(t, repo.Create(webhook))
+	}
+	disabled.Disable()
+	require.NoError(t, repo.Update(disabled))
+
+	found, err := repo.GetByID(active.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"campaign_updated"}, found.EventTypes)
+
+	webhooks, err := repo.GetByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	assert.Len(t, webhooks, 2)
+
+	webhooks, err = repo.GetActiveByCampaignID("campaign-1")
+	assert.NoError(t, err)
+	if assert.Len(t, webhooks, 1) {
+		assert.Equal(t, active.ID, webhooks[0].ID)
+	}
+}
+
+func TestWebhookRepository_RecordDeliveryAttempt(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewWebhookRepository(db)
+
+	webhook := newTestWebhook("campaign-1")
+	require.NoError(t, repo.Create(webhook))
+
+	disabled, err := repo.RecordDeliveryAttempt(webhook.ID, false, 3)
+	assert.NoError(t, err)
+	assert.False(t, disabled)
+
+	// A successful attempt starts the count again
+	_, err = repo.RecordDeliveryAttempt(webhook.ID, true, 3)
+	assert.NoError(t, err)
+
+	for range 2 {
+		disabled, err = repo.RecordDeliveryAttempt(webhook.ID, false, 3)
+		assert.NoError(t, err)
+		assert.False(t, disabled)
+	}
+	disabled, err = repo.RecordDeliveryAttempt(webhook.ID, false, 3)
+	assert.NoError(t, err)
+	assert.True(t, disabled)
+
+	// The webhook is only reported disabled once
+	disabled, err = repo.RecordDeliveryAttempt(webhook.ID, false, 3)
+	assert.NoError(t, err)
+	assert.False(t, disabled)
+
+	found, err := repo.GetByID(webhook.ID)
+	assert.NoError(t, err)
+	assert.True(t, found.IsDisabled())
+	assert.Equal(t, 4, found.ConsecutiveFailures)
+}
+
+func TestWebhookRepository_Deliveries(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewWebhookRepository(db)
+
+	webhook := newTestWebhook("campaign-1")
+	require.NoError(t, repo.Create(webhook))
+
+	delivery := models.NewWebhookDelivery(webhook.ID, "evt-1", "campaign_updated", "{}")
+	created, err := repo.CreateDelivery(delivery)
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	// Another instance receiving the same event doesn't create a second delivery
+	created, err = repo.CreateDelivery(models.NewWebhookDelivery(webhook.ID, "evt-1", "campaign_updated", "{}"))
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	delivery.Payload = "changed"
+	delivery.RecordFailure(500, "", errors.New("boom"))
+	require.NoError(t, repo.UpdateDelivery(delivery))
+	_, err = repo.CreateDelivery(models.NewWebhookDelivery(webhook.ID, "evt-2", "campaign_updated", "{}"))
+	require.NoError(t, err)
+
+	found, err := repo.GetDelivery(webhook.ID, delivery.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, found.Attempts)
+	assert.Equal(t, "boom", found.Error)
+	assert.Equal(t, "{}", found.Payload, "expected the stored payload to be left as it is")
+
+	_, err = repo.GetDelivery(webhook.ID+1, delivery.ID)
+	assert.Error(t, err)
+
+	deliveries, err := repo.GetDeliveries(webhook.ID, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+
+	deliveries, err = repo.GetDeliveries(webhook.ID, models.WebhookDeliveryFailed, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestWebhookRepository_ClaimDueDeliveries(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewWebhookRepository(db)
+
+	webhook := newTestWebhook("campaign-1")
+	require.NoError(t, repo.Create(webhook))
+
+	due := models.NewWebhookDelivery(webhook.ID, "evt-1", "campaign_updated", "{}")
+	retrying := models.NewWebhookDelivery(webhook.ID, "evt-2", "campaign_updated", "{}")
+	retrying.RecordFailure(500, "", errors.New("boom"))
+	for _, delivery := range []*models.WebhookDelivery{due, retrying} {
+		_, err := repo.CreateDelivery(delivery)
+		require.NoError(t, err)
+	}
+
+	now := time.Now().Add(time.Second)
+	claimed, err := repo.ClaimDueDeliveries(now, time.Minute, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, claimed, 1) {
+		assert.Equal(t, due.ID, claimed[0].ID)
+	}
+
+	// Claimed deliveries aren't handed out again until the lease runs out
+	claimed, err = repo.ClaimDueDeliveries(now, time.Minute, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	claimed, err = repo.ClaimDueDeliveries(now.Add(2*time.Minute), time.Minute, 10)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+
+	require.NoError(t, repo.AbandonPendingDeliveries(webhook.ID, "Webhook was disabled"))
+	claimed, err = repo.ClaimDueDeliveries(now.Add(time.Hour), time.Minute, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	found, err := repo.GetDelivery(webhook.ID, due.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryFailed, found.Status)
+	assert.Equal(t, "Webhook was disabled", found.Error)
+}
+
+func TestWebhookRepository_Delete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	repo := NewWebhookRepository(db)
+
+	webhook := newTestWebhook("campaign-1")
+	require.NoError(t, repo.Create(webhook))
+	_, err := repo.CreateDelivery(models.NewWebhookDelivery(webhook.ID, "evt-1", "campaign_updated", "{}"))
+	require.NoError(t, err)
+
+	assert.NoError(t, repo.Delete(webhook))
+
+	_, err = repo.GetByID(webhook.ID)
+	assert.Error(t, err)
+	deliveries, err := repo.GetDeliveries(webhook.ID, "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
@@ -1,43 +1,52 @@
 package services
 
 import (
+	"time"
+
 	"github.com/oyen-bright/goFundIt/internal/models"
 	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
 	"github.com/oyen-bright/goFundIt/pkg/database"
 	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
 
 type activityService struct {
 	repo                repositories.ActivityRepository
+	commentRepo         repositories.CommentRepository
 	authService         services.AuthService
 	analyticsService    services.AnalyticsService
 	campaignService     services.CampaignService
 	notificationService services.NotificationService
 	broadcaster         services.EventBroadcaster
+	storage             storage.Storage
 	logger              logger.Logger
 	runAsync            func(func())
 }
 
 func NewActivityService(
 	repo repositories.ActivityRepository,
+	commentRepo repositories.CommentRepository,
 	authService services.AuthService,
 	campaignService services.CampaignService,
 	eventBroadcaster services.EventBroadcaster,
 	analyticsService services.AnalyticsService,
 
 	notificationService services.NotificationService,
+	storage storage.Storage,
 	logger logger.Logger,
 ) services.ActivityService {
 	return &activityService{
 		repo:                repo,
+		commentRepo:         commentRepo,
 		analyticsService:    analyticsService,
 		notificationService: notificationService,
 		authService:         authService,
 		campaignService:     campaignService,
 		broadcaster:         eventBroadcaster,
+		storage:             storage,
 		logger:              logger,
 		runAsync:            func(f func()) { go f() },
 	}
@@ -58,6 +67,13 @@ func (s *activityService) CreateActivity(activity models.Activity, userHandle, c
 			nil,
 		)
 	}
+	if !can(userHandle, campaign, models.CampaignActionParticipate) {
+		return models.Activity{}, errs.Forbidden("Viewers can't add activities to a campaign")
+	}
+
+	if err := validateActivitySchedule(&activity, nil); err != nil {
+		return models.Activity{}, err
+	}
 
 	// Setup activity
 	s.setupActivity(&activity, campaign, user)
@@ -71,7 +87,7 @@ func (s *activityService) CreateActivity(activity models.Activity, userHandle, c
 	s.runAsync(func() {
 		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityCreated, createdActivity)
 		s.notificationService.NotifyActivityAddition(&activity, campaign)
-		if campaign.CreatedBy.Handle != createdActivity.CreatedBy.Handle {
+		if !createdActivity.IsApproved {
 			s.notificationService.NotifyActivityApprovalRequest(&activity, campaign)
 		}
 		s.analyticsService.GetCurrentData().IncrementActivities()
@@ -113,31 +129,78 @@ func (s *activityService) ApproveActivity(activityID uint, userHandle, key strin
 		return nil, err
 	}
 
-	if campaign.CreatedBy.Handle != userHandle {
-		return nil, errs.Forbidden("Unauthorize: only campaign creator can approve activity")
+	if !can(userHandle, campaign, models.CampaignActionManageActivities) {
+		return nil, errs.Forbidden("Unauthorize: only campaign organisers can approve activity")
 	}
 
-	// approve activity
-	activity.ApproveActivity()
-	err = s.repo.Update(&activity)
+	if err := s.approveActivity(&activity, campaign); err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+// VoteOnActivity records a contributor's vote on a proposed activity, the activity is approved once
+// the approvals reach the campaign's activity vote threshold
+func (s *activityService) VoteOnActivity(activityID uint, campaignID, userEmail, key string, approve bool) (*models.Activity, error) {
+	activity, err := s.GetActivityByID(activityID, campaignID)
 	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	contributor := campaign.GetContributorByEmail(userEmail)
+	if contributor == nil || !contributor.Invitation.IsAccepted() {
+		return nil, errs.Forbidden("Only campaign contributors can vote on activities")
+	}
+	if activity.IsApproved {
+		return nil, errs.BadRequest("Activity has already been approved", nil)
+	}
+	if activity.HasVoted(contributor.ID) {
+		return nil, errs.BadRequest("You have already voted on this activity", nil)
+	}
+
+	if err := s.repo.AddVote(activity.AddVote(contributor.ID, approve)); err != nil {
 		return nil, errs.InternalServerError(err).Log(s.logger)
 	}
 
-	// broadcast event
-	go s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeActivityUpdated, activity)
+	if activity.Tally(campaign.ActivityVoteThreshold).HasReachedThreshold() {
+		if err := s.approveActivity(&activity, campaign); err != nil {
+			return nil, err
+		}
+	}
+
+	tally := activity.Tally(campaign.ActivityVoteThreshold)
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityVoteUpdated, tally)
+	})
 
-	// send notification
-	go s.notificationService.NotifyActivityApproved(&activity, campaign)
 	return &activity, nil
 }
 
 // GetActivitiesByCampaignID retrieves all activities for a campaign
-func (s *activityService) GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error) {
+func (s *activityService) GetActivitiesByCampaignID(campaignID, userHandle string) ([]models.Activity, error) {
 	activities, err := s.repo.GetByCampaignID(campaignID)
 	if err != nil {
 		return nil, (errs.InternalServerError(err)).Log(s.logger)
 	}
+
+	// Each activity shows how many of its comments the user hasn't read
+	activityIDs := make([]uint, len(activities))
+	for i, activity := range activities {
+		activityIDs[i] = activity.ID
+	}
+	unread, err := s.commentRepo.CountUnread(userHandle, activityIDs)
+	if err != nil {
+		return nil, (errs.InternalServerError(err)).Log(s.logger)
+	}
+	for i := range activities {
+		activities[i].UnreadComments = unread[activities[i].ID]
+	}
+
 	return activities, nil
 }
 
@@ -173,10 +236,36 @@ func (s *activityService) UpdateActivity(activity *models.Activity, userHandle s
 		return errs.BadRequest("Cannot update activity with paid contributors", activity)
 	}
 
+	if err := validateActivitySchedule(activity, &existingActivity); err != nil {
+		return err
+	}
+
 	if err := s.repo.Update(activity); err != nil {
 		return (errs.InternalServerError(err)).Log(s.logger)
 	}
 
+	// A larger capacity frees seats for the waitlist
+	existingActivity.Capacity = activity.Capacity
+	if len(existingActivity.Waitlist) > 0 && !existingActivity.IsFull() {
+		campaign, err := s.campaignService.GetCampaignByIDWithContributors(activity.CampaignID)
+		if err != nil {
+			return err
+		}
+		promoted, err := s.promoteFromWaitlist(campaign, &existingActivity)
+		if err != nil {
+			return err
+		}
+		activity.Contributors = existingActivity.Contributors
+		activity.Waitlist = existingActivity.Waitlist
+
+		s.runAsync(func() {
+			s.campaignService.RecalculateTargetAmount(activity.CampaignID)
+			for _, contributor := range promoted {
+				s.notificationService.NotifyWaitlistPromoted(&contributor, activity, campaign)
+			}
+		})
+	}
+
 	// Broadcast update
 	go s.broadcaster.NewEvent(activity.CampaignID, websocket.EventTypeActivityUpdated, activity)
 
@@ -201,6 +290,7 @@ func (s *activityService) DeleteActivityByID(activityID uint, campaignID, userHa
 	if err := s.repo.Delete(&activity); err != nil {
 		return (errs.InternalServerError(err)).Log(s.logger)
 	}
+	deleteStoredFiles(s.storage, s.logger, s.runAsync, activity.ImageFileIDs())
 
 	// Broadcast update
 	go s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityDeleted, activityID)
@@ -208,27 +298,53 @@ func (s *activityService) DeleteActivityByID(activityID uint, campaignID, userHa
 	return nil
 }
 
-// OptInContributor opts in a contributor to an activity
-func (s *activityService) OptInContributor(campaignID, userEmail, key string, activityID, contributorID uint) error {
+// OptInContributor opts in a contributor to an activity, contributors join the waitlist of a full activity.
+// It reports whether the contributor was waitlisted
+func (s *activityService) OptInContributor(campaignID, userEmail, key string, activityID, contributorID uint) (bool, error) {
 
 	// Validate campaign and user
 	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	//Validate Contributor and Activity
 	contributor, activity, err := s.validateContributorActivityForOptInOptOut(campaign, contributorID, activityID, userEmail)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if activity.IsContributorOptedIn(contributorID) {
-		return errs.BadRequest("Contributor has already opted in.", nil)
+		return false, errs.BadRequest("Contributor has already opted in.", nil)
+	}
+	if activity.IsContributorWaitlisted(contributorID) {
+		return false, errs.BadRequest("Contributor is already on the waitlist.", nil)
+	}
+	if activity.HasSignUpClosed() {
+		return false, errs.BadRequest("Sign-up for this activity has closed.", nil)
+	}
+	if campaign.HasOverduePayment(contributor) {
+		return false, errs.BadRequest("Your payment for a mandatory activity is overdue, pay it before joining other activities.", nil)
 	}
 
-	if err := s.repo.AddContributor(activity.ID, contributor.ID); err != nil {
-		return (errs.InternalServerError(err)).Log(s.logger)
+	if !activity.IsFull() {
+		if err := validateSharedCostChange(activity); err != nil {
+			return false, err
+		}
+	}
+
+	// The repository checks the free seats again with the activity locked, so it decides whether the contributor is waitlisted
+	waitlisted, err := s.repo.OptInContributor(activity.ID, contributor.ID)
+	if err != nil {
+		return false, (errs.InternalServerError(err)).Log(s.logger)
+	}
+
+	if waitlisted {
+		activity.AddToWaitlist(contributor.ID)
+		s.runAsync(func() {
+			s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+		})
+		return true, nil
 	}
 
 	// Broadcast update
@@ -236,12 +352,14 @@ func (s *activityService) OptInContributor(campaignID, userEmail, key string, ac
 	// go s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
 	s.runAsync(func() {
 		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+		s.campaignService.RecalculateTargetAmount(campaignID)
 	})
 
-	return nil
+	return false, nil
 }
 
-// OptOutContributor opts out a contributor from an activity
+// OptOutContributor opts out a contributor from an activity or takes them off its waitlist.
+// The seat a participant frees is given to the first contributor on the waitlist
 func (s *activityService) OptOutContributor(campaignID, userEmail, key string, activityID, contributorID uint) error {
 	// Validate campaign and user
 	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
@@ -254,21 +372,84 @@ func (s *activityService) OptOutContributor(campaignID, userEmail, key string, a
 	if err != nil {
 		return err
 	}
+
+	if activity.IsContributorWaitlisted(contributorID) {
+		if err := s.repo.RemoveFromWaitlist(activityID, contributor.ID); err != nil {
+			return (errs.InternalServerError(err)).Log(s.logger)
+		}
+
+		activity.RemoveFromWaitlist(contributor.ID)
+		s.runAsync(func() {
+			s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+		})
+		return nil
+	}
+
 	if !activity.IsContributorOptedIn(contributorID) {
 		return errs.BadRequest("Contributor has already opted out.", nil)
 	}
+	if err := validateSharedCostChange(activity); err != nil {
+		return err
+	}
 
 	if err := s.repo.RemoveContributor(activityID, contributor.ID); err != nil {
 		return (errs.InternalServerError(err)).Log(s.logger)
 	}
+	activity.RemoveContributor(*contributor)
+
+	promoted, err := s.promoteFromWaitlist(campaign, activity)
+	if err != nil {
+		return err
+	}
 
 	// Broadcast update
-	activity.RemoveContributor(*contributor)
-	go s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+		s.campaignService.RecalculateTargetAmount(campaignID)
+		for _, contributor := range promoted {
+			s.notificationService.NotifyWaitlistPromoted(&contributor, activity, campaign)
+		}
+	})
 
 	return nil
 }
 
+// UpdateActivitySplit changes how the cost of an activity is shared by its participants.
+// Weights are used by weighted splits and amounts by custom splits, shares can only be set for participants
+func (s *activityService) UpdateActivitySplit(activityID uint, campaignID, userHandle, key string, strategy models.SplitStrategy, shares []models.ActivityShare) (*models.Activity, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+	if !can(userHandle, campaign, models.CampaignActionManageActivities) {
+		return nil, errs.Forbidden("Only campaign organisers can change how an activity is split")
+	}
+
+	activity := campaign.GetActivityById(activityID)
+	if activity == nil {
+		return nil, errs.NotFound("Activity not found in this campaign.")
+	}
+	if activity.GetPaidContributorsCount() > 0 {
+		return nil, errs.BadRequest("Cannot change the split of an activity with paid contributors", nil)
+	}
+
+	if err := validateActivityShares(activity, strategy, shares); err != nil {
+		return nil, err
+	}
+
+	activity.SetSplit(strategy, shares)
+	if err := s.repo.UpdateSplit(activity); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+		s.campaignService.RecalculateTargetAmount(campaignID)
+	})
+
+	return activity, nil
+}
+
 // GetParticipants retrieves all contributors for a specific activity
 func (s *activityService) GetParticipants(activityID uint, campaignID, key string) ([]models.Contributor, error) {
 
@@ -309,7 +490,15 @@ func (s *activityService) setupActivity(activity *models.Activity, campaign *mod
 	activity.UpdateCreatedBy(*user)
 	activity.UpdateCampaignId(campaign.ID)
 
-	if activity.CreatedByHandle == campaign.CreatedByHandle {
+	// Shares are set and the waitlist is filled once contributors opt in
+	activity.Shares = nil
+	activity.Waitlist = nil
+
+	// Images are uploaded once the activity exists
+	activity.SetImage("", "", "", "")
+
+	// Activities added by organisers don't need approval
+	if can(activity.CreatedByHandle, campaign, models.CampaignActionManageActivities) {
 		activity.ApproveActivity()
 	} else {
 		activity.MarkAsNotMandatory()
@@ -359,3 +548,120 @@ func (s *activityService) validateContributorActivityForOptInOptOut(campaign *mo
 
 	return contributor, activity, nil
 }
+
+// promoteFromWaitlist gives the free seats of an activity to the waitlisted contributors in the order they joined,
+// contributors who paid or no longer hold a place in the campaign are skipped
+func (s *activityService) promoteFromWaitlist(campaign *models.Campaign, activity *models.Activity) ([]models.Contributor, error) {
+	var promoted []models.Contributor
+	waitlist := append([]models.ActivityWaitlistEntry(nil), activity.Waitlist...)
+
+	for _, entry := range waitlist {
+		if activity.IsFull() {
+			break
+		}
+
+		contributor := campaign.GetContributorByID(entry.ContributorID)
+		if contributor == nil || !contributor.Invitation.IsOpen() || contributor.HasPaid() {
+			continue
+		}
+
+		if err := s.repo.PromoteFromWaitlist(activity.ID, contributor.ID); err != nil {
+			return nil, (errs.InternalServerError(err)).Log(s.logger)
+		}
+		activity.RemoveFromWaitlist(contributor.ID)
+		activity.AddContributor(*contributor)
+		promoted = append(promoted, *contributor)
+	}
+
+	return promoted, nil
+}
+
+// approveActivity approves an activity and notifies the campaign, organisers approve activities directly and contributors by vote
+func (s *activityService) approveActivity(activity *models.Activity, campaign *models.Campaign) error {
+	activity.ApproveActivity()
+	if err := s.repo.Update(activity); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	approved := *activity
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeActivityUpdated, approved)
+		s.notificationService.NotifyActivityApproved(&approved, campaign)
+	})
+	return nil
+}
+
+// validateActivitySchedule checks a new sign-up deadline or payment due date is still ahead, dates kept from the
+// previous version of the activity are not checked. It also checks the activity ends after it starts and its location is complete
+func validateActivitySchedule(activity, previous *models.Activity) error {
+	var previousDeadline, previousDueDate *time.Time
+	if previous != nil {
+		previousDeadline, previousDueDate = previous.SignUpDeadline, previous.PaymentDueDate
+	}
+
+	if isNewPastDate(activity.SignUpDeadline, previousDeadline) {
+		return errs.BadRequest("Sign-up deadline must be in the future", activity.SignUpDeadline)
+	}
+	if isNewPastDate(activity.PaymentDueDate, previousDueDate) {
+		return errs.BadRequest("Payment due date must be in the future", activity.PaymentDueDate)
+	}
+
+	if activity.EndsAt != nil && (activity.StartsAt == nil || !activity.EndsAt.After(*activity.StartsAt)) {
+		return errs.BadRequest("Activity must end after it starts", activity.EndsAt)
+	}
+	if (activity.Latitude == nil) != (activity.Longitude == nil) {
+		return errs.BadRequest("Latitude and longitude must be set together", nil)
+	}
+	return nil
+}
+
+// isNewPastDate checks if a date was changed to one that has already passed
+func isNewPastDate(date, previous *time.Time) bool {
+	if date == nil || (previous != nil && date.Equal(*previous)) {
+		return false
+	}
+	return date.Before(time.Now())
+}
+
+// validateSharedCostChange stops participants joining or leaving a shared activity once someone paid their share,
+// as every participant's share changes with the number of participants
+func validateSharedCostChange(activity *models.Activity) error {
+	if activity.GetSplitStrategy() != models.SplitStrategyPerPerson && activity.GetPaidContributorsCount() > 0 {
+		return errs.BadRequest("The cost of this activity is shared and a participant has already paid.", nil)
+	}
+	return nil
+}
+
+func validateActivityShares(activity *models.Activity, strategy models.SplitStrategy, shares []models.ActivityShare) error {
+	if !strategy.IsValid() {
+		return errs.BadRequest("Split strategy must be one of per_person, equal, weighted or custom", strategy)
+	}
+	if len(shares) > 0 && strategy != models.SplitStrategyWeighted && strategy != models.SplitStrategyCustom {
+		return errs.BadRequest("Shares can only be set for weighted and custom splits", nil)
+	}
+
+	seen := make(map[uint]bool, len(shares))
+	var customTotal float64
+	for _, share := range shares {
+		if !activity.IsContributorOptedIn(share.ContributorID) {
+			return errs.BadRequest("Shares can only be set for participants of the activity", share.ContributorID)
+		}
+		if seen[share.ContributorID] {
+			return errs.BadRequest("A participant can only have one share", share.ContributorID)
+		}
+		seen[share.ContributorID] = true
+
+		if strategy == models.SplitStrategyWeighted && share.Weight <= 0 {
+			return errs.BadRequest("Weights must be greater than 0", share.ContributorID)
+		}
+		if strategy == models.SplitStrategyCustom && share.Amount < 0 {
+			return errs.BadRequest("Amounts cannot be negative", share.ContributorID)
+		}
+		customTotal += share.Amount
+	}
+
+	if strategy == models.SplitStrategyCustom && customTotal > activity.Cost {
+		return errs.BadRequest("Custom amounts cannot add up to more than the activity cost", nil)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"testing"
+	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
@@ -35,6 +36,9 @@ func TestCreateActivity(t *testing.T) {
 		runAsync:            func(f func()) { f() },
 	}
 
+	startsAt, endsBeforeStart := time.Now().Add(48*time.Hour), time.Now().Add(24*time.Hour)
+	latitude := 6.4
+
 	tests := []struct {
 		name        string
 		activity    models.Activity
@@ -131,6 +135,46 @@ func TestCreateActivity(t *testing.T) {
 			wantErr:     true,
 			expectedErr: "Sorry, you can't add activities to campaigns you're not part of. Join the campaign to get started!",
 		},
+		{
+			name: "creation fails - activity ends before it starts",
+			activity: models.Activity{
+				Title:    "Test Activity",
+				Cost:     100,
+				StartsAt: &startsAt,
+				EndsAt:   &endsBeforeStart,
+			},
+			userHandle:  "user1",
+			campaignID:  "campaign1",
+			campaignKey: "campaignKey",
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaignKey").Return(
+					&models.Campaign{ID: "campaign1", CreatedBy: models.User{Handle: "user1", Email: "user1@test.com"}}, nil,
+				)
+				mockAuth.EXPECT().GetUserByHandle("user1").Return(models.User{Handle: "user1", Email: "user1@test.com"}, nil)
+			},
+			wantErr:     true,
+			expectedErr: "Activity must end after it starts",
+		},
+		{
+			name: "creation fails - latitude without longitude",
+			activity: models.Activity{
+				Title:    "Test Activity",
+				Cost:     100,
+				Location: "Pier 4",
+				Latitude: &latitude,
+			},
+			userHandle:  "user1",
+			campaignID:  "campaign1",
+			campaignKey: "campaignKey",
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaignKey").Return(
+					&models.Campaign{ID: "campaign1", CreatedBy: models.User{Handle: "user1", Email: "user1@test.com"}}, nil,
+				)
+				mockAuth.EXPECT().GetUserByHandle("user1").Return(models.User{Handle: "user1", Email: "user1@test.com"}, nil)
+			},
+			wantErr:     true,
+			expectedErr: "Latitude and longitude must be set together",
+		},
 	}
 
 	for _, tt := range tests {
@@ -169,15 +213,16 @@ func TestApproveActivity(t *testing.T) {
 	mockLogger := mockLogger.NewMockLogger(t)
 
 	// Create service
-	service := NewActivityService(
-		mockRepo,
-		mockAuth,
-		mockCampaign,
-		mockBroadcaster,
-		mockAnalytics,
-		mockNotification,
-		mockLogger,
-	)
+	service := &activityService{
+		repo:                mockRepo,
+		authService:         mockAuth,
+		campaignService:     mockCampaign,
+		broadcaster:         mockBroadcaster,
+		analyticsService:    mockAnalytics,
+		notificationService: mockNotification,
+		logger:              mockLogger,
+		runAsync:            func(f func()) { f() },
+	}
 
 	tests := []struct {
 		name        string
@@ -201,7 +246,7 @@ func TestApproveActivity(t *testing.T) {
 						CampaignID: "campaign1",
 						IsApproved: false,
 					}, nil,
-				)
+				).Once()
 
 				// Mock GetCampaignByID
 				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
@@ -209,23 +254,23 @@ func TestApproveActivity(t *testing.T) {
 						ID:        "campaign1",
 						CreatedBy: models.User{Handle: "owner"},
 					}, nil,
-				)
+				).Once()
 
 				// Mock Update
-				mockRepo.EXPECT().Update(mock.AnythingOfType("*models.Activity")).Return(nil)
+				mockRepo.EXPECT().Update(mock.AnythingOfType("*models.Activity")).Return(nil).Once()
 
 				// Mock broadcaster
 				mockBroadcaster.EXPECT().NewEvent(
 					"campaign1",
 					websocket.EventTypeActivityUpdated,
 					mock.AnythingOfType("models.Activity"),
-				)
+				).Once()
 
 				// Mock notifications
 				mockNotification.EXPECT().NotifyActivityApproved(
 					mock.AnythingOfType("*models.Activity"),
 					mock.AnythingOfType("*models.Campaign"),
-				).Return(nil)
+				).Return(nil).Once()
 			},
 			wantErr: false,
 		},
@@ -251,7 +296,7 @@ func TestApproveActivity(t *testing.T) {
 				)
 			},
 			wantErr:     true,
-			expectedErr: "Unauthorize: only campaign creator can approve activity",
+			expectedErr: "Unauthorize: only campaign organisers can approve activity",
 		},
 	}
 
@@ -275,6 +320,9 @@ func TestApproveActivity(t *testing.T) {
 }
 
 func TestOptInContributor(t *testing.T) {
+	capacity := 1
+	past := time.Now().Add(-time.Hour)
+
 	// Setup mocks
 	mockRepo := mockRepo.NewMockActivityRepository(t)
 	mockAuth := mockInterfaces.NewMockAuthService(t)
@@ -305,6 +353,7 @@ func TestOptInContributor(t *testing.T) {
 		campaignKey   string
 		wantErr       bool
 		expectedErr   string
+		waitlisted    bool
 	}{
 		{
 			name:          "successful opt-in",
@@ -326,13 +375,14 @@ func TestOptInContributor(t *testing.T) {
 					}, nil,
 				)
 
-				mockRepo.EXPECT().AddContributor(uint(1), uint(1)).Return(nil)
+				mockRepo.EXPECT().OptInContributor(uint(1), uint(1)).Return(false, nil).Once()
 
 				mockBroadcaster.EXPECT().NewEvent(
 					"campaign1",
 					websocket.EventTypeActivityUpdated,
 					mock.AnythingOfType("*models.Activity"),
 				)
+				mockCampaign.EXPECT().RecalculateTargetAmount("campaign1")
 			},
 			wantErr: false,
 		},
@@ -361,6 +411,170 @@ func TestOptInContributor(t *testing.T) {
 			wantErr:     true,
 			expectedErr: "Action cannot be performed after making a payment",
 		},
+		{
+			name:          "opt-in fails - shared cost already paid by a participant",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				paid := models.Contributor{ID: 2, Email: "paid@test.com", Payment: &models.Payment{
+					PaymentStatus: models.PaymentStatusSucceeded,
+				}}
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true, SplitStrategy: models.SplitStrategyEqual, Contributors: []models.Contributor{paid}},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+							paid,
+						},
+					}, nil,
+				)
+			},
+			wantErr:     true,
+			expectedErr: "The cost of this activity is shared and a participant has already paid",
+		},
+		{
+			name:          "full activity - contributor joins the waitlist",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true, Capacity: &capacity, Contributors: []models.Contributor{{ID: 2}}},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+							{ID: 2, Email: "seated@test.com"},
+						},
+					}, nil,
+				)
+
+				mockRepo.EXPECT().OptInContributor(uint(1), uint(1)).Return(true, nil).Once()
+
+				mockBroadcaster.EXPECT().NewEvent(
+					"campaign1",
+					websocket.EventTypeActivityUpdated,
+					mock.MatchedBy(func(activity *models.Activity) bool {
+						return activity.WaitlistPosition(1) == 1
+					}),
+				)
+			},
+			waitlisted: true,
+		},
+		{
+			name:          "last seat taken by another opt-in - contributor joins the waitlist",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true, Capacity: &capacity},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+						},
+					}, nil,
+				)
+
+				mockRepo.EXPECT().OptInContributor(uint(1), uint(1)).Return(true, nil).Once()
+
+				mockBroadcaster.EXPECT().NewEvent(
+					"campaign1",
+					websocket.EventTypeActivityUpdated,
+					mock.MatchedBy(func(activity *models.Activity) bool {
+						return activity.WaitlistPosition(1) == 1 && !activity.IsContributorOptedIn(1)
+					}),
+				)
+			},
+			waitlisted: true,
+		},
+		{
+			name:          "opt-in fails - already on the waitlist",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true, Capacity: &capacity, Contributors: []models.Contributor{{ID: 2}},
+								Waitlist: []models.ActivityWaitlistEntry{{ContributorID: 1}}},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+						},
+					}, nil,
+				)
+			},
+			wantErr:     true,
+			expectedErr: "Contributor is already on the waitlist",
+		},
+		{
+			name:          "opt-in fails - sign-up closed",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true, SignUpDeadline: &past},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+						},
+					}, nil,
+				)
+			},
+			wantErr:     true,
+			expectedErr: "Sign-up for this activity has closed",
+		},
+		{
+			name:          "opt-in fails - mandatory activity payment overdue",
+			campaignID:    "campaign1",
+			userEmail:     "user@test.com",
+			campaignKey:   "campaign-key",
+			activityID:    1,
+			contributorID: 1,
+			setupMocks: func() {
+				mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(
+					&models.Campaign{
+						ID: "campaign1",
+						Activities: []models.Activity{
+							{ID: 1, IsApproved: true},
+							{ID: 2, IsApproved: true, IsMandatory: true, PaymentDueDate: &past,
+								Contributors: []models.Contributor{{ID: 1}}},
+						},
+						Contributors: []models.Contributor{
+							{ID: 1, Email: "user@test.com"},
+						},
+					}, nil,
+				)
+			},
+			wantErr:     true,
+			expectedErr: "Your payment for a mandatory activity is overdue",
+		},
 	}
 
 	for _, tt := range tests {
@@ -368,13 +582,14 @@ func TestOptInContributor(t *testing.T) {
 			mockCampaign.ExpectedCalls = nil
 			tt.setupMocks()
 
-			err := service.OptInContributor(tt.campaignID, tt.userEmail, tt.campaignKey, tt.activityID, tt.contributorID)
+			waitlisted, err := service.OptInContributor(tt.campaignID, tt.userEmail, tt.campaignKey, tt.activityID, tt.contributorID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedErr)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.waitlisted, waitlisted)
 			}
 		})
 	}
@@ -444,6 +659,7 @@ func TestOptOutContributor(t *testing.T) {
 					websocket.EventTypeActivityUpdated,
 					mock.AnythingOfType("*models.Activity"),
 				)
+				mockCampaign.EXPECT().RecalculateTargetAmount("campaign1")
 			},
 			wantErr: false,
 		},
@@ -540,3 +756,340 @@ func TestOptOutContributor(t *testing.T) {
 		})
 	}
 }
+
+func TestOptOutContributorWaitlist(t *testing.T) {
+	mockRepo := mockRepo.NewMockActivityRepository(t)
+	mockCampaign := mockInterfaces.NewMockCampaignService(t)
+	mockBroadcaster := mockInterfaces.NewMockEventBroadcaster(t)
+	mockNotification := mockInterfaces.NewMockNotificationService(t)
+
+	service := &activityService{
+		repo:                mockRepo,
+		campaignService:     mockCampaign,
+		broadcaster:         mockBroadcaster,
+		notificationService: mockNotification,
+		runAsync:            func(f func()) { f() },
+	}
+
+	capacity := 1
+	seated := models.Contributor{ID: 1, Email: "seated@example.com"}
+	paid := models.Contributor{ID: 2, Email: "paid@example.com", Payment: &models.Payment{PaymentStatus: models.PaymentStatusSucceeded}}
+	waiting := models.Contributor{ID: 3, Email: "waiting@example.com"}
+	newCampaign := func() *models.Campaign {
+		return &models.Campaign{
+			ID:           "campaign1",
+			Contributors: []models.Contributor{seated, paid, waiting},
+			Activities: []models.Activity{{
+				ID:           1,
+				IsApproved:   true,
+				Capacity:     &capacity,
+				Contributors: []models.Contributor{seated},
+				Waitlist: []models.ActivityWaitlistEntry{
+					{ContributorID: paid.ID},
+					{ContributorID: waiting.ID},
+				},
+			}},
+		}
+	}
+
+	t.Run("freed seat goes to the first eligible contributor on the waitlist", func(t *testing.T) {
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(newCampaign(), nil).Once()
+		mockRepo.EXPECT().RemoveContributor(uint(1), seated.ID).Return(nil).Once()
+		mockRepo.EXPECT().PromoteFromWaitlist(uint(1), waiting.ID).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.MatchedBy(func(activity *models.Activity) bool {
+			return activity.IsContributorOptedIn(waiting.ID) && !activity.IsContributorWaitlisted(waiting.ID) &&
+				activity.WaitlistPosition(paid.ID) == 1
+		})).Once()
+		mockCampaign.EXPECT().RecalculateTargetAmount("campaign1").Once()
+		mockNotification.EXPECT().NotifyWaitlistPromoted(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.ID == waiting.ID
+		}), mock.AnythingOfType("*models.Activity"), mock.AnythingOfType("*models.Campaign")).Return(nil).Once()
+
+		err := service.OptOutContributor("campaign1", seated.Email, "campaign-key", 1, seated.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("waitlisted contributor leaves the waitlist", func(t *testing.T) {
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(newCampaign(), nil).Once()
+		mockRepo.EXPECT().RemoveFromWaitlist(uint(1), waiting.ID).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.MatchedBy(func(activity *models.Activity) bool {
+			return !activity.IsContributorWaitlisted(waiting.ID)
+		})).Once()
+
+		err := service.OptOutContributor("campaign1", waiting.Email, "campaign-key", 1, waiting.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateActivitySplit(t *testing.T) {
+	mockRepo := mockRepo.NewMockActivityRepository(t)
+	mockCampaign := mockInterfaces.NewMockCampaignService(t)
+	mockBroadcaster := mockInterfaces.NewMockEventBroadcaster(t)
+
+	service := &activityService{
+		repo:            mockRepo,
+		campaignService: mockCampaign,
+		broadcaster:     mockBroadcaster,
+		runAsync:        func(f func()) { f() },
+	}
+
+	participants := []models.Contributor{
+		{ID: 1, Email: "first@example.com"},
+		{ID: 2, Email: "second@example.com"},
+	}
+	newCampaign := func(contributors ...models.Contributor) *models.Campaign {
+		return &models.Campaign{
+			ID:           "campaign1",
+			CreatedBy:    models.User{Handle: "organiser"},
+			Contributors: contributors,
+			Activities: []models.Activity{
+				{ID: 1, Cost: 100, IsApproved: true, Contributors: contributors},
+			},
+		}
+	}
+
+	t.Run("sets a weighted split and recalculates the target amount", func(t *testing.T) {
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(newCampaign(participants...), nil).Once()
+		mockRepo.EXPECT().UpdateSplit(mock.AnythingOfType("*models.Activity")).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.AnythingOfType("*models.Activity")).Once()
+		mockCampaign.EXPECT().RecalculateTargetAmount("campaign1").Once()
+
+		activity, err := service.UpdateActivitySplit(1, "campaign1", "organiser", "campaign-key",
+			models.SplitStrategyWeighted, []models.ActivityShare{{ContributorID: 1, Weight: 3}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.SplitStrategyWeighted, activity.SplitStrategy)
+		assert.Equal(t, float64(75), activity.ShareOf(1))
+		assert.Equal(t, float64(25), activity.ShareOf(2))
+	})
+
+	t.Run("custom amounts leave the rest of the cost to the other participants", func(t *testing.T) {
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(newCampaign(participants...), nil).Once()
+		mockRepo.EXPECT().UpdateSplit(mock.AnythingOfType("*models.Activity")).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.AnythingOfType("*models.Activity")).Once()
+		mockCampaign.EXPECT().RecalculateTargetAmount("campaign1").Once()
+
+		activity, err := service.UpdateActivitySplit(1, "campaign1", "organiser", "campaign-key",
+			models.SplitStrategyCustom, []models.ActivityShare{{ContributorID: 2, Amount: 30}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(70), activity.ShareOf(1))
+		assert.Equal(t, float64(30), activity.ShareOf(2))
+	})
+
+	t.Run("invitees who haven't accepted don't share the cost", func(t *testing.T) {
+		invited := models.Contributor{ID: 3, Email: "invited@example.com", Invitation: models.ContributorInvitation{Status: models.InvitationStatusPending}}
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(newCampaign(append(participants, invited)...), nil).Once()
+		mockRepo.EXPECT().UpdateSplit(mock.AnythingOfType("*models.Activity")).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.AnythingOfType("*models.Activity")).Once()
+		mockCampaign.EXPECT().RecalculateTargetAmount("campaign1").Once()
+
+		activity, err := service.UpdateActivitySplit(1, "campaign1", "organiser", "campaign-key",
+			models.SplitStrategyEqual, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(50), activity.ShareOf(1))
+		assert.Equal(t, float64(50), activity.ShareOf(2))
+		assert.Equal(t, float64(0), activity.ShareOf(3))
+	})
+
+	tests := []struct {
+		name        string
+		userHandle  string
+		campaign    *models.Campaign
+		strategy    models.SplitStrategy
+		shares      []models.ActivityShare
+		expectedErr string
+	}{
+		{
+			name:        "only organisers can change the split",
+			userHandle:  "someone",
+			campaign:    newCampaign(participants...),
+			strategy:    models.SplitStrategyEqual,
+			expectedErr: "Only campaign organisers can change how an activity is split",
+		},
+		{
+			name:        "unknown strategy",
+			userHandle:  "organiser",
+			campaign:    newCampaign(participants...),
+			strategy:    "random",
+			expectedErr: "Split strategy must be one of",
+		},
+		{
+			name:        "shares for non participants",
+			userHandle:  "organiser",
+			campaign:    newCampaign(participants...),
+			strategy:    models.SplitStrategyWeighted,
+			shares:      []models.ActivityShare{{ContributorID: 9, Weight: 2}},
+			expectedErr: "Shares can only be set for participants of the activity",
+		},
+		{
+			name:        "shares for an equal split",
+			userHandle:  "organiser",
+			campaign:    newCampaign(participants...),
+			strategy:    models.SplitStrategyEqual,
+			shares:      []models.ActivityShare{{ContributorID: 1, Weight: 2}},
+			expectedErr: "Shares can only be set for weighted and custom splits",
+		},
+		{
+			name:        "custom amounts above the cost",
+			userHandle:  "organiser",
+			campaign:    newCampaign(participants...),
+			strategy:    models.SplitStrategyCustom,
+			shares:      []models.ActivityShare{{ContributorID: 1, Amount: 80}, {ContributorID: 2, Amount: 40}},
+			expectedErr: "Custom amounts cannot add up to more than the activity cost",
+		},
+		{
+			name:       "a participant has paid",
+			userHandle: "organiser",
+			campaign: newCampaign(
+				models.Contributor{ID: 1, Payment: &models.Payment{PaymentStatus: models.PaymentStatusSucceeded}},
+			),
+			strategy:    models.SplitStrategyEqual,
+			expectedErr: "Cannot change the split of an activity with paid contributors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCampaign.EXPECT().GetCampaignByID("campaign1", "campaign-key").Return(tt.campaign, nil).Once()
+
+			_, err := service.UpdateActivitySplit(1, "campaign1", tt.userHandle, "campaign-key", tt.strategy, tt.shares)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestVoteOnActivity(t *testing.T) {
+	mockRepo := mockRepo.NewMockActivityRepository(t)
+	mockCampaign := mockInterfaces.NewMockCampaignService(t)
+	mockBroadcaster := mockInterfaces.NewMockEventBroadcaster(t)
+	mockNotification := mockInterfaces.NewMockNotificationService(t)
+
+	service := &activityService{
+		repo:                mockRepo,
+		campaignService:     mockCampaign,
+		broadcaster:         mockBroadcaster,
+		notificationService: mockNotification,
+		logger:              mockLogger.NewMockLogger(t),
+		runAsync:            func(f func()) { f() },
+	}
+
+	newCampaign := func(threshold int) *models.Campaign {
+		return &models.Campaign{
+			ID:                    "campaign1",
+			CreatedBy:             models.User{Handle: "organiser", Email: "organiser@example.com"},
+			ActivityVoteThreshold: threshold,
+			Contributors: []models.Contributor{
+				{ID: 1, Email: "first@example.com"},
+				{ID: 2, Email: "second@example.com"},
+				{ID: 3, Email: "invited@example.com", Invitation: models.ContributorInvitation{Status: models.InvitationStatusPending}},
+			},
+		}
+	}
+	proposed := func(votes ...models.ActivityVote) models.Activity {
+		return models.Activity{ID: 1, CampaignID: "campaign1", Votes: votes}
+	}
+
+	t.Run("records the vote and streams the tally", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint(1)).Return(proposed(), nil).Once()
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "key").Return(newCampaign(2), nil).Once()
+		mockRepo.EXPECT().AddVote(mock.MatchedBy(func(v *models.ActivityVote) bool {
+			return v.ContributorID == 1 && v.Approve
+		})).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityVoteUpdated, models.ActivityVoteTally{
+			ActivityID: 1, Approvals: 1, Threshold: 2,
+		}).Once()
+
+		activity, err := service.VoteOnActivity(1, "campaign1", "first@example.com", "key", true)
+		assert.NoError(t, err)
+		assert.False(t, activity.IsApproved)
+	})
+
+	t.Run("approves the activity once the threshold is reached", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint(1)).Return(proposed(models.ActivityVote{ContributorID: 1, Approve: true}), nil).Once()
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "key").Return(newCampaign(2), nil).Once()
+		mockRepo.EXPECT().AddVote(mock.AnythingOfType("*models.ActivityVote")).Return(nil).Once()
+		mockRepo.EXPECT().Update(mock.MatchedBy(func(a *models.Activity) bool { return a.IsApproved })).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityUpdated, mock.AnythingOfType("models.Activity")).Once()
+		mockNotification.EXPECT().NotifyActivityApproved(mock.AnythingOfType("*models.Activity"), mock.AnythingOfType("*models.Campaign")).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityVoteUpdated, models.ActivityVoteTally{
+			ActivityID: 1, Approvals: 2, Threshold: 2, IsApproved: true,
+		}).Once()
+
+		activity, err := service.VoteOnActivity(1, "campaign1", "second@example.com", "key", true)
+		assert.NoError(t, err)
+		assert.True(t, activity.IsApproved)
+	})
+
+	t.Run("campaigns without a threshold leave approval to the organisers", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint(1)).Return(proposed(models.ActivityVote{ContributorID: 1, Approve: true}), nil).Once()
+		mockCampaign.EXPECT().GetCampaignByID("campaign1", "key").Return(newCampaign(0), nil).Once()
+		mockRepo.EXPECT().AddVote(mock.AnythingOfType("*models.ActivityVote")).Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent("campaign1", websocket.EventTypeActivityVoteUpdated, mock.AnythingOfType("models.ActivityVoteTally")).Once()
+
+		activity, err := service.VoteOnActivity(1, "campaign1", "second@example.com", "key", true)
+		assert.NoError(t, err)
+		assert.False(t, activity.IsApproved)
+	})
+
+	tests := []struct {
+		name        string
+		activity    models.Activity
+		userEmail   string
+		expectedErr string
+	}{
+		{
+			name:        "only contributors can vote",
+			activity:    proposed(),
+			userEmail:   "invited@example.com",
+			expectedErr: "Only campaign contributors can vote on activities",
+		},
+		{
+			name:        "contributors can only vote once",
+			activity:    proposed(models.ActivityVote{ContributorID: 1, Approve: false}),
+			userEmail:   "first@example.com",
+			expectedErr: "You have already voted on this activity",
+		},
+		{
+			name:        "approved activities can't be voted on",
+			activity:    models.Activity{ID: 1, CampaignID: "campaign1", IsApproved: true},
+			userEmail:   "first@example.com",
+			expectedErr: "Activity has already been approved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.EXPECT().GetByID(uint(1)).Return(tt.activity, nil).Once()
+			mockCampaign.EXPECT().GetCampaignByID("campaign1", "key").Return(newCampaign(2), nil).Once()
+
+			_, err := service.VoteOnActivity(1, "campaign1", tt.userEmail, "key", true)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetActivitiesByCampaignID(t *testing.T) {
+	activityRepo := mockRepo.NewMockActivityRepository(t)
+	commentRepo := mockRepo.NewMockCommentRepository(t)
+
+	service := &activityService{
+		repo:        activityRepo,
+		commentRepo: commentRepo,
+		logger:      mockLogger.NewMockLogger(t),
+		runAsync:    func(f func()) { f() },
+	}
+
+	activityRepo.EXPECT().GetByCampaignID("campaign1").Return([]models.Activity{{ID: 1}, {ID: 2}}, nil).Once()
+	commentRepo.EXPECT().CountUnread("reader", []uint{1, 2}).Return(map[uint]int64{2: 4}, nil).Once()
+
+	activities, err := service.GetActivitiesByCampaignID("campaign1", "reader")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), activities[0].UnreadComments)
+	assert.Equal(t, int64(4), activities[1].UnreadComments)
+}
@@ -1,24 +1,75 @@
 package services
 
 import (
+	dto "github.com/oyen-bright/goFundIt/internal/api/dto/event"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
 
+// recentEventIDs is how many event IDs are remembered to drop redelivered events
+const recentEventIDs = 1024
+
+// eventBroadcasterImpl publishes events on the bus so every instance delivers them to its own clients
 type eventBroadcasterImpl struct {
-	hub *websocket.Hub
+	hub    *websocket.Hub
+	bus    eventbus.Bus
+	seen   *eventbus.Deduplicator
+	logger logger.Logger
 }
 
-func NewEventBroadcaster(hub *websocket.Hub) services.EventBroadcaster {
-	return &eventBroadcasterImpl{
-		hub: hub,
+func NewEventBroadcaster(hub *websocket.Hub, bus eventbus.Bus, logger logger.Logger) services.EventBroadcaster {
+	e := &eventBroadcasterImpl{
+		hub:    hub,
+		bus:    bus,
+		seen:   eventbus.NewDeduplicator(recentEventIDs),
+		logger: logger,
 	}
+	bus.Subscribe(e.deliver)
+	return e
 }
 
+// NewEvent publishes the event with the payloads built from data, the bus numbers it in the campaign.
+// It is delivered to the clients of this instance only when the bus fails, without a number as it can't be replayed
 func (e *eventBroadcasterImpl) NewEvent(campaignID string, eventType websocket.EventType, data interface{}) {
+	payload, err := dto.NewPayload(data)
+	if err != nil {
+		e.logger.Error(err, "Error building event payload", map[string]interface{}{"campaignId": campaignID, "type": eventType})
+		return
+	}
+	event, err := eventbus.NewEvent(campaignID, string(eventType), payload.Members, payload.Privileged)
+	if err != nil {
+		e.logger.Error(err, "Error encoding event", map[string]interface{}{"campaignId": campaignID, "type": eventType})
+		return
+	}
+
+	if err := e.bus.Publish(event); err != nil {
+		e.logger.Error(err, "Error publishing event, delivering it to local clients only", map[string]interface{}{"campaignId": campaignID, "type": eventType})
+		e.deliver(event)
+	}
+}
 
-	e.hub.BroadcastToCampaign(campaignID, websocket.Message{
-		Type: eventType,
-		Data: data,
-	})
+// SendTransient delivers short-lived updates, like typing indicators, to the clients of this instance only.
+// They aren't numbered, kept for reconnecting clients, published on the bus or sent to webhooks
+func (e *eventBroadcasterImpl) SendTransient(campaignID string, eventType websocket.EventType, data interface{}) {
+	e.hub.SendToCampaign(campaignID, websocket.Message{Type: eventType, Data: data})
+}
+
+// deliver sends an event from the bus to the clients of this instance, redelivered events are dropped
+func (e *eventBroadcasterImpl) deliver(event eventbus.Event) {
+	if e.seen.Seen(event.ID) {
+		return
+	}
+
+	message := websocket.Message{
+		ID:   event.ID,
+		Seq:  event.Seq,
+		Type: websocket.EventType(event.Type),
+		Data: event.Data,
+	}
+	if len(event.PrivilegedData) > 0 {
+		message.PrivilegedData = event.PrivilegedData
+	}
+	e.hub.BroadcastToCampaign(event.CampaignID, message)
 }
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus/memory"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// failingBus stands in for a bus whose connection is down
+type failingBus struct {
+	memory.Memory
+}
+
+func (f *failingBus) Publish(eventbus.Event) error {
+	return errors.New("connection refused")
+}
+
+func TestEventBroadcaster(t *testing.T) {
+	t.Run("events reach the clients of every instance", func(t *testing.T) {
+		bus := memory.NewMemory()
+		hub, otherHub := websocket.NewHub(), websocket.NewHub()
+		defer hub.Close()
+		defer otherHub.Close()
+
+		broadcaster := NewEventBroadcaster(hub, bus, mockLogger.NewMockLogger(t))
+		NewEventBroadcaster(otherHub, bus, mockLogger.NewMockLogger(t))
+
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, map[string]string{"title": "Road trip"})
+
+		assert.Equal(t, uint64(1), hub.LatestSeq("campaign-123"))
+		assert.Equal(t, uint64(1), otherHub.LatestSeq("campaign-123"))
+	})
+
+	t.Run("every instance keeps the number the bus gave the event", func(t *testing.T) {
+		bus := memory.NewMemory()
+		hub, otherHub := websocket.NewHub(), websocket.NewHub()
+		defer hub.Close()
+		defer otherHub.Close()
+
+		// The second instance starts after the first event, it must not number the next one 1
+		broadcaster := NewEventBroadcaster(hub, bus, mockLogger.NewMockLogger(t))
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, nil)
+		other := NewEventBroadcaster(otherHub, bus, mockLogger.NewMockLogger(t))
+		other.NewEvent("campaign-123", websocket.EventTypeCommentCreated, nil)
+
+		assert.Equal(t, uint64(2), hub.LatestSeq("campaign-123"))
+		assert.Equal(t, uint64(2), otherHub.LatestSeq("campaign-123"))
+	})
+
+	t.Run("redelivered events are dropped", func(t *testing.T) {
+		bus := memory.NewMemory()
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		NewEventBroadcaster(hub, bus, mockLogger.NewMockLogger(t))
+
+		event, err := eventbus.NewEvent("campaign-123", string(websocket.EventTypeCampaignUpdated), nil, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, bus.Publish(event))
+		assert.NoError(t, bus.Publish(event))
+
+		assert.Equal(t, uint64(1), hub.LatestSeq("campaign-123"))
+	})
+
+	t.Run("events reach local clients unnumbered when the bus fails", func(t *testing.T) {
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		client := websocket.NewStreamClient(hub, "campaign-123", "member")
+		hub.Register(client)
+
+		logger := mockLogger.NewMockLogger(t)
+		logger.EXPECT().Error(mock.Anything, "Error publishing event, delivering it to local clients only", mock.Anything).Return()
+
+		broadcaster := NewEventBroadcaster(hub, &failingBus{}, logger)
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, nil)
+
+		received := <-client.Messages()
+		assert.Equal(t, websocket.EventTypeCampaignUpdated, received.Type)
+		assert.Zero(t, received.Seq)
+		assert.Equal(t, uint64(0), hub.LatestSeq("campaign-123"), "expected the hub not to number events itself")
+	})
+
+	t.Run("payment details are only sent to privileged members", func(t *testing.T) {
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		member := websocket.NewStreamClient(hub, "campaign-123", "member")
+		owner := websocket.NewStreamClient(hub, "campaign-123", "owner")
+		owner.SetPrivileged(true)
+		hub.Register(member)
+		hub.Register(owner)
+
+		gatewayResponse := `{"authorization": {"last4": "4081"}}`
+		contributor := &models.Contributor{
+			ID:         1,
+			CampaignID: "campaign-123",
+			Name:       "Ada",
+			Email:      "ada@example.com",
+			Amount:     100,
+			Payment: &models.Payment{
+				Reference:       "REF-1",
+				Amount:          100,
+				PaymentStatus:   models.PaymentStatusSucceeded,
+				GatewayResponse: &gatewayResponse,
+			},
+		}
+
+		broadcaster := NewEventBroadcaster(hub, memory.NewMemory(), mockLogger.NewMockLogger(t))
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeContributorUpdated, contributor)
+
+		var memberView, ownerView map[string]interface{}
+		assert.NoError(t, json.Unmarshal((<-member.Messages()).Data.(json.RawMessage), &memberView))
+		assert.NoError(t, json.Unmarshal((<-owner.Messages()).Data.(json.RawMessage), &ownerView))
+
+		assert.Equal(t, "Ada", memberView["name"])
+		assert.Equal(t, string(models.PaymentStatusSucceeded), memberView["paymentStatus"])
+		assert.NotContains(t, memberView, "email")
+		assert.NotContains(t, memberView, "payment")
+
+		assert.Equal(t, "ada@example.com", ownerView["email"])
+		assert.Equal(t, gatewayResponse, ownerView["payment"].(map[string]interface{})["gatewayResponse"])
+	})
+
+	t.Run("campaigns broadcast as values only send their payload", func(t *testing.T) {
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		member := websocket.NewStreamClient(hub, "campaign-123", "member")
+		hub.Register(member)
+
+		gatewayResponse := `{"authorization": {"last4": "4081"}}`
+		campaign := models.Campaign{
+			ID:    "campaign-123",
+			Title: "Road trip",
+			Key:   "campaign-key",
+			Contributors: []models.Contributor{{
+				ID:         1,
+				CampaignID: "campaign-123",
+				Name:       "Ada",
+				Email:      "ada@example.com",
+				Amount:     100,
+				Payment: &models.Payment{
+					Reference:       "REF-1",
+					Amount:          100,
+					PaymentStatus:   models.PaymentStatusSucceeded,
+					GatewayResponse: &gatewayResponse,
+				},
+			}},
+		}
+
+		broadcaster := NewEventBroadcaster(hub, memory.NewMemory(), mockLogger.NewMockLogger(t))
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, campaign)
+
+		data := (<-member.Messages()).Data.(json.RawMessage)
+		assert.NotContains(t, string(data), "ada@example.com")
+		assert.NotContains(t, string(data), "4081")
+		assert.NotContains(t, string(data), "campaign-key")
+
+		var memberView map[string]interface{}
+		assert.NoError(t, json.Unmarshal(data, &memberView))
+		assert.Equal(t, "Road trip", memberView["title"])
+		contributor := memberView["contributors"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "Ada", contributor["name"])
+		assert.NotContains(t, contributor, "email")
+		assert.NotContains(t, contributor, "payment")
+	})
+
+	t.Run("models without a payload are dropped", func(t *testing.T) {
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		logger := mockLogger.NewMockLogger(t)
+		logger.EXPECT().Error(mock.Anything, "Error building event payload", mock.Anything).Return()
+
+		broadcaster := NewEventBroadcaster(hub, memory.NewMemory(), logger)
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, models.User{Email: "ada@example.com"})
+
+		assert.Equal(t, uint64(0), hub.LatestSeq("campaign-123"))
+	})
+
+	t.Run("events that can't be encoded are dropped", func(t *testing.T) {
+		hub := websocket.NewHub()
+		defer hub.Close()
+
+		logger := mockLogger.NewMockLogger(t)
+		logger.EXPECT().Error(mock.Anything, "Error encoding event", mock.Anything).Return()
+
+		broadcaster := NewEventBroadcaster(hub, memory.NewMemory(), logger)
+		broadcaster.NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, make(chan int))
+
+		assert.Equal(t, uint64(0), hub.LatestSeq("campaign-123"))
+	})
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/ical"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+)
+
+type calendarService struct {
+	repo            repositories.CalendarFeedRepository
+	campaignService services.CampaignService
+	encryptor       encryption.Encryptor
+	secret          string
+	logger          logger.Logger
+}
+
+func NewCalendarService(
+	repo repositories.CalendarFeedRepository,
+	campaignService services.CampaignService,
+	encryptor encryption.Encryptor,
+	secret string,
+	logger logger.Logger,
+) services.CalendarService {
+	return &calendarService{
+		repo:            repo,
+		campaignService: campaignService,
+		encryptor:       encryptor,
+		secret:          secret,
+		logger:          logger,
+	}
+}
+
+// CreateCalendarFeed creates a feed of the campaign's scheduled activities, contributor feeds only list the activities the user opted into
+func (s *calendarService) CreateCalendarFeed(campaignID, key, userEmail string, scope models.CalendarFeedScope) (*models.CalendarFeed, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can subscribe to its calendar")
+	}
+
+	var contributorID *uint
+	if scope == models.CalendarFeedScopeContributor {
+		contributor := campaign.GetContributorByEmail(userEmail)
+		if contributor == nil || !contributor.Invitation.IsAccepted() {
+			return nil, errs.BadRequest("Only contributors can subscribe to their own activities", nil)
+		}
+		contributorID = &contributor.ID
+	}
+
+	feed, err := models.NewCalendarFeed(s.encryptor, s.secret, campaignID, key, userEmail, contributorID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.repo.Create(feed); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return feed, nil
+}
+
+// GetCalendarFeeds fetches the calendar feeds the user created for the campaign that haven't been revoked
+func (s *calendarService) GetCalendarFeeds(campaignID, userEmail string) ([]models.CalendarFeed, error) {
+	feeds, err := s.repo.GetActiveByEmail(campaignID, userEmail)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return feeds, nil
+}
+
+// RevokeCalendarFeed revokes one of the user's calendar feeds, calendar apps subscribed to it stop receiving updates
+func (s *calendarService) RevokeCalendarFeed(feedID uint, campaignID, userEmail string) error {
+	feed, err := s.repo.GetByID(feedID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return errs.NotFound("Calendar feed not found")
+		}
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if feed.CampaignID != campaignID || feed.Email != userEmail {
+		return errs.NotFound("Calendar feed not found")
+	}
+	if feed.IsRevoked() {
+		return errs.BadRequest("Calendar feed has already been revoked", nil)
+	}
+
+	feed.Revoke()
+	if err := s.repo.Update(feed); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	return nil
+}
+
+// RenderCalendarFeed renders the iCalendar document of the feed the token belongs to.
+// Feeds stop working once revoked or once their owner is no longer part of the campaign
+func (s *calendarService) RenderCalendarFeed(token string) (string, error) {
+	feed, err := s.repo.GetByTokenHash(encryption.HashKey(s.secret, token))
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return "", errs.NotFound("Calendar feed not found")
+		}
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+	if feed.IsRevoked() {
+		return "", errs.NotFound("Calendar feed not found")
+	}
+
+	campaignKey, err := feed.CampaignKey(s.encryptor, token)
+	if err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+
+	campaign, err := s.campaignService.GetCampaignByID(feed.CampaignID, campaignKey)
+	if err != nil {
+		return "", err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(feed.Email) {
+		return "", errs.NotFound("Calendar feed not found")
+	}
+	if feed.IsContributorFeed() {
+		contributor := campaign.GetContributorByID(*feed.ContributorID)
+		if contributor == nil || !contributor.Invitation.IsAccepted() {
+			return "", errs.NotFound("Calendar feed not found")
+		}
+	}
+
+	return s.buildCalendar(campaign, feed).String(), nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+// buildCalendar lists the approved, scheduled activities of the campaign, contributor feeds only list the ones the contributor opted into
+func (s *calendarService) buildCalendar(campaign *models.Campaign, feed *models.CalendarFeed) *ical.Calendar {
+	calendar := ical.New(campaign.Title)
+
+	for _, activity := range campaign.Activities {
+		if !activity.IsApproved || !activity.IsScheduled() {
+			continue
+		}
+		if feed.IsContributorFeed() && !activity.IsContributorOptedIn(*feed.ContributorID) {
+			continue
+		}
+
+		calendar.AddEvent(ical.Event{
+			UID:         fmt.Sprintf("activity-%d@gofundit", activity.ID),
+			Summary:     activity.Title,
+			Description: activity.Notes,
+			Location:    activity.Location,
+			Latitude:    activity.Latitude,
+			Longitude:   activity.Longitude,
+			Start:       *activity.StartsAt,
+			End:         activity.EndsAt,
+			UpdatedAt:   activity.UpdatedAt,
+		})
+	}
+	return calendar
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func setupCalendarTest(t *testing.T) (
+	*calendarService,
+	*mockRepo.MockCalendarFeedRepository,
+	*mockService.MockCampaignService,
+) {
+	repo := mockRepo.NewMockCalendarFeedRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+
+	service := &calendarService{
+		repo:            repo,
+		campaignService: campaignService,
+		encryptor:       encryption.New([]string{"test-key"}),
+		secret:          testCampaignKeySecret,
+		logger:          mockLogger.NewMockLogger(t),
+	}
+
+	return service, repo, campaignService
+}
+
+func newCalendarTestCampaign() *models.Campaign {
+	startsAt := time.Date(2030, 6, 1, 9, 0, 0, 0, time.UTC)
+	member := models.Contributor{ID: 2, CampaignID: "campaign-123", Email: "member@example.com"}
+
+	return &models.Campaign{
+		ID:        "campaign-123",
+		Title:     "Beach Trip",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "creator@example.com"},
+			member,
+			{ID: 3, CampaignID: "campaign-123", Email: "invited@example.com", Invitation: models.ContributorInvitation{Status: models.InvitationStatusPending}},
+		},
+		Activities: []models.Activity{
+			{ID: 10, Title: "Boat Ride", IsApproved: true, StartsAt: &startsAt, Location: "Pier 4", Contributors: []models.Contributor{member}},
+			{ID: 11, Title: "Dinner", IsApproved: true, StartsAt: &startsAt},
+			{ID: 12, Title: "Unscheduled", IsApproved: true},
+			{ID: 13, Title: "Pending Approval", StartsAt: &startsAt},
+		},
+	}
+}
+
+func TestCreateCalendarFeed(t *testing.T) {
+	service, repo, campaignService := setupCalendarTest(t)
+
+	t.Run("contributor feed", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(f *models.CalendarFeed) bool {
+			return f.IsContributorFeed() && *f.ContributorID == 2 && f.TokenHash == encryption.HashKey(testCampaignKeySecret, f.Token)
+		})).Return(nil).Once()
+
+		feed, err := service.CreateCalendarFeed("campaign-123", "campaign-key", "member@example.com", models.CalendarFeedScopeContributor)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, feed.Token)
+
+		campaignKey, err := feed.CampaignKey(service.encryptor, feed.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, "campaign-key", campaignKey)
+	})
+
+	t.Run("campaign feed", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(f *models.CalendarFeed) bool {
+			return f.Scope == models.CalendarFeedScopeCampaign && f.ContributorID == nil
+		})).Return(nil).Once()
+
+		_, err := service.CreateCalendarFeed("campaign-123", "campaign-key", "creator@example.com", models.CalendarFeedScopeCampaign)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invited contributor cannot subscribe", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+
+		_, err := service.CreateCalendarFeed("campaign-123", "campaign-key", "invited@example.com", models.CalendarFeedScopeContributor)
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestRevokeCalendarFeed(t *testing.T) {
+	service, repo, _ := setupCalendarTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		repo.EXPECT().GetByID(uint(1)).Return(&models.CalendarFeed{ID: 1, CampaignID: "campaign-123", Email: "member@example.com"}, nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(f *models.CalendarFeed) bool { return f.IsRevoked() })).Return(nil).Once()
+
+		err := service.RevokeCalendarFeed(1, "campaign-123", "member@example.com")
+		assert.NoError(t, err)
+	})
+
+	t.Run("feed of another user", func(t *testing.T) {
+		repo.EXPECT().GetByID(uint(1)).Return(&models.CalendarFeed{ID: 1, CampaignID: "campaign-123", Email: "member@example.com"}, nil).Once()
+
+		err := service.RevokeCalendarFeed(1, "campaign-123", "other@example.com")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo.EXPECT().GetByID(uint(2)).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := service.RevokeCalendarFeed(2, "campaign-123", "member@example.com")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestRenderCalendarFeed(t *testing.T) {
+	service, repo, campaignService := setupCalendarTest(t)
+
+	newFeed := func(email string, contributorID *uint) *models.CalendarFeed {
+		feed, err := models.NewCalendarFeed(service.encryptor, testCampaignKeySecret, "campaign-123", "campaign-key", email, contributorID)
+		assert.NoError(t, err)
+		return feed
+	}
+
+	t.Run("campaign feed lists approved scheduled activities", func(t *testing.T) {
+		feed := newFeed("creator@example.com", nil)
+		repo.EXPECT().GetByTokenHash(feed.TokenHash).Return(feed, nil).Once()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+
+		calendar, err := service.RenderCalendarFeed(feed.Token)
+		assert.NoError(t, err)
+		assert.Contains(t, calendar, "X-WR-CALNAME:Beach Trip")
+		assert.Contains(t, calendar, "UID:activity-10@gofundit")
+		assert.Contains(t, calendar, "UID:activity-11@gofundit")
+		assert.Contains(t, calendar, "LOCATION:Pier 4")
+		assert.NotContains(t, calendar, "Unscheduled")
+		assert.NotContains(t, calendar, "Pending Approval")
+	})
+
+	t.Run("contributor feed lists opted in activities", func(t *testing.T) {
+		contributorID := uint(2)
+		feed := newFeed("member@example.com", &contributorID)
+		repo.EXPECT().GetByTokenHash(feed.TokenHash).Return(feed, nil).Once()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+
+		calendar, err := service.RenderCalendarFeed(feed.Token)
+		assert.NoError(t, err)
+		assert.Contains(t, calendar, "UID:activity-10@gofundit")
+		assert.NotContains(t, calendar, "UID:activity-11@gofundit")
+	})
+
+	t.Run("revoked feed", func(t *testing.T) {
+		feed := newFeed("creator@example.com", nil)
+		feed.Revoke()
+		repo.EXPECT().GetByTokenHash(feed.TokenHash).Return(feed, nil).Once()
+
+		_, err := service.RenderCalendarFeed(feed.Token)
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("owner left the campaign", func(t *testing.T) {
+		feed := newFeed("gone@example.com", nil)
+		repo.EXPECT().GetByTokenHash(feed.TokenHash).Return(feed, nil).Once()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "campaign-key").Return(newCalendarTestCampaign(), nil).Once()
+
+		_, err := service.RenderCalendarFeed(feed.Token)
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		repo.EXPECT().GetByTokenHash(mock.Anything).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.RenderCalendarFeed("GC-unknown")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
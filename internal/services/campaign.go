@@ -12,6 +12,7 @@ import (
 	"github.com/oyen-bright/goFundIt/pkg/encryption"
 	"github.com/oyen-bright/goFundIt/pkg/errs"
 	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
 
@@ -20,7 +21,9 @@ type campaignService struct {
 	authService         services.AuthService
 	analyticsService    services.AnalyticsService
 	notificationService services.NotificationService
+	accessService       services.CampaignAccessService
 	encryptor           encryption.Encryptor
+	storage             storage.Storage
 	broadcaster         services.EventBroadcaster
 	logger              logger.Logger
 	runAsync            func(func())
@@ -31,7 +34,9 @@ func NewCampaignService(
 	authService services.AuthService,
 	analyticsService services.AnalyticsService,
 	notificationService services.NotificationService,
+	accessService services.CampaignAccessService,
 	encryptor encryption.Encryptor,
+	storage storage.Storage,
 
 	broadcast services.EventBroadcaster,
 	logger logger.Logger,
@@ -41,9 +46,11 @@ func NewCampaignService(
 		authService:         authService,
 		analyticsService:    analyticsService,
 		notificationService: notificationService,
+		accessService:       accessService,
 		broadcaster:         broadcast,
 		logger:              logger,
 		encryptor:           encryptor,
+		storage:             storage,
 		runAsync:            func(f func()) { go f() },
 	}
 }
@@ -76,7 +83,7 @@ func (s *campaignService) CreateCampaign(campaign *models.Campaign, userHandle s
 		)
 	}
 
-	// Create new users for non-existing emails
+	// Create new users for non-existing emails, contributors reference their user by email
 	if len(nonExisting) > 0 {
 		_, err = s.authService.CreateUsers(createUsersFromEmails(nonExisting))
 		if err != nil {
@@ -94,6 +101,21 @@ func (s *campaignService) CreateCampaign(campaign *models.Campaign, userHandle s
 	// Setup campaign with creator's details
 	campaign.FromBinding(user)
 
+	// Contributors join once they accept their invitation, the creator joins straight away
+	for i := range campaign.Contributors {
+		contributor := &campaign.Contributors[i]
+		if contributor.Email == user.Email {
+			contributor.Invitation.Accept()
+			continue
+		}
+		s.accessService.IssueInvitation(contributor)
+	}
+
+	// Register the creator's key and issue each contributor their own key, they are stored with the campaign
+	if err := s.accessService.PrepareCampaignKeys(campaign); err != nil {
+		return models.Campaign{}, err
+	}
+
 	// Create campaign in database
 	campaign.Encrypt(s.encryptor)
 	*campaign, err = s.repo.Create(campaign)
@@ -102,6 +124,7 @@ func (s *campaignService) CreateCampaign(campaign *models.Campaign, userHandle s
 	if err != nil {
 		return models.Campaign{}, errs.InternalServerError(err).Log(s.logger)
 	}
+	campaign.AccessKeys = nil
 
 	// go s.notificationService.NotifyCampaignCreation(campaign)
 	// go s.analyticsService.GetCurrentData().IncrementCampaigns(campaign.TargetAmount)
@@ -122,12 +145,23 @@ func (s *campaignService) UpdateCampaign(req dto.CampaignUpdateRequest, campaign
 	}
 
 	// Validate User can update Campaign
-	if campaign.CreatedBy.Handle != userHandle {
-		return nil, errs.BadRequest("Unauthorized: only campaign owner can update campaign", nil)
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.BadRequest("Unauthorized: only campaign organisers can update campaign", nil)
+	}
+
+	// End date changes are validated by the extend and close operations
+	if req.EndDate != nil && !req.EndDate.Equal(campaign.EndDate) {
+		return nil, errs.BadRequest("Campaign end date can only be changed by extending or closing the campaign", nil)
 	}
 
 	// Update Campaign
-	campaign.Update(req.Title, req.Description, req.EndDate)
+	campaign.Update(req.Title, req.Description)
+	if req.GoalAmount != nil {
+		campaign.GoalAmount = *req.GoalAmount
+	}
+	if req.ActivityVoteThreshold != nil {
+		campaign.ActivityVoteThreshold = *req.ActivityVoteThreshold
+	}
 	campaign.Key = key
 	campaign.Encrypt(s.encryptor)
 	*campaign, err = s.repo.Update(campaign)
@@ -137,6 +171,15 @@ func (s *campaignService) UpdateCampaign(req dto.CampaignUpdateRequest, campaign
 		return nil, errs.InternalServerError(err).Log(s.logger)
 	}
 
+	// Milestones already reached are kept
+	if req.Milestones != nil {
+		campaign.Milestones, err = s.repo.ReplaceMilestones(campaign.ID, models.NewCampaignMilestones(campaign.ID, req.Milestones))
+		if err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+	s.checkMilestones(campaign)
+
 	// Broadcast Event
 	// go s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignUpdated, campaign)
 
@@ -153,12 +196,55 @@ func (s *campaignService) UpdateCampaign(req dto.CampaignUpdateRequest, campaign
 
 }
 
+// UpdateCampaignVisibility makes a campaign public or private
+func (s *campaignService) UpdateCampaignVisibility(visibility models.CampaignVisibility, campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate User can update Campaign
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.Forbidden("Only campaign organisers can change campaign visibility")
+	}
+
+	campaign.Key = key
+	if visibility == models.CampaignVisibilityPublic {
+		if err := campaign.MakePublic(s.encryptor); err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	} else {
+		campaign.MakePrivate()
+	}
+
+	campaign.Encrypt(s.encryptor)
+	*campaign, err = s.repo.Update(campaign)
+	campaign.Decrypt(s.encryptor)
+
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignUpdated, campaign)
+	})
+
+	return campaign, nil
+}
+
 // DeleteCampaign deletes a campaign by ID
 func (s *campaignService) DeleteCampaign(campaignID string) error {
 	// TODO: only admin should be able to delete campaigns
+	// Uploaded files are looked up first so they can be removed once the campaign is gone
+	campaign, lookupErr := s.repo.GetByIDWithSelectedData(campaignID, models.PreloadOption{Images: true, Activities: true, ActivitiesExpenses: true})
+
 	if err := s.repo.Delete(campaignID); err != nil {
 		return errs.InternalServerError(err).Log(s.logger)
 	}
+
+	if lookupErr == nil {
+		deleteStoredFiles(s.storage, s.logger, s.runAsync, campaign.StoredFileIDs())
+	}
 	return nil
 }
 
@@ -166,14 +252,14 @@ func (s *campaignService) DeleteCampaign(campaignID string) error {
 // GetCampaignByID fetches campaign by ID
 func (s *campaignService) GetCampaignByID(id, key string) (*models.Campaign, error) {
 	campaign, err := s.repo.GetByID(id)
-	//TODO:implement a better way to handle this
-	campaign.UpdateTotalContributionsAmount()
 	if err != nil {
 		if database.Error(err).IsNotfound() {
 			return nil, errs.BadRequest("Campaign not found", nil)
 		}
 		return nil, errs.InternalServerError(err).Log(s.logger)
 	}
+	//TODO:implement a better way to handle this
+	campaign.UpdateTotalContributionsAmount()
 	campaign.Key = key
 	campaign.Decrypt(s.encryptor)
 	return &campaign, nil
@@ -201,6 +287,7 @@ func (s *campaignService) GetCampaignByIDWithAllRelatedData(id string) (*models.
 		Contributors:           true,
 		ContributorsActivities: true,
 		CreatedBy:              true,
+		Milestones:             true,
 	}
 
 	campaign, err := s.repo.GetByIDWithSelectedData(id, preload)
@@ -213,6 +300,35 @@ func (s *campaignService) GetCampaignByIDWithAllRelatedData(id string) (*models.
 	return &campaign, nil
 }
 
+// GetPublicCampaignBySlug fetches a public campaign by its slug and decrypts it with the sealed campaign key
+func (s *campaignService) GetPublicCampaignBySlug(slug string) (*models.Campaign, error) {
+	campaign, err := s.repo.GetBySlug(slug)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Campaign not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := campaign.UnsealPublicKey(s.encryptor); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	campaign.Decrypt(s.encryptor)
+	return &campaign, nil
+}
+
+// HasCampaign checks if the user is the owner of a campaign, a user can only own one campaign at a time
+func (s *campaignService) HasCampaign(userHandle string) (bool, error) {
+	campaign, err := s.repo.GetByHandle(userHandle)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return false, nil
+		}
+		return false, errs.InternalServerError(err).Log(s.logger)
+	}
+	return campaign.ID != "", nil
+}
+
 // GetExpiredCampaigns fetches all expired campaigns
 func (s *campaignService) GetExpiredCampaigns() ([]models.Campaign, error) {
 	//TODO: only admin should be able to get expired campaigns
@@ -249,32 +365,42 @@ func (s *campaignService) RecalculateTargetAmount(campaignID string) {
 		return
 	}
 	var newTargetAmount float64
-	for _, contributor := range campaign.Contributors {
-		newTargetAmount += contributor.GetAmountTotal()
+	for _, contributor := range campaign.AcceptedContributors() {
+		newTargetAmount += campaign.GetAmountOwed(&contributor)
 	}
 	campaign.TargetAmount = newTargetAmount
 
 	s.repo.Update(&campaign)
+	s.checkMilestones(&campaign)
 
 	// Broadcast Event
 	// go s.broadcaster.NewEvent(campaignID, websocket.EventTypeCampaignUpdated, campaign)
 
 	s.runAsync(func() {
-		s.broadcaster.NewEvent(campaignID, websocket.EventTypeCampaignUpdated, campaign)
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeCampaignUpdated, &campaign)
 	})
 
 }
 
+// CheckMilestones marks the milestones a campaign has reached, each milestone is notified once
+func (s *campaignService) CheckMilestones(campaignID string) {
+	campaign, err := s.repo.GetByID(campaignID)
+	if err != nil {
+		return
+	}
+	s.checkMilestones(&campaign)
+}
+
 // Helper Methods --------------------------------------------------------
 
 // checkExistingCampaign verifies if user can create a new campaign
 func (s *campaignService) checkExistingCampaign(userHandle string) error {
-	campaign, err := s.repo.GetByHandle(userHandle)
-	if err == nil && campaign.ID != "" {
-		return errs.BadRequest("You already have an active campaign", nil)
+	hasCampaign, err := s.HasCampaign(userHandle)
+	if err != nil {
+		return err
 	}
-	if err != nil && !database.Error(err).IsNotfound() {
-		return errs.InternalServerError(err).Log(s.logger)
+	if hasCampaign {
+		return errs.BadRequest("You already have an active campaign", nil)
 	}
 	return nil
 }
@@ -289,3 +415,25 @@ func createUsersFromEmails(emails []string) []models.User {
 	}
 	return users
 }
+
+// checkMilestones notifies the milestones of a campaign that are due, the database decides which
+// request marks a milestone so concurrent payments don't notify it twice
+func (s *campaignService) checkMilestones(campaign *models.Campaign) {
+	for _, milestone := range campaign.DueMilestones() {
+		reached, err := s.repo.MarkMilestoneReached(milestone.ID)
+		if err != nil {
+			errs.InternalServerError(err).Log(s.logger)
+			continue
+		}
+		if !reached {
+			continue
+		}
+
+		milestone.MarkReached()
+		reachedMilestone := *milestone
+		s.runAsync(func() {
+			s.notificationService.NotifyCampaignMilestone(campaign, fmt.Sprintf("%d%% of the goal raised", reachedMilestone.Percentage))
+			s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignMilestone, reachedMilestone)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+)
+
+type campaignAccessService struct {
+	repo                repositories.CampaignAccessKeyRepository
+	campaignRepo        repositories.CampaignRepository
+	notificationService services.NotificationService
+	encryptor           encryption.Encryptor
+	secret              string
+	logger              logger.Logger
+	runAsync            func(func())
+}
+
+func NewCampaignAccessService(
+	repo repositories.CampaignAccessKeyRepository,
+	campaignRepo repositories.CampaignRepository,
+	notificationService services.NotificationService,
+	encryptor encryption.Encryptor,
+	secret string,
+	logger logger.Logger,
+) services.CampaignAccessService {
+	return &campaignAccessService{
+		repo:                repo,
+		campaignRepo:        campaignRepo,
+		notificationService: notificationService,
+		encryptor:           encryptor,
+		secret:              secret,
+		logger:              logger,
+		runAsync:            func(f func()) { go f() },
+	}
+}
+
+// VerifyCampaignKey checks the presented key against the campaign and returns the campaign key it unlocks.
+// Campaigns created before per-member keys existed have no stored keys, for those the key is
+// verified by decrypting the campaign with it until the creator issues member keys.
+func (s *campaignAccessService) VerifyCampaignKey(campaignID, key, userEmail string) (string, error) {
+	campaign, err := s.campaignRepo.GetByIDWithSelectedData(campaignID, models.PreloadOption{Contributors: true})
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return "", errs.NotFound("Campaign not found")
+		}
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+
+	accessKey, err := s.repo.GetByKeyHash(campaign.ID, encryption.HashKey(s.secret, key))
+	if err != nil {
+		if !database.Error(err).IsNotfound() {
+			return "", errs.InternalServerError(err).Log(s.logger)
+		}
+		return s.verifyLegacyKey(&campaign, key, userEmail)
+	}
+
+	if !accessKey.Matches(s.secret, key) {
+		return "", errs.New("Invalid campaign key", http.StatusUnauthorized)
+	}
+	if accessKey.IsRevoked() {
+		return "", errs.Forbidden("Campaign key has been revoked")
+	}
+	if accessKey.Email != userEmail {
+		return "", errs.Forbidden("Campaign key was not issued to this user")
+	}
+	if contributor := campaign.GetContributorByEmail(userEmail); contributor != nil && !contributor.Invitation.IsAccepted() {
+		return "", errs.Forbidden("Accept your invitation to access this campaign")
+	}
+
+	campaignKey, err := accessKey.CampaignKey(s.encryptor, key)
+	if err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+	return campaignKey, nil
+}
+
+// CreateOwnerKey registers the campaign key as the creator's access key
+func (s *campaignAccessService) CreateOwnerKey(campaign *models.Campaign) error {
+	accessKey, err := models.NewOwnerAccessKey(s.encryptor, s.secret, campaign.ID, campaign.Key, campaign.CreatedBy.Email)
+	if err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.repo.Create(accessKey); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	return nil
+}
+
+// PrepareCampaignKeys creates the creator's key and a key for each contributor of a new campaign.
+// The keys are attached to the campaign so they are stored in the same transaction as the campaign
+func (s *campaignAccessService) PrepareCampaignKeys(campaign *models.Campaign) error {
+	ownerKey, err := models.NewOwnerAccessKey(s.encryptor, s.secret, campaign.ID, campaign.Key, campaign.CreatedBy.Email)
+	if err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	campaign.AccessKeys = []models.CampaignAccessKey{*ownerKey}
+
+	for i := range campaign.Contributors {
+		contributor := &campaign.Contributors[i]
+		accessKey, err := models.NewCampaignAccessKey(s.encryptor, s.secret, campaign.ID, campaign.Key, contributor.Email)
+		if err != nil {
+			return errs.InternalServerError(err).Log(s.logger)
+		}
+		contributor.AccessKey = accessKey.Key
+		campaign.AccessKeys = append(campaign.AccessKeys, *accessKey)
+	}
+	return nil
+}
+
+// PrepareMemberKey creates a key for a new contributor without storing it, the key is stored along with the contributor
+func (s *campaignAccessService) PrepareMemberKey(campaignID, campaignKey string, contributor *models.Contributor) (*models.CampaignAccessKey, error) {
+	accessKey, err := models.NewCampaignAccessKey(s.encryptor, s.secret, campaignID, campaignKey, contributor.Email)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	contributor.AccessKey = accessKey.Key
+	return accessKey, nil
+}
+
+// IssueMemberKey revokes any key previously issued to email and issues a new one
+func (s *campaignAccessService) IssueMemberKey(campaignID, campaignKey, email string) (string, error) {
+	if err := s.RevokeMemberKeys(campaignID, email); err != nil {
+		return "", err
+	}
+
+	accessKey, err := models.NewCampaignAccessKey(s.encryptor, s.secret, campaignID, campaignKey, email)
+	if err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.repo.Create(accessKey); err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+	return accessKey.Key, nil
+}
+
+// RevokeMemberKeys revokes every key issued to email for a campaign
+func (s *campaignAccessService) RevokeMemberKeys(campaignID, email string) error {
+	if err := s.repo.RevokeByEmail(campaignID, email); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	return nil
+}
+
+// PrepareOwnerKeyTransfer moves the owner key of a campaign to its new owner and creates a member key for the previous owner.
+// Nothing is stored, the keys are stored with the ownership change. Campaigns without stored keys keep verifying
+// the campaign key, so no keys are returned for them.
+func (s *campaignAccessService) PrepareOwnerKeyTransfer(campaign *models.Campaign, campaignKey, newOwnerEmail string) (*models.OwnerKeyTransfer, error) {
+	hasKeys, err := s.repo.HasKeys(campaign.ID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if !hasKeys {
+		return nil, nil
+	}
+
+	ownerKey, err := s.repo.GetOwnerKey(campaign.ID)
+	if err != nil {
+		if !database.Error(err).IsNotfound() {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+		ownerKey, err = models.NewOwnerAccessKey(s.encryptor, s.secret, campaign.ID, campaignKey, newOwnerEmail)
+		if err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+	ownerKey.Email = newOwnerEmail
+
+	previousOwnerKey, err := models.NewCampaignAccessKey(s.encryptor, s.secret, campaign.ID, campaignKey, campaign.CreatedBy.Email)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	return &models.OwnerKeyTransfer{OwnerKey: ownerKey, PreviousOwnerKey: previousOwnerKey}, nil
+}
+
+// IssueInvitation starts a new invitation for a contributor, the contributor has to accept it to join the campaign
+func (s *campaignAccessService) IssueInvitation(contributor *models.Contributor) {
+	contributor.Invitation.Issue(s.secret)
+}
+
+// RestoreInvitationToken recovers the token of a pending invitation so the same link can be sent again
+func (s *campaignAccessService) RestoreInvitationToken(contributor *models.Contributor) {
+	contributor.Invitation.Remind(s.secret)
+}
+
+// InvitationTokenHash returns the hash an invitation is looked up by
+func (s *campaignAccessService) InvitationTokenHash(token string) string {
+	return models.HashInvitationToken(s.secret, token)
+}
+
+// ReissueContributorKey issues a new key to a contributor and emails it to them
+func (s *campaignAccessService) ReissueContributorKey(campaignID string, contributorID uint, key, userHandle string) error {
+	campaign, contributor, err := s.getCampaignAndContributor(campaignID, contributorID, userHandle)
+	if err != nil {
+		return err
+	}
+
+	// Register the creator's key first so the creator keeps access once the campaign has stored keys
+	hasKeys, err := s.repo.HasKeys(campaign.ID)
+	if err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	if !hasKeys {
+		campaign.Key = key
+		if err := s.CreateOwnerKey(campaign); err != nil {
+			return err
+		}
+	}
+
+	contributor.AccessKey, err = s.IssueMemberKey(campaign.ID, key, contributor.Email)
+	if err != nil {
+		return err
+	}
+
+	campaign.Key = key
+	campaign.Decrypt(s.encryptor)
+
+	s.runAsync(func() {
+		s.notificationService.NotifyContributorAdded(contributor, campaign)
+	})
+
+	return nil
+}
+
+// RevokeContributorKey revokes a contributor's access to a campaign
+func (s *campaignAccessService) RevokeContributorKey(campaignID string, contributorID uint, userHandle string) error {
+	campaign, contributor, err := s.getCampaignAndContributor(campaignID, contributorID, userHandle)
+	if err != nil {
+		return err
+	}
+
+	return s.RevokeMemberKeys(campaign.ID, contributor.Email)
+}
+
+// Helper Methods --------------------------------------------------------
+
+// verifyLegacyKey verifies the key of a campaign without stored keys by decrypting the campaign with it
+func (s *campaignAccessService) verifyLegacyKey(campaign *models.Campaign, key, userEmail string) (string, error) {
+	hasKeys, err := s.repo.HasKeys(campaign.ID)
+	if err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+	if hasKeys {
+		return "", errs.New("Invalid campaign key", http.StatusUnauthorized)
+	}
+
+	campaign.Key = key
+	if err := campaign.Decrypt(s.encryptor); err != nil {
+		return "", errs.New("Invalid campaign key", http.StatusUnauthorized)
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return "", errs.Forbidden("You are not part of this campaign")
+	}
+	return key, nil
+}
+
+// getCampaignAndContributor fetches a campaign contributor, only campaign organisers can manage keys
+func (s *campaignAccessService) getCampaignAndContributor(campaignID string, contributorID uint, userHandle string) (*models.Campaign, *models.Contributor, error) {
+	campaign, err := s.campaignRepo.GetByIDWithSelectedData(campaignID, models.PreloadOption{Contributors: true})
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, nil, errs.NotFound("Campaign not found")
+		}
+		return nil, nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if !can(userHandle, &campaign, models.CampaignActionManageContributors) {
+		return nil, nil, errs.Forbidden("Only campaign organisers can manage campaign keys")
+	}
+
+	contributor := campaign.GetContributorByID(contributorID)
+	if contributor == nil {
+		return nil, nil, errs.NotFound("Contributor not found")
+	}
+	return &campaign, contributor, nil
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const testCampaignKeySecret = "test-campaign-key-secret"
+
+func setupCampaignAccessTest(t *testing.T) (
+	*campaignAccessService,
+	*mockRepo.MockCampaignAccessKeyRepository,
+	*mockRepo.MockCampaignRepository,
+	*mockService.MockNotificationService,
+	encryption.Encryptor,
+) {
+	repo := mockRepo.NewMockCampaignAccessKeyRepository(t)
+	campaignRepo := mockRepo.NewMockCampaignRepository(t)
+	notificationService := mockService.NewMockNotificationService(t)
+	encryptor := encryption.New([]string{"test-key"})
+
+	service := &campaignAccessService{
+		repo:                repo,
+		campaignRepo:        campaignRepo,
+		notificationService: notificationService,
+		encryptor:           encryptor,
+		secret:              testCampaignKeySecret,
+		logger:              mockLogger.NewMockLogger(t),
+		runAsync:            func(f func()) { f() },
+	}
+
+	return service, repo, campaignRepo, notificationService, encryptor
+}
+
+func assertErrorCode(t *testing.T, err error, code int) {
+	appErr, ok := err.(errs.Error)
+	if assert.True(t, ok) {
+		assert.Equal(t, code, appErr.Code())
+	}
+}
+
+func TestVerifyCampaignKey(t *testing.T) {
+	service, repo, campaignRepo, _, encryptor := setupCampaignAccessTest(t)
+
+	campaignKey := "GC-campaign"
+	campaign := models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+		},
+	}
+
+	memberKey, err := models.NewCampaignAccessKey(encryptor, testCampaignKeySecret, campaign.ID, campaignKey, "member@example.com")
+	assert.NoError(t, err)
+
+	reset := func() {
+		repo.ExpectedCalls = nil
+		campaignRepo.ExpectedCalls = nil
+		campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil)
+	}
+
+	t.Run("valid member key unlocks the campaign key", func(t *testing.T) {
+		reset()
+		repo.EXPECT().GetByKeyHash(campaign.ID, memberKey.KeyHash).Return(memberKey, nil)
+
+		key, err := service.VerifyCampaignKey(campaign.ID, memberKey.Key, "member@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, campaignKey, key)
+	})
+
+	t.Run("key issued to another member", func(t *testing.T) {
+		reset()
+		repo.EXPECT().GetByKeyHash(campaign.ID, memberKey.KeyHash).Return(memberKey, nil)
+
+		_, err := service.VerifyCampaignKey(campaign.ID, memberKey.Key, "creator@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		reset()
+		revoked := *memberKey
+		revoked.Revoke()
+		repo.EXPECT().GetByKeyHash(campaign.ID, memberKey.KeyHash).Return(&revoked, nil)
+
+		_, err := service.VerifyCampaignKey(campaign.ID, memberKey.Key, "member@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("member who has not accepted their invitation", func(t *testing.T) {
+		repo.ExpectedCalls = nil
+		campaignRepo.ExpectedCalls = nil
+		invited := campaign
+		invited.Contributors = []models.Contributor{{ID: 1, Email: "member@example.com"}}
+		invited.Contributors[0].Invitation.Issue(testCampaignKeySecret)
+		campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(invited, nil)
+		repo.EXPECT().GetByKeyHash(campaign.ID, memberKey.KeyHash).Return(memberKey, nil)
+
+		_, err := service.VerifyCampaignKey(campaign.ID, memberKey.Key, "member@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("unknown key on a campaign with stored keys", func(t *testing.T) {
+		reset()
+		repo.EXPECT().GetByKeyHash(campaign.ID, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		repo.EXPECT().HasKeys(campaign.ID).Return(true, nil)
+
+		_, err := service.VerifyCampaignKey(campaign.ID, "GM-wrong", "member@example.com")
+		assertErrorCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("campaign not found", func(t *testing.T) {
+		campaignRepo.ExpectedCalls = nil
+		campaignRepo.EXPECT().GetByIDWithSelectedData("missing", mock.Anything).Return(models.Campaign{}, gorm.ErrRecordNotFound)
+
+		_, err := service.VerifyCampaignKey("missing", memberKey.Key, "member@example.com")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestVerifyCampaignKey_Legacy(t *testing.T) {
+	service, repo, campaignRepo, _, encryptor := setupCampaignAccessTest(t)
+
+	campaignKey := "GC-campaign"
+	campaign := models.Campaign{
+		ID:          "campaign-123",
+		Key:         campaignKey,
+		Title:       "Trip",
+		Description: "Weekend trip",
+		CreatedBy:   models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+		},
+	}
+	assert.NoError(t, campaign.Encrypt(encryptor))
+
+	repo.EXPECT().GetByKeyHash(campaign.ID, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+	repo.EXPECT().HasKeys(campaign.ID).Return(false, nil)
+	campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil)
+
+	t.Run("campaign key of a member", func(t *testing.T) {
+		key, err := service.VerifyCampaignKey(campaign.ID, campaignKey, "member@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, campaignKey, key)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := service.VerifyCampaignKey(campaign.ID, "GC-wrong", "member@example.com")
+		assertErrorCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("user not part of campaign", func(t *testing.T) {
+		_, err := service.VerifyCampaignKey(campaign.ID, campaignKey, "stranger@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestPrepareCampaignKeys(t *testing.T) {
+	service, _, _, _, encryptor := setupCampaignAccessTest(t)
+
+	campaign := &models.Campaign{
+		ID:        "campaign-123",
+		Key:       "GC-campaign",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{Email: "creator@example.com"},
+			{Email: "member@example.com"},
+		},
+	}
+
+	// Nothing is stored until the campaign is created
+	err := service.PrepareCampaignKeys(campaign)
+	assert.NoError(t, err)
+	if assert.Len(t, campaign.AccessKeys, 3) {
+		assert.True(t, campaign.AccessKeys[0].IsOwner)
+		assert.True(t, campaign.AccessKeys[0].Matches(testCampaignKeySecret, "GC-campaign"))
+	}
+
+	member := campaign.Contributors[1]
+	assert.NotEmpty(t, member.AccessKey)
+	unsealed, err := campaign.AccessKeys[2].CampaignKey(encryptor, member.AccessKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "GC-campaign", unsealed)
+}
+
+func TestPrepareMemberKey(t *testing.T) {
+	service, _, _, _, encryptor := setupCampaignAccessTest(t)
+
+	// Nothing is stored until the contributor is created
+	contributor := &models.Contributor{Email: "member@example.com"}
+	accessKey, err := service.PrepareMemberKey("campaign-123", "GC-campaign", contributor)
+	assert.NoError(t, err)
+	assert.Equal(t, "member@example.com", accessKey.Email)
+	assert.False(t, accessKey.IsOwner)
+	assert.Equal(t, accessKey.Key, contributor.AccessKey)
+	assert.True(t, accessKey.Matches(testCampaignKeySecret, contributor.AccessKey))
+
+	unsealed, err := accessKey.CampaignKey(encryptor, contributor.AccessKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "GC-campaign", unsealed)
+}
+
+func TestReissueContributorKey(t *testing.T) {
+	service, repo, campaignRepo, notificationService, _ := setupCampaignAccessTest(t)
+
+	campaign := models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+		},
+	}
+
+	t.Run("creator reissues a key", func(t *testing.T) {
+		campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil).Once()
+		repo.EXPECT().HasKeys(campaign.ID).Return(false, nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(k *models.CampaignAccessKey) bool { return k.IsOwner })).Return(nil).Once()
+		repo.EXPECT().RevokeByEmail(campaign.ID, "member@example.com").Return(nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(k *models.CampaignAccessKey) bool { return !k.IsOwner })).Return(nil).Once()
+		notificationService.EXPECT().NotifyContributorAdded(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.AccessKey != ""
+		}), mock.Anything).Return(nil).Once()
+
+		err := service.ReissueContributorKey(campaign.ID, 1, "GC-campaign", "creator")
+		assert.NoError(t, err)
+	})
+
+	t.Run("only creator can reissue", func(t *testing.T) {
+		campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil).Once()
+
+		err := service.ReissueContributorKey(campaign.ID, 1, "GC-campaign", "member")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("contributor not found", func(t *testing.T) {
+		campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil).Once()
+
+		err := service.ReissueContributorKey(campaign.ID, 2, "GC-campaign", "creator")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestRevokeContributorKey(t *testing.T) {
+	service, repo, campaignRepo, _, _ := setupCampaignAccessTest(t)
+
+	campaign := models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+		},
+	}
+
+	campaignRepo.EXPECT().GetByIDWithSelectedData(campaign.ID, mock.Anything).Return(campaign, nil)
+	repo.EXPECT().RevokeByEmail(campaign.ID, "member@example.com").Return(nil)
+
+	err := service.RevokeContributorKey(campaign.ID, 1, "creator")
+	assert.NoError(t, err)
+}
+
+func TestPrepareOwnerKeyTransfer(t *testing.T) {
+	service, repo, _, _, encryptor := setupCampaignAccessTest(t)
+
+	campaign := &models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+	}
+
+	t.Run("moves the owner key and creates a member key for the previous owner", func(t *testing.T) {
+		repo.EXPECT().HasKeys(campaign.ID).Return(true, nil).Once()
+		repo.EXPECT().GetOwnerKey(campaign.ID).Return(&models.CampaignAccessKey{ID: 1, Email: "creator@example.com", IsOwner: true}, nil).Once()
+
+		keys, err := service.PrepareOwnerKeyTransfer(campaign, "GC-campaign", "new@example.com")
+		assert.NoError(t, err)
+		if assert.NotNil(t, keys) {
+			assert.Equal(t, uint(1), keys.OwnerKey.ID)
+			assert.Equal(t, "new@example.com", keys.OwnerKey.Email)
+			assert.Equal(t, "creator@example.com", keys.PreviousOwnerKey.Email)
+			assert.False(t, keys.PreviousOwnerKey.IsOwner)
+
+			unsealed, err := keys.PreviousOwnerKey.CampaignKey(encryptor, keys.PreviousOwnerKey.Key)
+			assert.NoError(t, err)
+			assert.Equal(t, "GC-campaign", unsealed)
+		}
+	})
+
+	t.Run("creates the owner key when none is active", func(t *testing.T) {
+		repo.EXPECT().HasKeys(campaign.ID).Return(true, nil).Once()
+		repo.EXPECT().GetOwnerKey(campaign.ID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		keys, err := service.PrepareOwnerKeyTransfer(campaign, "GC-campaign", "new@example.com")
+		assert.NoError(t, err)
+		if assert.NotNil(t, keys) {
+			assert.Zero(t, keys.OwnerKey.ID)
+			assert.True(t, keys.OwnerKey.IsOwner)
+			assert.Equal(t, "new@example.com", keys.OwnerKey.Email)
+		}
+	})
+
+	t.Run("legacy campaign keeps the campaign key", func(t *testing.T) {
+		repo.EXPECT().HasKeys(campaign.ID).Return(false, nil).Once()
+
+		keys, err := service.PrepareOwnerKeyTransfer(campaign, "GC-campaign", "new@example.com")
+		assert.NoError(t, err)
+		assert.Nil(t, keys)
+	})
+}
+
+func TestIssueInvitation(t *testing.T) {
+	service, _, _, _, _ := setupCampaignAccessTest(t)
+	contributor := &models.Contributor{Email: "member@example.com"}
+
+	service.IssueInvitation(contributor)
+	assert.True(t, contributor.Invitation.IsPending())
+	assert.NotEmpty(t, contributor.Invitation.Token)
+	assert.Equal(t, service.InvitationTokenHash(contributor.Invitation.Token), *contributor.Invitation.TokenHash)
+
+	// Reminders send the same token so the link in the invitation email keeps working
+	token := contributor.Invitation.Token
+	contributor.Invitation.Token = ""
+	service.RestoreInvitationToken(contributor)
+	assert.Equal(t, token, contributor.Invitation.Token)
+	assert.Equal(t, service.InvitationTokenHash(contributor.Invitation.Token), *contributor.Invitation.TokenHash)
+	assert.NotNil(t, contributor.Invitation.RemindedAt)
+
+	// Invitations issued before tokens had seeds get a new token
+	contributor.Invitation.TokenSeed = nil
+	service.RestoreInvitationToken(contributor)
+	assert.NotEqual(t, token, contributor.Invitation.Token)
+	assert.Equal(t, service.InvitationTokenHash(contributor.Invitation.Token), *contributor.Invitation.TokenHash)
+	assert.NotNil(t, contributor.Invitation.TokenSeed)
+}
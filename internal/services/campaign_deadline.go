@@ -0,0 +1,234 @@
+package services
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+const campaignDateFormat = "January 2, 2006 15:04 MST"
+
+type campaignDeadlineService struct {
+	repo                repositories.CampaignExtensionRepository
+	campaignRepo        repositories.CampaignRepository
+	campaignService     services.CampaignService
+	cronService         services.CronService
+	notificationService services.NotificationService
+	broadcaster         services.EventBroadcaster
+	encryptor           encryption.Encryptor
+	logger              logger.Logger
+	runAsync            func(func())
+}
+
+func NewCampaignDeadlineService(
+	repo repositories.CampaignExtensionRepository,
+	campaignRepo repositories.CampaignRepository,
+	campaignService services.CampaignService,
+	cronService services.CronService,
+	notificationService services.NotificationService,
+	broadcaster services.EventBroadcaster,
+	encryptor encryption.Encryptor,
+	logger logger.Logger,
+) services.CampaignDeadlineService {
+	return &campaignDeadlineService{
+		repo:                repo,
+		campaignRepo:        campaignRepo,
+		campaignService:     campaignService,
+		cronService:         cronService,
+		notificationService: notificationService,
+		broadcaster:         broadcaster,
+		encryptor:           encryptor,
+		logger:              logger,
+		runAsync:            func(f func()) { go f() },
+	}
+}
+
+// ExtendCampaign moves the end date of a campaign, if the campaign requires approval for extensions
+// the extension waits for a majority of contributors to vote for it
+func (s *campaignDeadlineService) ExtendCampaign(endDate time.Time, campaignID, key, userHandle string) (*models.CampaignExtension, error) {
+	campaign, err := s.getCampaignAsOrganiser(campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := campaign.ValidateExtension(endDate); err != nil {
+		return nil, errs.BadRequest("Cannot extend campaign: "+err.Error(), nil)
+	}
+
+	if _, err := s.repo.GetPendingByCampaignID(campaignID); err == nil {
+		return nil, errs.BadRequest("An extension of this campaign is already awaiting approval", nil)
+	} else if !database.Error(err).IsNotfound() {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	extension := models.NewCampaignExtension(campaignID, campaign.EndDate, endDate)
+	requiresApproval := campaign.ExtensionRequiresApproval && len(campaign.AcceptedContributors()) > 0
+	if !requiresApproval {
+		extension.Approve()
+	}
+
+	if err := s.repo.Create(extension); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if !requiresApproval {
+		return extension, s.applyExtension(campaign, key, extension)
+	}
+
+	s.runAsync(func() {
+		s.notificationService.NotifyCampaignChanged(campaign, "End date extension awaiting your approval",
+			formatCampaignDate(extension.FromDate), formatCampaignDate(extension.ToDate))
+	})
+
+	return extension, nil
+}
+
+// VoteOnExtension records a contributor's vote on a pending extension and applies it once a majority approved it
+func (s *campaignDeadlineService) VoteOnExtension(extensionID uint, approve bool, campaignID, key, userEmail string) (*models.CampaignExtension, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	contributor := campaign.GetContributorByEmail(userEmail)
+	if contributor == nil || !contributor.Invitation.IsAccepted() {
+		return nil, errs.Forbidden("Only campaign contributors can vote on extensions")
+	}
+
+	extension, err := s.repo.GetByID(extensionID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Extension not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if extension.CampaignID != campaignID {
+		return nil, errs.NotFound("Extension not found")
+	}
+	if !extension.IsPending() {
+		return nil, errs.BadRequest("Extension has already been resolved", nil)
+	}
+	if extension.HasVoted(contributor.ID) {
+		return nil, errs.BadRequest("You have already voted on this extension", nil)
+	}
+
+	if err := s.repo.AddVote(extension.AddVote(contributor.ID, approve)); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	extension.Tally(len(campaign.AcceptedContributors()))
+	if extension.IsPending() {
+		return &extension, nil
+	}
+
+	// The campaign may have ended or been paid out while the vote was running
+	approved := extension.Status == models.CampaignExtensionStatusApproved
+	if approved && campaign.ValidateExtension(extension.ToDate) != nil {
+		extension.Reject()
+		approved = false
+	}
+
+	if err := s.repo.Update(&extension); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if approved {
+		return &extension, s.applyExtension(campaign, key, &extension)
+	}
+
+	s.runAsync(func() {
+		s.notificationService.NotifyCampaignChanged(campaign, "End date extension rejected",
+			formatCampaignDate(extension.FromDate), formatCampaignDate(extension.ToDate))
+	})
+
+	return &extension, nil
+}
+
+// CloseCampaignEarly ends an active campaign now, only organisers can close a campaign
+func (s *campaignDeadlineService) CloseCampaignEarly(campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.getCampaignAsOrganiser(campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	previousEndDate := campaign.EndDate
+	if err := campaign.CloseEarly(); err != nil {
+		return nil, errs.BadRequest("Cannot close campaign: "+err.Error(), nil)
+	}
+
+	// A pending extension no longer applies to a closed campaign
+	if extension, err := s.repo.GetPendingByCampaignID(campaignID); err == nil {
+		extension.Reject()
+		if err := s.repo.Update(&extension); err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	} else if !database.Error(err).IsNotfound() {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.saveCampaign(campaign, key); err != nil {
+		return nil, err
+	}
+
+	s.cronService.RescheduleCampaignDeadline(campaign)
+	s.runAsync(func() {
+		s.notificationService.NotifyCampaignChanged(campaign, "Campaign closed early",
+			formatCampaignDate(previousEndDate), formatCampaignDate(campaign.EndDate))
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignUpdated, campaign)
+	})
+
+	return campaign, nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+func (s *campaignDeadlineService) applyExtension(campaign *models.Campaign, key string, extension *models.CampaignExtension) error {
+	campaign.ExtendEndDate(extension.ToDate)
+	if err := s.saveCampaign(campaign, key); err != nil {
+		return err
+	}
+
+	s.cronService.RescheduleCampaignDeadline(campaign)
+	s.runAsync(func() {
+		s.notificationService.NotifyCampaignChanged(campaign, "End date extended",
+			formatCampaignDate(extension.FromDate), formatCampaignDate(extension.ToDate))
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignUpdated, campaign)
+	})
+	return nil
+}
+
+func (s *campaignDeadlineService) saveCampaign(campaign *models.Campaign, key string) error {
+	var err error
+	campaign.Key = key
+	campaign.Encrypt(s.encryptor)
+	*campaign, err = s.campaignRepo.Update(campaign)
+	campaign.Decrypt(s.encryptor)
+
+	if err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	return nil
+}
+
+func (s *campaignDeadlineService) getCampaignAsOrganiser(campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.Forbidden("Only campaign organisers can change the campaign end date")
+	}
+	return campaign, nil
+}
+
+func formatCampaignDate(date time.Time) string {
+	return date.UTC().Format(campaignDateFormat)
+}
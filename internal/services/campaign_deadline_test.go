@@ -0,0 +1,267 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type campaignDeadlineMocks struct {
+	repo                *mockRepo.MockCampaignExtensionRepository
+	campaignRepo        *mockRepo.MockCampaignRepository
+	campaignService     *mockService.MockCampaignService
+	cronService         *mockService.MockCronService
+	notificationService *mockService.MockNotificationService
+	broadcaster         *mockService.MockEventBroadcaster
+}
+
+func setupCampaignDeadlineTest(t *testing.T) (*campaignDeadlineService, campaignDeadlineMocks) {
+	m := campaignDeadlineMocks{
+		repo:                mockRepo.NewMockCampaignExtensionRepository(t),
+		campaignRepo:        mockRepo.NewMockCampaignRepository(t),
+		campaignService:     mockService.NewMockCampaignService(t),
+		cronService:         mockService.NewMockCronService(t),
+		notificationService: mockService.NewMockNotificationService(t),
+		broadcaster:         mockService.NewMockEventBroadcaster(t),
+	}
+
+	service := &campaignDeadlineService{
+		repo:                m.repo,
+		campaignRepo:        m.campaignRepo,
+		campaignService:     m.campaignService,
+		cronService:         m.cronService,
+		notificationService: m.notificationService,
+		broadcaster:         m.broadcaster,
+		encryptor:           encryption.New([]string{"test-key"}),
+		logger:              mockLogger.NewMockLogger(t),
+		runAsync:            func(f func()) { f() },
+	}
+
+	return service, m
+}
+
+func newDeadlineTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		Title:     "Trip",
+		StartDate: time.Now().Add(-24 * time.Hour),
+		EndDate:   time.Now().Add(24 * time.Hour),
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "one@example.com"},
+			{ID: 2, Email: "two@example.com"},
+			{ID: 3, Email: "three@example.com"},
+		},
+	}
+}
+
+func expectCampaignSaved(m campaignDeadlineMocks) {
+	m.campaignRepo.EXPECT().Update(mock.AnythingOfType("*models.Campaign")).
+		RunAndReturn(func(c *models.Campaign) (models.Campaign, error) { return *c, nil }).Once()
+	m.cronService.EXPECT().RescheduleCampaignDeadline(mock.AnythingOfType("*models.Campaign")).Return().Once()
+	m.broadcaster.EXPECT().NewEvent("campaign-123", mock.Anything, mock.Anything).Return().Once()
+}
+
+func TestExtendCampaign(t *testing.T) {
+	t.Run("applied straight away", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		newEndDate := campaign.EndDate.Add(48 * time.Hour)
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetPendingByCampaignID(campaign.ID).Return(models.CampaignExtension{}, gorm.ErrRecordNotFound).Once()
+		m.repo.EXPECT().Create(mock.AnythingOfType("*models.CampaignExtension")).Return(nil).Once()
+		expectCampaignSaved(m)
+		m.notificationService.EXPECT().NotifyCampaignChanged(campaign, "End date extended", mock.Anything, formatCampaignDate(newEndDate)).Return(nil).Once()
+
+		extension, err := service.ExtendCampaign(newEndDate, campaign.ID, "key-123", "creator")
+		assert.NoError(t, err)
+		assert.Equal(t, models.CampaignExtensionStatusApproved, extension.Status)
+		assert.True(t, campaign.EndDate.Equal(newEndDate))
+	})
+
+	t.Run("awaits approval", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		campaign.ExtensionRequiresApproval = true
+		previousEndDate := campaign.EndDate
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetPendingByCampaignID(campaign.ID).Return(models.CampaignExtension{}, gorm.ErrRecordNotFound).Once()
+		m.repo.EXPECT().Create(mock.AnythingOfType("*models.CampaignExtension")).Return(nil).Once()
+		m.notificationService.EXPECT().NotifyCampaignChanged(campaign, "End date extension awaiting your approval", mock.Anything, mock.Anything).Return(nil).Once()
+
+		extension, err := service.ExtendCampaign(previousEndDate.Add(48*time.Hour), campaign.ID, "key-123", "creator")
+		assert.NoError(t, err)
+		assert.True(t, extension.IsPending())
+		assert.True(t, campaign.EndDate.Equal(previousEndDate))
+	})
+
+	t.Run("end date before current end date", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.ExtendCampaign(campaign.EndDate.Add(-time.Hour), campaign.ID, "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("payout already initiated", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		campaign.Payout = &models.Payout{}
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.ExtendCampaign(campaign.EndDate.Add(48*time.Hour), campaign.ID, "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("only creator can extend", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.ExtendCampaign(campaign.EndDate.Add(48*time.Hour), campaign.ID, "key-123", "member")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestVoteOnExtension(t *testing.T) {
+	newPendingExtension := func(campaign *models.Campaign) models.CampaignExtension {
+		extension := models.NewCampaignExtension(campaign.ID, campaign.EndDate, campaign.EndDate.Add(48*time.Hour))
+		extension.ID = 1
+		return *extension
+	}
+
+	t.Run("vote without majority", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetByID(uint(1)).Return(newPendingExtension(campaign), nil).Once()
+		m.repo.EXPECT().AddVote(mock.MatchedBy(func(v *models.CampaignExtensionVote) bool {
+			return v.ContributorID == 1 && v.Approve
+		})).Return(nil).Once()
+
+		extension, err := service.VoteOnExtension(1, true, campaign.ID, "key-123", "one@example.com")
+		assert.NoError(t, err)
+		assert.True(t, extension.IsPending())
+	})
+
+	t.Run("majority approves", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		pending := newPendingExtension(campaign)
+		pending.AddVote(1, true)
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetByID(uint(1)).Return(pending, nil).Once()
+		m.repo.EXPECT().AddVote(mock.AnythingOfType("*models.CampaignExtensionVote")).Return(nil).Once()
+		m.repo.EXPECT().Update(mock.MatchedBy(func(e *models.CampaignExtension) bool {
+			return e.Status == models.CampaignExtensionStatusApproved
+		})).Return(nil).Once()
+		expectCampaignSaved(m)
+		m.notificationService.EXPECT().NotifyCampaignChanged(campaign, "End date extended", mock.Anything, mock.Anything).Return(nil).Once()
+
+		extension, err := service.VoteOnExtension(1, true, campaign.ID, "key-123", "two@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, models.CampaignExtensionStatusApproved, extension.Status)
+		assert.True(t, campaign.EndDate.Equal(extension.ToDate))
+	})
+
+	t.Run("majority rejects", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		campaign.Contributors = campaign.Contributors[:2]
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetByID(uint(1)).Return(newPendingExtension(campaign), nil).Once()
+		m.repo.EXPECT().AddVote(mock.AnythingOfType("*models.CampaignExtensionVote")).Return(nil).Once()
+		m.repo.EXPECT().Update(mock.MatchedBy(func(e *models.CampaignExtension) bool {
+			return e.Status == models.CampaignExtensionStatusRejected
+		})).Return(nil).Once()
+		m.notificationService.EXPECT().NotifyCampaignChanged(campaign, "End date extension rejected", mock.Anything, mock.Anything).Return(nil).Once()
+
+		extension, err := service.VoteOnExtension(1, false, campaign.ID, "key-123", "one@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, models.CampaignExtensionStatusRejected, extension.Status)
+	})
+
+	t.Run("already voted", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		pending := newPendingExtension(campaign)
+		pending.AddVote(1, true)
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetByID(uint(1)).Return(pending, nil).Once()
+
+		_, err := service.VoteOnExtension(1, true, campaign.ID, "key-123", "one@example.com")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("not a contributor", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.VoteOnExtension(1, true, campaign.ID, "key-123", "stranger@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("invitee who hasn't accepted", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		campaign.Contributors[2].Invitation.Status = models.InvitationStatusPending
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.VoteOnExtension(1, true, campaign.ID, "key-123", "three@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+		m.repo.AssertNotCalled(t, "AddVote", mock.Anything)
+	})
+}
+
+func TestCloseCampaignEarly(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		m.repo.EXPECT().GetPendingByCampaignID(campaign.ID).Return(models.CampaignExtension{ID: 1, Status: models.CampaignExtensionStatusPending}, nil).Once()
+		m.repo.EXPECT().Update(mock.MatchedBy(func(e *models.CampaignExtension) bool {
+			return e.Status == models.CampaignExtensionStatusRejected
+		})).Return(nil).Once()
+		expectCampaignSaved(m)
+		m.notificationService.EXPECT().NotifyCampaignChanged(campaign, "Campaign closed early", mock.Anything, mock.Anything).Return(nil).Once()
+
+		result, err := service.CloseCampaignEarly(campaign.ID, "key-123", "creator")
+		assert.NoError(t, err)
+		assert.True(t, result.IsClosedEarly())
+		assert.False(t, result.EndDate.After(time.Now()))
+	})
+
+	t.Run("campaign has ended", func(t *testing.T) {
+		service, m := setupCampaignDeadlineTest(t)
+		campaign := newDeadlineTestCampaign()
+		campaign.EndDate = time.Now().Add(-time.Hour)
+
+		m.campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		_, err := service.CloseCampaignEarly(campaign.ID, "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
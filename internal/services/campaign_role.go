@@ -0,0 +1,162 @@
+package services
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+type campaignRoleService struct {
+	repo                repositories.CampaignRoleRepository
+	campaignService     services.CampaignService
+	accessService       services.CampaignAccessService
+	authService         services.AuthService
+	notificationService services.NotificationService
+	broadcaster         services.EventBroadcaster
+	logger              logger.Logger
+	runAsync            func(func())
+}
+
+func NewCampaignRoleService(
+	repo repositories.CampaignRoleRepository,
+	campaignService services.CampaignService,
+	accessService services.CampaignAccessService,
+	authService services.AuthService,
+	notificationService services.NotificationService,
+	broadcaster services.EventBroadcaster,
+	logger logger.Logger,
+) services.CampaignRoleService {
+	return &campaignRoleService{
+		repo:                repo,
+		campaignService:     campaignService,
+		accessService:       accessService,
+		authService:         authService,
+		notificationService: notificationService,
+		broadcaster:         broadcaster,
+		logger:              logger,
+		runAsync:            func(f func()) { go f() },
+	}
+}
+
+// GetCampaignRoles lists the owner and the users holding a role other than member
+func (s *campaignRoleService) GetCampaignRoles(campaignID, key string) ([]models.CampaignUserRole, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := models.NewCampaignUserRole(campaign.ID, campaign.CreatedBy, models.CampaignRoleOwner)
+	return append([]models.CampaignUserRole{*owner}, campaign.Roles...), nil
+}
+
+// AssignRole gives a campaign member a role, assigning member removes the role the user held
+func (s *campaignRoleService) AssignRole(email string, role models.CampaignRole, campaignID, key, userHandle string) (*models.CampaignUserRole, error) {
+	if !role.IsAssignable() {
+		return nil, errs.BadRequest("Invalid role, the owner can only change by transferring ownership", nil)
+	}
+
+	campaign, user, err := s.getCampaignAndMember(email, campaignID, key, userHandle, models.CampaignActionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	userRole := models.NewCampaignUserRole(campaign.ID, *user, role)
+	if role == models.CampaignRoleMember {
+		err = s.repo.Remove(campaign.ID, user.Handle)
+	} else {
+		err = s.repo.Assign(userRole)
+	}
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignRoleUpdated, userRole)
+	})
+
+	return userRole, nil
+}
+
+// TransferOwnership makes a campaign member the owner, the previous owner stays on as co-organiser.
+// It returns the member key issued to the previous owner, empty for campaigns without stored keys.
+func (s *campaignRoleService) TransferOwnership(email, campaignID, key, userHandle string) (string, error) {
+	campaign, newOwner, err := s.getCampaignAndMember(email, campaignID, key, userHandle, models.CampaignActionTransferOwnership)
+	if err != nil {
+		return "", err
+	}
+
+	// A user can only own one campaign at a time
+	hasCampaign, err := s.campaignService.HasCampaign(newOwner.Handle)
+	if err != nil {
+		return "", err
+	}
+	if hasCampaign {
+		return "", errs.BadRequest("User already owns another campaign", nil)
+	}
+
+	keys, err := s.accessService.PrepareOwnerKeyTransfer(campaign, key, newOwner.Email)
+	if err != nil {
+		return "", err
+	}
+
+	previousOwner := campaign.CreatedBy
+	previousOwnerRole := models.NewCampaignUserRole(campaign.ID, previousOwner, models.CampaignRoleCoOrganiser)
+	if err := s.repo.TransferOwnership(campaign.ID, *newOwner, previousOwnerRole, keys); err != nil {
+		return "", errs.InternalServerError(err).Log(s.logger)
+	}
+
+	var previousOwnerKey string
+	if keys != nil {
+		previousOwnerKey = keys.PreviousOwnerKey.Key
+	}
+
+	campaign.Key = key
+	s.runAsync(func() {
+		s.notificationService.NotifyOwnershipTransferred(campaign, newOwner, &previousOwner)
+		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeCampaignRoleUpdated, previousOwnerRole)
+	})
+
+	return previousOwnerKey, nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+// getCampaignAndMember fetches a campaign and the user account of one of its members other than the owner
+func (s *campaignRoleService) getCampaignAndMember(email, campaignID, key, userHandle string, action models.CampaignAction) (*models.Campaign, *models.User, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !can(userHandle, campaign, action) {
+		return nil, nil, errs.Forbidden("Only the campaign owner can manage roles")
+	}
+
+	if campaign.CreatedBy.Email == email {
+		return nil, nil, errs.BadRequest("User is already the campaign owner", nil)
+	}
+	if !campaign.EmailIsPartOfCampaign(email) {
+		return nil, nil, errs.NotFound("User is not part of this campaign")
+	}
+
+	user, err := s.authService.GetUserByEmail(email)
+	if err != nil {
+		return nil, nil, err
+	}
+	return campaign, user, nil
+}
+
+// Helper Functions ------------------------------------------------------
+
+// can checks if a user is allowed to perform an action on a campaign.
+// The owner approves payments and collects payouts until a treasurer is assigned.
+func can(userHandle string, campaign *models.Campaign, action models.CampaignAction) bool {
+	role := campaign.RoleOf(userHandle)
+	if role == models.CampaignRoleOwner && !campaign.HasTreasurer() && models.CampaignRoleTreasurer.Can(action) {
+		return true
+	}
+	return role.Can(action)
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCampaignRoleTest(t *testing.T) (
+	*campaignRoleService,
+	*mockRepo.MockCampaignRoleRepository,
+	*mockService.MockCampaignService,
+	*mockService.MockCampaignAccessService,
+	*mockService.MockAuthService,
+	*mockService.MockNotificationService,
+	*mockService.MockEventBroadcaster,
+) {
+	repo := mockRepo.NewMockCampaignRoleRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	authService := mockService.NewMockAuthService(t)
+	notificationService := mockService.NewMockNotificationService(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &campaignRoleService{
+		repo:                repo,
+		campaignService:     campaignService,
+		accessService:       accessService,
+		authService:         authService,
+		notificationService: notificationService,
+		broadcaster:         broadcaster,
+		logger:              mockLogger.NewMockLogger(t),
+		runAsync:            func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, accessService, authService, notificationService, broadcaster
+}
+
+func newRoleTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "owner", Email: "owner@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+			{ID: 2, Email: "organiser@example.com"},
+		},
+		Roles: []models.CampaignUserRole{
+			{CampaignID: "campaign-123", UserHandle: "organiser", Email: "organiser@example.com", Role: models.CampaignRoleCoOrganiser},
+		},
+	}
+}
+
+func TestCan(t *testing.T) {
+	campaign := newRoleTestCampaign()
+
+	assert.True(t, can("owner", campaign, models.CampaignActionManageRoles))
+	assert.True(t, can("organiser", campaign, models.CampaignActionUpdate))
+	assert.False(t, can("organiser", campaign, models.CampaignActionManageRoles))
+	assert.False(t, can("member", campaign, models.CampaignActionManageContributors))
+	assert.True(t, can("member", campaign, models.CampaignActionParticipate))
+
+	// The owner holds the money actions until a treasurer is assigned
+	assert.True(t, can("owner", campaign, models.CampaignActionManagePayout))
+	campaign.Roles = append(campaign.Roles, models.CampaignUserRole{UserHandle: "treasurer", Role: models.CampaignRoleTreasurer})
+	assert.False(t, can("owner", campaign, models.CampaignActionManagePayout))
+	assert.True(t, can("treasurer", campaign, models.CampaignActionManagePayout))
+	assert.True(t, can("treasurer", campaign, models.CampaignActionApprovePayment))
+	assert.False(t, can("organiser", campaign, models.CampaignActionApprovePayment))
+
+	campaign.Roles = append(campaign.Roles, models.CampaignUserRole{UserHandle: "viewer", Role: models.CampaignRoleViewer})
+	assert.False(t, can("viewer", campaign, models.CampaignActionParticipate))
+}
+
+func TestGetCampaignRoles(t *testing.T) {
+	service, _, campaignService, _, _, _, _ := setupCampaignRoleTest(t)
+	campaign := newRoleTestCampaign()
+
+	campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+
+	roles, err := service.GetCampaignRoles(campaign.ID, "key")
+	assert.NoError(t, err)
+	assert.Len(t, roles, 2)
+	assert.Equal(t, models.CampaignRoleOwner, roles[0].Role)
+	assert.Equal(t, "owner@example.com", roles[0].Email)
+}
+
+func TestAssignRole(t *testing.T) {
+	service, repo, campaignService, _, authService, _, broadcaster := setupCampaignRoleTest(t)
+	member := &models.User{Handle: "member", Email: "member@example.com"}
+
+	t.Run("owner assigns treasurer", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+		authService.EXPECT().GetUserByEmail(member.Email).Return(member, nil).Once()
+		repo.EXPECT().Assign(mock.MatchedBy(func(r *models.CampaignUserRole) bool {
+			return r.UserHandle == "member" && r.Role == models.CampaignRoleTreasurer
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent(campaign.ID, websocket.EventTypeCampaignRoleUpdated, mock.Anything).Once()
+
+		role, err := service.AssignRole(member.Email, models.CampaignRoleTreasurer, campaign.ID, "key", "owner")
+		assert.NoError(t, err)
+		assert.Equal(t, models.CampaignRoleTreasurer, role.Role)
+	})
+
+	t.Run("assigning member removes the role", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		organiser := &models.User{Handle: "organiser", Email: "organiser@example.com"}
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+		authService.EXPECT().GetUserByEmail(organiser.Email).Return(organiser, nil).Once()
+		repo.EXPECT().Remove(campaign.ID, "organiser").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent(campaign.ID, websocket.EventTypeCampaignRoleUpdated, mock.Anything).Once()
+
+		_, err := service.AssignRole(organiser.Email, models.CampaignRoleMember, campaign.ID, "key", "owner")
+		assert.NoError(t, err)
+	})
+
+	t.Run("co-organiser cannot manage roles", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+
+		_, err := service.AssignRole(member.Email, models.CampaignRoleViewer, campaign.ID, "key", "organiser")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("owner role cannot be assigned", func(t *testing.T) {
+		_, err := service.AssignRole(member.Email, models.CampaignRoleOwner, "campaign-123", "key", "owner")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("user not part of campaign", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+
+		_, err := service.AssignRole("stranger@example.com", models.CampaignRoleViewer, campaign.ID, "key", "owner")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestTransferOwnership(t *testing.T) {
+	service, repo, campaignService, accessService, authService, notificationService, broadcaster := setupCampaignRoleTest(t)
+	newOwner := &models.User{Handle: "member", Email: "member@example.com"}
+
+	t.Run("owner transfers ownership", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+		authService.EXPECT().GetUserByEmail(newOwner.Email).Return(newOwner, nil).Once()
+		campaignService.EXPECT().HasCampaign(newOwner.Handle).Return(false, nil).Once()
+		keys := &models.OwnerKeyTransfer{
+			OwnerKey:         &models.CampaignAccessKey{ID: 1, Email: newOwner.Email, IsOwner: true},
+			PreviousOwnerKey: &models.CampaignAccessKey{Email: "owner@example.com", Key: "GM-previous-owner"},
+		}
+		accessService.EXPECT().PrepareOwnerKeyTransfer(campaign, "key", newOwner.Email).Return(keys, nil).Once()
+		repo.EXPECT().TransferOwnership(campaign.ID, *newOwner, mock.MatchedBy(func(r *models.CampaignUserRole) bool {
+			return r.UserHandle == "owner" && r.Role == models.CampaignRoleCoOrganiser
+		}), keys).Return(nil).Once()
+		notificationService.EXPECT().NotifyOwnershipTransferred(campaign, newOwner, mock.MatchedBy(func(u *models.User) bool {
+			return u.Handle == "owner"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent(campaign.ID, websocket.EventTypeCampaignRoleUpdated, mock.Anything).Once()
+
+		key, err := service.TransferOwnership(newOwner.Email, campaign.ID, "key", "owner")
+		assert.NoError(t, err)
+		assert.Equal(t, "GM-previous-owner", key)
+	})
+
+	t.Run("new owner already owns a campaign", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+		authService.EXPECT().GetUserByEmail(newOwner.Email).Return(newOwner, nil).Once()
+		campaignService.EXPECT().HasCampaign(newOwner.Handle).Return(true, nil).Once()
+
+		_, err := service.TransferOwnership(newOwner.Email, campaign.ID, "key", "owner")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("nothing changes when the keys can't be prepared", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+		authService.EXPECT().GetUserByEmail(newOwner.Email).Return(newOwner, nil).Once()
+		campaignService.EXPECT().HasCampaign(newOwner.Handle).Return(false, nil).Once()
+		accessService.EXPECT().PrepareOwnerKeyTransfer(campaign, "key", newOwner.Email).
+			Return(nil, errs.InternalServerError(errors.New("db down"))).Once()
+
+		_, err := service.TransferOwnership(newOwner.Email, campaign.ID, "key", "owner")
+		assertErrorCode(t, err, http.StatusInternalServerError)
+	})
+
+	t.Run("only owner can transfer", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+
+		_, err := service.TransferOwnership(newOwner.Email, campaign.ID, "key", "organiser")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("cannot transfer to the owner", func(t *testing.T) {
+		campaign := newRoleTestCampaign()
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key").Return(campaign, nil).Once()
+
+		_, err := service.TransferOwnership("owner@example.com", campaign.ID, "key", "owner")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
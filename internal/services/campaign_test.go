@@ -12,8 +12,11 @@ import (
 	mockInterfaces "github.com/oyen-bright/goFundIt/internal/services/mocks"
 	encrypt "github.com/oyen-bright/goFundIt/pkg/encryption/mocks"
 	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	mockStorage "github.com/oyen-bright/goFundIt/pkg/storage/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func setupCampaignService(t *testing.T) (
@@ -50,6 +53,8 @@ func setupCampaignService(t *testing.T) (
 
 func TestCreateCampaign(t *testing.T) {
 	service, mockRepo, mockAuth, mockAnalytics, mockNotification, _, mockLogger, encryptor := setupCampaignService(t)
+	mockAccess := mockInterfaces.NewMockCampaignAccessService(t)
+	service.accessService = mockAccess
 
 	t.Run("successful campaign creation", func(t *testing.T) {
 		campaignKey := "test_key"
@@ -76,7 +81,14 @@ func TestCreateCampaign(t *testing.T) {
 		mockAuth.EXPECT().GetUserByHandle(userHandle).Return(user, nil)
 
 		encryptor.EXPECT().EncryptStruct(mock.AnythingOfType("*models.Campaign"), mock.AnythingOfType("string")).Return(mock.AnythingOfType("*models.Campaign"), nil)
-		mockRepo.EXPECT().Create(campaign).Return(*campaign, nil)
+		mockAccess.EXPECT().PrepareCampaignKeys(campaign).Run(func(c *models.Campaign) {
+			c.Contributors[0].AccessKey = "GM-member-key"
+			c.AccessKeys = []models.CampaignAccessKey{{Email: user.Email, IsOwner: true}, {Email: "test@example.com"}}
+		}).Return(nil)
+		mockRepo.EXPECT().Create(campaign).RunAndReturn(func(c *models.Campaign) (models.Campaign, error) {
+			assert.Len(t, c.AccessKeys, 2, "expected the keys to be stored with the campaign")
+			return *c, nil
+		})
 
 		encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), mock.AnythingOfType("string")).Return(mock.Anything, nil)
 
@@ -90,6 +102,8 @@ func TestCreateCampaign(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, campaign.Title, result.Title)
+		assert.Equal(t, "GM-member-key", result.Contributors[0].AccessKey)
+		assert.True(t, result.Contributors[0].Invitation.HasResponded(), "the creator joins without an invitation")
 	})
 
 	mockRepo.ExpectedCalls = nil
@@ -126,6 +140,49 @@ func TestCreateCampaign(t *testing.T) {
 	})
 }
 
+func TestCreateCampaignInvitesContributors(t *testing.T) {
+	service, mockRepo, mockAuth, mockAnalytics, mockNotification, _, _, encryptor := setupCampaignService(t)
+	mockAccess := mockInterfaces.NewMockCampaignAccessService(t)
+	service.accessService = mockAccess
+
+	campaign := &models.Campaign{
+		Key:         "test_key",
+		Title:       "Test Campaign",
+		Description: "Test Description",
+		Contributors: []models.Contributor{
+			{Email: "creator@example.com", Amount: 100},
+			{Email: "friend@example.com", Amount: 50},
+		},
+	}
+	user := models.User{Handle: "creator", Email: "creator@example.com"}
+
+	mockRepo.EXPECT().GetByHandle(user.Handle).Return(models.Campaign{}, nil)
+	mockAuth.EXPECT().FindExistingAndNonExistingUsers([]string{"creator@example.com", "friend@example.com"}).
+		Return([]models.User{user}, []string{"friend@example.com"}, nil)
+	mockAuth.EXPECT().CreateUsers(mock.AnythingOfType("[]models.User")).Return([]models.User{}, nil)
+	mockAuth.EXPECT().GetUserByHandle(user.Handle).Return(user, nil)
+	mockAccess.EXPECT().IssueInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+		return c.Email == "friend@example.com"
+	})).Run(func(c *models.Contributor) {
+		c.Invitation.Issue("secret")
+	}).Once()
+
+	encryptor.EXPECT().EncryptStruct(mock.AnythingOfType("*models.Campaign"), mock.AnythingOfType("string")).Return(mock.AnythingOfType("*models.Campaign"), nil)
+	mockRepo.EXPECT().Create(campaign).Return(*campaign, nil)
+	encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), mock.AnythingOfType("string")).Return(mock.Anything, nil)
+
+	mockAccess.EXPECT().PrepareCampaignKeys(campaign).Return(nil)
+
+	mockAnalytics.EXPECT().GetCurrentData().Return(&models.PlatformAnalytics{})
+	mockNotification.EXPECT().NotifyCampaignCreation(campaign).Return(nil)
+
+	result, err := service.CreateCampaign(campaign, user.Handle)
+	assert.NoError(t, err)
+	assert.True(t, result.Contributors[0].Invitation.IsAccepted())
+	assert.True(t, result.Contributors[1].Invitation.IsPending())
+	assert.Equal(t, float64(100), result.GetPledgedAmount(), "only accepted contributors count toward the pledged amount")
+}
+
 func TestUpdateCampaign(t *testing.T) {
 	service, mockRepo, _, _, mockNotification, mockBroadcaster, mockLogger, encryptor := setupCampaignService(t)
 
@@ -136,12 +193,10 @@ func TestUpdateCampaign(t *testing.T) {
 
 		updatedTitle := "Updated Title"
 		updatedDescription := "Updated Description"
-		endDate := time.Now().Add(24 * time.Hour)
 
 		updateReq := dto.CampaignUpdateRequest{
 			Title:       &updatedTitle,
 			Description: &updatedDescription,
-			EndDate:     &endDate,
 		}
 
 		existingCampaign := &models.Campaign{
@@ -170,6 +225,65 @@ func TestUpdateCampaign(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("successful goal and milestones update", func(t *testing.T) {
+		service, mockRepo, _, _, mockNotification, mockBroadcaster, _, encryptor := setupCampaignService(t)
+
+		campaignID := "goal-id"
+		campaignKey := "test_key"
+		goalAmount := 1000.0
+
+		updateReq := dto.CampaignUpdateRequest{
+			GoalAmount: &goalAmount,
+			Milestones: []int{10, 100},
+		}
+		existingCampaign := models.Campaign{
+			ID:        campaignID,
+			Key:       campaignKey,
+			CreatedBy: models.User{Handle: "test_user"},
+		}
+		milestones := []models.CampaignMilestone{
+			{ID: 1, CampaignID: campaignID, Percentage: 10},
+			{ID: 2, CampaignID: campaignID, Percentage: 100},
+		}
+
+		mockRepo.EXPECT().GetByID(campaignID).Return(existingCampaign, nil).Once()
+		encryptor.EXPECT().EncryptStruct(mock.AnythingOfType("*models.Campaign"), campaignKey).Return(mock.AnythingOfType("*models.Campaign"), nil)
+		mockRepo.EXPECT().Update(mock.MatchedBy(func(c *models.Campaign) bool {
+			return c.GoalAmount == goalAmount
+		})).Return(existingCampaign, nil).Once()
+		encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), campaignKey).Return(mock.Anything, nil)
+		mockRepo.EXPECT().ReplaceMilestones(campaignID, mock.MatchedBy(func(m []models.CampaignMilestone) bool {
+			return len(m) == 2 && m[0].Percentage == 10 && m[1].Percentage == 100
+		})).Return(milestones, nil).Once()
+		mockBroadcaster.EXPECT().NewEvent(campaignID, mock.Anything, mock.Anything)
+		mockNotification.EXPECT().NotifyCampaignUpdate(mock.AnythingOfType("*models.Campaign"), "").Return(nil)
+
+		result, err := service.UpdateCampaign(updateReq, campaignID, campaignKey, "test_user")
+
+		assert.NoError(t, err)
+		assert.Equal(t, milestones, result.Milestones)
+	})
+
+	t.Run("error - end date changed", func(t *testing.T) {
+		campaignID := "test-id"
+		campaignKey := "test_key"
+		endDate := time.Now().Add(48 * time.Hour)
+
+		updateReq := dto.CampaignUpdateRequest{EndDate: &endDate}
+		existingCampaign := models.Campaign{
+			ID:        campaignID,
+			EndDate:   time.Now().Add(24 * time.Hour),
+			CreatedBy: models.User{Handle: "test_user"},
+		}
+
+		mockRepo.EXPECT().GetByID(campaignID).Return(existingCampaign, nil).Once()
+		encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), campaignKey).Return(mock.Anything, nil)
+
+		_, err := service.UpdateCampaign(updateReq, campaignID, campaignKey, "test_user")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Campaign end date can only be changed by extending or closing the campaign")
+	})
+
 	t.Run("error - campaign not found", func(t *testing.T) {
 		updatedTitle := "Updated Title"
 
@@ -248,10 +362,36 @@ func TestGetActiveCampaigns(t *testing.T) {
 
 func TestDeleteCampaign(t *testing.T) {
 	service, mockRepo, _, _, _, _, mockLogger, _ := setupCampaignService(t)
+	mockStorage := mockStorage.NewMockStorage(t)
+	service.storage = mockStorage
+	filesPreload := models.PreloadOption{Images: true, Activities: true, ActivitiesExpenses: true}
 
 	t.Run("successful deletion", func(t *testing.T) {
 		campaignID := "test-id"
+		mockRepo.EXPECT().GetByIDWithSelectedData(campaignID, filesPreload).Return(models.Campaign{ID: campaignID}, nil).Once()
+		mockRepo.EXPECT().Delete(campaignID).Return(nil)
+
+		err := service.DeleteCampaign(campaignID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("uploaded files are removed", func(t *testing.T) {
+		mockRepo.Calls = nil
+		mockRepo.ExpectedCalls = nil
+
+		campaignID := "test-id"
+		mockRepo.EXPECT().GetByIDWithSelectedData(campaignID, filesPreload).Return(models.Campaign{
+			ID:     campaignID,
+			Images: []models.CampaignImage{{ImageID: "image-1", ThumbnailID: "thumb-1"}},
+			Activities: []models.Activity{{
+				ImageID:  "activity-image",
+				Expenses: []models.ActivityExpense{{ReceiptID: "receipt-1"}, {}},
+			}},
+		}, nil).Once()
 		mockRepo.EXPECT().Delete(campaignID).Return(nil)
+		for _, id := range []string{"image-1", "thumb-1", "activity-image", "receipt-1"} {
+			mockStorage.EXPECT().DeleteFile(id).Return(nil).Once()
+		}
 
 		err := service.DeleteCampaign(campaignID)
 		assert.NoError(t, err)
@@ -262,6 +402,7 @@ func TestDeleteCampaign(t *testing.T) {
 		mockRepo.ExpectedCalls = nil
 
 		campaignID := "test-id"
+		mockRepo.EXPECT().GetByIDWithSelectedData(campaignID, filesPreload).Return(models.Campaign{}, gorm.ErrRecordNotFound).Once()
 		mockRepo.EXPECT().Delete(campaignID).Return(fmt.Errorf("deletion error"))
 		mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -400,6 +541,35 @@ func TestRecalculateTargetAmount(t *testing.T) {
 		mockBroadcaster.AssertExpectations(t)
 	})
 
+	t.Run("includes each contributor's activity shares", func(t *testing.T) {
+		mockRepo.ExpectedCalls = nil
+		campaignID := "split-id"
+		contributors := []models.Contributor{
+			{ID: 1, Amount: 100},
+			{ID: 2, Amount: 200},
+		}
+		campaign := models.Campaign{
+			ID:           campaignID,
+			Contributors: contributors,
+			Activities: []models.Activity{
+				{ID: 1, Cost: 50, Contributors: contributors},
+				{ID: 2, Cost: 90, SplitStrategy: models.SplitStrategyEqual, Contributors: contributors},
+				{ID: 3, Cost: 60, SplitStrategy: models.SplitStrategyWeighted, Contributors: contributors,
+					Shares: []models.ActivityShare{{ContributorID: 2, Weight: 2}}},
+			},
+		}
+
+		mockRepo.EXPECT().GetByID(campaignID).Return(campaign, nil)
+		mockRepo.EXPECT().Update(mock.MatchedBy(func(c *models.Campaign) bool {
+			// 300 + 50 per person + 90 split equally + 60 split 1:2
+			return c.TargetAmount == 300+100+90+60
+		})).Return(campaign, nil)
+		mockBroadcaster.EXPECT().NewEvent(campaignID, mock.Anything, mock.Anything)
+
+		service.RecalculateTargetAmount(campaignID)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("error - campaign not found", func(t *testing.T) {
 		mockRepo.ExpectedCalls = nil
 		campaignID := "non-existent"
@@ -408,3 +578,136 @@ func TestRecalculateTargetAmount(t *testing.T) {
 		service.RecalculateTargetAmount(campaignID)
 	})
 }
+
+func TestCheckMilestones(t *testing.T) {
+	service, mockRepo, _, _, mockNotification, mockBroadcaster, mockLogger, _ := setupCampaignService(t)
+
+	campaignID := "test-id"
+	newCampaign := func() models.Campaign {
+		return models.Campaign{
+			ID:         campaignID,
+			GoalAmount: 400,
+			Contributors: []models.Contributor{
+				{Amount: 200, Payment: &models.Payment{Amount: 200, PaymentStatus: models.PaymentStatusSucceeded}},
+				{Amount: 200},
+			},
+			Milestones: []models.CampaignMilestone{
+				{ID: 1, CampaignID: campaignID, Percentage: 25},
+				{ID: 2, CampaignID: campaignID, Percentage: 50},
+				{ID: 3, CampaignID: campaignID, Percentage: 75},
+			},
+		}
+	}
+
+	t.Run("notifies each reached milestone", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(campaignID).Return(newCampaign(), nil).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(1)).Return(true, nil).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(2)).Return(true, nil).Once()
+		mockNotification.EXPECT().NotifyCampaignMilestone(mock.AnythingOfType("*models.Campaign"), "25% of the goal raised").Return(nil).Once()
+		mockNotification.EXPECT().NotifyCampaignMilestone(mock.AnythingOfType("*models.Campaign"), "50% of the goal raised").Return(nil).Once()
+		mockBroadcaster.EXPECT().NewEvent(campaignID, websocket.EventTypeCampaignMilestone, mock.AnythingOfType("models.CampaignMilestone")).Times(2)
+
+		service.CheckMilestones(campaignID)
+	})
+
+	t.Run("skips milestones already marked by another check", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(campaignID).Return(newCampaign(), nil).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(1)).Return(false, nil).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(2)).Return(false, nil).Once()
+
+		service.CheckMilestones(campaignID)
+	})
+
+	t.Run("skips reached milestones", func(t *testing.T) {
+		campaign := newCampaign()
+		campaign.Milestones[0].MarkReached()
+		campaign.Milestones[1].MarkReached()
+		mockRepo.EXPECT().GetByID(campaignID).Return(campaign, nil).Once()
+
+		service.CheckMilestones(campaignID)
+	})
+
+	t.Run("error - marking milestone fails", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(campaignID).Return(newCampaign(), nil).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(1)).Return(false, errors.New("db error")).Once()
+		mockRepo.EXPECT().MarkMilestoneReached(uint(2)).Return(false, nil).Once()
+		mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		service.CheckMilestones(campaignID)
+	})
+
+	t.Run("error - campaign not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID("non-existent").Return(models.Campaign{}, fmt.Errorf("not found")).Once()
+
+		service.CheckMilestones("non-existent")
+	})
+}
+
+func TestUpdateCampaignVisibility(t *testing.T) {
+	service, mockRepo, _, _, _, mockBroadcaster, _, encryptor := setupCampaignService(t)
+
+	campaignID := "test_id"
+	campaignKey := "test_key"
+	existingCampaign := models.Campaign{
+		ID:        campaignID,
+		Title:     "Community Event",
+		CreatedBy: models.User{Handle: "creator"},
+	}
+
+	t.Run("creator makes campaign public", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(campaignID).Return(existingCampaign, nil).Once()
+		encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), campaignKey).Return(mock.Anything, nil)
+		encryptor.EXPECT().Encrypt(mock.Anything).Return("sealed_key", nil).Once()
+		encryptor.EXPECT().EncryptStruct(mock.AnythingOfType("*models.Campaign"), campaignKey).Return(mock.Anything, nil).Once()
+		mockRepo.EXPECT().Update(mock.MatchedBy(func(c *models.Campaign) bool {
+			return c.IsPublic() && c.Slug != nil && c.PublicKey == "sealed_key"
+		})).RunAndReturn(func(c *models.Campaign) (models.Campaign, error) {
+			return *c, nil
+		}).Once()
+		mockBroadcaster.EXPECT().NewEvent(campaignID, mock.Anything, mock.Anything).Return().Once()
+
+		result, err := service.UpdateCampaignVisibility(models.CampaignVisibilityPublic, campaignID, campaignKey, "creator")
+		assert.NoError(t, err)
+		assert.True(t, result.IsPublic())
+		assert.Contains(t, *result.Slug, "community-event-")
+	})
+
+	t.Run("only organisers can change visibility", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(campaignID).Return(existingCampaign, nil).Once()
+
+		_, err := service.UpdateCampaignVisibility(models.CampaignVisibilityPublic, campaignID, campaignKey, "member")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Only campaign organisers can change campaign visibility")
+	})
+}
+
+func TestGetPublicCampaignBySlug(t *testing.T) {
+	service, mockRepo, _, _, _, _, _, encryptor := setupCampaignService(t)
+
+	slug := "community-event-abc123"
+
+	t.Run("public campaign", func(t *testing.T) {
+		publicCampaign := models.Campaign{
+			ID:         "test_id",
+			Slug:       &slug,
+			Visibility: models.CampaignVisibilityPublic,
+			PublicKey:  "sealed_key",
+		}
+
+		mockRepo.EXPECT().GetBySlug(slug).Return(publicCampaign, nil).Once()
+		encryptor.EXPECT().Decrypt(mock.Anything).Return("test_key", nil).Once()
+		encryptor.EXPECT().DecryptStruct(mock.AnythingOfType("*models.Campaign"), "test_key").Return(mock.Anything, nil).Once()
+
+		result, err := service.GetPublicCampaignBySlug(slug)
+		assert.NoError(t, err)
+		assert.Equal(t, "test_key", result.Key)
+	})
+
+	t.Run("campaign not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetBySlug("missing").Return(models.Campaign{}, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.GetPublicCampaignBySlug("missing")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Campaign not found")
+	})
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+type chatService struct {
+	repo            interfaces.ChatRepository
+	campaignService services.CampaignService
+	broadcaster     services.EventBroadcaster
+	encryptor       encryption.Encryptor
+	logger          logger.Logger
+	runAsync        func(func())
+}
+
+func NewChatService(
+	repo interfaces.ChatRepository,
+	campaignService services.CampaignService,
+	broadcaster services.EventBroadcaster,
+	encryptor encryption.Encryptor,
+	logger logger.Logger,
+) services.ChatService {
+	return &chatService{
+		repo:            repo,
+		campaignService: campaignService,
+		broadcaster:     broadcaster,
+		encryptor:       encryptor,
+		logger:          logger,
+		runAsync:        func(f func()) { go f() },
+	}
+}
+
+// SendMessage stores a message in the campaign chat and broadcasts it to the connected members.
+// A message resent with the same client ID is returned without being stored again
+func (s *chatService) SendMessage(campaignID, key, userHandle, content, clientID string) (*models.ChatMessage, error) {
+	if clientID != "" {
+		existing, err := s.repo.GetByClientID(campaignID, userHandle, clientID)
+		if err == nil {
+			if err := existing.Decrypt(s.encryptor, key); err != nil {
+				return nil, errs.InternalServerError(err).Log(s.logger)
+			}
+			return &existing, nil
+		}
+		if !database.Error(err).IsNotfound() {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+
+	message, err := models.NewChatMessage(campaignID, userHandle, content, clientID)
+	if err != nil {
+		return nil, errs.BadRequest(err.Error(), nil)
+	}
+
+	if err := message.Encrypt(s.encryptor, key); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if err := s.repo.Create(message); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if err := message.Decrypt(s.encryptor, key); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeChatMessage, message)
+	})
+
+	return message, nil
+}
+
+// SetTyping tells the connected members that the user started or stopped typing, the update is transient
+// so it doesn't take a place in the replay log or reach webhooks
+func (s *chatService) SetTyping(campaignID, userHandle string, typing bool) {
+	s.broadcaster.SendTransient(campaignID, websocket.EventTypeChatTyping, models.ChatTyping{
+		UserHandle: userHandle,
+		Typing:     typing,
+	})
+}
+
+// RecordReceipt records that a message was delivered to or read by the user and tells the connected members.
+// Receipts for the user's own messages are ignored
+func (s *chatService) RecordReceipt(campaignID, userHandle, messageID string, status models.ChatReceiptStatus) (*models.ChatReceipt, error) {
+	if !status.IsValid() {
+		return nil, errs.BadRequest("Receipt status must be delivered or read", nil)
+	}
+
+	message, err := s.repo.GetByID(messageID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Message not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if message.CampaignID != campaignID {
+		return nil, errs.NotFound("Message not found")
+	}
+	if message.SenderHandle == userHandle {
+		return nil, nil
+	}
+
+	receipt := models.NewChatReceipt(message.ID, userHandle, status)
+	if err := s.repo.SaveReceipt(receipt); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeChatReceipt, receipt)
+	})
+
+	return receipt, nil
+}
+
+// GetHistory fetches the chat messages of a campaign oldest first, before or after a message ID when given
+// so reconnecting clients can fill in what they missed
+func (s *chatService) GetHistory(campaignID, key, userEmail, before, after string, limit int) ([]models.ChatMessage, error) {
+	if before != "" && after != "" {
+		return nil, errs.BadRequest("Only one of before or after can be set", nil)
+	}
+
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can read the chat")
+	}
+
+	var anchor *models.ChatMessage
+	if anchorID := before + after; anchorID != "" {
+		message, err := s.repo.GetByID(anchorID)
+		if err != nil {
+			if database.Error(err).IsNotfound() {
+				return nil, errs.NotFound("Message not found")
+			}
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+		if message.CampaignID != campaignID {
+			return nil, errs.NotFound("Message not found")
+		}
+		anchor = &message
+	}
+
+	messages, err := s.repo.GetHistory(campaignID, anchor, after != "", models.ChatHistorySize(limit))
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	for i := range messages {
+		if err := messages[i].Decrypt(s.encryptor, key); err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+	return messages, nil
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const testChatKey = "campaign-key"
+
+func setupChatTest(t *testing.T) (
+	*chatService,
+	*mockRepo.MockChatRepository,
+	*mockService.MockCampaignService,
+	*mockService.MockEventBroadcaster,
+) {
+	repo := mockRepo.NewMockChatRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &chatService{
+		repo:            repo,
+		campaignService: campaignService,
+		broadcaster:     broadcaster,
+		encryptor:       encryption.New([]string{"test-key"}),
+		logger:          mockLogger.NewMockLogger(t),
+		runAsync:        func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, broadcaster
+}
+
+func TestChatService_SendMessage(t *testing.T) {
+	t.Run("stores the message encrypted and broadcasts it", func(t *testing.T) {
+		service, repo, _, broadcaster := setupChatTest(t)
+
+		repo.On("GetByClientID", "campaign-123", "member", "client-1").Return(models.ChatMessage{}, gorm.ErrRecordNotFound)
+		repo.On("Create", mock.MatchedBy(func(m *models.ChatMessage) bool {
+			return m.Content != "" && m.Content != "Hello everyone"
+		})).Return(nil)
+		broadcaster.On("NewEvent", "campaign-123", websocket.EventTypeChatMessage, mock.AnythingOfType("*models.ChatMessage")).Return()
+
+		message, err := service.SendMessage("campaign-123", testChatKey, "member", "  Hello everyone ", "client-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Hello everyone", message.Content)
+		assert.Equal(t, "member", message.SenderHandle)
+	})
+
+	t.Run("resent message is not stored twice", func(t *testing.T) {
+		service, repo, _, broadcaster := setupChatTest(t)
+
+		existing, err := models.NewChatMessage("campaign-123", "member", "Hello everyone", "client-1")
+		require.NoError(t, err)
+		require.NoError(t, existing.Encrypt(service.encryptor, testChatKey))
+		repo.On("GetByClientID", "campaign-123", "member", "client-1").Return(*existing, nil)
+
+		message, err := service.SendMessage("campaign-123", testChatKey, "member", "Hello everyone", "client-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, message.ID)
+		assert.Equal(t, "Hello everyone", message.Content)
+		repo.AssertNotCalled(t, "Create", mock.Anything)
+		broadcaster.AssertNotCalled(t, "NewEvent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		service, _, _, _ := setupChatTest(t)
+
+		_, err := service.SendMessage("campaign-123", testChatKey, "member", "   ", "")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestChatService_SetTyping(t *testing.T) {
+	service, _, _, broadcaster := setupChatTest(t)
+
+	broadcaster.EXPECT().SendTransient("campaign-1", websocket.EventTypeChatTyping, models.ChatTyping{
+		UserHandle: "member",
+		Typing:     true,
+	}).Once()
+
+	service.SetTyping("campaign-1", "member", true)
+}
+
+func TestChatService_RecordReceipt(t *testing.T) {
+	message := models.ChatMessage{ID: "MSG1", CampaignID: "campaign-123", SenderHandle: "sender"}
+
+	t.Run("records and broadcasts the receipt", func(t *testing.T) {
+		service, repo, _, broadcaster := setupChatTest(t)
+
+		repo.On("GetByID", "MSG1").Return(message, nil)
+		repo.On("SaveReceipt", mock.MatchedBy(func(r *models.ChatReceipt) bool {
+			return r.MessageID == "MSG1" && r.UserHandle == "reader" && r.ReadAt != nil
+		})).Return(nil)
+		broadcaster.On("NewEvent", "campaign-123", websocket.EventTypeChatReceipt, mock.AnythingOfType("*models.ChatReceipt")).Return()
+
+		receipt, err := service.RecordReceipt("campaign-123", "reader", "MSG1", models.ChatReceiptRead)
+
+		require.NoError(t, err)
+		assert.NotNil(t, receipt.DeliveredAt)
+	})
+
+	t.Run("own message is ignored", func(t *testing.T) {
+		service, repo, _, _ := setupChatTest(t)
+
+		repo.On("GetByID", "MSG1").Return(message, nil)
+
+		receipt, err := service.RecordReceipt("campaign-123", "sender", "MSG1", models.ChatReceiptDelivered)
+
+		assert.NoError(t, err)
+		assert.Nil(t, receipt)
+		repo.AssertNotCalled(t, "SaveReceipt", mock.Anything)
+	})
+
+	t.Run("message of another campaign", func(t *testing.T) {
+		service, repo, _, _ := setupChatTest(t)
+
+		repo.On("GetByID", "MSG1").Return(message, nil)
+
+		_, err := service.RecordReceipt("campaign-456", "reader", "MSG1", models.ChatReceiptDelivered)
+
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		service, _, _, _ := setupChatTest(t)
+
+		_, err := service.RecordReceipt("campaign-123", "reader", "MSG1", "seen")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestChatService_GetHistory(t *testing.T) {
+	campaign := &models.Campaign{
+		ID:           "campaign-123",
+		CreatedBy:    models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{{ID: 1, CampaignID: "campaign-123", Email: "member@example.com"}},
+	}
+
+	t.Run("returns decrypted messages after the anchor", func(t *testing.T) {
+		service, repo, campaignService, _ := setupChatTest(t)
+
+		anchor := models.ChatMessage{ID: "MSG1", CampaignID: "campaign-123"}
+		newer, err := models.NewChatMessage("campaign-123", "creator", "Welcome", "")
+		require.NoError(t, err)
+		require.NoError(t, newer.Encrypt(service.encryptor, testChatKey))
+
+		campaignService.On("GetCampaignByID", "campaign-123", testChatKey).Return(campaign, nil)
+		repo.On("GetByID", "MSG1").Return(anchor, nil)
+		repo.On("GetHistory", "campaign-123", &anchor, true, models.DefaultChatHistorySize).Return([]models.ChatMessage{*newer}, nil)
+
+		messages, err := service.GetHistory("campaign-123", testChatKey, "member@example.com", "", "MSG1", 0)
+
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, "Welcome", messages[0].Content)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		service, _, campaignService, _ := setupChatTest(t)
+
+		campaignService.On("GetCampaignByID", "campaign-123", testChatKey).Return(campaign, nil)
+
+		_, err := service.GetHistory("campaign-123", testChatKey, "stranger@example.com", "", "", 0)
+
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("before and after together", func(t *testing.T) {
+		service, _, _, _ := setupChatTest(t)
+
+		_, err := service.GetHistory("campaign-123", testChatKey, "member@example.com", "MSG1", "MSG2", 0)
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/oyen-bright/goFundIt/internal/models"
 	"github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
@@ -14,26 +17,34 @@ type commentService struct {
 	repo                interfaces.CommentRepository
 	authService         services.AuthService
 	activityService     services.ActivityService
+	campaignService     services.CampaignService
 	notificationService services.NotificationService
 	broadcaster         services.EventBroadcaster
+	contentFilter       services.ContentFilter
 	logger              logger.Logger
+	runAsync            func(func())
 }
 
 func NewCommentService(
 	repo interfaces.CommentRepository,
 	authService services.AuthService,
 	activityService services.ActivityService,
+	campaignService services.CampaignService,
 	notificationService services.NotificationService,
 	broadcaster services.EventBroadcaster,
+	contentFilter services.ContentFilter,
 	logger logger.Logger,
 ) services.CommentService {
 	return &commentService{
 		repo:                repo,
 		authService:         authService,
 		activityService:     activityService,
+		campaignService:     campaignService,
 		notificationService: notificationService,
 		broadcaster:         broadcaster,
+		contentFilter:       contentFilter,
 		logger:              logger,
+		runAsync:            func(f func()) { go f() },
 	}
 }
 
@@ -64,6 +75,12 @@ func (c *commentService) CreateComment(comment *models.Comment, campaignID strin
 	}
 
 	comment.FromBinding(user, activityID)
+
+	// Filter content
+	if err := c.checkContent(comment, campaignID); err != nil {
+		return err
+	}
+
 	err = c.repo.Create(comment)
 
 	if err != nil {
@@ -74,22 +91,37 @@ func (c *commentService) CreateComment(comment *models.Comment, campaignID strin
 		return errs.InternalServerError(err).Log(c.logger)
 	}
 
+	if comment.Flagged {
+		c.logModeration(models.NewCommentModerationLog(campaignID, comment.ID, "", models.CommentModerationFlag, comment.FlagReason))
+	}
+
 	// Broadcast new comment
-	go c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentCreated, comment)
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentCreated, comment)
+	})
 
-	go c.notificationService.NotifyCommentAddition(comment, &activity)
+	c.runAsync(func() {
+		c.notificationService.NotifyCommentAddition(comment, &activity)
+	})
+
+	c.notifyMentions(comment, &activity, comment.Mentions())
 
 	return nil
 }
 
-// DeleteComment deletes a comment by ID
-func (c *commentService) DeleteComment(commentID string, userHandle string) error {
+// DeleteComment deletes a comment by ID, the event is sent to the campaign of the comment's activity
+func (c *commentService) DeleteComment(commentID, campaignID, userHandle string) error {
 	//Validate comment for modification
-	_, err := c.validateCommentForModification(commentID, userHandle)
+	comment, err := c.validateCommentForModification(commentID, userHandle)
 	if err != nil {
 		return err
 	}
 
+	activity, err := c.activityService.GetActivityByID(comment.ActivityID, campaignID)
+	if err != nil {
+		return errs.NotFound("Comment not found")
+	}
+
 	//Delete comment
 	err = c.repo.Delete(commentID)
 
@@ -98,46 +130,217 @@ func (c *commentService) DeleteComment(commentID string, userHandle string) erro
 	}
 
 	// Broadcast event
-	go c.broadcaster.NewEvent(commentID, websocket.EventTypeCommentDeleted, commentID)
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(activity.CampaignID, websocket.EventTypeCommentDeleted, commentID)
+	})
 
 	return nil
 
 }
 
-func (c *commentService) GetActivityComments(activityID uint) ([]models.Comment, error) {
-	comments, err := c.repo.GetByActivityID(activityID)
+// GetActivityComments gets a page of top level comments on an activity, newest first
+func (c *commentService) GetActivityComments(activityID uint, cursor string, limit int) (models.CommentPage, error) {
+	after, err := parseCommentCursor(cursor)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+
+	limit = models.CommentPageSize(limit)
+	comments, err := c.repo.GetByActivityID(activityID, after, limit+1)
+	if err != nil {
+		return models.CommentPage{}, errs.InternalServerError(err).Log(c.logger)
+	}
+	models.RedactComments(comments)
+	return models.NewCommentPage(comments, limit), nil
+}
+
+// GetCommentReplies gets a page of direct replies to a comment, oldest first
+func (c *commentService) GetCommentReplies(commentID, cursor string, limit int) (models.CommentPage, error) {
+	after, err := parseCommentCursor(cursor)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+
+	limit = models.CommentPageSize(limit)
+	comments, err := c.repo.FindReplies(commentID, after, limit+1)
+	if err != nil {
+		return models.CommentPage{}, errs.InternalServerError(err).Log(c.logger)
+	}
+	models.RedactComments(comments)
+	return models.NewCommentPage(comments, limit), nil
+}
+
+// GetCommentThread gets every reply under a comment as a flat list, each reply has its depth and path in the thread
+func (c *commentService) GetCommentThread(commentID string) ([]models.Comment, error) {
+	if _, err := c.repo.Get(commentID); err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.BadRequest("Comment not found", err)
+		}
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+
+	replies, err := c.repo.FindThread(commentID)
 	if err != nil {
 		return nil, errs.InternalServerError(err).Log(c.logger)
 	}
-	return comments, nil
+	models.RedactComments(replies)
+	return models.FlattenThread(commentID, replies), nil
 }
 
-// GetCommentReplies gets all replies to a comment
-func (c *commentService) GetCommentReplies(commentID string) ([]models.Comment, error) {
-	comments, err := c.repo.FindReplies(commentID)
+// MarkCommentsRead marks every comment on the activity as read by the user
+func (c *commentService) MarkCommentsRead(activityID uint, campaignID, userHandle string) error {
+	if _, err := c.activityService.GetActivityByID(activityID, campaignID); err != nil {
+		return err
+	}
+
+	if err := c.repo.MarkRead(models.NewCommentReadMarker(userHandle, activityID)); err != nil {
+		return errs.InternalServerError(err).Log(c.logger)
+	}
+	return nil
+}
+
+// GetUnreadSummary counts the comments the user hasn't read on each activity of the campaign
+func (c *commentService) GetUnreadSummary(campaignID, userHandle string) (*models.CampaignUnreadComments, error) {
+	activities, err := c.activityService.GetActivitiesByCampaignID(campaignID, userHandle)
 	if err != nil {
-		return comments, errs.InternalServerError(err).Log(c.logger)
+		return nil, err
+	}
+
+	summary := &models.CampaignUnreadComments{
+		CampaignID: campaignID,
+		Activities: make([]models.ActivityUnreadComments, 0, len(activities)),
+	}
+	for _, activity := range activities {
+		summary.Total += activity.UnreadComments
+		summary.Activities = append(summary.Activities, models.ActivityUnreadComments{
+			ActivityID: activity.ID,
+			Title:      activity.Title,
+			Unread:     activity.UnreadComments,
+		})
 	}
-	return comments, nil
+	return summary, nil
 }
 
-// UpdateComment updates a comment by the comment ID
-func (c *commentService) UpdateComment(comment models.Comment, userHandle string) error {
+// GetCommentRevisions gets the previous contents of an edited comment, newest first.
+// The revisions of a hidden comment are hidden with it
+func (c *commentService) GetCommentRevisions(commentID string) ([]models.CommentRevision, error) {
+	comment, err := c.repo.Get(commentID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.BadRequest("Comment not found", err)
+		}
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	if comment.Hidden {
+		return []models.CommentRevision{}, nil
+	}
+
+	revisions, err := c.repo.GetRevisions(commentID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	return revisions, nil
+}
+
+// UpdateComment updates a comment by the comment ID, the previous content is kept as a revision
+func (c *commentService) UpdateComment(comment models.Comment, campaignID, userHandle string) (*models.Comment, error) {
 	//Validate comment for modification
-	_, err := c.validateCommentForModification(comment.ID, userHandle)
+	existingComment, err := c.validateCommentForModification(comment.ID, userHandle)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if existingComment.Hidden {
+		return nil, errs.BadRequest("You can't edit a hidden comment", nil)
+	}
+
+	previousContent := existingComment.Content
+	wasFlagged := existingComment.Flagged
+	revision := existingComment.Edit(comment.Content, userHandle)
+	if revision == nil {
+		return existingComment, nil
+	}
+
+	// Filter content
+	if err := c.checkContent(existingComment, campaignID); err != nil {
+		return nil, err
 	}
 
 	// Update comment
-	err = c.repo.Update(&comment)
+	err = c.repo.Update(existingComment, revision)
 	if err != nil {
-		return errs.InternalServerError(err).Log(c.logger)
+		return nil, errs.InternalServerError(err).Log(c.logger)
 	}
-	//TODO Broadcast event ?
 
-	return nil
+	if existingComment.Flagged && !wasFlagged {
+		c.logModeration(models.NewCommentModerationLog(campaignID, existingComment.ID, "", models.CommentModerationFlag, existingComment.FlagReason))
+	}
+
+	// Broadcast event
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentUpdated, existingComment)
+	})
+
+	// Only members mentioned by the edit are notified
+	if mentions := existingComment.NewMentions(previousContent); len(mentions) > 0 {
+		activity, err := c.activityService.GetActivityByID(existingComment.ActivityID, campaignID)
+		if err != nil {
+			c.logger.Error(err, "Error getting activity for comment mentions", nil)
+		} else {
+			c.notifyMentions(existingComment, &activity, mentions)
+		}
+	}
+
+	return existingComment, nil
+}
+
+// AddReaction reacts to a comment with an emoji, reacting twice with the same emoji has no effect
+func (c *commentService) AddReaction(commentID, campaignID, userHandle, emoji string) (*models.Comment, error) {
+	comment, err := c.validateCommentForReaction(commentID, userHandle, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.HasReaction(userHandle, emoji) {
+		return comment, nil
+	}
 
+	reaction := models.NewCommentReaction(comment.ID, userHandle, emoji)
+	if err := c.repo.AddReaction(reaction); err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	comment.Reactions = append(comment.Reactions, *reaction)
+
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentUpdated, comment)
+	})
+
+	return comment, nil
+}
+
+// RemoveReaction removes the user's emoji reaction from a comment
+func (c *commentService) RemoveReaction(commentID, campaignID, userHandle, emoji string) (*models.Comment, error) {
+	comment, err := c.validateCommentForReaction(commentID, userHandle, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comment.HasReaction(userHandle, emoji) {
+		return nil, errs.NotFound("Reaction not found")
+	}
+
+	if err := c.repo.RemoveReaction(comment.ID, userHandle, emoji); err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	comment.Reactions = slices.DeleteFunc(comment.Reactions, func(reaction models.CommentReaction) bool {
+		return reaction.UserHandle == userHandle && reaction.Emoji == emoji
+	})
+
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentUpdated, comment)
+	})
+
+	return comment, nil
 }
 
 // Helper function -----------------------------------------------------------
@@ -164,3 +367,64 @@ func (c *commentService) validateCommentForModification(commentID, userHandle st
 
 	return &comment, nil
 }
+
+func parseCommentCursor(cursor string) (*models.CommentCursor, error) {
+	after, err := models.ParseCommentCursor(cursor)
+	if err != nil {
+		return nil, errs.BadRequest("Invalid cursor", nil)
+	}
+	return after, nil
+}
+
+func (c *commentService) validateCommentForReaction(commentID, userHandle, emoji string) (*models.Comment, error) {
+	if !models.IsValidEmoji(emoji) {
+		return nil, errs.BadRequest("Reaction must be an emoji", nil)
+	}
+
+	//Validate user
+	if _, err := c.authService.GetUserByHandle(userHandle); err != nil {
+		return nil, err
+	}
+
+	//Validate comment
+	comment, err := c.repo.Get(commentID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.BadRequest("Comment not found", err)
+		}
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+
+	return &comment, nil
+}
+
+// notifyMentions notifies the mentioned campaign members in the background, the author and unknown handles are skipped
+func (c *commentService) notifyMentions(comment *models.Comment, activity *models.Activity, handles []string) {
+	if len(handles) == 0 {
+		return
+	}
+
+	c.runAsync(func() {
+		campaign, err := c.campaignService.GetCampaignByIDWithContributors(activity.CampaignID)
+		if err != nil {
+			c.logger.Error(err, "Error getting campaign for comment mentions", nil)
+			return
+		}
+
+		var mentioned []models.User
+		for _, handle := range handles {
+			if strings.EqualFold(handle, comment.CreatedByHandle) {
+				continue
+			}
+			user, err := c.authService.GetUserByHandle(handle)
+			if err != nil || !campaign.EmailIsPartOfCampaign(user.Email) {
+				continue
+			}
+			mentioned = append(mentioned, user)
+		}
+
+		if len(mentioned) > 0 {
+			c.notificationService.NotifyCommentMention(comment, activity, mentioned)
+		}
+	})
+}
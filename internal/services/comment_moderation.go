@@ -0,0 +1,200 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+// ReportComment reports a comment to the campaign moderators, a member reports a comment once
+func (c *commentService) ReportComment(commentID, campaignID, userHandle, reason string) (*models.CommentReport, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errs.BadRequest("A reason is required", nil)
+	}
+
+	user, err := c.authService.GetUserByHandle(userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := c.campaignService.GetCampaignByIDWithContributors(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if !campaign.EmailIsPartOfCampaign(user.Email) {
+		return nil, errs.Forbidden("Only campaign members can report comments")
+	}
+
+	comment, err := c.getCampaignComment(commentID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.CreatedByHandle == userHandle {
+		return nil, errs.BadRequest("You can't report your own comment", nil)
+	}
+
+	report := models.NewCommentReport(comment.ID, campaignID, userHandle, reason)
+	if err := c.repo.AddReport(report); err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	if report.ID == 0 {
+		return nil, errs.BadRequest("You already reported this comment", nil)
+	}
+
+	c.logger.Info("Comment reported", map[string]interface{}{
+		"campaignId": campaignID,
+		"commentId":  comment.ID,
+		"reporter":   userHandle,
+		"reason":     reason,
+	})
+
+	return report, nil
+}
+
+// ModerateComment hides, unhides or removes any comment in the campaign, only organisers can moderate.
+// The returned comment is nil when the comment was removed
+func (c *commentService) ModerateComment(commentID, campaignID, userHandle string, action models.CommentModerationAction, reason string) (*models.Comment, error) {
+	reason = strings.TrimSpace(reason)
+	if !action.IsManual() {
+		return nil, errs.BadRequest("Action must be hide, unhide or remove", nil)
+	}
+	if action.NeedsReason() && reason == "" {
+		return nil, errs.BadRequest("A reason is required", nil)
+	}
+
+	if err := c.validateModerator(campaignID, userHandle); err != nil {
+		return nil, err
+	}
+
+	comment, err := c.getCampaignComment(commentID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case models.CommentModerationHide:
+		comment.Hide(reason, userHandle)
+	case models.CommentModerationUnhide:
+		if !comment.Hidden {
+			return nil, errs.BadRequest("Comment isn't hidden", nil)
+		}
+		comment.Unhide()
+	case models.CommentModerationRemove:
+		if err := c.repo.Delete(comment.ID); err != nil {
+			return nil, errs.InternalServerError(err).Log(c.logger)
+		}
+	}
+
+	if action != models.CommentModerationRemove {
+		if err := c.repo.UpdateModeration(comment); err != nil {
+			return nil, errs.InternalServerError(err).Log(c.logger)
+		}
+	}
+
+	c.logModeration(models.NewCommentModerationLog(campaignID, comment.ID, userHandle, action, reason))
+
+	if action == models.CommentModerationRemove {
+		c.runAsync(func() {
+			c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentDeleted, comment.ID)
+		})
+		return nil, nil
+	}
+
+	comment.Redact()
+	c.runAsync(func() {
+		c.broadcaster.NewEvent(campaignID, websocket.EventTypeCommentUpdated, comment)
+	})
+	return comment, nil
+}
+
+// GetCommentReports gets the comments reported in the campaign, newest first, only organisers can see them
+func (c *commentService) GetCommentReports(campaignID, userHandle string) ([]models.CommentReport, error) {
+	if err := c.validateModerator(campaignID, userHandle); err != nil {
+		return nil, err
+	}
+
+	reports, err := c.repo.GetReports(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	return reports, nil
+}
+
+// GetModerationLog gets the moderation actions taken in the campaign, newest first, only organisers can see them
+func (c *commentService) GetModerationLog(campaignID, userHandle string) ([]models.CommentModerationLog, error) {
+	if err := c.validateModerator(campaignID, userHandle); err != nil {
+		return nil, err
+	}
+
+	logs, err := c.repo.GetModerationLog(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+	return logs, nil
+}
+
+// Helper function -----------------------------------------------------------
+
+// checkContent runs the content filter on the comment, blocked comments are rejected and flagged ones marked.
+// The comment is let through when the filter fails
+func (c *commentService) checkContent(comment *models.Comment, campaignID string) error {
+	check, err := c.contentFilter.Check(comment.Content)
+	if err != nil {
+		c.logger.Error(err, "Error filtering comment content", map[string]interface{}{"commentId": comment.ID})
+	}
+
+	switch check.Verdict {
+	case models.ContentBlocked:
+		c.logModeration(models.NewCommentModerationLog(campaignID, "", "", models.CommentModerationBlock, check.Reason))
+		return errs.BadRequest("Your comment was blocked by the content filter", check.Reason)
+	case models.ContentFlagged:
+		comment.Flag(check.Reason)
+	}
+	return nil
+}
+
+// logModeration saves a moderation action, failing to save it doesn't undo the action
+func (c *commentService) logModeration(log *models.CommentModerationLog) {
+	c.logger.Info("Comment moderated", map[string]interface{}{
+		"campaignId": log.CampaignID,
+		"commentId":  log.CommentID,
+		"moderator":  log.ModeratorHandle,
+		"action":     log.Action,
+		"reason":     log.Reason,
+	})
+
+	if err := c.repo.SaveModerationLog(log); err != nil {
+		c.logger.Error(err, "Error saving comment moderation log", nil)
+	}
+}
+
+func (c *commentService) validateModerator(campaignID, userHandle string) error {
+	campaign, err := c.campaignService.GetCampaignByIDWithContributors(campaignID)
+	if err != nil {
+		return err
+	}
+	if !can(userHandle, campaign, models.CampaignActionModerateComments) {
+		return errs.Forbidden("Only campaign organisers can moderate comments")
+	}
+	return nil
+}
+
+// getCampaignComment gets a comment and checks it was posted on an activity of the campaign
+func (c *commentService) getCampaignComment(commentID, campaignID string) (*models.Comment, error) {
+	comment, err := c.repo.Get(commentID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Comment not found")
+		}
+		return nil, errs.InternalServerError(err).Log(c.logger)
+	}
+
+	if _, err := c.activityService.GetActivityByID(comment.ActivityID, campaignID); err != nil {
+		return nil, errs.NotFound("Comment not found")
+	}
+	return &comment, nil
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockInterfaces "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	serviceMocks "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	loggerMocks "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type commentModerationMocks struct {
+	repo        *mockInterfaces.MockCommentRepository
+	auth        *serviceMocks.MockAuthService
+	activity    *serviceMocks.MockActivityService
+	campaign    *serviceMocks.MockCampaignService
+	broadcaster *serviceMocks.MockEventBroadcaster
+	logger      *loggerMocks.MockLogger
+}
+
+func setupCommentModerationTest(t *testing.T) (*commentService, commentModerationMocks) {
+	m := commentModerationMocks{
+		repo:        mockInterfaces.NewMockCommentRepository(t),
+		auth:        serviceMocks.NewMockAuthService(t),
+		activity:    serviceMocks.NewMockActivityService(t),
+		campaign:    serviceMocks.NewMockCampaignService(t),
+		broadcaster: serviceMocks.NewMockEventBroadcaster(t),
+		logger:      loggerMocks.NewMockLogger(t),
+	}
+	service := newTestCommentService(m.repo, m.auth, m.activity, m.campaign, serviceMocks.NewMockNotificationService(t), m.broadcaster, m.logger)
+	return service, m
+}
+
+func newModerationTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "member@example.com"},
+		},
+		Roles: []models.CampaignUserRole{
+			{CampaignID: "campaign-123", UserHandle: "organiser", Role: models.CampaignRoleCoOrganiser},
+			{CampaignID: "campaign-123", UserHandle: "treasurer", Role: models.CampaignRoleTreasurer},
+		},
+	}
+}
+
+func TestCommentService_ReportComment(t *testing.T) {
+	comment := models.Comment{ID: "CMT1", ActivityID: 1, CreatedByHandle: "author"}
+
+	t.Run("member reports a comment", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.auth.On("GetUserByHandle", "member").Return(models.User{Handle: "member", Email: "member@example.com"}, nil)
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(comment, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(models.Activity{ID: 1, CampaignID: "campaign-123"}, nil)
+		m.repo.On("AddReport", mock.AnythingOfType("*models.CommentReport")).Run(func(args mock.Arguments) {
+			args.Get(0).(*models.CommentReport).ID = 1
+		}).Return(nil)
+		m.logger.EXPECT().Info("Comment reported", mock.Anything).Return()
+
+		report, err := service.ReportComment("CMT1", "campaign-123", "member", " Spam ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Spam", report.Reason)
+	})
+
+	t.Run("repeated report", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.auth.On("GetUserByHandle", "member").Return(models.User{Handle: "member", Email: "member@example.com"}, nil)
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(comment, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(models.Activity{ID: 1, CampaignID: "campaign-123"}, nil)
+		m.repo.On("AddReport", mock.AnythingOfType("*models.CommentReport")).Return(nil)
+
+		_, err := service.ReportComment("CMT1", "campaign-123", "member", "Spam")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("own comment", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.auth.On("GetUserByHandle", "author").Return(models.User{Handle: "author", Email: "member@example.com"}, nil)
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(comment, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(models.Activity{ID: 1, CampaignID: "campaign-123"}, nil)
+
+		_, err := service.ReportComment("CMT1", "campaign-123", "author", "Spam")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.auth.On("GetUserByHandle", "stranger").Return(models.User{Handle: "stranger", Email: "stranger@example.com"}, nil)
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+
+		_, err := service.ReportComment("CMT1", "campaign-123", "stranger", "Spam")
+
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestCommentService_ModerateComment(t *testing.T) {
+	t.Run("co-organiser hides a comment", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(models.Comment{ID: "CMT1", ActivityID: 1, Content: "Abuse"}, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(models.Activity{ID: 1, CampaignID: "campaign-123"}, nil)
+		m.repo.On("UpdateModeration", mock.MatchedBy(func(c *models.Comment) bool {
+			return c.Hidden && c.HiddenReason == "Harassment" && c.HiddenByHandle == "organiser"
+		})).Return(nil)
+		m.repo.On("SaveModerationLog", mock.MatchedBy(func(l *models.CommentModerationLog) bool {
+			return l.Action == models.CommentModerationHide && l.ModeratorHandle == "organiser" && l.CommentID == "CMT1"
+		})).Return(nil)
+		m.logger.EXPECT().Info("Comment moderated", mock.Anything).Return()
+		m.broadcaster.On("NewEvent", "campaign-123", websocket.EventTypeCommentUpdated, mock.AnythingOfType("*models.Comment")).Return()
+
+		comment, err := service.ModerateComment("CMT1", "campaign-123", "organiser", models.CommentModerationHide, "Harassment")
+
+		require.NoError(t, err)
+		assert.True(t, comment.Hidden)
+		assert.Empty(t, comment.Content)
+	})
+
+	t.Run("creator removes a comment", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(models.Comment{ID: "CMT1", ActivityID: 1, CreatedByHandle: "author"}, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(models.Activity{ID: 1, CampaignID: "campaign-123"}, nil)
+		m.repo.On("Delete", "CMT1").Return(nil)
+		m.repo.On("SaveModerationLog", mock.MatchedBy(func(l *models.CommentModerationLog) bool {
+			return l.Action == models.CommentModerationRemove && l.Reason == "Spam"
+		})).Return(nil)
+		m.logger.EXPECT().Info("Comment moderated", mock.Anything).Return()
+		m.broadcaster.On("NewEvent", "campaign-123", websocket.EventTypeCommentDeleted, "CMT1").Return()
+
+		comment, err := service.ModerateComment("CMT1", "campaign-123", "creator", models.CommentModerationRemove, "Spam")
+
+		assert.NoError(t, err)
+		assert.Nil(t, comment)
+	})
+
+	t.Run("treasurer can't moderate", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+
+		_, err := service.ModerateComment("CMT1", "campaign-123", "treasurer", models.CommentModerationHide, "Spam")
+
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("comment of another campaign", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.campaign.On("GetCampaignByIDWithContributors", "campaign-123").Return(newModerationTestCampaign(), nil)
+		m.repo.On("Get", "CMT1").Return(models.Comment{ID: "CMT1", ActivityID: 2}, nil)
+		m.activity.On("GetActivityByID", uint(2), "campaign-123").Return(models.Activity{}, assert.AnError)
+
+		_, err := service.ModerateComment("CMT1", "campaign-123", "creator", models.CommentModerationHide, "Spam")
+
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		service, _ := setupCommentModerationTest(t)
+
+		_, err := service.ModerateComment("CMT1", "campaign-123", "creator", models.CommentModerationRemove, " ")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("filter actions can't be taken by hand", func(t *testing.T) {
+		service, _ := setupCommentModerationTest(t)
+
+		_, err := service.ModerateComment("CMT1", "campaign-123", "creator", models.CommentModerationBlock, "Spam")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestCommentService_ContentFilter(t *testing.T) {
+	user := models.User{Handle: "testuser"}
+	activity := models.Activity{ID: 1, CampaignID: "campaign-123"}
+
+	t.Run("blocked comment isn't created", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+		service.contentFilter = NewWordListFilter([]string{"scam"}, nil)
+
+		m.auth.On("GetUserByHandle", "testuser").Return(user, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(activity, nil)
+		m.repo.On("SaveModerationLog", mock.MatchedBy(func(l *models.CommentModerationLog) bool {
+			return l.Action == models.CommentModerationBlock && l.CommentID == ""
+		})).Return(nil)
+		m.logger.EXPECT().Info("Comment moderated", mock.Anything).Return()
+
+		err := service.CreateComment(&models.Comment{Content: "Join my SCAM now"}, "campaign-123", 1, "testuser")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+		m.repo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("flagged comment is created and logged", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+		service.contentFilter = NewWordListFilter(nil, []string{"idiot"})
+
+		m.auth.On("GetUserByHandle", "testuser").Return(user, nil)
+		m.activity.On("GetActivityByID", uint(1), "campaign-123").Return(activity, nil)
+		m.repo.On("Create", mock.MatchedBy(func(c *models.Comment) bool { return c.Flagged })).Return(nil)
+		m.repo.On("SaveModerationLog", mock.MatchedBy(func(l *models.CommentModerationLog) bool {
+			return l.Action == models.CommentModerationFlag && l.CommentID != ""
+		})).Return(nil)
+		m.logger.EXPECT().Info("Comment moderated", mock.Anything).Return()
+		m.broadcaster.On("NewEvent", "campaign-123", websocket.EventTypeCommentCreated, mock.Anything).Return()
+		service.notificationService.(*serviceMocks.MockNotificationService).On("NotifyCommentAddition", mock.Anything, mock.Anything).Return(nil)
+
+		comment := &models.Comment{Content: "Don't be an idiot"}
+		err := service.CreateComment(comment, "campaign-123", 1, "testuser")
+
+		assert.NoError(t, err)
+		assert.True(t, comment.Flagged)
+	})
+
+	t.Run("hidden comment can't be edited", func(t *testing.T) {
+		service, m := setupCommentModerationTest(t)
+
+		m.auth.On("GetUserByHandle", "testuser").Return(user, nil)
+		m.repo.On("Get", "CMT1").Return(models.Comment{ID: "CMT1", CreatedByHandle: "testuser", Hidden: true}, nil)
+
+		_, err := service.UpdateComment(models.Comment{ID: "CMT1", Content: "Sorry"}, "campaign-123", "testuser")
+
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mockInterfaces "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
@@ -17,11 +19,12 @@ func TestCommentService_CreateComment(t *testing.T) {
 	mockRepo := mockInterfaces.NewMockCommentRepository(t)
 	mockAuth := serviceMocks.NewMockAuthService(t)
 	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
 	mockNotification := serviceMocks.NewMockNotificationService(t)
 	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
 	mockLogger := loggerMocks.NewMockLogger(t)
 
-	service := NewCommentService(mockRepo, mockAuth, mockActivity, mockNotification, mockBroadcaster, mockLogger)
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
 
 	t.Run("successful comment creation", func(t *testing.T) {
 		comment := &models.Comment{Content: "Test comment"}
@@ -57,26 +60,52 @@ func TestCommentService_DeleteComment(t *testing.T) {
 	mockRepo := mockInterfaces.NewMockCommentRepository(t)
 	mockAuth := serviceMocks.NewMockAuthService(t)
 	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
 	mockNotification := serviceMocks.NewMockNotificationService(t)
 	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
 	mockLogger := loggerMocks.NewMockLogger(t)
 
-	service := NewCommentService(mockRepo, mockAuth, mockActivity, mockNotification, mockBroadcaster, mockLogger)
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
 
 	t.Run("successful deletion", func(t *testing.T) {
 		user := models.User{Handle: "testuser"}
-		comment := models.Comment{ID: "123", CreatedByHandle: "testuser"}
+		comment := models.Comment{ID: "123", ActivityID: 1, CreatedByHandle: "testuser"}
 
 		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
 		mockRepo.On("Get", "123").Return(comment, nil)
+		mockActivity.On("GetActivityByID", uint(1), "campaign-1").Return(models.Activity{ID: 1, CampaignID: "campaign-1"}, nil).Once()
 		mockRepo.On("Delete", "123").Return(nil)
 
-		mockBroadcaster.On("NewEvent", "123", websocket.EventTypeCommentDeleted, "123").Return(nil)
-		err := service.DeleteComment("123", "testuser")
+		// The event is sent to the campaign, not to the comment ID
+		mockBroadcaster.On("NewEvent", "campaign-1", websocket.EventTypeCommentDeleted, "123").Return(nil).Once()
+		err := service.DeleteComment("123", "campaign-1", "testuser")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 		mockAuth.AssertExpectations(t)
+		mockBroadcaster.AssertExpectations(t)
+	})
+
+	t.Run("comment from another campaign", func(t *testing.T) {
+		mockRepo.ExpectedCalls = nil
+		mockRepo.Calls = nil
+		mockAuth.ExpectedCalls = nil
+		mockAuth.Calls = nil
+		mockBroadcaster.ExpectedCalls = nil
+		mockBroadcaster.Calls = nil
+
+		user := models.User{Handle: "testuser"}
+		comment := models.Comment{ID: "123", ActivityID: 1, CreatedByHandle: "testuser"}
+
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(comment, nil)
+		mockActivity.On("GetActivityByID", uint(1), "campaign-2").Return(models.Activity{}, errs.NotFound("Activity does not belong to this campaign")).Once()
+
+		err := service.DeleteComment("123", "campaign-2", "testuser")
+
+		assertErrorCode(t, err, http.StatusNotFound)
+		mockRepo.AssertNotCalled(t, "Delete", "123")
+		mockBroadcaster.AssertNotCalled(t, "NewEvent")
 	})
 
 	t.Run("unauthorized deletion", func(t *testing.T) {
@@ -99,7 +128,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
 		mockRepo.On("Get", "123").Return(comment, nil)
 
-		err := service.DeleteComment("123", "testuser")
+		err := service.DeleteComment("123", "campaign-1", "testuser")
 
 		mockRepo.AssertNotCalled(t, "Delete", "123")
 
@@ -113,11 +142,12 @@ func TestCommentService_GetActivityComments(t *testing.T) {
 	mockRepo := mockInterfaces.NewMockCommentRepository(t)
 	mockAuth := serviceMocks.NewMockAuthService(t)
 	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
 	mockNotification := serviceMocks.NewMockNotificationService(t)
 	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
 	mockLogger := loggerMocks.NewMockLogger(t)
 
-	service := NewCommentService(mockRepo, mockAuth, mockActivity, mockNotification, mockBroadcaster, mockLogger)
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
 
 	t.Run("get comments successfully", func(t *testing.T) {
 		expectedComments := []models.Comment{
@@ -125,43 +155,139 @@ func TestCommentService_GetActivityComments(t *testing.T) {
 			{ID: "2", Content: "Comment 2"},
 		}
 
-		mockRepo.On("GetByActivityID", uint(1)).Return(expectedComments, nil)
+		mockRepo.On("GetByActivityID", uint(1), (*models.CommentCursor)(nil), models.DefaultCommentPageSize+1).Return(expectedComments, nil)
 
-		comments, err := service.GetActivityComments(1)
+		page, err := service.GetActivityComments(1, "", 0)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedComments, comments)
+		assert.Equal(t, expectedComments, page.Comments)
+		assert.Empty(t, page.NextCursor)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("next page", func(t *testing.T) {
+		mockRepo.ExpectedCalls = nil
+		mockRepo.Calls = nil
+
+		createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		cursor := models.CommentCursor{CreatedAt: createdAt, ID: "3"}
+		comments := []models.Comment{
+			{ID: "2", CreatedAt: createdAt.Add(-time.Minute)},
+			{ID: "1", CreatedAt: createdAt.Add(-2 * time.Minute)},
+			{ID: "0", CreatedAt: createdAt.Add(-3 * time.Minute)},
+		}
+
+		mockRepo.On("GetByActivityID", uint(1), &cursor, 3).Return(comments, nil)
+
+		page, err := service.GetActivityComments(1, cursor.Encode(), 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Comments, 2)
+		assert.Equal(t, models.CommentCursor{CreatedAt: comments[1].CreatedAt, ID: "1"}.Encode(), page.NextCursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := service.GetActivityComments(1, "not a cursor", 0)
+
+		assertErrorCode(t, err, 400)
+	})
 }
 
 func TestCommentService_UpdateComment(t *testing.T) {
 	mockRepo := mockInterfaces.NewMockCommentRepository(t)
 	mockAuth := serviceMocks.NewMockAuthService(t)
 	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
 	mockNotification := serviceMocks.NewMockNotificationService(t)
 	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
 	mockLogger := loggerMocks.NewMockLogger(t)
 
-	service := NewCommentService(mockRepo, mockAuth, mockActivity, mockNotification, mockBroadcaster, mockLogger)
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
 
 	t.Run("successful update", func(t *testing.T) {
 		user := models.User{Handle: "testuser"}
-		comment := models.Comment{
+		storedComment := models.Comment{
 			ID:              "123",
 			CreatedByHandle: "testuser",
-			Content:         "Updated content",
+			Content:         "Original content",
+		}
+		comment := models.Comment{
+			ID:      "123",
+			Content: "Updated content",
 		}
 
 		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
-		mockRepo.On("Get", "123").Return(comment, nil)
-		mockRepo.On("Update", &comment).Return(nil)
+		mockRepo.On("Get", "123").Return(storedComment, nil)
+		mockRepo.On("Update", mock.MatchedBy(func(c *models.Comment) bool {
+			return c.Content == "Updated content" && c.Edited && c.EditedAt != nil
+		}), mock.MatchedBy(func(r *models.CommentRevision) bool {
+			return r.CommentID == "123" && r.Content == "Original content" && r.EditedByHandle == "testuser"
+		})).Return(nil)
+		mockBroadcaster.On("NewEvent", "campaign1", websocket.EventTypeCommentUpdated, mock.AnythingOfType("*models.Comment")).Return(nil)
 
-		err := service.UpdateComment(comment, "testuser")
+		updatedComment, err := service.UpdateComment(comment, "campaign1", "testuser")
 
 		assert.NoError(t, err)
+		assert.True(t, updatedComment.Edited)
+		assert.Equal(t, "Updated content", updatedComment.Content)
 		mockRepo.AssertExpectations(t)
 		mockAuth.AssertExpectations(t)
+		mockBroadcaster.AssertExpectations(t)
+	})
+
+	t.Run("unchanged content", func(t *testing.T) {
+		mockRepo.ExpectedCalls = nil
+		mockRepo.Calls = nil
+		mockAuth.ExpectedCalls = nil
+		mockAuth.Calls = nil
+
+		user := models.User{Handle: "testuser"}
+		storedComment := models.Comment{ID: "123", CreatedByHandle: "testuser", Content: "Same content"}
+
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(storedComment, nil)
+
+		updatedComment, err := service.UpdateComment(models.Comment{ID: "123", Content: "Same content"}, "campaign1", "testuser")
+
+		assert.NoError(t, err)
+		assert.False(t, updatedComment.Edited)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("notifies new mentions", func(t *testing.T) {
+		mockRepo.ExpectedCalls = nil
+		mockRepo.Calls = nil
+		mockAuth.ExpectedCalls = nil
+		mockAuth.Calls = nil
+		mockBroadcaster.ExpectedCalls = nil
+		mockBroadcaster.Calls = nil
+
+		author := models.User{Handle: "AUTHOR", Email: "author@example.com"}
+		mentioned := models.User{Handle: "MEMBER", Email: "member@example.com"}
+		outsider := models.User{Handle: "OUTSIDER", Email: "outsider@example.com"}
+		storedComment := models.Comment{ID: "123", ActivityID: 1, CreatedByHandle: "AUTHOR", Content: "Thanks @already"}
+		activity := models.Activity{ID: 1, CampaignID: "campaign1"}
+		campaign := &models.Campaign{
+			ID:           "campaign1",
+			CreatedBy:    author,
+			Contributors: []models.Contributor{{Email: "member@example.com"}},
+		}
+
+		mockAuth.On("GetUserByHandle", "AUTHOR").Return(author, nil)
+		mockAuth.On("GetUserByHandle", "MEMBER").Return(mentioned, nil)
+		mockAuth.On("GetUserByHandle", "OUTSIDER").Return(outsider, nil)
+		mockRepo.On("Get", "123").Return(storedComment, nil)
+		mockRepo.On("Update", mock.AnythingOfType("*models.Comment"), mock.AnythingOfType("*models.CommentRevision")).Return(nil)
+		mockBroadcaster.On("NewEvent", "campaign1", websocket.EventTypeCommentUpdated, mock.AnythingOfType("*models.Comment")).Return(nil)
+		mockActivity.On("GetActivityByID", uint(1), "campaign1").Return(activity, nil)
+		mockCampaign.On("GetCampaignByIDWithContributors", "campaign1").Return(campaign, nil)
+		mockNotification.On("NotifyCommentMention", mock.AnythingOfType("*models.Comment"), &activity, []models.User{mentioned}).Return(nil)
+
+		_, err := service.UpdateComment(models.Comment{ID: "123", Content: "Thanks @already, @member @outsider and @author"}, "campaign1", "AUTHOR")
+
+		assert.NoError(t, err)
+		mockNotification.AssertExpectations(t)
+		mockAuth.AssertNotCalled(t, "GetUserByHandle", "ALREADY")
 	})
 
 	t.Run("unauthorized update", func(t *testing.T) {
@@ -189,13 +315,13 @@ func TestCommentService_UpdateComment(t *testing.T) {
 		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
 		mockRepo.On("Get", "123").Return(comment, nil)
 
-		err := service.UpdateComment(comment, "testuser")
+		_, err := service.UpdateComment(comment, "campaign1", "testuser")
 
 		// Assert that an error is returned.
 		assert.Error(t, err)
 
 		// Assert that Update was not called.
-		mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 
 		// Verify expectations on the other mocks.
 		mockRepo.AssertExpectations(t)
@@ -208,11 +334,12 @@ func TestCommentService_GetCommentReplies(t *testing.T) {
 	mockRepo := mockInterfaces.NewMockCommentRepository(t)
 	mockAuth := serviceMocks.NewMockAuthService(t)
 	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
 	mockNotification := serviceMocks.NewMockNotificationService(t)
 	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
 	mockLogger := loggerMocks.NewMockLogger(t)
 
-	service := NewCommentService(mockRepo, mockAuth, mockActivity, mockNotification, mockBroadcaster, mockLogger)
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
 
 	t.Run("get replies successfully", func(t *testing.T) {
 		expectedReplies := []models.Comment{
@@ -220,16 +347,207 @@ func TestCommentService_GetCommentReplies(t *testing.T) {
 			{ID: "3", Content: "Reply 2", ParentID: stringPtr("1")},
 		}
 
-		mockRepo.On("FindReplies", "1").Return(expectedReplies, nil)
+		mockRepo.On("FindReplies", "1", (*models.CommentCursor)(nil), models.MaxCommentPageSize+1).Return(expectedReplies, nil)
 
-		replies, err := service.GetCommentReplies("1")
+		page, err := service.GetCommentReplies("1", "", 500)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedReplies, replies)
+		assert.Equal(t, expectedReplies, page.Comments)
 		mockRepo.AssertExpectations(t)
 	})
 }
 
+func TestCommentService_GetCommentThread(t *testing.T) {
+	mockRepo := mockInterfaces.NewMockCommentRepository(t)
+	mockAuth := serviceMocks.NewMockAuthService(t)
+	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
+	mockNotification := serviceMocks.NewMockNotificationService(t)
+	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
+	mockLogger := loggerMocks.NewMockLogger(t)
+
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
+
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	replies := []models.Comment{
+		{ID: "B", ParentID: stringPtr("ROOT"), CreatedAt: createdAt.Add(2 * time.Minute)},
+		{ID: "A", ParentID: stringPtr("ROOT"), CreatedAt: createdAt.Add(time.Minute)},
+		{ID: "A1", ParentID: stringPtr("A"), CreatedAt: createdAt.Add(3 * time.Minute)},
+		{ID: "A1a", ParentID: stringPtr("A1"), CreatedAt: createdAt.Add(4 * time.Minute)},
+	}
+
+	mockRepo.On("Get", "ROOT").Return(models.Comment{ID: "ROOT"}, nil)
+	mockRepo.On("FindThread", "ROOT").Return(replies, nil)
+
+	thread, err := service.GetCommentThread("ROOT")
+
+	assert.NoError(t, err)
+	if assert.Len(t, thread, 4) {
+		assert.Equal(t, "A", thread[0].ID)
+		assert.Equal(t, 1, thread[0].Depth)
+		assert.Equal(t, "A1", thread[1].ID)
+		assert.Equal(t, "A1a", thread[2].ID)
+		assert.Equal(t, 3, thread[2].Depth)
+		assert.Equal(t, "ROOT/A/A1/A1a", thread[2].Path)
+		assert.Equal(t, "B", thread[3].ID)
+		assert.Equal(t, "ROOT/B", thread[3].Path)
+	}
+}
+
+func TestCommentService_UnreadComments(t *testing.T) {
+	mockRepo := mockInterfaces.NewMockCommentRepository(t)
+	mockAuth := serviceMocks.NewMockAuthService(t)
+	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
+	mockNotification := serviceMocks.NewMockNotificationService(t)
+	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
+	mockLogger := loggerMocks.NewMockLogger(t)
+
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
+
+	t.Run("mark read", func(t *testing.T) {
+		mockActivity.On("GetActivityByID", uint(1), "campaign1").Return(models.Activity{ID: 1, CampaignID: "campaign1"}, nil).Once()
+		mockRepo.On("MarkRead", mock.MatchedBy(func(marker *models.CommentReadMarker) bool {
+			return marker.UserHandle == "testuser" && marker.ActivityID == 1 && !marker.LastReadAt.IsZero()
+		})).Return(nil).Once()
+
+		err := service.MarkCommentsRead(1, "campaign1", "testuser")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("mark read on another campaign's activity", func(t *testing.T) {
+		mockActivity.On("GetActivityByID", uint(2), "campaign1").Return(models.Activity{}, errs.NotFound("Activity does not belong to this campaign")).Once()
+
+		err := service.MarkCommentsRead(2, "campaign1", "testuser")
+
+		assertErrorCode(t, err, 404)
+	})
+
+	t.Run("campaign summary", func(t *testing.T) {
+		activities := []models.Activity{
+			{ID: 1, Title: "Venue", UnreadComments: 3},
+			{ID: 2, Title: "Food", UnreadComments: 0},
+			{ID: 3, Title: "Music", UnreadComments: 2},
+		}
+		mockActivity.On("GetActivitiesByCampaignID", "campaign1", "testuser").Return(activities, nil).Once()
+
+		summary, err := service.GetUnreadSummary("campaign1", "testuser")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), summary.Total)
+		assert.Len(t, summary.Activities, 3)
+		assert.Equal(t, models.ActivityUnreadComments{ActivityID: 1, Title: "Venue", Unread: 3}, summary.Activities[0])
+	})
+}
+
+func TestCommentService_Reactions(t *testing.T) {
+	mockRepo := mockInterfaces.NewMockCommentRepository(t)
+	mockAuth := serviceMocks.NewMockAuthService(t)
+	mockActivity := serviceMocks.NewMockActivityService(t)
+	mockCampaign := serviceMocks.NewMockCampaignService(t)
+	mockNotification := serviceMocks.NewMockNotificationService(t)
+	mockBroadcaster := serviceMocks.NewMockEventBroadcaster(t)
+	mockLogger := loggerMocks.NewMockLogger(t)
+
+	service := newTestCommentService(mockRepo, mockAuth, mockActivity, mockCampaign, mockNotification, mockBroadcaster, mockLogger)
+
+	reset := func() {
+		mockRepo.ExpectedCalls = nil
+		mockRepo.Calls = nil
+		mockAuth.ExpectedCalls = nil
+		mockAuth.Calls = nil
+		mockBroadcaster.ExpectedCalls = nil
+		mockBroadcaster.Calls = nil
+	}
+	user := models.User{Handle: "testuser"}
+
+	t.Run("add reaction", func(t *testing.T) {
+		reset()
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(models.Comment{ID: "123"}, nil)
+		mockRepo.On("AddReaction", models.NewCommentReaction("123", "testuser", "👍")).Return(nil)
+		mockBroadcaster.On("NewEvent", "campaign1", websocket.EventTypeCommentUpdated, mock.AnythingOfType("*models.Comment")).Return(nil)
+
+		comment, err := service.AddReaction("123", "campaign1", "testuser", "👍")
+
+		assert.NoError(t, err)
+		assert.True(t, comment.HasReaction("testuser", "👍"))
+		mockBroadcaster.AssertExpectations(t)
+	})
+
+	t.Run("repeated reaction", func(t *testing.T) {
+		reset()
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(models.Comment{
+			ID:        "123",
+			Reactions: []models.CommentReaction{{CommentID: "123", UserHandle: "testuser", Emoji: "👍"}},
+		}, nil)
+
+		comment, err := service.AddReaction("123", "campaign1", "testuser", "👍")
+
+		assert.NoError(t, err)
+		assert.Len(t, comment.Reactions, 1)
+		mockRepo.AssertNotCalled(t, "AddReaction", mock.Anything)
+	})
+
+	t.Run("invalid emoji", func(t *testing.T) {
+		reset()
+		_, err := service.AddReaction("123", "campaign1", "testuser", "nice")
+
+		assertErrorCode(t, err, 400)
+		mockRepo.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("remove reaction", func(t *testing.T) {
+		reset()
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(models.Comment{
+			ID:        "123",
+			Reactions: []models.CommentReaction{{CommentID: "123", UserHandle: "testuser", Emoji: "🎉"}},
+		}, nil)
+		mockRepo.On("RemoveReaction", "123", "testuser", "🎉").Return(nil)
+		mockBroadcaster.On("NewEvent", "campaign1", websocket.EventTypeCommentUpdated, mock.AnythingOfType("*models.Comment")).Return(nil)
+
+		comment, err := service.RemoveReaction("123", "campaign1", "testuser", "🎉")
+
+		assert.NoError(t, err)
+		assert.Empty(t, comment.Reactions)
+	})
+
+	t.Run("remove missing reaction", func(t *testing.T) {
+		reset()
+		mockAuth.On("GetUserByHandle", "testuser").Return(user, nil)
+		mockRepo.On("Get", "123").Return(models.Comment{ID: "123"}, nil)
+
+		_, err := service.RemoveReaction("123", "campaign1", "testuser", "🎉")
+
+		assertErrorCode(t, err, 404)
+	})
+}
+
+func newTestCommentService(
+	repo *mockInterfaces.MockCommentRepository,
+	authService *serviceMocks.MockAuthService,
+	activityService *serviceMocks.MockActivityService,
+	campaignService *serviceMocks.MockCampaignService,
+	notificationService *serviceMocks.MockNotificationService,
+	broadcaster *serviceMocks.MockEventBroadcaster,
+	logger *loggerMocks.MockLogger,
+) *commentService {
+	return &commentService{
+		repo:                repo,
+		authService:         authService,
+		activityService:     activityService,
+		campaignService:     campaignService,
+		notificationService: notificationService,
+		broadcaster:         broadcaster,
+		contentFilter:       NewWordListFilter(nil, nil),
+		logger:              logger,
+		runAsync:            func(f func()) { f() },
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"errors"
+
+	ai "github.com/oyen-bright/goFundIt/internal/ai/interfaces"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+)
+
+type contentFilterChain struct {
+	filters []services.ContentFilter
+}
+
+// NewContentFilterChain creates a content filter that runs the filters in order and returns the strictest verdict.
+// A failing filter doesn't stop the others, its error is returned with the verdict of the rest
+func NewContentFilterChain(filters ...services.ContentFilter) services.ContentFilter {
+	return &contentFilterChain{filters: filters}
+}
+
+func (f *contentFilterChain) Check(content string) (models.ContentCheck, error) {
+	result := models.ContentCheck{Verdict: models.ContentAllowed}
+	var errs []error
+
+	for _, filter := range f.filters {
+		check, err := filter.Check(content)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = result.Stricter(check)
+		if result.Verdict == models.ContentBlocked {
+			break
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+type wordListFilter struct {
+	blocked []string
+	flagged []string
+}
+
+// NewWordListFilter creates a content filter that blocks content containing a blocked word or phrase
+// and flags content containing a flagged one
+func NewWordListFilter(blocked, flagged []string) services.ContentFilter {
+	return &wordListFilter{blocked: blocked, flagged: flagged}
+}
+
+func (f *wordListFilter) Check(content string) (models.ContentCheck, error) {
+	for _, term := range f.blocked {
+		if models.ContainsTerm(content, term) {
+			return models.ContentCheck{Verdict: models.ContentBlocked, Reason: "Contains blocked words"}, nil
+		}
+	}
+	for _, term := range f.flagged {
+		if models.ContainsTerm(content, term) {
+			return models.ContentCheck{Verdict: models.ContentFlagged, Reason: "Contains flagged words"}, nil
+		}
+	}
+	return models.ContentCheck{Verdict: models.ContentAllowed}, nil
+}
+
+type aiContentFilter struct {
+	aIService ai.AIService
+}
+
+// NewAIContentFilter creates a content filter that asks the AI service to review the content
+func NewAIContentFilter(aIService ai.AIService) services.ContentFilter {
+	return &aiContentFilter{aIService: aIService}
+}
+
+func (f *aiContentFilter) Check(content string) (models.ContentCheck, error) {
+	check, err := f.aIService.ModerateContent(content)
+	if err != nil {
+		return models.ContentCheck{}, err
+	}
+	if !check.Verdict.IsValid() {
+		return models.ContentCheck{}, errors.New("unknown content verdict " + string(check.Verdict))
+	}
+	return check, nil
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	mockAI "github.com/oyen-bright/goFundIt/internal/ai/mocks"
+	"github.com/oyen-bright/goFundIt/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordListFilter(t *testing.T) {
+	filter := NewWordListFilter([]string{"scam", "send money"}, []string{"idiot"})
+
+	tests := []struct {
+		name     string
+		content  string
+		expected models.ContentVerdict
+	}{
+		{name: "clean content", content: "See you at the beach", expected: models.ContentAllowed},
+		{name: "blocked word", content: "This is a SCAM!", expected: models.ContentBlocked},
+		{name: "blocked phrase across punctuation", content: "Please send, money now", expected: models.ContentBlocked},
+		{name: "flagged word", content: "Don't be an idiot", expected: models.ContentFlagged},
+		{name: "part of a longer word", content: "Scampi for dinner", expected: models.ContentAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, err := filter.Check(tt.content)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, check.Verdict)
+		})
+	}
+}
+
+func TestContentFilterChain(t *testing.T) {
+	t.Run("strictest verdict wins", func(t *testing.T) {
+		aiService := mockAI.NewMockAIService(t)
+		aiService.On("ModerateContent", "You idiot").Return(models.ContentCheck{Verdict: models.ContentBlocked, Reason: "Harassment"}, nil)
+
+		filter := NewContentFilterChain(NewWordListFilter(nil, []string{"idiot"}), NewAIContentFilter(aiService))
+		check, err := filter.Check("You idiot")
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ContentBlocked, check.Verdict)
+		assert.Equal(t, "Harassment", check.Reason)
+	})
+
+	t.Run("blocked content skips the remaining filters", func(t *testing.T) {
+		aiService := mockAI.NewMockAIService(t)
+
+		filter := NewContentFilterChain(NewWordListFilter([]string{"scam"}, nil), NewAIContentFilter(aiService))
+		check, err := filter.Check("scam")
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ContentBlocked, check.Verdict)
+		aiService.AssertNotCalled(t, "ModerateContent", "scam")
+	})
+
+	t.Run("failing filter returns the verdict of the rest", func(t *testing.T) {
+		aiService := mockAI.NewMockAIService(t)
+		aiService.On("ModerateContent", "You idiot").Return(models.ContentCheck{}, errors.New("unavailable"))
+
+		filter := NewContentFilterChain(NewAIContentFilter(aiService), NewWordListFilter(nil, []string{"idiot"}))
+		check, err := filter.Check("You idiot")
+
+		assert.Error(t, err)
+		assert.Equal(t, models.ContentFlagged, check.Verdict)
+	})
+}
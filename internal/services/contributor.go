@@ -1,6 +1,9 @@
 package services
 
 import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
 	"github.com/oyen-bright/goFundIt/internal/models"
 	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
@@ -13,6 +16,7 @@ import (
 type contributorService struct {
 	repo                repositories.ContributorRepository
 	campaignService     services.CampaignService
+	accessService       services.CampaignAccessService
 	analyticsService    services.AnalyticsService
 	authService         services.AuthService
 	notificationService services.NotificationService
@@ -24,6 +28,7 @@ type contributorService struct {
 func NewContributorService(
 	repo repositories.ContributorRepository,
 	campaignService services.CampaignService,
+	accessService services.CampaignAccessService,
 	analyticsService services.AnalyticsService,
 	authService services.AuthService,
 	notificationService services.NotificationService,
@@ -33,6 +38,7 @@ func NewContributorService(
 	return &contributorService{
 		repo:                repo,
 		campaignService:     campaignService,
+		accessService:       accessService,
 		analyticsService:    analyticsService,
 		authService:         authService,
 		notificationService: notificationService,
@@ -75,7 +81,18 @@ func (s *contributorService) AddContributorToCampaign(contributor *models.Contri
 	contributor.CampaignID = campaignId
 	campaign.Key = campaignKey
 
-	if err = s.repo.Create(contributor); err != nil {
+	// Contributors join once they accept their invitation, unless they already asked to join
+	if !contributor.Invitation.HasResponded() {
+		s.accessService.IssueInvitation(contributor)
+	}
+
+	// The contributor's own campaign key is stored along with them
+	accessKey, err := s.accessService.PrepareMemberKey(campaignId, campaignKey, contributor)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.Create(contributor, accessKey); err != nil {
 		return errs.InternalServerError(err).Log(s.logger)
 	}
 
@@ -89,6 +106,10 @@ func (s *contributorService) AddContributorToCampaign(contributor *models.Contri
 	//
 	s.runAsync(func() {
 		s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeContributionCreated, contributor)
+		if contributor.Invitation.IsPending() {
+			s.notificationService.NotifyContributorInvited(contributor, campaign)
+			return
+		}
 		s.notificationService.NotifyContributorAdded(contributor, campaign)
 		s.campaignService.RecalculateTargetAmount(campaignId)
 	})
@@ -96,6 +117,153 @@ func (s *contributorService) AddContributorToCampaign(contributor *models.Contri
 	return nil
 }
 
+// ImportContributors adds contributors to a campaign in bulk, every contributor is validated first
+// and either all of them are added or none are
+func (s *contributorService) ImportContributors(contributors []models.Contributor, campaignId, campaignKey, userHandle string) ([]models.Contributor, error) {
+	if len(contributors) == 0 {
+		return nil, errs.BadRequest("No contributors to import", nil)
+	}
+	if len(contributors) > models.MaxContributorImportRows {
+		return nil, errs.BadRequest(fmt.Sprintf("Cannot import more than %d contributors at once", models.MaxContributorImportRows), nil)
+	}
+
+	// Get campaign
+	campaign, err := s.campaignService.GetCampaignByID(campaignId, campaignKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCanAddContributors(campaign, userHandle); err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, len(contributors))
+	for i, contributor := range contributors {
+		emails[i] = contributor.Email
+	}
+	existing, nonExisting, err := s.authService.FindExistingAndNonExistingUsers(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate every row so all the problems are reported at once
+	rowErrors, replaced := validateImportedContributors(campaign, contributors, existing)
+	if len(rowErrors) > 0 {
+		return nil, errs.ValidationFailed("Some contributors are invalid, no contributors were imported", rowErrors)
+	}
+
+	// Create new users for non-existing emails, contributors reference their user by email
+	if len(nonExisting) > 0 {
+		if _, err := s.authService.CreateUsers(createUsersFromEmails(nonExisting)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Each contributor gets their own campaign key, the keys are stored with the contributors and the keys
+	// of the contributors who were replaced are revoked in the same transaction
+	accessKeys := make([]models.CampaignAccessKey, len(contributors))
+	for i := range contributors {
+		contributors[i].CampaignID = campaignId
+		s.accessService.IssueInvitation(&contributors[i])
+
+		accessKey, err := s.accessService.PrepareMemberKey(campaignId, campaignKey, &contributors[i])
+		if err != nil {
+			return nil, err
+		}
+		accessKeys[i] = *accessKey
+	}
+
+	if err := s.repo.CreateMultiple(contributors, replaced, accessKeys); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	campaign.Key = campaignKey
+	s.runAsync(func() {
+		for i := range contributors {
+			s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeContributionCreated, contributors[i])
+		}
+		s.notificationService.NotifyContributorsImported(contributors, campaign)
+	})
+
+	return contributors, nil
+}
+
+// AcceptInvitation adds an invited contributor to the campaign
+func (s *contributorService) AcceptInvitation(token string) (*models.Contributor, error) {
+	contributor, err := s.getPendingInvitation(token)
+	if err != nil {
+		return nil, err
+	}
+
+	contributor.Invitation.Accept()
+	if err := s.repo.UpdateInvitation(contributor); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
+		s.campaignService.RecalculateTargetAmount(contributor.CampaignID)
+	})
+
+	return contributor, nil
+}
+
+// DeclineInvitation declines an invitation, revokes the contributor's key and notifies the campaign creator
+func (s *contributorService) DeclineInvitation(token string) error {
+	contributor, err := s.getPendingInvitation(token)
+	if err != nil {
+		return err
+	}
+
+	contributor.Invitation.Decline()
+	if err := s.repo.UpdateInvitation(contributor); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.accessService.RevokeMemberKeys(contributor.CampaignID, contributor.Email); err != nil {
+		return err
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
+		campaign, err := s.campaignService.GetCampaignByIDWithAllRelatedData(contributor.CampaignID)
+		if err != nil {
+			return
+		}
+		s.notificationService.NotifyInvitationDeclined(contributor, campaign)
+	})
+
+	return nil
+}
+
+// SendInvitationReminders reminds contributors of the invitations they have not answered and expires the ones past due
+func (s *contributorService) SendInvitationReminders() {
+	contributors, err := s.repo.GetPendingInvitations()
+	if err != nil {
+		errs.InternalServerError(err).Log(s.logger)
+		return
+	}
+
+	for i := range contributors {
+		contributor := &contributors[i]
+
+		if contributor.Invitation.HasExpired() {
+			s.expireInvitation(contributor)
+			continue
+		}
+		if !contributor.Invitation.IsDueForReminder() {
+			continue
+		}
+
+		s.accessService.RestoreInvitationToken(contributor)
+		if err := s.repo.UpdateInvitation(contributor); err != nil {
+			errs.InternalServerError(err).Log(s.logger)
+			continue
+		}
+		s.notificationService.SendInvitationReminder(contributor)
+	}
+}
+
 func (s *contributorService) UpdateContributor(contributor *models.Contributor) error {
 
 	// Update contributor
@@ -155,9 +323,8 @@ func (s *contributorService) RemoveContributorFromCampaign(contributorId uint, c
 	}
 
 	//validate ownership
-	if campaign.CreatedBy.Handle != userHandle {
-
-		return errs.BadRequest("Unauthorized: Only campaign creator can remove contributors", nil)
+	if !can(userHandle, campaign, models.CampaignActionManageContributors) {
+		return errs.BadRequest("Unauthorized: Only campaign organisers can remove contributors", nil)
 	}
 
 	// Get contributor
@@ -176,6 +343,11 @@ func (s *contributorService) RemoveContributorFromCampaign(contributorId uint, c
 		return errs.InternalServerError(err).Log(s.logger)
 	}
 
+	// Revoke the contributor's campaign key
+	if err := s.accessService.RevokeMemberKeys(campaignId, contributor.Email); err != nil {
+		return err
+	}
+
 	// broadcast event
 	// go s.broadcaster.NewEvent(campaign.ID, websocket.EventTypeContributorDeleted, contributor)
 
@@ -191,6 +363,25 @@ func (s *contributorService) RemoveContributorFromCampaign(contributorId uint, c
 	return nil
 }
 
+// LeaveCampaign removes a contributor from their campaign and revokes their key, the contributor is kept so their payment stays on record
+func (s *contributorService) LeaveCampaign(contributor *models.Contributor, refunded bool) error {
+	contributor.Leave(refunded)
+	if err := s.repo.Leave(contributor); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if err := s.accessService.RevokeMemberKeys(contributor.CampaignID, contributor.Email); err != nil {
+		return err
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
+		s.campaignService.RecalculateTargetAmount(contributor.CampaignID)
+	})
+
+	return nil
+}
+
 // GetContributors retrieves contributor by id
 func (s *contributorService) GetContributorByID(contributorID uint) (models.Contributor, error) {
 	contributor, err := s.repo.GetContributorById(contributorID, true)
@@ -217,9 +408,31 @@ func (s *contributorService) GetContributorsByCampaignID(campaignID string) ([]m
 // Helper Methods --------------------------------------
 
 func (s *contributorService) validateCampaignAndPermissions(campaign *models.Campaign, userHandle string, contributor *models.Contributor) error {
-	// Check creator permission
-	if campaign.CreatedBy.Handle != userHandle {
-		return errs.BadRequest("Unauthorized: Only campaign creator can add contributors", nil)
+	if err := s.validateCanAddContributors(campaign, userHandle); err != nil {
+		return err
+	}
+
+	// Check for existing contributor in this campaign, contributors who declined or let their invitation expire can be invited again
+	if existing := campaign.GetContributorByEmail(contributor.Email); existing != nil {
+		if existing.Invitation.IsOpen() {
+			return errs.BadRequest("Contributor already exists in this campaign", nil)
+		}
+		if existing.Payment != nil {
+			return errs.BadRequest("Contributor left this campaign after paying and cannot be added again", nil)
+		}
+		if err := s.repo.Delete(existing); err != nil {
+			return errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+
+	return nil
+}
+
+// validateCanAddContributors checks the user can add contributors to the campaign
+func (s *contributorService) validateCanAddContributors(campaign *models.Campaign, userHandle string) error {
+	// Check organiser permission
+	if !can(userHandle, campaign, models.CampaignActionManageContributors) {
+		return errs.BadRequest("Unauthorized: Only campaign organisers can add contributors", nil)
 	}
 
 	// Check campaign status
@@ -227,10 +440,121 @@ func (s *contributorService) validateCampaignAndPermissions(campaign *models.Cam
 		return errs.BadRequest("Cannot add contributors: Campaign has ended", nil)
 	}
 
-	// Check for existing contributor in this campaign
-	if contributor := campaign.GetContributorByEmail(contributor.Email); contributor != nil {
-		return errs.BadRequest("Contributor already exists in this campaign", nil)
+	return nil
+}
+
+// getPendingInvitation fetches the contributor an invitation token was issued to, expired invitations are closed
+func (s *contributorService) getPendingInvitation(token string) (*models.Contributor, error) {
+	contributor, err := s.repo.GetContributorByInvitationTokenHash(s.accessService.InvitationTokenHash(token))
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Invitation not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if !contributor.Invitation.IsPending() {
+		return nil, errs.BadRequest("Invitation has already been answered", nil)
+	}
+	if contributor.Invitation.HasExpired() {
+		s.expireInvitation(&contributor)
+		return nil, errs.BadRequest("Invitation has expired", nil)
 	}
+	return &contributor, nil
+}
 
-	return nil
+// expireInvitation closes an invitation that was not answered in time and revokes the contributor's key
+func (s *contributorService) expireInvitation(contributor *models.Contributor) {
+	contributor.Invitation.Expire()
+	if err := s.repo.UpdateInvitation(contributor); err != nil {
+		errs.InternalServerError(err).Log(s.logger)
+		return
+	}
+	s.accessService.RevokeMemberKeys(contributor.CampaignID, contributor.Email)
+}
+
+// Helper Functions --------------------------------------------------
+
+// validateImportedContributors checks each imported contributor and returns the problems of every invalid row,
+// along with the contributors who declined or let their invitation expire and are invited again
+func validateImportedContributors(campaign *models.Campaign, contributors []models.Contributor, existingUsers []models.User) (rowErrors []models.ContributorImportError, replaced []models.Contributor) {
+	validate := validator.New()
+
+	users := make(map[string]models.User, len(existingUsers))
+	for _, user := range existingUsers {
+		users[user.Email] = user
+	}
+	rows := make(map[string]int, len(contributors))
+	// Seats left in the activities with a capacity, rows take them in order
+	seatsLeft := make(map[uint]int)
+
+	for i := range contributors {
+		contributor := &contributors[i]
+		row := i + 1
+		var problems []string
+
+		if err := validate.Var(contributor.Email, "required,email"); err != nil {
+			problems = append(problems, "email must be a valid email address")
+		} else if firstRow, ok := rows[contributor.Email]; ok {
+			problems = append(problems, fmt.Sprintf("email is already on row %d", firstRow))
+		} else {
+			rows[contributor.Email] = row
+		}
+		if contributor.Name != "" && len(contributor.Name) < 3 {
+			problems = append(problems, "name must be at least 3 characters")
+		}
+		if contributor.Amount <= 0 {
+			problems = append(problems, "amount must be greater than 0")
+		}
+
+		if existing := campaign.GetContributorByEmail(contributor.Email); existing != nil && existing.Invitation.IsOpen() {
+			problems = append(problems, "contributor already exists in this campaign")
+		} else if existing != nil && existing.Payment != nil {
+			problems = append(problems, "contributor left this campaign after paying and cannot be added again")
+		} else {
+			if existing != nil {
+				replaced = append(replaced, *existing)
+			}
+			if user, ok := users[contributor.Email]; ok && !user.CanContributeToACampaign() {
+				problems = append(problems, "contributor is part of another campaign")
+			}
+		}
+
+		// Opt the contributor into the campaign's approved activities, the same as an opt-in
+		var seatsTaken []uint
+		for j, activity := range contributor.Activities {
+			campaignActivity := campaign.GetActivityById(activity.ID)
+			if campaignActivity != nil && campaignActivity.HasCapacity() {
+				if _, ok := seatsLeft[activity.ID]; !ok {
+					seatsLeft[activity.ID] = campaignActivity.AvailableSeats()
+				}
+			}
+			switch {
+			case campaignActivity == nil:
+				problems = append(problems, fmt.Sprintf("activity %d is not part of this campaign", activity.ID))
+			case !campaignActivity.IsApproved:
+				problems = append(problems, fmt.Sprintf("activity %d is not approved", activity.ID))
+			case campaignActivity.HasSignUpClosed():
+				problems = append(problems, fmt.Sprintf("sign-up for activity %d has closed", activity.ID))
+			case campaignActivity.HasCapacity() && seatsLeft[activity.ID] <= 0:
+				problems = append(problems, fmt.Sprintf("activity %d is full", activity.ID))
+			default:
+				if campaignActivity.HasCapacity() {
+					seatsTaken = append(seatsTaken, activity.ID)
+				}
+				contributor.Activities[j] = *campaignActivity
+				contributor.Activities[j].Contributors = nil
+			}
+		}
+
+		if len(problems) > 0 {
+			rowErrors = append(rowErrors, models.NewContributorImportError(row, contributor.Email, problems...))
+			continue
+		}
+		for _, activityID := range seatsTaken {
+			seatsLeft[activityID]--
+		}
+	}
+
+	return rowErrors, replaced
 }
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+type contributorRequestService struct {
+	repo               repositories.ContributorRequestRepository
+	campaignService    services.CampaignService
+	contributorService services.ContributorService
+	broadcaster        services.EventBroadcaster
+	logger             logger.Logger
+	runAsync           func(func())
+}
+
+func NewContributorRequestService(
+	repo repositories.ContributorRequestRepository,
+	campaignService services.CampaignService,
+	contributorService services.ContributorService,
+	broadcaster services.EventBroadcaster,
+	logger logger.Logger,
+) services.ContributorRequestService {
+	return &contributorRequestService{
+		repo:               repo,
+		campaignService:    campaignService,
+		contributorService: contributorService,
+		broadcaster:        broadcaster,
+		logger:             logger,
+		runAsync:           func(f func()) { go f() },
+	}
+}
+
+// RequestToLeave records a contributor's request to leave their campaign, contributors who paid can only leave once they are refunded
+func (s *contributorRequestService) RequestToLeave(campaignID, key, userEmail, reason string) (*models.ContributorRequest, error) {
+	campaign, contributor, err := s.getCampaignAsContributor(campaignID, key, userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.CreatedBy.Email == userEmail {
+		return nil, errs.BadRequest("Campaign creators cannot leave their campaign", nil)
+	}
+
+	return s.createRequest(models.NewLeaveRequest(contributor, reason))
+}
+
+// RequestAmountChange records a contributor's request to contribute a different amount
+func (s *contributorRequestService) RequestAmountChange(campaignID, key, userEmail string, amount float64, reason string) (*models.ContributorRequest, error) {
+	_, contributor, err := s.getCampaignAsContributor(campaignID, key, userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if contributor.HasPaid() {
+		return nil, errs.BadRequest("Cannot change the amount after making a payment", nil)
+	}
+	if amount <= 0 {
+		return nil, errs.BadRequest("Amount must be greater than 0", nil)
+	}
+	if amount == contributor.Amount {
+		return nil, errs.BadRequest("The requested amount is the same as your current amount", nil)
+	}
+
+	return s.createRequest(models.NewAmountChangeRequest(contributor, amount, reason))
+}
+
+// GetContributorRequests fetches the contributor requests of a campaign, organisers see every request and contributors see their own
+func (s *contributorRequestService) GetContributorRequests(campaignID, key, userHandle, userEmail string) ([]models.ContributorRequest, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	email := userEmail
+	if canReviewRequests(userHandle, campaign) {
+		email = ""
+	} else if campaign.GetContributorByEmail(userEmail) == nil {
+		return nil, errs.Forbidden("Only campaign organisers and contributors can view contributor requests")
+	}
+
+	requests, err := s.repo.GetByCampaignID(campaignID, email)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return requests, nil
+}
+
+// ApproveContributorRequest applies a contributor's request, refundIssued confirms the payment of a contributor who is leaving was refunded.
+// A contributor who paid leaving is approved by the treasurer alone, as the treasurer confirms the refund.
+func (s *contributorRequestService) ApproveContributorRequest(requestID uint, campaignID, key, userHandle, note string, refundIssued bool) (*models.ContributorRequest, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+	if !canReviewRequests(userHandle, campaign) {
+		return nil, errs.Forbidden("Only campaign organisers can review contributor requests")
+	}
+
+	request, err := s.getPendingRequest(requestID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	contributor := campaign.GetContributorByID(request.ContributorID)
+	if contributor == nil || !contributor.Invitation.IsAccepted() {
+		return nil, errs.BadRequest("Contributor is no longer part of this campaign", nil)
+	}
+
+	paid := contributor.HasPaid()
+	if request.IsLeave() && paid {
+		if !can(userHandle, campaign, models.CampaignActionApprovePayment) {
+			return nil, errs.Forbidden("Only the campaign treasurer can approve a contributor who paid leaving, as it confirms their refund")
+		}
+	} else if !can(userHandle, campaign, models.CampaignActionManageContributors) {
+		return nil, errs.Forbidden("Only campaign organisers can review contributor requests")
+	}
+
+	if request.IsLeave() {
+		if err := s.approveLeave(contributor, refundIssued); err != nil {
+			return nil, err
+		}
+	} else {
+		if paid {
+			return nil, errs.BadRequest("Cannot change the amount of a contributor who has paid", nil)
+		}
+		contributor.Amount = *request.RequestedAmount
+		if err := s.contributorService.UpdateContributor(contributor); err != nil {
+			return nil, err
+		}
+	}
+
+	request.Approve(userHandle, note, request.IsLeave() && paid)
+	if err := s.repo.Update(request); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeContributorRequestUpdated, request)
+	})
+
+	return request, nil
+}
+
+// RejectContributorRequest declines a contributor's request, the contributor is left unchanged
+func (s *contributorRequestService) RejectContributorRequest(requestID uint, campaignID, key, userHandle, note string) (*models.ContributorRequest, error) {
+	if _, err := s.getCampaignAsOrganiser(campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	request, err := s.getPendingRequest(requestID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Reject(userHandle, note)
+	if err := s.repo.Update(request); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeContributorRequestUpdated, request)
+	})
+
+	return request, nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+// approveLeave removes the contributor from the campaign, a contributor who paid can only leave once their refund is confirmed
+func (s *contributorRequestService) approveLeave(contributor *models.Contributor, refundIssued bool) error {
+	paid := contributor.HasPaid()
+	if paid && !refundIssued {
+		return errs.BadRequest("Contributor has paid, issue a refund before approving their request to leave", nil)
+	}
+	return s.contributorService.LeaveCampaign(contributor, paid)
+}
+
+func (s *contributorRequestService) createRequest(request *models.ContributorRequest) (*models.ContributorRequest, error) {
+	hasPending, err := s.repo.HasPendingRequest(request.ContributorID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if hasPending {
+		return nil, errs.BadRequest("You already have a pending request for this campaign", nil)
+	}
+
+	if err := s.repo.Create(request); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(request.CampaignID, websocket.EventTypeContributorRequestUpdated, request)
+	})
+
+	return request, nil
+}
+
+func (s *contributorRequestService) getCampaignAsContributor(campaignID, key, userEmail string) (*models.Campaign, *models.Contributor, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if campaign.HasEnded() {
+		return nil, nil, errs.BadRequest("Cannot make requests: Campaign has ended", nil)
+	}
+
+	contributor := campaign.GetContributorByEmail(userEmail)
+	if contributor == nil || !contributor.Invitation.IsAccepted() {
+		return nil, nil, errs.Forbidden("Only contributors of this campaign can make requests")
+	}
+	return campaign, contributor, nil
+}
+
+func (s *contributorRequestService) getCampaignAsOrganiser(campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionManageContributors) {
+		return nil, errs.Forbidden("Only campaign organisers can review contributor requests")
+	}
+	return campaign, nil
+}
+
+func (s *contributorRequestService) getPendingRequest(requestID uint, campaignID string) (*models.ContributorRequest, error) {
+	request, err := s.repo.GetByID(requestID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Contributor request not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if request.CampaignID != campaignID {
+		return nil, errs.NotFound("Contributor request not found")
+	}
+	if !request.IsPending() {
+		return nil, errs.BadRequest("Contributor request has already been reviewed", nil)
+	}
+	return &request, nil
+}
+
+// Helper Functions ------------------------------------------------------
+
+// canReviewRequests checks if a user reviews contributor requests, organisers review them and
+// the treasurer reviews contributors who paid leaving
+func canReviewRequests(userHandle string, campaign *models.Campaign) bool {
+	return can(userHandle, campaign, models.CampaignActionManageContributors) || can(userHandle, campaign, models.CampaignActionApprovePayment)
+}
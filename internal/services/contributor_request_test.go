@@ -0,0 +1,321 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func setupContributorRequestTest(t *testing.T) (
+	*contributorRequestService,
+	*mockRepo.MockContributorRequestRepository,
+	*mockService.MockCampaignService,
+	*mockService.MockContributorService,
+	*mockService.MockEventBroadcaster,
+) {
+	repo := mockRepo.NewMockContributorRequestRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	contributorService := mockService.NewMockContributorService(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &contributorRequestService{
+		repo:               repo,
+		campaignService:    campaignService,
+		contributorService: contributorService,
+		broadcaster:        broadcaster,
+		logger:             mockLogger.NewMockLogger(t),
+		runAsync:           func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, contributorService, broadcaster
+}
+
+func newContributorRequestTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		EndDate:   time.Now().Add(24 * time.Hour),
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "creator@example.com", Amount: 100},
+			{ID: 2, CampaignID: "campaign-123", Email: "member@example.com", Amount: 50},
+			{ID: 3, CampaignID: "campaign-123", Email: "paid@example.com", Amount: 50, Payment: &models.Payment{
+				Reference: "ref-1", PaymentStatus: models.PaymentStatusSucceeded,
+			}},
+		},
+	}
+}
+
+func TestRequestToLeave(t *testing.T) {
+	service, repo, campaignService, _, broadcaster := setupContributorRequestTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().HasPendingRequest(uint(2)).Return(false, nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+			return r.IsLeave() && r.ContributorID == 2 && r.Amount == 50 && r.Reason == "Can't make it"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		request, err := service.RequestToLeave("campaign-123", "key", "member@example.com", "Can't make it")
+		assert.NoError(t, err)
+		assert.True(t, request.IsPending())
+	})
+
+	t.Run("contributors who paid can ask to leave", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().HasPendingRequest(uint(3)).Return(false, nil).Once()
+		repo.EXPECT().Create(mock.AnythingOfType("*models.ContributorRequest")).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		_, err := service.RequestToLeave("campaign-123", "key", "paid@example.com", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("creator cannot leave", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.RequestToLeave("campaign-123", "key", "creator@example.com", "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("pending request", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().HasPendingRequest(uint(2)).Return(true, nil).Once()
+
+		_, err := service.RequestToLeave("campaign-123", "key", "member@example.com", "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("not a contributor", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.RequestToLeave("campaign-123", "key", "stranger@example.com", "")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestRequestAmountChange(t *testing.T) {
+	service, repo, campaignService, _, broadcaster := setupContributorRequestTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().HasPendingRequest(uint(2)).Return(false, nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+			return !r.IsLeave() && r.Amount == 50 && *r.RequestedAmount == 80
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		_, err := service.RequestAmountChange("campaign-123", "key", "member@example.com", 80, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("same amount", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.RequestAmountChange("campaign-123", "key", "member@example.com", 50, "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("contributor has paid", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.RequestAmountChange("campaign-123", "key", "paid@example.com", 80, "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestGetContributorRequests(t *testing.T) {
+	service, repo, campaignService, _, _ := setupContributorRequestTest(t)
+
+	t.Run("organisers see every request", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().GetByCampaignID("campaign-123", "").Return([]models.ContributorRequest{{ID: 1}, {ID: 2}}, nil).Once()
+
+		requests, err := service.GetContributorRequests("campaign-123", "key", "creator", "creator@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, requests, 2)
+	})
+
+	t.Run("treasurer sees every request", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaign.Roles = []models.CampaignUserRole{{UserHandle: "treasurer", Role: models.CampaignRoleTreasurer}}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByCampaignID("campaign-123", "").Return([]models.ContributorRequest{{ID: 1}, {ID: 2}}, nil).Once()
+
+		requests, err := service.GetContributorRequests("campaign-123", "key", "treasurer", "treasurer@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, requests, 2)
+	})
+
+	t.Run("contributors see their own requests", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().GetByCampaignID("campaign-123", "member@example.com").Return([]models.ContributorRequest{{ID: 1}}, nil).Once()
+
+		requests, err := service.GetContributorRequests("campaign-123", "key", "member", "member@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, requests, 1)
+	})
+
+	t.Run("strangers cannot view requests", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.GetContributorRequests("campaign-123", "key", "stranger", "stranger@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestApproveContributorRequest(t *testing.T) {
+	service, repo, campaignService, contributorService, broadcaster := setupContributorRequestTest(t)
+
+	newRequest := func(campaign *models.Campaign, contributorID uint, leave bool) models.ContributorRequest {
+		contributor := campaign.GetContributorByID(contributorID)
+		if leave {
+			return *models.NewLeaveRequest(contributor, "")
+		}
+		return *models.NewAmountChangeRequest(contributor, 80, "")
+	}
+
+	t.Run("amount change is applied", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(10)).Return(newRequest(campaign, 2, false), nil).Once()
+		contributorService.EXPECT().UpdateContributor(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.ID == 2 && c.Amount == 80
+		})).Return(nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+			return r.Status == models.ContributorRequestStatusApproved && *r.ReviewedByHandle == "creator" && r.ReviewNote == "Done"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		request, err := service.ApproveContributorRequest(10, "campaign-123", "key", "creator", "Done", false)
+		assert.NoError(t, err)
+		assert.False(t, request.RefundIssued)
+	})
+
+	t.Run("contributor leaves", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(11)).Return(newRequest(campaign, 2, true), nil).Once()
+		contributorService.EXPECT().LeaveCampaign(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.ID == 2
+		}), false).Return(nil).Once()
+		repo.EXPECT().Update(mock.AnythingOfType("*models.ContributorRequest")).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		_, err := service.ApproveContributorRequest(11, "campaign-123", "key", "creator", "", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("contributor who paid needs a refund to leave", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(12)).Return(newRequest(campaign, 3, true), nil).Once()
+
+		_, err := service.ApproveContributorRequest(12, "campaign-123", "key", "creator", "", false)
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("contributor who paid leaves once refunded", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(12)).Return(newRequest(campaign, 3, true), nil).Once()
+		contributorService.EXPECT().LeaveCampaign(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.ID == 3
+		}), true).Return(nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+			return r.RefundIssued
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		_, err := service.ApproveContributorRequest(12, "campaign-123", "key", "creator", "", true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("only the treasurer confirms refunds", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaign.Roles = []models.CampaignUserRole{{UserHandle: "treasurer", Role: models.CampaignRoleTreasurer}}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(12)).Return(newRequest(campaign, 3, true), nil).Once()
+
+		_, err := service.ApproveContributorRequest(12, "campaign-123", "key", "creator", "", true)
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("treasurer approves a contributor who paid leaving", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaign.Roles = []models.CampaignUserRole{{UserHandle: "treasurer", Role: models.CampaignRoleTreasurer}}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(12)).Return(newRequest(campaign, 3, true), nil).Once()
+		contributorService.EXPECT().LeaveCampaign(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.ID == 3
+		}), true).Return(nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+			return r.RefundIssued && *r.ReviewedByHandle == "treasurer"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+		_, err := service.ApproveContributorRequest(12, "campaign-123", "key", "treasurer", "", true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("treasurer cannot approve other requests", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		campaign.Roles = []models.CampaignUserRole{{UserHandle: "treasurer", Role: models.CampaignRoleTreasurer}}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(10)).Return(newRequest(campaign, 2, false), nil).Once()
+
+		_, err := service.ApproveContributorRequest(10, "campaign-123", "key", "treasurer", "", false)
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("members cannot review requests", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+
+		_, err := service.ApproveContributorRequest(10, "campaign-123", "key", "member", "", false)
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("request not found", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newContributorRequestTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(99)).Return(models.ContributorRequest{}, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.ApproveContributorRequest(99, "campaign-123", "key", "creator", "", false)
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("reviewed request", func(t *testing.T) {
+		campaign := newContributorRequestTestCampaign()
+		request := newRequest(campaign, 2, false)
+		request.Reject("creator", "")
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(10)).Return(request, nil).Once()
+
+		_, err := service.ApproveContributorRequest(10, "campaign-123", "key", "creator", "", false)
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestRejectContributorRequest(t *testing.T) {
+	service, repo, campaignService, _, broadcaster := setupContributorRequestTest(t)
+
+	campaign := newContributorRequestTestCampaign()
+	campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+	repo.EXPECT().GetByID(uint(10)).Return(*models.NewLeaveRequest(campaign.GetContributorByID(2), ""), nil).Once()
+	repo.EXPECT().Update(mock.MatchedBy(func(r *models.ContributorRequest) bool {
+		return r.Status == models.ContributorRequestStatusRejected && r.ReviewNote == "We need you"
+	})).Return(nil).Once()
+	broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorRequestUpdated, mock.Anything).Once()
+
+	request, err := service.RejectContributorRequest(10, "campaign-123", "key", "creator", "We need you")
+	assert.NoError(t, err)
+	assert.NotNil(t, request.ReviewedAt)
+}
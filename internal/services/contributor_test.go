@@ -1,16 +1,19 @@
 package services
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
 	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
 	loggerMock "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func setupContributorTest(t *testing.T) (
@@ -45,8 +48,16 @@ func setupContributorTest(t *testing.T) (
 	return repo, campaignService, analyticsService, authService, notificationService, broadcaster, logger, service
 }
 
+// prepareMemberKey stands in for CampaignAccessService.PrepareMemberKey
+func prepareMemberKey(campaignID, campaignKey string, contributor *models.Contributor) (*models.CampaignAccessKey, error) {
+	contributor.AccessKey = "GM-member-key"
+	return &models.CampaignAccessKey{CampaignID: campaignID, Email: contributor.Email, Key: contributor.AccessKey}, nil
+}
+
 func TestAddContributorToCampaign(t *testing.T) {
 	repo, campaignService, _, authService, notificationService, broadcaster, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
 
 	testCases := []struct {
 		name        string
@@ -79,13 +90,103 @@ func TestAddContributorToCampaign(t *testing.T) {
 				campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(campaign, nil)
 				authService.EXPECT().FindUserByEmail("test@example.com").Return(nil, nil)
 				authService.EXPECT().CreateUser(mock.AnythingOfType("models.User")).Return(nil)
-				repo.EXPECT().Create(mock.AnythingOfType("*models.Contributor")).Return(nil)
-				broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributionCreated, mock.Anything)
-				notificationService.EXPECT().NotifyContributorAdded(mock.Anything, mock.Anything).Return(nil)
+				accessService.EXPECT().IssueInvitation(mock.AnythingOfType("*models.Contributor")).Run(func(c *models.Contributor) {
+					c.Invitation.Issue("secret")
+				}).Once()
+				accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).RunAndReturn(prepareMemberKey).Once()
+				repo.EXPECT().Create(mock.MatchedBy(func(c *models.Contributor) bool {
+					return c.Invitation.IsPending() && c.AccessKey == "GM-member-key"
+				}), mock.MatchedBy(func(k *models.CampaignAccessKey) bool {
+					return k.Email == "test@example.com"
+				})).Return(nil).Once()
+				broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributionCreated, mock.Anything).Once()
+				notificationService.EXPECT().NotifyContributorInvited(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectedError: false,
+		},
+		{
+			name: "Success - Accepted contributor joins straight away",
+			contributor: func() *models.Contributor {
+				contributor := &models.Contributor{Name: "Requester", Email: "requester@example.com"}
+				contributor.Invitation.Accept()
+				return contributor
+			}(),
+			campaignID:  "campaign-123",
+			campaignKey: "key-123",
+			userHandle:  "creator",
+			setupMocks: func() {
+				campaign := &models.Campaign{
+					ID:        "campaign-123",
+					EndDate:   time.Now().AddDate(0, 0, 30),
+					CreatedBy: models.User{Handle: "creator"},
+				}
+
+				campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(campaign, nil)
+				authService.EXPECT().FindUserByEmail("requester@example.com").Return(&models.User{Email: "requester@example.com"}, nil)
+				accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).RunAndReturn(prepareMemberKey).Once()
+				repo.EXPECT().Create(mock.AnythingOfType("*models.Contributor"), mock.MatchedBy(func(k *models.CampaignAccessKey) bool {
+					return k.Email == "requester@example.com"
+				})).Return(nil).Once()
+				broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributionCreated, mock.Anything).Once()
+				notificationService.EXPECT().NotifyContributorAdded(mock.Anything, mock.Anything).Return(nil).Once()
 				campaignService.EXPECT().RecalculateTargetAmount("campaign-123")
 			},
 			expectedError: false,
 		},
+		{
+			name:        "Success - Declined contributor is invited again",
+			contributor: &models.Contributor{Name: "Declined", Email: "declined@example.com"},
+			campaignID:  "campaign-123",
+			campaignKey: "key-123",
+			userHandle:  "creator",
+			setupMocks: func() {
+				declined := models.Contributor{ID: 7, Email: "declined@example.com"}
+				declined.Invitation.Decline()
+				campaign := &models.Campaign{
+					ID:           "campaign-123",
+					EndDate:      time.Now().AddDate(0, 0, 30),
+					CreatedBy:    models.User{Handle: "creator"},
+					Contributors: []models.Contributor{declined},
+				}
+
+				campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(campaign, nil)
+				repo.EXPECT().Delete(mock.MatchedBy(func(c *models.Contributor) bool { return c.ID == 7 })).Return(nil).Once()
+				authService.EXPECT().FindUserByEmail("declined@example.com").Return(&models.User{
+					Email:         "declined@example.com",
+					Contributions: []models.Contributor{declined},
+				}, nil)
+				accessService.EXPECT().IssueInvitation(mock.AnythingOfType("*models.Contributor")).Run(func(c *models.Contributor) {
+					c.Invitation.Issue("secret")
+				}).Once()
+				accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).RunAndReturn(prepareMemberKey).Once()
+				repo.EXPECT().Create(mock.AnythingOfType("*models.Contributor"), mock.MatchedBy(func(k *models.CampaignAccessKey) bool {
+					return k.Email == "declined@example.com"
+				})).Return(nil).Once()
+				broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributionCreated, mock.Anything).Once()
+				notificationService.EXPECT().NotifyContributorInvited(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectedError: false,
+		},
+		{
+			name:        "Failure - Pending invitation already exists",
+			contributor: &models.Contributor{Name: "Pending", Email: "pending@example.com"},
+			campaignID:  "campaign-123",
+			campaignKey: "key-123",
+			userHandle:  "creator",
+			setupMocks: func() {
+				pending := models.Contributor{ID: 8, Email: "pending@example.com"}
+				pending.Invitation.Issue("secret")
+				campaign := &models.Campaign{
+					ID:           "campaign-123",
+					EndDate:      time.Now().AddDate(0, 0, 30),
+					CreatedBy:    models.User{Handle: "creator"},
+					Contributors: []models.Contributor{pending},
+				}
+
+				campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(campaign, nil)
+			},
+			expectedError: true,
+		},
 		{
 			name:        "Failure - Campaign Not Found",
 			campaignKey: "key-123",
@@ -116,6 +217,144 @@ func TestAddContributorToCampaign(t *testing.T) {
 	}
 }
 
+func TestImportContributors(t *testing.T) {
+	repo, campaignService, _, authService, notificationService, broadcaster, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
+
+	kayakingCapacity, signUpClosedAt := 2, time.Now().Add(-time.Hour)
+	newCampaign := func() *models.Campaign {
+		declined := models.Contributor{ID: 7, CampaignID: "campaign-123", Email: "declined@example.com", Amount: 50}
+		declined.Invitation.Decline()
+		return &models.Campaign{
+			ID:        "campaign-123",
+			EndDate:   time.Now().AddDate(0, 0, 30),
+			CreatedBy: models.User{Handle: "creator"},
+			Contributors: []models.Contributor{
+				{ID: 6, CampaignID: "campaign-123", Email: "member@example.com", Amount: 50},
+				declined,
+			},
+			Activities: []models.Activity{
+				{ID: 1, Title: "Dinner", Cost: 20, IsApproved: true},
+				{ID: 2, Title: "Boat trip", Cost: 40},
+				{ID: 3, Title: "Kayaking", Cost: 30, IsApproved: true, Capacity: &kayakingCapacity, Contributors: []models.Contributor{{ID: 6, Email: "member@example.com"}}},
+				{ID: 4, Title: "Concert", Cost: 60, IsApproved: true, SignUpDeadline: &signUpClosedAt},
+			},
+		}
+	}
+
+	t.Run("contributors are created together and notified in one batch", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "Jane Doe", Email: "jane@example.com", Amount: 100, Activities: []models.Activity{{ID: 1}}},
+			{Name: "Declined", Email: "declined@example.com", Amount: 80},
+		}
+
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(newCampaign(), nil).Once()
+		authService.EXPECT().FindExistingAndNonExistingUsers([]string{"jane@example.com", "declined@example.com"}).
+			Return([]models.User{{Email: "declined@example.com"}}, []string{"jane@example.com"}, nil).Once()
+		authService.EXPECT().CreateUsers(mock.MatchedBy(func(users []models.User) bool {
+			return len(users) == 1 && users[0].Email == "jane@example.com"
+		})).Return(nil, nil).Once()
+		accessService.EXPECT().IssueInvitation(mock.AnythingOfType("*models.Contributor")).Run(func(c *models.Contributor) {
+			c.Invitation.Issue("secret")
+		}).Times(2)
+		accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).RunAndReturn(prepareMemberKey).Times(2)
+		// The keys are stored in the same transaction as the contributors
+		repo.EXPECT().CreateMultiple(mock.MatchedBy(func(contributors []models.Contributor) bool {
+			return len(contributors) == 2 && contributors[0].Activities[0].Title == "Dinner" && contributors[1].CampaignID == "campaign-123"
+		}), mock.MatchedBy(func(replaced []models.Contributor) bool {
+			return len(replaced) == 1 && replaced[0].ID == 7
+		}), mock.MatchedBy(func(accessKeys []models.CampaignAccessKey) bool {
+			return len(accessKeys) == 2 && accessKeys[0].Email == "jane@example.com" && accessKeys[1].Email == "declined@example.com"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributionCreated, mock.Anything).Times(2)
+		notificationService.EXPECT().NotifyContributorsImported(mock.MatchedBy(func(contributors []models.Contributor) bool {
+			return len(contributors) == 2 && contributors[0].Invitation.IsPending() && contributors[0].AccessKey == "GM-member-key"
+		}), mock.Anything).Return(nil).Once()
+
+		imported, err := service.ImportContributors(contributors, "campaign-123", "key-123", "creator")
+		assert.NoError(t, err)
+		assert.Len(t, imported, 2)
+	})
+
+	t.Run("every invalid row is reported and nothing is created", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "Jane Doe", Email: "jane@example.com", Amount: 100},
+			{Name: "Jo", Email: "not-an-email", Amount: 0},
+			{Name: "Jane Again", Email: "jane@example.com", Amount: 100, Activities: []models.Activity{{ID: 2}, {ID: 9}}},
+			{Name: "Member", Email: "member@example.com", Amount: 100},
+			{Name: "Busy", Email: "busy@example.com", Amount: 100},
+		}
+
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(newCampaign(), nil).Once()
+		authService.EXPECT().FindExistingAndNonExistingUsers(mock.Anything).Return([]models.User{
+			{Email: "busy@example.com", Contributions: []models.Contributor{{CampaignID: "another-campaign"}}},
+		}, nil, nil).Once()
+
+		_, err := service.ImportContributors(contributors, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+
+		rowErrors := err.(errs.Error).Errors().([]models.ContributorImportError)
+		assert.Len(t, rowErrors, 4)
+		assert.Equal(t, 2, rowErrors[0].Row)
+		assert.ElementsMatch(t, []string{"email must be a valid email address", "name must be at least 3 characters", "amount must be greater than 0"}, rowErrors[0].Errors)
+		assert.Equal(t, 3, rowErrors[1].Row)
+		assert.ElementsMatch(t, []string{"email is already on row 1", "activity 2 is not approved", "activity 9 is not part of this campaign"}, rowErrors[1].Errors)
+		assert.Equal(t, []string{"contributor already exists in this campaign"}, rowErrors[2].Errors)
+		assert.Equal(t, []string{"contributor is part of another campaign"}, rowErrors[3].Errors)
+	})
+
+	t.Run("rows can't take more seats than are left or join activities whose sign-up closed", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "Invalid", Email: "invalid@example.com", Amount: 0, Activities: []models.Activity{{ID: 3}}},
+			{Name: "Jane Doe", Email: "jane@example.com", Amount: 100, Activities: []models.Activity{{ID: 3}}},
+			{Name: "John Doe", Email: "john@example.com", Amount: 100, Activities: []models.Activity{{ID: 3}, {ID: 4}}},
+		}
+
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(newCampaign(), nil).Once()
+		authService.EXPECT().FindExistingAndNonExistingUsers(mock.Anything).Return(nil, nil, nil).Once()
+
+		_, err := service.ImportContributors(contributors, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+
+		// The invalid first row doesn't take the last seat, the second row does
+		rowErrors := err.(errs.Error).Errors().([]models.ContributorImportError)
+		assert.Len(t, rowErrors, 2)
+		assert.Equal(t, []string{"amount must be greater than 0"}, rowErrors[0].Errors)
+		assert.Equal(t, 3, rowErrors[1].Row)
+		assert.ElementsMatch(t, []string{"activity 3 is full", "sign-up for activity 4 has closed"}, rowErrors[1].Errors)
+	})
+
+	t.Run("nothing is imported when a key can't be created", func(t *testing.T) {
+		contributors := []models.Contributor{
+			{Name: "Jane Doe", Email: "jane@example.com", Amount: 100},
+			{Name: "John Doe", Email: "john@example.com", Amount: 100},
+		}
+
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(newCampaign(), nil).Once()
+		authService.EXPECT().FindExistingAndNonExistingUsers(mock.Anything).Return([]models.User{{Email: "jane@example.com"}, {Email: "john@example.com"}}, nil, nil).Once()
+		accessService.EXPECT().IssueInvitation(mock.AnythingOfType("*models.Contributor")).Times(2)
+		accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).RunAndReturn(prepareMemberKey).Once()
+		accessService.EXPECT().PrepareMemberKey("campaign-123", "key-123", mock.AnythingOfType("*models.Contributor")).Return(nil, errs.InternalServerError(assert.AnError)).Once()
+
+		// CreateMultiple isn't expected, nothing is stored
+		_, err := service.ImportContributors(contributors, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusInternalServerError)
+	})
+
+	t.Run("only organisers can import contributors", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(newCampaign(), nil).Once()
+
+		_, err := service.ImportContributors([]models.Contributor{{Email: "jane@example.com", Amount: 100}}, "campaign-123", "key-123", "someone-else")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("too many contributors", func(t *testing.T) {
+		_, err := service.ImportContributors(make([]models.Contributor, models.MaxContributorImportRows+1), "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
 func TestUpdateContributor(t *testing.T) {
 	repo, campaignService, _, _, _, broadcaster, mockLogger, service := setupContributorTest(t)
 
@@ -260,6 +499,8 @@ func TestGetContributorsByCampaignID(t *testing.T) {
 
 func TestRemoveContributorFromCampaign(t *testing.T) {
 	repo, campaignService, _, _, _, broadcaster, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
 
 	testCases := []struct {
 		name          string
@@ -277,7 +518,7 @@ func TestRemoveContributorFromCampaign(t *testing.T) {
 			userHandle:    "creator",
 			campaignKey:   "key-123",
 			setupMocks: func() {
-				contributor := &models.Contributor{ID: 1, CampaignID: "campaign-123"}
+				contributor := &models.Contributor{ID: 1, CampaignID: "campaign-123", Email: "test@example.com"}
 				campaign := &models.Campaign{
 					ID: "campaign-123",
 					CreatedBy: models.User{
@@ -288,6 +529,7 @@ func TestRemoveContributorFromCampaign(t *testing.T) {
 
 				campaignService.EXPECT().GetCampaignByID("campaign-123", "key-123").Return(campaign, nil)
 				repo.EXPECT().Delete(contributor).Return(nil)
+				accessService.EXPECT().RevokeMemberKeys("campaign-123", "test@example.com").Return(nil)
 				broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorDeleted, mock.Anything)
 				campaignService.EXPECT().RecalculateTargetAmount("campaign-123")
 			},
@@ -324,3 +566,160 @@ func TestRemoveContributorFromCampaign(t *testing.T) {
 		})
 	}
 }
+
+// newInvitedContributor returns a contributor with a pending invitation and the hash of its token
+func newInvitedContributor() (models.Contributor, string) {
+	contributor := models.Contributor{ID: 1, CampaignID: "campaign-123", Email: "invited@example.com"}
+	contributor.Invitation.Issue("secret")
+	return contributor, *contributor.Invitation.TokenHash
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	repo, campaignService, _, _, _, broadcaster, mockLogger, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
+
+	t.Run("contributor joins the campaign", func(t *testing.T) {
+		contributor, tokenHash := newInvitedContributor()
+		accessService.EXPECT().InvitationTokenHash("token").Return(tokenHash).Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash(tokenHash).Return(contributor, nil).Once()
+		repo.EXPECT().UpdateInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.Invitation.Status == models.InvitationStatusAccepted && c.Invitation.TokenHash == nil
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorUpdated, mock.Anything).Once()
+		campaignService.EXPECT().RecalculateTargetAmount("campaign-123").Once()
+
+		accepted, err := service.AcceptInvitation("token")
+		assert.NoError(t, err)
+		assert.True(t, accepted.Invitation.IsAccepted())
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		accessService.EXPECT().InvitationTokenHash("unknown").Return("unknown-hash").Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash("unknown-hash").Return(models.Contributor{}, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.AcceptInvitation("unknown")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("expired invitation is closed", func(t *testing.T) {
+		contributor, tokenHash := newInvitedContributor()
+		expiredAt := time.Now().Add(-time.Hour)
+		contributor.Invitation.ExpiresAt = &expiredAt
+
+		accessService.EXPECT().InvitationTokenHash("token").Return(tokenHash).Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash(tokenHash).Return(contributor, nil).Once()
+		repo.EXPECT().UpdateInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.Invitation.Status == models.InvitationStatusExpired
+		})).Return(nil).Once()
+		accessService.EXPECT().RevokeMemberKeys("campaign-123", "invited@example.com").Return(nil).Once()
+
+		_, err := service.AcceptInvitation("token")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		contributor, tokenHash := newInvitedContributor()
+		accessService.EXPECT().InvitationTokenHash("token").Return(tokenHash).Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash(tokenHash).Return(contributor, nil).Once()
+		repo.EXPECT().UpdateInvitation(mock.Anything).Return(assert.AnError).Once()
+		mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Once()
+
+		_, err := service.AcceptInvitation("token")
+		assertErrorCode(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestDeclineInvitation(t *testing.T) {
+	repo, campaignService, _, _, notificationService, broadcaster, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
+
+	t.Run("creator is notified", func(t *testing.T) {
+		contributor, tokenHash := newInvitedContributor()
+		campaign := &models.Campaign{ID: "campaign-123", CreatedBy: models.User{Email: "creator@example.com"}}
+
+		accessService.EXPECT().InvitationTokenHash("token").Return(tokenHash).Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash(tokenHash).Return(contributor, nil).Once()
+		repo.EXPECT().UpdateInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.Invitation.Status == models.InvitationStatusDeclined
+		})).Return(nil).Once()
+		accessService.EXPECT().RevokeMemberKeys("campaign-123", "invited@example.com").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorUpdated, mock.Anything).Once()
+		campaignService.EXPECT().GetCampaignByIDWithAllRelatedData("campaign-123").Return(campaign, nil).Once()
+		notificationService.EXPECT().NotifyInvitationDeclined(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.Email == "invited@example.com"
+		}), campaign).Return(nil).Once()
+
+		err := service.DeclineInvitation("token")
+		assert.NoError(t, err)
+	})
+
+	t.Run("answered invitation", func(t *testing.T) {
+		contributor, tokenHash := newInvitedContributor()
+		contributor.Invitation.Status = models.InvitationStatusAccepted
+
+		accessService.EXPECT().InvitationTokenHash("token").Return(tokenHash).Once()
+		repo.EXPECT().GetContributorByInvitationTokenHash(tokenHash).Return(contributor, nil).Once()
+
+		err := service.DeclineInvitation("token")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestSendInvitationReminders(t *testing.T) {
+	repo, _, _, _, notificationService, _, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
+
+	longAgo := time.Now().Add(-models.InvitationReminderInterval - time.Hour)
+	expiredAt := time.Now().Add(-time.Hour)
+
+	due, _ := newInvitedContributor()
+	due.Invitation.InvitedAt = &longAgo
+
+	recent, _ := newInvitedContributor()
+	recent.ID, recent.Email = 2, "recent@example.com"
+
+	expired, _ := newInvitedContributor()
+	expired.ID, expired.Email = 3, "expired@example.com"
+	expired.Invitation.ExpiresAt = &expiredAt
+
+	repo.EXPECT().GetPendingInvitations().Return([]models.Contributor{due, recent, expired}, nil).Once()
+
+	accessService.EXPECT().RestoreInvitationToken(mock.MatchedBy(func(c *models.Contributor) bool { return c.ID == 1 })).Run(func(c *models.Contributor) {
+		c.Invitation.Remind("secret")
+	}).Once()
+	repo.EXPECT().UpdateInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+		return c.ID == 1 && c.Invitation.RemindedAt != nil
+	})).Return(nil).Once()
+	notificationService.EXPECT().SendInvitationReminder(mock.MatchedBy(func(c *models.Contributor) bool { return c.ID == 1 })).Return(nil).Once()
+
+	repo.EXPECT().UpdateInvitation(mock.MatchedBy(func(c *models.Contributor) bool {
+		return c.ID == 3 && c.Invitation.Status == models.InvitationStatusExpired
+	})).Return(nil).Once()
+	accessService.EXPECT().RevokeMemberKeys("campaign-123", "expired@example.com").Return(nil).Once()
+
+	service.SendInvitationReminders()
+}
+
+func TestLeaveCampaign(t *testing.T) {
+	repo, campaignService, _, _, _, broadcaster, _, service := setupContributorTest(t)
+	accessService := mockService.NewMockCampaignAccessService(t)
+	service.accessService = accessService
+
+	contributor := &models.Contributor{ID: 3, CampaignID: "campaign-123", Email: "paid@example.com", Payment: &models.Payment{
+		PaymentStatus: models.PaymentStatusSucceeded,
+	}}
+
+	repo.EXPECT().Leave(mock.MatchedBy(func(c *models.Contributor) bool {
+		return c.HasLeft() && c.Payment.PaymentStatus == models.PaymentStatusRefunded
+	})).Return(nil).Once()
+	accessService.EXPECT().RevokeMemberKeys("campaign-123", "paid@example.com").Return(nil).Once()
+	broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeContributorUpdated, contributor).Once()
+	campaignService.EXPECT().RecalculateTargetAmount("campaign-123").Once()
+
+	err := service.LeaveCampaign(contributor, true)
+	assert.NoError(t, err)
+	assert.False(t, contributor.Invitation.IsOpen())
+}
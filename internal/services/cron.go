@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -13,19 +14,29 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
+// deadlineReminderLeadTime is how long before a rescheduled end date the deadline reminder is sent
+const deadlineReminderLeadTime = 24 * time.Hour
+
 type cronService struct {
 	campaignService     interfaces.CampaignService
+	contributorService  interfaces.ContributorService
 	notificationService interfaces.NotificationService
 	logger              logger.Logger
 	cron                *cron.Cron
+
+	// deadlines holds the one-off jobs of campaigns whose end date changed, keyed by campaign ID
+	deadlines   map[string][]*time.Timer
+	deadlinesMu sync.Mutex
 }
 
-func NewCronService(campaignService interfaces.CampaignService, notificationService interfaces.NotificationService, logger logger.Logger) interfaces.CronService {
+func NewCronService(campaignService interfaces.CampaignService, contributorService interfaces.ContributorService, notificationService interfaces.NotificationService, logger logger.Logger) interfaces.CronService {
 	return &cronService{
 		campaignService:     campaignService,
+		contributorService:  contributorService,
 		cron:                cron.New(cron.WithLocation(time.UTC)),
 		notificationService: notificationService,
 		logger:              logger,
+		deadlines:           make(map[string][]*time.Timer),
 	}
 }
 
@@ -52,6 +63,17 @@ func (n *cronService) StartCronJobs() error {
 		return fmt.Errorf("failed to schedule contribution reminders job: %w", err)
 	}
 
+	// Remind contributors of unanswered invitations and expire the ones past due every day
+	_, err = n.cron.AddFunc("0 12 * * *", func() {
+		monitorCronJob("invitation-reminders", func() {
+			n.contributorService.SendInvitationReminders()
+		})
+	})
+	n.logger.Info("Invitation reminders job scheduled - running at noon UTC daily", nil)
+	if err != nil {
+		return fmt.Errorf("failed to schedule invitation reminders job: %w", err)
+	}
+
 	// Check campaign deadlines every day
 	_, err = n.cron.AddFunc("0 0 * * *", func() {
 		monitorCronJob("campaign-deadline", func() {
@@ -69,14 +91,17 @@ func (n *cronService) StartCronJobs() error {
 
 // monitorCronJob wraps a cron job with Sentry monitoring
 func monitorCronJob(slug string, job func()) {
-	// Start the job
-	checkInId := sentry.CaptureCheckIn(
+	// Start the job, no check-in ID is returned when Sentry is not configured
+	var checkInId sentry.EventID
+	if id := sentry.CaptureCheckIn(
 		&sentry.CheckIn{
 			MonitorSlug: slug,
 			Status:      sentry.CheckInStatusInProgress,
 		},
 		nil,
-	)
+	); id != nil {
+		checkInId = *id
+	}
 
 	startTime := time.Now()
 
@@ -89,7 +114,7 @@ func monitorCronJob(slug string, job func()) {
 			// Mark job as failed
 			sentry.CaptureCheckIn(
 				&sentry.CheckIn{
-					ID:          *checkInId,
+					ID:          checkInId,
 					MonitorSlug: slug,
 					Status:      sentry.CheckInStatusError,
 					Duration:    time.Since(startTime),
@@ -105,7 +130,7 @@ func monitorCronJob(slug string, job func()) {
 	// Mark job as complete
 	sentry.CaptureCheckIn(
 		&sentry.CheckIn{
-			ID:          *checkInId,
+			ID:          checkInId,
 			MonitorSlug: slug,
 			Status:      sentry.CheckInStatusOK,
 			Duration:    time.Since(startTime),
@@ -118,6 +143,42 @@ func (n *cronService) StopCronJobs() {
 	if n.cron != nil {
 		n.cron.Stop()
 	}
+
+	n.deadlinesMu.Lock()
+	defer n.deadlinesMu.Unlock()
+	for campaignID, timers := range n.deadlines {
+		stopTimers(timers)
+		delete(n.deadlines, campaignID)
+	}
+}
+
+// RescheduleCampaignDeadline replaces the deadline reminder and cleanup of a campaign after its end date changed,
+// so they run at the new end date instead of waiting for the next daily run
+func (n *cronService) RescheduleCampaignDeadline(campaign *models.Campaign) {
+	campaignID, endDate := campaign.ID, campaign.EndDate
+
+	n.deadlinesMu.Lock()
+	defer n.deadlinesMu.Unlock()
+
+	stopTimers(n.deadlines[campaignID])
+	delete(n.deadlines, campaignID)
+
+	var timers []*time.Timer
+	if untilReminder := time.Until(endDate.Add(-deadlineReminderLeadTime)); untilReminder > 0 {
+		timers = append(timers, time.AfterFunc(untilReminder, func() {
+			monitorCronJob("campaign-deadline", func() {
+				n.remindCampaignDeadline(campaignID, endDate)
+			})
+		}))
+	}
+
+	timers = append(timers, time.AfterFunc(time.Until(endDate), func() {
+		monitorCronJob("cleanup-campaign", func() {
+			n.cleanUpCampaignAt(campaignID, endDate)
+		})
+	}))
+
+	n.deadlines[campaignID] = timers
 }
 
 // cleanUpExpiredCampaign checks if a campaign has ended and if it can be cleaned up
@@ -129,39 +190,47 @@ func (n *cronService) cleanUpExpiredCampaign() {
 	}
 
 	for _, campaign := range expiredCampaigns {
+		go n.cleanUpCampaign(&campaign)
+	}
 
-		go func(campaign *models.Campaign) {
-			if !campaign.HasEnded() {
-				return
-			}
-
-			if !campaign.HasEnded() {
-				return
-			}
+}
 
-			if !campaign.CanCleanUp() {
-				n.notificationService.NotifyCampaignPayoutRequired(campaign)
-				return
-			}
+// cleanUpCampaign exports and deletes an ended campaign, or asks for its payout first
+func (n *cronService) cleanUpCampaign(campaign *models.Campaign) {
+	if !campaign.HasEnded() {
+		return
+	}
 
-			campaignFullData, err := n.campaignService.GetCampaignByIDWithAllRelatedData(campaign.ID)
-			if err != nil {
-				return
-			}
-			filePath, err := createJSONExport(*campaignFullData)
-			if err != nil {
-				return
-			}
-			defer os.Remove(filePath)
-			err = n.notificationService.NotifyCampaignCleanUp(campaign, filePath)
-			if err != nil {
-				return
-			}
-			n.campaignService.DeleteCampaign(campaign.ID)
+	if !campaign.CanCleanUp() {
+		n.notificationService.NotifyCampaignPayoutRequired(campaign)
+		return
+	}
 
-		}(&campaign)
+	campaignFullData, err := n.campaignService.GetCampaignByIDWithAllRelatedData(campaign.ID)
+	if err != nil {
+		return
+	}
+	filePath, err := createJSONExport(*campaignFullData)
+	if err != nil {
+		return
+	}
+	defer os.Remove(filePath)
+	err = n.notificationService.NotifyCampaignCleanUp(campaign, filePath)
+	if err != nil {
+		return
 	}
+	n.campaignService.DeleteCampaign(campaign.ID)
+}
+
+// cleanUpCampaignAt cleans up a rescheduled campaign if its end date has not changed since
+func (n *cronService) cleanUpCampaignAt(campaignID string, endDate time.Time) {
+	n.forgetDeadline(campaignID)
 
+	campaign, err := n.campaignService.GetCampaignByIDWithAllRelatedData(campaignID)
+	if err != nil || !campaign.EndDate.Equal(endDate) {
+		return
+	}
+	n.cleanUpCampaign(campaign)
 }
 
 // checkContributionReminders checks if a campaign has contributions that are due for reminders
@@ -171,7 +240,7 @@ func (n *cronService) checkContributionReminders() {
 		return
 	}
 	for _, campaign := range campaigns {
-		for _, contributor := range campaign.Contributors {
+		for _, contributor := range campaign.AcceptedContributors() {
 			if !contributor.HasPaid() {
 				go n.notificationService.SendContributionReminder(&contributor, &campaign)
 			}
@@ -191,8 +260,30 @@ func (n *cronService) checkCampaignDeadline() {
 	}
 }
 
+// remindCampaignDeadline sends the deadline reminder of a rescheduled campaign if its end date has not changed since
+func (n *cronService) remindCampaignDeadline(campaignID string, endDate time.Time) {
+	campaign, err := n.campaignService.GetCampaignByIDWithAllRelatedData(campaignID)
+	if err != nil || !campaign.EndDate.Equal(endDate) {
+		return
+	}
+	n.notificationService.SendDeadlineReminder(campaign)
+}
+
+// forgetDeadline drops the finished jobs of a campaign
+func (n *cronService) forgetDeadline(campaignID string) {
+	n.deadlinesMu.Lock()
+	defer n.deadlinesMu.Unlock()
+	delete(n.deadlines, campaignID)
+}
+
 // Helper Functions ----------------------------------------------
 
+func stopTimers(timers []*time.Timer) {
+	for _, timer := range timers {
+		timer.Stop()
+	}
+}
+
 func createJSONExport(data models.Campaign) (string, error) {
 	fileName := fmt.Sprintf("campaign_export_%s_%s.json",
 		data.ID,
@@ -16,7 +16,8 @@ func TestCronService(t *testing.T) {
 	mockNotificationService := interfaces.NewMockNotificationService(t)
 	mockLogger := logger.NewMockLogger(t)
 
-	cronService := NewCronService(mockCampaignService, mockNotificationService, mockLogger)
+	cronService := NewCronService(mockCampaignService, interfaces.NewMockContributorService(t), mockNotificationService, mockLogger)
+	mockLogger.EXPECT().Info(mock.Anything, mock.Anything).Return()
 
 	t.Run("StartCronJobs", func(t *testing.T) {
 		err := cronService.StartCronJobs()
@@ -116,3 +117,56 @@ func TestCreateJSONExport(t *testing.T) {
 	assert.Contains(t, filePath, "campaign_export_test-id")
 	assert.Contains(t, filePath, ".json")
 }
+
+func TestRescheduleCampaignDeadline(t *testing.T) {
+	t.Run("cleans up at the new end date", func(t *testing.T) {
+		mockCampaignService := interfaces.NewMockCampaignService(t)
+		mockNotificationService := interfaces.NewMockNotificationService(t)
+		mockLogger := logger.NewMockLogger(t)
+
+		campaign := models.Campaign{
+			ID:      "test-id",
+			EndDate: time.Now().Add(50 * time.Millisecond),
+		}
+
+		cleanedUp := make(chan struct{})
+		mockCampaignService.EXPECT().GetCampaignByIDWithAllRelatedData(campaign.ID).Return(&campaign, nil)
+		mockNotificationService.EXPECT().NotifyCampaignCleanUp(mock.Anything, mock.Anything).Return(nil)
+		mockCampaignService.EXPECT().DeleteCampaign(campaign.ID).RunAndReturn(func(string) error {
+			close(cleanedUp)
+			return nil
+		})
+
+		cronService := NewCronService(mockCampaignService, interfaces.NewMockContributorService(t), mockNotificationService, mockLogger)
+		defer cronService.StopCronJobs()
+		cronService.RescheduleCampaignDeadline(&campaign)
+
+		select {
+		case <-cleanedUp:
+		case <-time.After(time.Second):
+			t.Fatal("campaign was not cleaned up at its new end date")
+		}
+	})
+
+	t.Run("replaces the previous schedule", func(t *testing.T) {
+		mockCampaignService := interfaces.NewMockCampaignService(t)
+		mockNotificationService := interfaces.NewMockNotificationService(t)
+		mockLogger := logger.NewMockLogger(t)
+
+		campaign := models.Campaign{
+			ID:      "test-id",
+			EndDate: time.Now().Add(20 * time.Millisecond),
+		}
+
+		service := NewCronService(mockCampaignService, interfaces.NewMockContributorService(t), mockNotificationService, mockLogger)
+		defer service.StopCronJobs()
+		service.RescheduleCampaignDeadline(&campaign)
+
+		campaign.EndDate = time.Now().Add(72 * time.Hour)
+		service.RescheduleCampaignDeadline(&campaign)
+
+		// No calls are expected on the mocks once the first schedule is replaced
+		time.Sleep(100 * time.Millisecond)
+		assert.Len(t, service.(*cronService).deadlines[campaign.ID], 2)
+	})
+}
@@ -0,0 +1,337 @@
+package services
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+// ExpenseReceiptLinkExpiry is how long a signed link to an expense receipt works
+const ExpenseReceiptLinkExpiry = 15 * time.Minute
+
+type expenseService struct {
+	repo            repositories.ExpenseRepository
+	campaignService services.CampaignService
+	storage         storage.Storage
+	broadcaster     services.EventBroadcaster
+	logger          logger.Logger
+	runAsync        func(func())
+}
+
+func NewExpenseService(
+	repo repositories.ExpenseRepository,
+	campaignService services.CampaignService,
+	storage storage.Storage,
+	broadcaster services.EventBroadcaster,
+	logger logger.Logger,
+) services.ExpenseService {
+	return &expenseService{
+		repo:            repo,
+		campaignService: campaignService,
+		storage:         storage,
+		broadcaster:     broadcaster,
+		logger:          logger,
+		runAsync:        func(f func()) { go f() },
+	}
+}
+
+// RecordExpense logs money spent on an approved activity, the receipt is an optional file path uploaded to storage as a private document.
+// Campaign organisers and the treasurer can record expenses until the activity is reconciled
+func (s *expenseService) RecordExpense(activityID uint, campaignID, key, userHandle, userEmail string, amount float64, paidByEmail, note, receipt string) (*models.ActivityExpense, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManageExpenses(userHandle, campaign) {
+		return nil, errs.Forbidden("Only campaign organisers and the treasurer can record expenses")
+	}
+
+	if paidByEmail == "" {
+		paidByEmail = userEmail
+	}
+	if !campaign.EmailIsPartOfCampaign(paidByEmail) {
+		return nil, errs.BadRequest("Expenses must be paid by a campaign member", paidByEmail)
+	}
+
+	report, err := s.getBudgetReport(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.getApprovedActivity(report, activityID)
+	if err != nil {
+		return nil, err
+	}
+	if activity.Reconciliation != nil {
+		return nil, errs.BadRequest("Cannot record expenses: Activity has been reconciled", nil)
+	}
+
+	expense := models.NewActivityExpense(&models.Activity{ID: activityID, CampaignID: campaignID}, amount, paidByEmail, note, userHandle)
+
+	if receipt != "" {
+		id, err := s.storage.UploadPrivateFile(receipt, "activity/receipts")
+		if err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+		expense.ReceiptID = id
+	}
+
+	if err := s.repo.Create(expense); err != nil {
+		s.deleteReceipt(expense)
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	activity.Expenses = append(activity.Expenses, *expense)
+	activity.Spent += expense.Amount
+	activity.Variance -= expense.Amount
+	s.broadcastBudget(campaignID, *activity)
+
+	return expense, nil
+}
+
+// DeleteExpense removes an expense recorded by mistake along with its receipt
+func (s *expenseService) DeleteExpense(expenseID, activityID uint, campaignID, key, userHandle string) error {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return err
+	}
+
+	if !canManageExpenses(userHandle, campaign) {
+		return errs.Forbidden("Only campaign organisers and the treasurer can delete expenses")
+	}
+
+	expense, err := s.repo.GetByID(expenseID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return errs.NotFound("Expense not found")
+		}
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	if expense.CampaignID != campaignID || expense.ActivityID != activityID {
+		return errs.NotFound("Expense not found")
+	}
+
+	report, err := s.getBudgetReport(campaignID)
+	if err != nil {
+		return err
+	}
+	activity := report.GetActivityReport(activityID)
+	if activity != nil && activity.Reconciliation != nil {
+		return errs.BadRequest("Cannot delete expenses: Activity has been reconciled", nil)
+	}
+
+	if err := s.repo.Delete(&expense); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	s.deleteReceipt(&expense)
+
+	if activity != nil {
+		for i, recorded := range activity.Expenses {
+			if recorded.ID == expense.ID {
+				activity.Expenses = append(activity.Expenses[:i], activity.Expenses[i+1:]...)
+				break
+			}
+		}
+		activity.Spent -= expense.Amount
+		activity.Variance += expense.Amount
+		s.broadcastBudget(campaignID, *activity)
+	}
+
+	return nil
+}
+
+// GetExpenseReceipt returns a signed link to the receipt of an expense, only campaign members can see it
+func (s *expenseService) GetExpenseReceipt(expenseID, activityID uint, campaignID, key, userEmail string) (*models.DocumentLink, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can view receipts")
+	}
+
+	expense, err := s.repo.GetByID(expenseID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Expense not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if expense.CampaignID != campaignID || expense.ActivityID != activityID {
+		return nil, errs.NotFound("Expense not found")
+	}
+	if expense.ReceiptID == "" {
+		return nil, errs.NotFound("Expense has no receipt")
+	}
+
+	// Receipts uploaded before they were private keep their public link
+	if !storage.IsPrivate(expense.ReceiptID) {
+		return &models.DocumentLink{URL: expense.ReceiptURL}, nil
+	}
+
+	url, err := s.storage.SignedURL(expense.ReceiptID, ExpenseReceiptLinkExpiry)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	expiresAt := time.Now().Add(ExpenseReceiptLinkExpiry)
+
+	return &models.DocumentLink{URL: url, ExpiresAt: &expiresAt}, nil
+}
+
+// GetActivityBudget compares the budget of an activity with what was spent on it
+func (s *expenseService) GetActivityBudget(activityID uint, campaignID, key, userEmail string) (*models.ActivityBudgetReport, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can view budgets")
+	}
+
+	report, err := s.getBudgetReport(campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getApprovedActivity(report, activityID)
+}
+
+// GetCampaignBudget compares the budget of every approved activity of a campaign with what was spent
+func (s *expenseService) GetCampaignBudget(campaignID, key, userEmail string) (*models.CampaignBudgetReport, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can view budgets")
+	}
+
+	return s.getBudgetReport(campaignID)
+}
+
+// ReconcileActivity settles the over or under spend of an activity once the campaign has ended or been paid out.
+// Leftovers can be refunded to the participants or rolled into another activity, overspend can be shared out or absorbed
+func (s *expenseService) ReconcileActivity(activityID uint, campaignID, key, userHandle string, resolution models.ReconciliationResolution, targetActivityID *uint, note string) (*models.ActivityReconciliation, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionManagePayout) {
+		return nil, errs.Forbidden("Only the campaign treasurer can reconcile activities")
+	}
+	if !campaign.HasEnded() && campaign.Payout == nil {
+		return nil, errs.BadRequest("Activities can only be reconciled once the campaign has ended or been paid out", nil)
+	}
+
+	activities, err := s.repo.GetActivitiesByCampaignID(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	report := models.NewCampaignBudgetReport(campaignID, activities)
+
+	activityReport, err := s.getApprovedActivity(report, activityID)
+	if err != nil {
+		return nil, err
+	}
+	if activityReport.Reconciliation != nil {
+		return nil, errs.BadRequest("Activity has already been reconciled", nil)
+	}
+
+	switch resolution {
+	case models.ReconciliationResolutionRefund:
+		if activityReport.Variance == 0 {
+			return nil, errs.BadRequest("Nothing to rebalance: Activity is on budget", nil)
+		}
+		if activityReport.Budget <= 0 {
+			return nil, errs.BadRequest("Cannot rebalance: Activity has no participants to rebalance between", nil)
+		}
+		targetActivityID = nil
+	case models.ReconciliationResolutionRollover:
+		if activityReport.Variance <= 0 {
+			return nil, errs.BadRequest("Only leftover budget can be rolled into another activity", nil)
+		}
+		if targetActivityID == nil || *targetActivityID == activityID {
+			return nil, errs.BadRequest("Choose another activity to roll the leftover budget into", nil)
+		}
+		target := report.GetActivityReport(*targetActivityID)
+		if target == nil {
+			return nil, errs.BadRequest("Leftover budget can only be rolled into an approved activity of the campaign", targetActivityID)
+		}
+		if target.Reconciliation != nil {
+			return nil, errs.BadRequest("Cannot roll leftover budget into an activity that has been reconciled", targetActivityID)
+		}
+	case models.ReconciliationResolutionAbsorb:
+		targetActivityID = nil
+	default:
+		return nil, errs.BadRequest("Invalid resolution", resolution)
+	}
+
+	var activity *models.Activity
+	for i := range activities {
+		if activities[i].ID == activityID {
+			activity = &activities[i]
+			break
+		}
+	}
+
+	reconciliation := activity.Reconcile(*activityReport, resolution, targetActivityID, note, userHandle)
+	if err := s.repo.CreateReconciliation(reconciliation); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	activityReport.Reconciliation = reconciliation
+	s.broadcastBudget(campaignID, *activityReport)
+	if targetActivityID != nil {
+		target := report.GetActivityReport(*targetActivityID)
+		target.RolledIn += reconciliation.Variance
+		target.Variance += reconciliation.Variance
+		s.broadcastBudget(campaignID, *target)
+	}
+
+	return reconciliation, nil
+}
+
+// Helper functions
+
+// canManageExpenses checks if the user organises the activities or manages the money of the campaign
+func canManageExpenses(userHandle string, campaign *models.Campaign) bool {
+	return can(userHandle, campaign, models.CampaignActionManageActivities) ||
+		can(userHandle, campaign, models.CampaignActionManagePayout)
+}
+
+func (s *expenseService) getBudgetReport(campaignID string) (*models.CampaignBudgetReport, error) {
+	activities, err := s.repo.GetActivitiesByCampaignID(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return models.NewCampaignBudgetReport(campaignID, activities), nil
+}
+
+// getApprovedActivity returns the report of an activity, budgets are only tracked for approved activities
+func (s *expenseService) getApprovedActivity(report *models.CampaignBudgetReport, activityID uint) (*models.ActivityBudgetReport, error) {
+	activity := report.GetActivityReport(activityID)
+	if activity == nil {
+		return nil, errs.NotFound("Activity not found or not approved")
+	}
+	return activity, nil
+}
+
+func (s *expenseService) deleteReceipt(expense *models.ActivityExpense) {
+	deleteStoredFiles(s.storage, s.logger, s.runAsync, []string{expense.ReceiptID})
+}
+
+func (s *expenseService) broadcastBudget(campaignID string, report models.ActivityBudgetReport) {
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityBudgetUpdated, report)
+	})
+}
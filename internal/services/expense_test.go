@@ -0,0 +1,364 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	mockStorage "github.com/oyen-bright/goFundIt/pkg/storage/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func setupExpenseTest(t *testing.T) (
+	*expenseService,
+	*mockRepo.MockExpenseRepository,
+	*mockService.MockCampaignService,
+	*mockStorage.MockStorage,
+	*mockService.MockEventBroadcaster,
+) {
+	repo := mockRepo.NewMockExpenseRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	storage := mockStorage.NewMockStorage(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &expenseService{
+		repo:            repo,
+		campaignService: campaignService,
+		storage:         storage,
+		broadcaster:     broadcaster,
+		logger:          mockLogger.NewMockLogger(t),
+		runAsync:        func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, storage, broadcaster
+}
+
+func newExpenseTestCampaign(ended bool) *models.Campaign {
+	endDate := time.Now().Add(24 * time.Hour)
+	if ended {
+		endDate = time.Now().Add(-time.Hour)
+	}
+	return &models.Campaign{
+		ID:        "campaign-123",
+		EndDate:   endDate,
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "creator@example.com"},
+			{ID: 2, CampaignID: "campaign-123", Email: "member@example.com"},
+		},
+		Roles: []models.CampaignUserRole{
+			{CampaignID: "campaign-123", UserHandle: "treasurer", Email: "treasurer@example.com", Role: models.CampaignRoleTreasurer},
+		},
+	}
+}
+
+// newExpenseTestActivities returns an activity with a budget of 100 and 60 spent, and a second activity to roll leftovers into
+func newExpenseTestActivities() []models.Activity {
+	contributors := []models.Contributor{{ID: 1}, {ID: 2}}
+	return []models.Activity{
+		{
+			ID: 1, CampaignID: "campaign-123", Title: "Boat tour", Cost: 100, SplitStrategy: models.SplitStrategyEqual, IsApproved: true,
+			Contributors: contributors,
+			Expenses:     []models.ActivityExpense{{ID: 5, ActivityID: 1, CampaignID: "campaign-123", Amount: 60}},
+		},
+		{ID: 2, CampaignID: "campaign-123", Title: "Dinner", Cost: 50, SplitStrategy: models.SplitStrategyEqual, IsApproved: true, Contributors: contributors},
+		{ID: 3, CampaignID: "campaign-123", Title: "Museum", Cost: 20},
+	}
+}
+
+func TestRecordExpense(t *testing.T) {
+	service, repo, campaignService, storage, broadcaster := setupExpenseTest(t)
+
+	t.Run("success with receipt", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		storage.EXPECT().UploadPrivateFile("/tmp/receipt.jpg", "activity/receipts").Return("private/activity/receipts/receipt-1.jpg", nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(e *models.ActivityExpense) bool {
+			return e.ActivityID == 1 && e.Amount == 25 && e.PaidByEmail == "member@example.com" && e.ReceiptID == "private/activity/receipts/receipt-1.jpg"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.MatchedBy(func(r models.ActivityBudgetReport) bool {
+			return r.Spent == 85 && r.Variance == 15 && len(r.Expenses) == 2
+		})).Once()
+
+		expense, err := service.RecordExpense(1, "campaign-123", "key", "creator", "creator@example.com", 25, "member@example.com", "Fuel", "/tmp/receipt.jpg")
+		assert.NoError(t, err)
+		assert.Empty(t, expense.ReceiptURL)
+		assert.Equal(t, "creator", expense.RecordedByHandle)
+	})
+
+	t.Run("treasurer records expense paid by themselves", func(t *testing.T) {
+		campaign := newExpenseTestCampaign(false)
+		campaign.Contributors = append(campaign.Contributors, models.Contributor{ID: 3, Email: "treasurer@example.com"})
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(e *models.ActivityExpense) bool {
+			return e.PaidByEmail == "treasurer@example.com" && e.ReceiptID == ""
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.Anything).Once()
+
+		_, err := service.RecordExpense(1, "campaign-123", "key", "treasurer", "treasurer@example.com", 10, "", "", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("members cannot record expenses", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		_, err := service.RecordExpense(1, "campaign-123", "key", "member", "member@example.com", 10, "", "", "")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("payer must be part of the campaign", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		_, err := service.RecordExpense(1, "campaign-123", "key", "creator", "creator@example.com", 10, "stranger@example.com", "", "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("activity not approved", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+
+		_, err := service.RecordExpense(3, "campaign-123", "key", "creator", "creator@example.com", 10, "", "", "")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("activity reconciled", func(t *testing.T) {
+		activities := newExpenseTestActivities()
+		activities[0].Reconciliation = &models.ActivityReconciliation{ActivityID: 1, Resolution: models.ReconciliationResolutionAbsorb}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(activities, nil).Once()
+
+		_, err := service.RecordExpense(1, "campaign-123", "key", "creator", "creator@example.com", 10, "", "", "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("receipt removed when expense cannot be saved", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		storage.EXPECT().UploadPrivateFile("/tmp/receipt.jpg", "activity/receipts").Return("private/activity/receipts/receipt-1.jpg", nil).Once()
+		repo.EXPECT().Create(mock.Anything).Return(errors.New("db error")).Once()
+		storage.EXPECT().DeleteFile("private/activity/receipts/receipt-1.jpg").Return(nil).Once()
+		service.logger.(*mockLogger.MockLogger).EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		_, err := service.RecordExpense(1, "campaign-123", "key", "creator", "creator@example.com", 10, "", "", "/tmp/receipt.jpg")
+		assertErrorCode(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestDeleteExpense(t *testing.T) {
+	service, repo, campaignService, storage, broadcaster := setupExpenseTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		expense := models.ActivityExpense{ID: 5, ActivityID: 1, CampaignID: "campaign-123", Amount: 60, ReceiptID: "receipt-1"}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(expense, nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		repo.EXPECT().Delete(&expense).Return(nil).Once()
+		storage.EXPECT().DeleteFile("receipt-1").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.MatchedBy(func(r models.ActivityBudgetReport) bool {
+			return r.Spent == 0 && r.Variance == 100 && len(r.Expenses) == 0
+		})).Once()
+
+		assert.NoError(t, service.DeleteExpense(5, 1, "campaign-123", "key", "creator"))
+	})
+
+	t.Run("expense of another activity", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{ID: 5, ActivityID: 2, CampaignID: "campaign-123"}, nil).Once()
+
+		assertErrorCode(t, service.DeleteExpense(5, 1, "campaign-123", "key", "creator"), http.StatusNotFound)
+	})
+
+	t.Run("expense not found", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{}, gorm.ErrRecordNotFound).Once()
+
+		assertErrorCode(t, service.DeleteExpense(5, 1, "campaign-123", "key", "creator"), http.StatusNotFound)
+	})
+
+	t.Run("members cannot delete expenses", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		assertErrorCode(t, service.DeleteExpense(5, 1, "campaign-123", "key", "member"), http.StatusForbidden)
+	})
+}
+
+func TestGetExpenseReceipt(t *testing.T) {
+	service, repo, campaignService, storage, _ := setupExpenseTest(t)
+
+	t.Run("members get a signed link", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{ID: 5, ActivityID: 1, CampaignID: "campaign-123", ReceiptID: "private/activity/receipts/receipt-1.jpg"}, nil).Once()
+		storage.EXPECT().SignedURL("private/activity/receipts/receipt-1.jpg", ExpenseReceiptLinkExpiry).Return("https://files/receipt-1.jpg?signature=abc", nil).Once()
+
+		link, err := service.GetExpenseReceipt(5, 1, "campaign-123", "key", "member@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://files/receipt-1.jpg?signature=abc", link.URL)
+		assert.NotNil(t, link.ExpiresAt)
+	})
+
+	t.Run("receipts uploaded before they were private keep their link", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{ID: 5, ActivityID: 1, CampaignID: "campaign-123", ReceiptID: "receipt-1", ReceiptURL: "https://cdn/receipt.jpg"}, nil).Once()
+
+		link, err := service.GetExpenseReceipt(5, 1, "campaign-123", "key", "member@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn/receipt.jpg", link.URL)
+		assert.Nil(t, link.ExpiresAt)
+	})
+
+	t.Run("expense without a receipt", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{ID: 5, ActivityID: 1, CampaignID: "campaign-123"}, nil).Once()
+
+		_, err := service.GetExpenseReceipt(5, 1, "campaign-123", "key", "member@example.com")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("expense of another activity", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetByID(uint(5)).Return(models.ActivityExpense{ID: 5, ActivityID: 2, CampaignID: "campaign-123", ReceiptID: "receipt-1"}, nil).Once()
+
+		_, err := service.GetExpenseReceipt(5, 1, "campaign-123", "key", "member@example.com")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("only campaign members can view receipts", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		_, err := service.GetExpenseReceipt(5, 1, "campaign-123", "key", "stranger@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestGetBudget(t *testing.T) {
+	service, repo, campaignService, _, _ := setupExpenseTest(t)
+
+	t.Run("campaign report", func(t *testing.T) {
+		activities := newExpenseTestActivities()
+		target := uint(2)
+		activities[0].Reconciliation = &models.ActivityReconciliation{ActivityID: 1, Variance: 40, Resolution: models.ReconciliationResolutionRollover, TargetActivityID: &target}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(activities, nil).Once()
+
+		report, err := service.GetCampaignBudget("campaign-123", "key", "member@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, report.Activities, 2)
+		assert.Equal(t, 150.0, report.Budget)
+		assert.Equal(t, 60.0, report.Spent)
+		assert.Equal(t, 90.0, report.Variance)
+		assert.Equal(t, 40.0, report.Activities[1].RolledIn)
+		assert.Equal(t, 90.0, report.Activities[1].Variance)
+	})
+
+	t.Run("activity report", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+
+		report, err := service.GetActivityBudget(1, "campaign-123", "key", "member@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, 100.0, report.Budget)
+		assert.Equal(t, 40.0, report.Variance)
+	})
+
+	t.Run("only members can view budgets", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		_, err := service.GetCampaignBudget("campaign-123", "key", "stranger@example.com")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
+
+func TestReconcileActivity(t *testing.T) {
+	service, repo, campaignService, _, broadcaster := setupExpenseTest(t)
+
+	t.Run("refund leftovers to participants", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(true), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		repo.EXPECT().CreateReconciliation(mock.AnythingOfType("*models.ActivityReconciliation")).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.Anything).Once()
+
+		reconciliation, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionRefund, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 40.0, reconciliation.Variance)
+		if assert.Len(t, reconciliation.Allocations, 2) {
+			assert.Equal(t, 20.0, reconciliation.Allocations[0].Amount)
+			assert.Equal(t, 20.0, reconciliation.Allocations[1].Amount)
+		}
+	})
+
+	t.Run("overspend is shared out", func(t *testing.T) {
+		activities := newExpenseTestActivities()
+		activities[0].Expenses = append(activities[0].Expenses, models.ActivityExpense{ID: 6, Amount: 70})
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(true), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(activities, nil).Once()
+		repo.EXPECT().CreateReconciliation(mock.AnythingOfType("*models.ActivityReconciliation")).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.Anything).Once()
+
+		reconciliation, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionRefund, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, -30.0, reconciliation.Variance)
+		assert.Equal(t, -15.0, reconciliation.Allocations[0].Amount)
+	})
+
+	t.Run("roll leftovers into another activity", func(t *testing.T) {
+		target := uint(2)
+		campaign := newExpenseTestCampaign(false)
+		campaign.Payout = &models.Payout{}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+		repo.EXPECT().CreateReconciliation(mock.MatchedBy(func(r *models.ActivityReconciliation) bool {
+			return *r.TargetActivityID == 2 && len(r.Allocations) == 0
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.MatchedBy(func(r models.ActivityBudgetReport) bool {
+			return r.ActivityID == 1
+		})).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityBudgetUpdated, mock.MatchedBy(func(r models.ActivityBudgetReport) bool {
+			return r.ActivityID == 2 && r.RolledIn == 40
+		})).Once()
+
+		_, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionRollover, &target, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("rollover target must be approved", func(t *testing.T) {
+		target := uint(3)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(true), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(newExpenseTestActivities(), nil).Once()
+
+		_, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionRollover, &target, "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("campaign still running", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(false), nil).Once()
+
+		_, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionAbsorb, nil, "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("only the treasurer can reconcile", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(true), nil).Once()
+
+		_, err := service.ReconcileActivity(1, "campaign-123", "key", "creator", models.ReconciliationResolutionAbsorb, nil, "")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("already reconciled", func(t *testing.T) {
+		activities := newExpenseTestActivities()
+		activities[0].Reconciliation = &models.ActivityReconciliation{ActivityID: 1, Resolution: models.ReconciliationResolutionAbsorb}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newExpenseTestCampaign(true), nil).Once()
+		repo.EXPECT().GetActivitiesByCampaignID("campaign-123").Return(activities, nil).Once()
+
+		_, err := service.ReconcileActivity(1, "campaign-123", "key", "treasurer", models.ReconciliationResolutionAbsorb, nil, "")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
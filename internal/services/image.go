@@ -0,0 +1,238 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/imaging"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+type imageService struct {
+	campaignRepo    repositories.CampaignRepository
+	activityRepo    repositories.ActivityRepository
+	campaignService services.CampaignService
+	storage         storage.Storage
+	broadcaster     services.EventBroadcaster
+	logger          logger.Logger
+	options         imaging.Options
+	runAsync        func(func())
+}
+
+func NewImageService(
+	campaignRepo repositories.CampaignRepository,
+	activityRepo repositories.ActivityRepository,
+	campaignService services.CampaignService,
+	storage storage.Storage,
+	broadcaster services.EventBroadcaster,
+	logger logger.Logger,
+) services.ImageService {
+	return &imageService{
+		campaignRepo:    campaignRepo,
+		activityRepo:    activityRepo,
+		campaignService: campaignService,
+		storage:         storage,
+		broadcaster:     broadcaster,
+		logger:          logger,
+		options:         imaging.DefaultOptions,
+		runAsync:        func(f func()) { go f() },
+	}
+}
+
+// uploadedImage is an image and its thumbnail stored through the Storage interface
+type uploadedImage struct {
+	url, id, thumbnailURL, thumbnailID string
+}
+
+// UploadCampaignImage validates an uploaded image file, strips its metadata and stores it with a thumbnail.
+// Only campaign organisers can add images
+func (s *imageService) UploadCampaignImage(campaignID, key, userHandle, file string) (*models.CampaignImage, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.Forbidden("Only campaign organisers can add campaign images")
+	}
+	if len(campaign.Images) >= models.MaxCampaignImages {
+		return nil, errs.BadRequest(fmt.Sprintf("A campaign can have at most %d images", models.MaxCampaignImages), nil)
+	}
+
+	uploaded, err := s.upload(file, "campaign/images")
+	if err != nil {
+		return nil, err
+	}
+
+	image := models.NewUploadedImage(campaignID, uploaded.url, uploaded.id, uploaded.thumbnailURL, uploaded.thumbnailID)
+	if err := s.campaignRepo.AddImage(image); err != nil {
+		deleteStoredFiles(s.storage, s.logger, s.runAsync, image.StoredFileIDs())
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	campaign.Images = append(campaign.Images, *image)
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeCampaignUpdated, campaign)
+	})
+
+	return image, nil
+}
+
+// DeleteCampaignImage removes a campaign image and its files from storage
+func (s *imageService) DeleteCampaignImage(imageID uint, campaignID, key, userHandle string) error {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return errs.Forbidden("Only campaign organisers can delete campaign images")
+	}
+
+	image := campaign.GetImageByID(imageID)
+	if image == nil {
+		return errs.NotFound("Image not found")
+	}
+
+	if err := s.campaignRepo.DeleteImage(image); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	deleteStoredFiles(s.storage, s.logger, s.runAsync, image.StoredFileIDs())
+
+	for i := range campaign.Images {
+		if campaign.Images[i].ID == imageID {
+			campaign.Images = append(campaign.Images[:i], campaign.Images[i+1:]...)
+			break
+		}
+	}
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeCampaignUpdated, campaign)
+	})
+
+	return nil
+}
+
+// UploadActivityImage sets the image of an activity, replacing and removing any previous one.
+// The creator of the activity and campaign organisers can change its image
+func (s *imageService) UploadActivityImage(activityID uint, campaignID, key, userHandle, file string) (*models.Activity, error) {
+	activity, err := s.getActivityForImage(activityID, campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded, err := s.upload(file, "activity/images")
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := activity.SetImage(uploaded.url, uploaded.id, uploaded.thumbnailURL, uploaded.thumbnailID)
+	if err := s.activityRepo.UpdateImage(activity); err != nil {
+		deleteStoredFiles(s.storage, s.logger, s.runAsync, activity.ImageFileIDs())
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	deleteStoredFiles(s.storage, s.logger, s.runAsync, replaced)
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+	})
+
+	return activity, nil
+}
+
+// DeleteActivityImage removes the image of an activity and its files from storage
+func (s *imageService) DeleteActivityImage(activityID uint, campaignID, key, userHandle string) (*models.Activity, error) {
+	activity, err := s.getActivityForImage(activityID, campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	if activity.ImageUrl == "" {
+		return nil, errs.BadRequest("Activity has no image", nil)
+	}
+
+	replaced := activity.SetImage("", "", "", "")
+	if err := s.activityRepo.UpdateImage(activity); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	deleteStoredFiles(s.storage, s.logger, s.runAsync, replaced)
+
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypeActivityUpdated, activity)
+	})
+
+	return activity, nil
+}
+
+// Helper functions
+
+func (s *imageService) getActivityForImage(activityID uint, campaignID, key, userHandle string) (*models.Activity, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := campaign.GetActivityById(activityID)
+	if activity == nil {
+		return nil, errs.NotFound("Activity not found in this campaign.")
+	}
+
+	if activity.CreatedByHandle != userHandle && !can(userHandle, campaign, models.CampaignActionManageActivities) {
+		return nil, errs.Forbidden("You are not authorized to modify this activity")
+	}
+	return activity, nil
+}
+
+// upload processes an image file and stores it and its thumbnail in the folder
+func (s *imageService) upload(file, folder string) (*uploadedImage, error) {
+	processed, err := imaging.Process(file, s.options)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrTooLarge):
+			return nil, errs.BadRequest(fmt.Sprintf("Image must be at most %dMB", s.options.MaxBytes>>20), nil)
+		case errors.Is(err, imaging.ErrDimensionsTooLarge):
+			return nil, errs.BadRequest(fmt.Sprintf("Image must be at most %d pixels wide and high", s.options.MaxDimension), nil)
+		case errors.Is(err, imaging.ErrUnsupportedType):
+			return nil, errs.BadRequest("Only JPEG and PNG images are supported", nil)
+		case errors.Is(err, imaging.ErrInvalidImage):
+			return nil, errs.BadRequest("Image could not be read", nil)
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	defer processed.Remove()
+
+	url, id, err := s.storage.UploadFile(processed.Path, folder)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	thumbnailURL, thumbnailID, err := s.storage.UploadFile(processed.ThumbnailPath, folder+"/thumbnails")
+	if err != nil {
+		deleteStoredFiles(s.storage, s.logger, s.runAsync, []string{id})
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	return &uploadedImage{url: url, id: id, thumbnailURL: thumbnailURL, thumbnailID: thumbnailID}, nil
+}
+
+// deleteStoredFiles removes files that are no longer referenced from storage in the background,
+// a file that cannot be removed is logged and left behind
+func deleteStoredFiles(store storage.Storage, log logger.Logger, runAsync func(func()), ids []string) {
+	ids = slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == "" })
+	if len(ids) == 0 {
+		return
+	}
+	runAsync(func() {
+		for _, id := range ids {
+			if err := store.DeleteFile(id); err != nil {
+				log.Error(err, "failed to delete stored file", map[string]interface{}{"fileID": id})
+			}
+		}
+	})
+}
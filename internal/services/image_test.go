@@ -0,0 +1,214 @@
+package services
+
+import (
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/imaging"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	mockStorage "github.com/oyen-bright/goFundIt/pkg/storage/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupImageTest(t *testing.T) (
+	*imageService,
+	*mockRepo.MockCampaignRepository,
+	*mockRepo.MockActivityRepository,
+	*mockService.MockCampaignService,
+	*mockStorage.MockStorage,
+	*mockService.MockEventBroadcaster,
+) {
+	campaignRepo := mockRepo.NewMockCampaignRepository(t)
+	activityRepo := mockRepo.NewMockActivityRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	storage := mockStorage.NewMockStorage(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &imageService{
+		campaignRepo:    campaignRepo,
+		activityRepo:    activityRepo,
+		campaignService: campaignService,
+		storage:         storage,
+		broadcaster:     broadcaster,
+		logger:          mockLogger.NewMockLogger(t),
+		options:         imaging.DefaultOptions,
+		runAsync:        func(f func()) { f() },
+	}
+
+	return service, campaignRepo, activityRepo, campaignService, storage, broadcaster
+}
+
+func newImageTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "creator@example.com"},
+			{ID: 2, CampaignID: "campaign-123", Email: "member@example.com"},
+		},
+		Images: []models.CampaignImage{{ID: 7, CampaignID: "campaign-123", ImageUrl: "https://cdn/old.png", ImageID: "old", ThumbnailID: "old-thumb"}},
+		Activities: []models.Activity{
+			{ID: 1, CampaignID: "campaign-123", Title: "Boat tour", CreatedByHandle: "member", ImageUrl: "https://cdn/boat.png", ImageID: "boat", ThumbnailID: "boat-thumb"},
+			{ID: 2, CampaignID: "campaign-123", Title: "Dinner", CreatedByHandle: "creator"},
+		},
+	}
+}
+
+func writeTestPNG(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "upload.png")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, png.Encode(file, image.NewNRGBA(image.Rect(0, 0, 40, 20))))
+	return path
+}
+
+func TestUploadCampaignImage(t *testing.T) {
+	service, campaignRepo, _, campaignService, storage, broadcaster := setupImageTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "campaign/images").Return("https://cdn/image.png", "image-1", nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "campaign/images/thumbnails").Return("https://cdn/thumb.png", "thumb-1", nil).Once()
+		campaignRepo.EXPECT().AddImage(mock.MatchedBy(func(i *models.CampaignImage) bool {
+			return i.CampaignID == "campaign-123" && i.ImageID == "image-1" && i.ThumbnailID == "thumb-1"
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, mock.MatchedBy(func(c *models.Campaign) bool {
+			return len(c.Images) == 2
+		})).Once()
+
+		image, err := service.UploadCampaignImage("campaign-123", "key", "creator", writeTestPNG(t))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn/image.png", image.ImageUrl)
+		assert.Equal(t, "https://cdn/thumb.png", image.ThumbnailUrl)
+	})
+
+	t.Run("only organisers can add images", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		_, err := service.UploadCampaignImage("campaign-123", "key", "member", writeTestPNG(t))
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("image limit reached", func(t *testing.T) {
+		campaign := newImageTestCampaign()
+		campaign.Images = make([]models.CampaignImage, models.MaxCampaignImages)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(campaign, nil).Once()
+
+		_, err := service.UploadCampaignImage("campaign-123", "key", "creator", writeTestPNG(t))
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("unsupported file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "upload.png")
+		require.NoError(t, os.WriteFile(path, []byte("not an image"), 0o600))
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		_, err := service.UploadCampaignImage("campaign-123", "key", "creator", path)
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("uploaded files removed when image cannot be saved", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "campaign/images").Return("https://cdn/image.png", "image-1", nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "campaign/images/thumbnails").Return("https://cdn/thumb.png", "thumb-1", nil).Once()
+		campaignRepo.EXPECT().AddImage(mock.Anything).Return(errors.New("db error")).Once()
+		storage.EXPECT().DeleteFile("image-1").Return(nil).Once()
+		storage.EXPECT().DeleteFile("thumb-1").Return(nil).Once()
+		service.logger.(*mockLogger.MockLogger).EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		_, err := service.UploadCampaignImage("campaign-123", "key", "creator", writeTestPNG(t))
+		assertErrorCode(t, err, http.StatusInternalServerError)
+	})
+}
+
+func TestDeleteCampaignImage(t *testing.T) {
+	service, campaignRepo, _, campaignService, storage, broadcaster := setupImageTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+		campaignRepo.EXPECT().DeleteImage(mock.MatchedBy(func(i *models.CampaignImage) bool { return i.ID == 7 })).Return(nil).Once()
+		storage.EXPECT().DeleteFile("old").Return(nil).Once()
+		storage.EXPECT().DeleteFile("old-thumb").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeCampaignUpdated, mock.MatchedBy(func(c *models.Campaign) bool {
+			return len(c.Images) == 0
+		})).Once()
+
+		assert.NoError(t, service.DeleteCampaignImage(7, "campaign-123", "key", "creator"))
+	})
+
+	t.Run("image not found", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		assertErrorCode(t, service.DeleteCampaignImage(8, "campaign-123", "key", "creator"), http.StatusNotFound)
+	})
+}
+
+func TestUploadActivityImage(t *testing.T) {
+	service, _, activityRepo, campaignService, storage, broadcaster := setupImageTest(t)
+
+	t.Run("creator replaces the image", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "activity/images").Return("https://cdn/new.png", "new", nil).Once()
+		storage.EXPECT().UploadFile(mock.AnythingOfType("string"), "activity/images/thumbnails").Return("https://cdn/new-thumb.png", "new-thumb", nil).Once()
+		activityRepo.EXPECT().UpdateImage(mock.MatchedBy(func(a *models.Activity) bool {
+			return a.ID == 1 && a.ImageID == "new" && a.ThumbnailUrl == "https://cdn/new-thumb.png"
+		})).Return(nil).Once()
+		storage.EXPECT().DeleteFile("boat").Return(nil).Once()
+		storage.EXPECT().DeleteFile("boat-thumb").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityUpdated, mock.AnythingOfType("*models.Activity")).Once()
+
+		activity, err := service.UploadActivityImage(1, "campaign-123", "key", "member", writeTestPNG(t))
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn/new.png", activity.ImageUrl)
+	})
+
+	t.Run("other members cannot change the image", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		_, err := service.UploadActivityImage(2, "campaign-123", "key", "member", writeTestPNG(t))
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("activity not found", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		_, err := service.UploadActivityImage(3, "campaign-123", "key", "creator", writeTestPNG(t))
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestDeleteActivityImage(t *testing.T) {
+	service, _, activityRepo, campaignService, storage, broadcaster := setupImageTest(t)
+
+	t.Run("organiser removes the image", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+		activityRepo.EXPECT().UpdateImage(mock.MatchedBy(func(a *models.Activity) bool {
+			return a.ID == 1 && a.ImageUrl == "" && a.ImageID == ""
+		})).Return(nil).Once()
+		storage.EXPECT().DeleteFile("boat").Return(nil).Once()
+		storage.EXPECT().DeleteFile("boat-thumb").Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypeActivityUpdated, mock.AnythingOfType("*models.Activity")).Once()
+
+		_, err := service.DeleteActivityImage(1, "campaign-123", "key", "creator")
+		assert.NoError(t, err)
+	})
+
+	t.Run("activity has no image", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newImageTestCampaign(), nil).Once()
+
+		_, err := service.DeleteActivityImage(2, "campaign-123", "key", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
@@ -9,12 +9,14 @@ type ActivityService interface {
 	UpdateActivity(activity *models.Activity, userHandle string) error
 	DeleteActivityByID(activityID uint, campaignID, userHandle string) error
 
-	GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error)
+	GetActivitiesByCampaignID(campaignID, userHandle string) ([]models.Activity, error)
 	GetActivityByID(activityID uint, campaignID string) (models.Activity, error)
 	GetParticipants(activityID uint, campaignId, key string) ([]models.Contributor, error)
 
-	OptInContributor(campaignID, userEmail, key string, activityID, contributorID uint) error
+	OptInContributor(campaignID, userEmail, key string, activityID, contributorID uint) (bool, error)
 	OptOutContributor(campaignID, userEmail, key string, activityID, contributorID uint) error
+	UpdateActivitySplit(activityID uint, campaignID, userHandle, key string, strategy models.SplitStrategy, shares []models.ActivityShare) (*models.Activity, error)
 
 	ApproveActivity(activityID uint, userHandle, key string) (*models.Activity, error)
+	VoteOnActivity(activityID uint, campaignID, userEmail, key string, approve bool) (*models.Activity, error)
 }
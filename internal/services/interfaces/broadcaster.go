@@ -4,4 +4,5 @@ import "github.com/oyen-bright/goFundIt/pkg/websocket"
 
 type EventBroadcaster interface {
 	NewEvent(campaignID string, eventType websocket.EventType, data interface{})
+	SendTransient(campaignID string, eventType websocket.EventType, data interface{})
 }
@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CalendarService interface {
+	CreateCalendarFeed(campaignID, key, userEmail string, scope models.CalendarFeedScope) (*models.CalendarFeed, error)
+	GetCalendarFeeds(campaignID, userEmail string) ([]models.CalendarFeed, error)
+	RevokeCalendarFeed(feedID uint, campaignID, userEmail string) error
+
+	RenderCalendarFeed(token string) (string, error)
+}
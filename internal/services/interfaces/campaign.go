@@ -9,14 +9,18 @@ type CampaignService interface {
 	CreateCampaign(campaign *models.Campaign, userHandle string) (models.Campaign, error)
 	UpdateCampaign(data dto.CampaignUpdateRequest, campaignID, key, userHandle string) (*models.Campaign, error)
 	DeleteCampaign(campaignID string) error
+	UpdateCampaignVisibility(visibility models.CampaignVisibility, campaignID, key, userHandle string) (*models.Campaign, error)
 
 	GetCampaignByID(id, key string) (*models.Campaign, error)
 	GetCampaignByIDWithContributors(id string) (*models.Campaign, error)
 	GetCampaignByIDWithAllRelatedData(id string) (*models.Campaign, error)
+	GetPublicCampaignBySlug(slug string) (*models.Campaign, error)
+	HasCampaign(userHandle string) (bool, error)
 
 	GetExpiredCampaigns() ([]models.Campaign, error)
 	GetActiveCampaigns() ([]models.Campaign, error)
 	GetNearEndCampaigns() ([]models.Campaign, error)
 
 	RecalculateTargetAmount(campaignID string)
+	CheckMilestones(campaignID string)
 }
@@ -0,0 +1,21 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CampaignAccessService interface {
+	VerifyCampaignKey(campaignID, key, userEmail string) (campaignKey string, err error)
+
+	CreateOwnerKey(campaign *models.Campaign) error
+	PrepareCampaignKeys(campaign *models.Campaign) error
+	PrepareMemberKey(campaignID, campaignKey string, contributor *models.Contributor) (*models.CampaignAccessKey, error)
+	IssueMemberKey(campaignID, campaignKey, email string) (memberKey string, err error)
+	RevokeMemberKeys(campaignID, email string) error
+	PrepareOwnerKeyTransfer(campaign *models.Campaign, campaignKey, newOwnerEmail string) (*models.OwnerKeyTransfer, error)
+
+	IssueInvitation(contributor *models.Contributor)
+	RestoreInvitationToken(contributor *models.Contributor)
+	InvitationTokenHash(token string) string
+
+	ReissueContributorKey(campaignID string, contributorID uint, key, userHandle string) error
+	RevokeContributorKey(campaignID string, contributorID uint, userHandle string) error
+}
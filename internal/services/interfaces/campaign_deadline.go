@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+type CampaignDeadlineService interface {
+	ExtendCampaign(endDate time.Time, campaignID, key, userHandle string) (*models.CampaignExtension, error)
+	VoteOnExtension(extensionID uint, approve bool, campaignID, key, userEmail string) (*models.CampaignExtension, error)
+	CloseCampaignEarly(campaignID, key, userHandle string) (*models.Campaign, error)
+}
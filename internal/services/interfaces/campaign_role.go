@@ -0,0 +1,9 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type CampaignRoleService interface {
+	GetCampaignRoles(campaignID, key string) ([]models.CampaignUserRole, error)
+	AssignRole(email string, role models.CampaignRole, campaignID, key, userHandle string) (*models.CampaignUserRole, error)
+	TransferOwnership(email, campaignID, key, userHandle string) (previousOwnerKey string, err error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ChatService interface {
+	SendMessage(campaignID, key, userHandle, content, clientID string) (*models.ChatMessage, error)
+	SetTyping(campaignID, userHandle string, typing bool)
+	RecordReceipt(campaignID, userHandle, messageID string, status models.ChatReceiptStatus) (*models.ChatReceipt, error)
+
+	GetHistory(campaignID, key, userEmail, before, after string, limit int) ([]models.ChatMessage, error)
+}
@@ -4,9 +4,22 @@ import "github.com/oyen-bright/goFundIt/internal/models"
 
 type CommentService interface {
 	CreateComment(comment *models.Comment, campaignID string, activityID uint, userHandle string) error
-	DeleteComment(commentID, userHandle string) error
-	UpdateComment(comment models.Comment, userHandle string) error
+	DeleteComment(commentID, campaignID, userHandle string) error
+	UpdateComment(comment models.Comment, campaignID, userHandle string) (*models.Comment, error)
 
-	GetActivityComments(activityID uint) ([]models.Comment, error)
-	GetCommentReplies(commentID string) ([]models.Comment, error)
+	AddReaction(commentID, campaignID, userHandle, emoji string) (*models.Comment, error)
+	RemoveReaction(commentID, campaignID, userHandle, emoji string) (*models.Comment, error)
+
+	GetActivityComments(activityID uint, cursor string, limit int) (models.CommentPage, error)
+	GetCommentReplies(commentID, cursor string, limit int) (models.CommentPage, error)
+	GetCommentThread(commentID string) ([]models.Comment, error)
+	GetCommentRevisions(commentID string) ([]models.CommentRevision, error)
+
+	MarkCommentsRead(activityID uint, campaignID, userHandle string) error
+	GetUnreadSummary(campaignID, userHandle string) (*models.CampaignUnreadComments, error)
+
+	ReportComment(commentID, campaignID, userHandle, reason string) (*models.CommentReport, error)
+	ModerateComment(commentID, campaignID, userHandle string, action models.CommentModerationAction, reason string) (*models.Comment, error)
+	GetCommentReports(campaignID, userHandle string) ([]models.CommentReport, error)
+	GetModerationLog(campaignID, userHandle string) ([]models.CommentModerationLog, error)
 }
@@ -0,0 +1,8 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+// ContentFilter checks user content before it is published
+type ContentFilter interface {
+	Check(content string) (models.ContentCheck, error)
+}
@@ -13,5 +13,11 @@ type ContributorService interface {
 	GetContributorsByCampaignID(campaignID string) ([]models.Contributor, error)
 
 	AddContributorToCampaign(contribution *models.Contributor, campaignId, campaignKey, userHandle string) error
+	ImportContributors(contributors []models.Contributor, campaignId, campaignKey, userHandle string) ([]models.Contributor, error)
 	RemoveContributorFromCampaign(contributorId uint, campaignId, userHandle, key string) error
+	LeaveCampaign(contributor *models.Contributor, refunded bool) error
+
+	AcceptInvitation(token string) (*models.Contributor, error)
+	DeclineInvitation(token string) error
+	SendInvitationReminders()
 }
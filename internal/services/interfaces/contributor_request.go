@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ContributorRequestService interface {
+	RequestToLeave(campaignID, key, userEmail, reason string) (*models.ContributorRequest, error)
+	RequestAmountChange(campaignID, key, userEmail string, amount float64, reason string) (*models.ContributorRequest, error)
+
+	GetContributorRequests(campaignID, key, userHandle, userEmail string) ([]models.ContributorRequest, error)
+	ApproveContributorRequest(requestID uint, campaignID, key, userHandle, note string, refundIssued bool) (*models.ContributorRequest, error)
+	RejectContributorRequest(requestID uint, campaignID, key, userHandle, note string) (*models.ContributorRequest, error)
+}
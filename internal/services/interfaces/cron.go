@@ -1,6 +1,9 @@
 package interfaces
 
+import "github.com/oyen-bright/goFundIt/internal/models"
+
 type CronService interface {
 	StartCronJobs() error
 	StopCronJobs()
+	RescheduleCampaignDeadline(campaign *models.Campaign)
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ExpenseService interface {
+	RecordExpense(activityID uint, campaignID, key, userHandle, userEmail string, amount float64, paidByEmail, note, receipt string) (*models.ActivityExpense, error)
+	DeleteExpense(expenseID, activityID uint, campaignID, key, userHandle string) error
+	GetExpenseReceipt(expenseID, activityID uint, campaignID, key, userEmail string) (*models.DocumentLink, error)
+
+	GetActivityBudget(activityID uint, campaignID, key, userEmail string) (*models.ActivityBudgetReport, error)
+	GetCampaignBudget(campaignID, key, userEmail string) (*models.CampaignBudgetReport, error)
+
+	ReconcileActivity(activityID uint, campaignID, key, userHandle string, resolution models.ReconciliationResolution, targetActivityID *uint, note string) (*models.ActivityReconciliation, error)
+}
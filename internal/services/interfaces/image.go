@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type ImageService interface {
+	UploadCampaignImage(campaignID, key, userHandle, file string) (*models.CampaignImage, error)
+	DeleteCampaignImage(imageID uint, campaignID, key, userHandle string) error
+
+	UploadActivityImage(activityID uint, campaignID, key, userHandle, file string) (*models.Activity, error)
+	DeleteActivityImage(activityID uint, campaignID, key, userHandle string) (*models.Activity, error)
+}
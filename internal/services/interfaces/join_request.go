@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type JoinRequestService interface {
+	RequestToJoin(request *models.JoinRequest, slug, userEmail string) error
+
+	GetJoinRequests(campaignID, key, userHandle string) ([]models.JoinRequest, error)
+	ApproveJoinRequest(requestID uint, campaignID, key, userHandle string) (*models.Contributor, error)
+	RejectJoinRequest(requestID uint, campaignID, key, userHandle string) error
+}
@@ -9,21 +9,32 @@ type NotificationService interface {
 	NotifyCampaignCleanUp(campaign *models.Campaign, data string) error
 	NotifyCampaignCreation(campaign *models.Campaign) error
 	NotifyCampaignUpdate(campaign *models.Campaign, updateType string) error
+	NotifyCampaignChanged(campaign *models.Campaign, updateType, from, to string) error
 	NotifyCampaignMilestone(campaign *models.Campaign, milestoneType string) error
+	NotifyOwnershipTransferred(campaign *models.Campaign, newOwner *models.User, previousOwner *models.User) error
 
 	// Activity notifications
 	NotifyActivityAddition(activity *models.Activity, campaign *models.Campaign) error
 	NotifyActivityApproved(activity *models.Activity, campaign *models.Campaign) error
 	NotifyActivityApprovalRequest(activity *models.Activity, campaign *models.Campaign) error
 	NotifyActivityUpdate(activity *models.Activity, campaign *models.Campaign) error
+	NotifyWaitlistPromoted(contributor *models.Contributor, activity *models.Activity, campaign *models.Campaign) error
 
 	//Comment notifications
 	NotifyCommentAddition(comment *models.Comment, activityID *models.Activity) error
+	NotifyCommentMention(comment *models.Comment, activity *models.Activity, mentioned []models.User) error
 
 	// Contributor notifications
 	NotifyContributorAdded(contributor *models.Contributor, campaign *models.Campaign) error
+	NotifyContributorInvited(contributor *models.Contributor, campaign *models.Campaign) error
+	NotifyContributorsImported(contributors []models.Contributor, campaign *models.Campaign) error
+	NotifyInvitationDeclined(contributor *models.Contributor, campaign *models.Campaign) error
 	NotifyPaymentReceived(contributor *models.Contributor, campaign *models.Campaign) error
 
+	// Join request notifications
+	NotifyJoinRequestReceived(request *models.JoinRequest, campaign *models.Campaign) error
+	NotifyJoinRequestRejected(request *models.JoinRequest, campaign *models.Campaign) error
+
 	// Payout
 	NotifyPayoutCollected(campaign *models.Campaign) error
 	NotifyCampaignPayoutRequired(campaign *models.Campaign) error
@@ -31,6 +42,7 @@ type NotificationService interface {
 	// Reminder notifications
 	SendContributionReminder(contributor *models.Contributor, campaign *models.Campaign) error
 	SendDeadlineReminder(campaign *models.Campaign) error
+	SendInvitationReminder(contributor *models.Contributor) error
 
 	// System notifications
 	SendSystemNotification(notificationType string, message string) error
@@ -7,7 +7,7 @@ import (
 
 type PaymentService interface {
 	InitializePayment(contributorID uint, key string) (*models.Payment, error)
-	InitializeManualPayment(contributorID uint, reference, userEmail, key string) (*models.Payment, error)
+	InitializeManualPayment(contributorID uint, reference, userEmail, userHandle, key string) (*models.Payment, error)
 
 	VerifyPayment(reference string) error
 	VerifyManualPayment(reference, userHandle, key string) error
@@ -15,6 +15,7 @@ type PaymentService interface {
 	DeletePayment(payment models.Payment) error
 
 	GetPaymentByReference(reference string) (*models.Payment, error)
+	GetPaymentProof(reference, userEmail, userHandle, key string) (*models.DocumentLink, error)
 	GetPaymentsByCampaign(campaignID string, limit, offset int) ([]*models.Payment, int64, error)
 	GetPaymentsByContributor(contributorID uint, limit, offset int) ([]models.Payment, int64, error)
 
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+)
+
+type PollService interface {
+	CreatePoll(campaignID, key, userHandle, question string, options []string, multipleChoice bool, deadline *time.Time) (*models.Poll, error)
+	GetPolls(campaignID, key, userEmail string) ([]models.Poll, error)
+
+	VoteOnPoll(pollID uint, campaignID, key, userEmail string, optionIDs []uint) (*models.Poll, error)
+	ClosePoll(pollID uint, campaignID, key, userHandle string) (*models.Poll, error)
+}
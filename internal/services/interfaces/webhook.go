@@ -0,0 +1,16 @@
+package interfaces
+
+import "github.com/oyen-bright/goFundIt/internal/models"
+
+type WebhookService interface {
+	CreateWebhook(campaignID, key, userHandle, url string, eventTypes []string) (*models.Webhook, error)
+	GetWebhooks(campaignID, key, userHandle string) ([]models.Webhook, error)
+	UpdateWebhook(webhookID uint, campaignID, key, userHandle string, url *string, eventTypes []string, enabled *bool) (*models.Webhook, error)
+	DeleteWebhook(webhookID uint, campaignID, key, userHandle string) error
+
+	GetDeliveries(webhookID uint, campaignID, key, userHandle string, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error)
+	ReplayDelivery(webhookID uint, campaignID, key, userHandle, deliveryID string) (*models.WebhookDelivery, error)
+
+	StartDeliveries() error
+	StopDeliveries()
+}
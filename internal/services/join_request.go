@@ -0,0 +1,163 @@
+package services
+
+import (
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+)
+
+type joinRequestService struct {
+	repo                repositories.JoinRequestRepository
+	campaignService     services.CampaignService
+	contributorService  services.ContributorService
+	notificationService services.NotificationService
+	logger              logger.Logger
+	runAsync            func(func())
+}
+
+func NewJoinRequestService(
+	repo repositories.JoinRequestRepository,
+	campaignService services.CampaignService,
+	contributorService services.ContributorService,
+	notificationService services.NotificationService,
+	logger logger.Logger,
+) services.JoinRequestService {
+	return &joinRequestService{
+		repo:                repo,
+		campaignService:     campaignService,
+		contributorService:  contributorService,
+		notificationService: notificationService,
+		logger:              logger,
+		runAsync:            func(f func()) { go f() },
+	}
+}
+
+// RequestToJoin records a request from userEmail to join a public campaign and notifies the creator
+func (s *joinRequestService) RequestToJoin(request *models.JoinRequest, slug, userEmail string) error {
+	campaign, err := s.campaignService.GetPublicCampaignBySlug(slug)
+	if err != nil {
+		return err
+	}
+
+	if campaign.HasEnded() {
+		return errs.BadRequest("Cannot join: Campaign has ended", nil)
+	}
+	if campaign.EmailIsPartOfCampaign(userEmail) {
+		return errs.BadRequest("You are already part of this campaign", nil)
+	}
+
+	hasPending, err := s.repo.HasPendingRequest(campaign.ID, userEmail)
+	if err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	if hasPending {
+		return errs.BadRequest("You already have a pending request to join this campaign", nil)
+	}
+
+	request.CampaignID = campaign.ID
+	request.Email = userEmail
+	request.Status = models.JoinRequestStatusPending
+
+	if err := s.repo.Create(request); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.notificationService.NotifyJoinRequestReceived(request, campaign)
+	})
+
+	return nil
+}
+
+// GetJoinRequests fetches the join requests of a campaign, only organisers can view them
+func (s *joinRequestService) GetJoinRequests(campaignID, key, userHandle string) ([]models.JoinRequest, error) {
+	if _, err := s.getCampaignAsOrganiser(campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.repo.GetByCampaignID(campaignID, "")
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return requests, nil
+}
+
+// ApproveJoinRequest adds the requester to the campaign as a contributor
+func (s *joinRequestService) ApproveJoinRequest(requestID uint, campaignID, key, userHandle string) (*models.Contributor, error) {
+	request, err := s.getPendingRequest(requestID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	// AddContributorToCampaign validates the creator and the campaign
+	contributor := request.ToContributor()
+	if err := s.contributorService.AddContributorToCampaign(contributor, campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	request.Approve()
+	if err := s.repo.Update(request); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	return contributor, nil
+}
+
+// RejectJoinRequest declines a join request and notifies the requester
+func (s *joinRequestService) RejectJoinRequest(requestID uint, campaignID, key, userHandle string) error {
+	campaign, err := s.getCampaignAsOrganiser(campaignID, key, userHandle)
+	if err != nil {
+		return err
+	}
+
+	request, err := s.getPendingRequest(requestID, campaignID)
+	if err != nil {
+		return err
+	}
+
+	request.Reject()
+	if err := s.repo.Update(request); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.runAsync(func() {
+		s.notificationService.NotifyJoinRequestRejected(request, campaign)
+	})
+
+	return nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+func (s *joinRequestService) getCampaignAsOrganiser(campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionManageContributors) {
+		return nil, errs.Forbidden("Only campaign organisers can manage join requests")
+	}
+	return campaign, nil
+}
+
+func (s *joinRequestService) getPendingRequest(requestID uint, campaignID string) (*models.JoinRequest, error) {
+	request, err := s.repo.GetByID(requestID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Join request not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if request.CampaignID != campaignID {
+		return nil, errs.NotFound("Join request not found")
+	}
+	if !request.IsPending() {
+		return nil, errs.BadRequest("Join request has already been reviewed", nil)
+	}
+	return &request, nil
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func setupJoinRequestTest(t *testing.T) (
+	*joinRequestService,
+	*mockRepo.MockJoinRequestRepository,
+	*mockService.MockCampaignService,
+	*mockService.MockContributorService,
+	*mockService.MockNotificationService,
+) {
+	repo := mockRepo.NewMockJoinRequestRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	contributorService := mockService.NewMockContributorService(t)
+	notificationService := mockService.NewMockNotificationService(t)
+
+	service := &joinRequestService{
+		repo:                repo,
+		campaignService:     campaignService,
+		contributorService:  contributorService,
+		notificationService: notificationService,
+		logger:              mockLogger.NewMockLogger(t),
+		runAsync:            func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, contributorService, notificationService
+}
+
+func newJoinRequestTestCampaign() *models.Campaign {
+	slug := "community-event-abc123"
+	return &models.Campaign{
+		ID:         "campaign-123",
+		Title:      "Community Event",
+		Slug:       &slug,
+		Visibility: models.CampaignVisibilityPublic,
+		EndDate:    time.Now().Add(24 * time.Hour),
+		CreatedBy:  models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, Email: "member@example.com"},
+		},
+	}
+}
+
+func TestRequestToJoin(t *testing.T) {
+	service, repo, campaignService, _, notificationService := setupJoinRequestTest(t)
+	campaign := newJoinRequestTestCampaign()
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetPublicCampaignBySlug(*campaign.Slug).Return(campaign, nil).Once()
+		repo.EXPECT().HasPendingRequest(campaign.ID, "jane@example.com").Return(false, nil).Once()
+		repo.EXPECT().Create(mock.AnythingOfType("*models.JoinRequest")).Return(nil).Once()
+		notificationService.EXPECT().NotifyJoinRequestReceived(mock.AnythingOfType("*models.JoinRequest"), campaign).Return(nil).Once()
+
+		request := models.NewJoinRequest("", "Jane", "", "Count me in", 50)
+		err := service.RequestToJoin(request, *campaign.Slug, "jane@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, campaign.ID, request.CampaignID)
+		assert.Equal(t, "jane@example.com", request.Email)
+	})
+
+	t.Run("already part of campaign", func(t *testing.T) {
+		campaignService.EXPECT().GetPublicCampaignBySlug(*campaign.Slug).Return(campaign, nil).Once()
+
+		err := service.RequestToJoin(models.NewJoinRequest("", "Member", "", "", 50), *campaign.Slug, "member@example.com")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("pending request exists", func(t *testing.T) {
+		campaignService.EXPECT().GetPublicCampaignBySlug(*campaign.Slug).Return(campaign, nil).Once()
+		repo.EXPECT().HasPendingRequest(campaign.ID, "jane@example.com").Return(true, nil).Once()
+
+		err := service.RequestToJoin(models.NewJoinRequest("", "Jane", "", "", 50), *campaign.Slug, "jane@example.com")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestApproveJoinRequest(t *testing.T) {
+	service, repo, _, contributorService, _ := setupJoinRequestTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		request := models.NewJoinRequest("campaign-123", "Jane", "jane@example.com", "", 50)
+		request.ID = 1
+
+		repo.EXPECT().GetByID(uint(1)).Return(*request, nil).Once()
+		contributorService.EXPECT().AddContributorToCampaign(mock.MatchedBy(func(c *models.Contributor) bool {
+			return c.Email == "jane@example.com" && c.Amount == 50
+		}), "campaign-123", "key-123", "creator").Return(nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(r *models.JoinRequest) bool {
+			return r.Status == models.JoinRequestStatusApproved
+		})).Return(nil).Once()
+
+		contributor, err := service.ApproveJoinRequest(1, "campaign-123", "key-123", "creator")
+		assert.NoError(t, err)
+		assert.Equal(t, "jane@example.com", contributor.Email)
+	})
+
+	t.Run("request of another campaign", func(t *testing.T) {
+		request := models.NewJoinRequest("campaign-456", "Jane", "jane@example.com", "", 50)
+		repo.EXPECT().GetByID(uint(2)).Return(*request, nil).Once()
+
+		_, err := service.ApproveJoinRequest(2, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		request := models.NewJoinRequest("campaign-123", "Jane", "jane@example.com", "", 50)
+		request.Reject()
+		repo.EXPECT().GetByID(uint(3)).Return(*request, nil).Once()
+
+		_, err := service.ApproveJoinRequest(3, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo.EXPECT().GetByID(uint(4)).Return(models.JoinRequest{}, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.ApproveJoinRequest(4, "campaign-123", "key-123", "creator")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestRejectJoinRequest(t *testing.T) {
+	service, repo, campaignService, _, notificationService := setupJoinRequestTest(t)
+	campaign := newJoinRequestTestCampaign()
+
+	t.Run("success", func(t *testing.T) {
+		request := models.NewJoinRequest(campaign.ID, "Jane", "jane@example.com", "", 50)
+
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(*request, nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(r *models.JoinRequest) bool {
+			return r.Status == models.JoinRequestStatusRejected
+		})).Return(nil).Once()
+		notificationService.EXPECT().NotifyJoinRequestRejected(mock.AnythingOfType("*models.JoinRequest"), campaign).Return(nil).Once()
+
+		err := service.RejectJoinRequest(1, campaign.ID, "key-123", "creator")
+		assert.NoError(t, err)
+	})
+
+	t.Run("only creator can reject", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID(campaign.ID, "key-123").Return(campaign, nil).Once()
+
+		err := service.RejectJoinRequest(1, campaign.ID, "key-123", "member")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+}
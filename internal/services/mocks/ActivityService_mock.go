@@ -187,9 +187,9 @@ func (_c *MockActivityService_DeleteActivityByID_Call) RunAndReturn(run func(uin
 	return _c
 }
 
-// GetActivitiesByCampaignID provides a mock function with given fields: campaignID
-func (_m *MockActivityService) GetActivitiesByCampaignID(campaignID string) ([]models.Activity, error) {
-	ret := _m.Called(campaignID)
+// GetActivitiesByCampaignID provides a mock function with given fields: campaignID, userHandle
+func (_m *MockActivityService) GetActivitiesByCampaignID(campaignID string, userHandle string) ([]models.Activity, error) {
+	ret := _m.Called(campaignID, userHandle)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetActivitiesByCampaignID")
@@ -197,19 +197,19 @@ func (_m *MockActivityService) GetActivitiesByCampaignID(campaignID string) ([]m
 
 	var r0 []models.Activity
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]models.Activity, error)); ok {
-		return rf(campaignID)
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.Activity, error)); ok {
+		return rf(campaignID, userHandle)
 	}
-	if rf, ok := ret.Get(0).(func(string) []models.Activity); ok {
-		r0 = rf(campaignID)
+	if rf, ok := ret.Get(0).(func(string, string) []models.Activity); ok {
+		r0 = rf(campaignID, userHandle)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Activity)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(campaignID)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, userHandle)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -224,13 +224,14 @@ type MockActivityService_GetActivitiesByCampaignID_Call struct {
 
 // GetActivitiesByCampaignID is a helper method to define mock.On call
 //   - campaignID string
-func (_e *MockActivityService_Expecter) GetActivitiesByCampaignID(campaignID interface{}) *MockActivityService_GetActivitiesByCampaignID_Call {
-	return &MockActivityService_GetActivitiesByCampaignID_Call{Call: _e.mock.On("GetActivitiesByCampaignID", campaignID)}
+//   - userHandle string
+func (_e *MockActivityService_Expecter) GetActivitiesByCampaignID(campaignID interface{}, userHandle interface{}) *MockActivityService_GetActivitiesByCampaignID_Call {
+	return &MockActivityService_GetActivitiesByCampaignID_Call{Call: _e.mock.On("GetActivitiesByCampaignID", campaignID, userHandle)}
 }
 
-func (_c *MockActivityService_GetActivitiesByCampaignID_Call) Run(run func(campaignID string)) *MockActivityService_GetActivitiesByCampaignID_Call {
+func (_c *MockActivityService_GetActivitiesByCampaignID_Call) Run(run func(campaignID string, userHandle string)) *MockActivityService_GetActivitiesByCampaignID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
@@ -240,7 +241,7 @@ func (_c *MockActivityService_GetActivitiesByCampaignID_Call) Return(_a0 []model
 	return _c
 }
 
-func (_c *MockActivityService_GetActivitiesByCampaignID_Call) RunAndReturn(run func(string) ([]models.Activity, error)) *MockActivityService_GetActivitiesByCampaignID_Call {
+func (_c *MockActivityService_GetActivitiesByCampaignID_Call) RunAndReturn(run func(string, string) ([]models.Activity, error)) *MockActivityService_GetActivitiesByCampaignID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -363,21 +364,31 @@ func (_c *MockActivityService_GetParticipants_Call) RunAndReturn(run func(uint,
 }
 
 // OptInContributor provides a mock function with given fields: campaignID, userEmail, key, activityID, contributorID
-func (_m *MockActivityService) OptInContributor(campaignID string, userEmail string, key string, activityID uint, contributorID uint) error {
+func (_m *MockActivityService) OptInContributor(campaignID string, userEmail string, key string, activityID uint, contributorID uint) (bool, error) {
 	ret := _m.Called(campaignID, userEmail, key, activityID, contributorID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for OptInContributor")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, uint, uint) error); ok {
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, uint, uint) (bool, error)); ok {
+		return rf(campaignID, userEmail, key, activityID, contributorID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, uint, uint) bool); ok {
 		r0 = rf(campaignID, userEmail, key, activityID, contributorID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string, string, uint, uint) error); ok {
+		r1 = rf(campaignID, userEmail, key, activityID, contributorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockActivityService_OptInContributor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OptInContributor'
@@ -402,12 +413,12 @@ func (_c *MockActivityService_OptInContributor_Call) Run(run func(campaignID str
 	return _c
 }
 
-func (_c *MockActivityService_OptInContributor_Call) Return(_a0 error) *MockActivityService_OptInContributor_Call {
-	_c.Call.Return(_a0)
+func (_c *MockActivityService_OptInContributor_Call) Return(_a0 bool, _a1 error) *MockActivityService_OptInContributor_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockActivityService_OptInContributor_Call) RunAndReturn(run func(string, string, string, uint, uint) error) *MockActivityService_OptInContributor_Call {
+func (_c *MockActivityService_OptInContributor_Call) RunAndReturn(run func(string, string, string, uint, uint) (bool, error)) *MockActivityService_OptInContributor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -509,6 +520,131 @@ func (_c *MockActivityService_UpdateActivity_Call) RunAndReturn(run func(*models
 	return _c
 }
 
+// UpdateActivitySplit provides a mock function with given fields: activityID, campaignID, userHandle, key, strategy, shares
+func (_m *MockActivityService) UpdateActivitySplit(activityID uint, campaignID string, userHandle string, key string, strategy models.SplitStrategy, shares []models.ActivityShare) (*models.Activity, error) {
+	ret := _m.Called(activityID, campaignID, userHandle, key, strategy, shares)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateActivitySplit")
+	}
+
+	var r0 *models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.SplitStrategy, []models.ActivityShare) (*models.Activity, error)); ok {
+		return rf(activityID, campaignID, userHandle, key, strategy, shares)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.SplitStrategy, []models.ActivityShare) *models.Activity); ok {
+		r0 = rf(activityID, campaignID, userHandle, key, strategy, shares)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, models.SplitStrategy, []models.ActivityShare) error); ok {
+		r1 = rf(activityID, campaignID, userHandle, key, strategy, shares)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockActivityService_UpdateActivitySplit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateActivitySplit'
+type MockActivityService_UpdateActivitySplit_Call struct {
+	*mock.Call
+}
+
+// UpdateActivitySplit is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - userHandle string
+//   - key string
+//   - strategy models.SplitStrategy
+//   - shares []models.ActivityShare
+func (_e *MockActivityService_Expecter) UpdateActivitySplit(activityID interface{}, campaignID interface{}, userHandle interface{}, key interface{}, strategy interface{}, shares interface{}) *MockActivityService_UpdateActivitySplit_Call {
+	return &MockActivityService_UpdateActivitySplit_Call{Call: _e.mock.On("UpdateActivitySplit", activityID, campaignID, userHandle, key, strategy, shares)}
+}
+
+func (_c *MockActivityService_UpdateActivitySplit_Call) Run(run func(activityID uint, campaignID string, userHandle string, key string, strategy models.SplitStrategy, shares []models.ActivityShare)) *MockActivityService_UpdateActivitySplit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(models.SplitStrategy), args[5].([]models.ActivityShare))
+	})
+	return _c
+}
+
+func (_c *MockActivityService_UpdateActivitySplit_Call) Return(_a0 *models.Activity, _a1 error) *MockActivityService_UpdateActivitySplit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockActivityService_UpdateActivitySplit_Call) RunAndReturn(run func(uint, string, string, string, models.SplitStrategy, []models.ActivityShare) (*models.Activity, error)) *MockActivityService_UpdateActivitySplit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoteOnActivity provides a mock function with given fields: activityID, campaignID, userEmail, key, approve
+func (_m *MockActivityService) VoteOnActivity(activityID uint, campaignID string, userEmail string, key string, approve bool) (*models.Activity, error) {
+	ret := _m.Called(activityID, campaignID, userEmail, key, approve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteOnActivity")
+	}
+
+	var r0 *models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, bool) (*models.Activity, error)); ok {
+		return rf(activityID, campaignID, userEmail, key, approve)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, bool) *models.Activity); ok {
+		r0 = rf(activityID, campaignID, userEmail, key, approve)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, bool) error); ok {
+		r1 = rf(activityID, campaignID, userEmail, key, approve)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockActivityService_VoteOnActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteOnActivity'
+type MockActivityService_VoteOnActivity_Call struct {
+	*mock.Call
+}
+
+// VoteOnActivity is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - userEmail string
+//   - key string
+//   - approve bool
+func (_e *MockActivityService_Expecter) VoteOnActivity(activityID interface{}, campaignID interface{}, userEmail interface{}, key interface{}, approve interface{}) *MockActivityService_VoteOnActivity_Call {
+	return &MockActivityService_VoteOnActivity_Call{Call: _e.mock.On("VoteOnActivity", activityID, campaignID, userEmail, key, approve)}
+}
+
+func (_c *MockActivityService_VoteOnActivity_Call) Run(run func(activityID uint, campaignID string, userEmail string, key string, approve bool)) *MockActivityService_VoteOnActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockActivityService_VoteOnActivity_Call) Return(_a0 *models.Activity, _a1 error) *MockActivityService_VoteOnActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockActivityService_VoteOnActivity_Call) RunAndReturn(run func(uint, string, string, string, bool) (*models.Activity, error)) *MockActivityService_VoteOnActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockActivityService creates a new instance of MockActivityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockActivityService(t interface {
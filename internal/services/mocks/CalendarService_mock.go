@@ -0,0 +1,259 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCalendarService is an autogenerated mock type for the CalendarService type
+type MockCalendarService struct {
+	mock.Mock
+}
+
+type MockCalendarService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarService) EXPECT() *MockCalendarService_Expecter {
+	return &MockCalendarService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCalendarFeed provides a mock function with given fields: campaignID, key, userEmail, scope
+func (_m *MockCalendarService) CreateCalendarFeed(campaignID string, key string, userEmail string, scope models.CalendarFeedScope) (*models.CalendarFeed, error) {
+	ret := _m.Called(campaignID, key, userEmail, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendarFeed")
+	}
+
+	var r0 *models.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, models.CalendarFeedScope) (*models.CalendarFeed, error)); ok {
+		return rf(campaignID, key, userEmail, scope)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, models.CalendarFeedScope) *models.CalendarFeed); ok {
+		r0 = rf(campaignID, key, userEmail, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CalendarFeed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, models.CalendarFeedScope) error); ok {
+		r1 = rf(campaignID, key, userEmail, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarService_CreateCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCalendarFeed'
+type MockCalendarService_CreateCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// CreateCalendarFeed is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+//   - scope models.CalendarFeedScope
+func (_e *MockCalendarService_Expecter) CreateCalendarFeed(campaignID interface{}, key interface{}, userEmail interface{}, scope interface{}) *MockCalendarService_CreateCalendarFeed_Call {
+	return &MockCalendarService_CreateCalendarFeed_Call{Call: _e.mock.On("CreateCalendarFeed", campaignID, key, userEmail, scope)}
+}
+
+func (_c *MockCalendarService_CreateCalendarFeed_Call) Run(run func(campaignID string, key string, userEmail string, scope models.CalendarFeedScope)) *MockCalendarService_CreateCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(models.CalendarFeedScope))
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_CreateCalendarFeed_Call) Return(_a0 *models.CalendarFeed, _a1 error) *MockCalendarService_CreateCalendarFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarService_CreateCalendarFeed_Call) RunAndReturn(run func(string, string, string, models.CalendarFeedScope) (*models.CalendarFeed, error)) *MockCalendarService_CreateCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendarFeeds provides a mock function with given fields: campaignID, userEmail
+func (_m *MockCalendarService) GetCalendarFeeds(campaignID string, userEmail string) ([]models.CalendarFeed, error) {
+	ret := _m.Called(campaignID, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarFeeds")
+	}
+
+	var r0 []models.CalendarFeed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.CalendarFeed, error)); ok {
+		return rf(campaignID, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.CalendarFeed); ok {
+		r0 = rf(campaignID, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CalendarFeed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarService_GetCalendarFeeds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarFeeds'
+type MockCalendarService_GetCalendarFeeds_Call struct {
+	*mock.Call
+}
+
+// GetCalendarFeeds is a helper method to define mock.On call
+//   - campaignID string
+//   - userEmail string
+func (_e *MockCalendarService_Expecter) GetCalendarFeeds(campaignID interface{}, userEmail interface{}) *MockCalendarService_GetCalendarFeeds_Call {
+	return &MockCalendarService_GetCalendarFeeds_Call{Call: _e.mock.On("GetCalendarFeeds", campaignID, userEmail)}
+}
+
+func (_c *MockCalendarService_GetCalendarFeeds_Call) Run(run func(campaignID string, userEmail string)) *MockCalendarService_GetCalendarFeeds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_GetCalendarFeeds_Call) Return(_a0 []models.CalendarFeed, _a1 error) *MockCalendarService_GetCalendarFeeds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarService_GetCalendarFeeds_Call) RunAndReturn(run func(string, string) ([]models.CalendarFeed, error)) *MockCalendarService_GetCalendarFeeds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderCalendarFeed provides a mock function with given fields: token
+func (_m *MockCalendarService) RenderCalendarFeed(token string) (string, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderCalendarFeed")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCalendarService_RenderCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderCalendarFeed'
+type MockCalendarService_RenderCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// RenderCalendarFeed is a helper method to define mock.On call
+//   - token string
+func (_e *MockCalendarService_Expecter) RenderCalendarFeed(token interface{}) *MockCalendarService_RenderCalendarFeed_Call {
+	return &MockCalendarService_RenderCalendarFeed_Call{Call: _e.mock.On("RenderCalendarFeed", token)}
+}
+
+func (_c *MockCalendarService_RenderCalendarFeed_Call) Run(run func(token string)) *MockCalendarService_RenderCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_RenderCalendarFeed_Call) Return(_a0 string, _a1 error) *MockCalendarService_RenderCalendarFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCalendarService_RenderCalendarFeed_Call) RunAndReturn(run func(string) (string, error)) *MockCalendarService_RenderCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeCalendarFeed provides a mock function with given fields: feedID, campaignID, userEmail
+func (_m *MockCalendarService) RevokeCalendarFeed(feedID uint, campaignID string, userEmail string) error {
+	ret := _m.Called(feedID, campaignID, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCalendarFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string, string) error); ok {
+		r0 = rf(feedID, campaignID, userEmail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCalendarService_RevokeCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeCalendarFeed'
+type MockCalendarService_RevokeCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// RevokeCalendarFeed is a helper method to define mock.On call
+//   - feedID uint
+//   - campaignID string
+//   - userEmail string
+func (_e *MockCalendarService_Expecter) RevokeCalendarFeed(feedID interface{}, campaignID interface{}, userEmail interface{}) *MockCalendarService_RevokeCalendarFeed_Call {
+	return &MockCalendarService_RevokeCalendarFeed_Call{Call: _e.mock.On("RevokeCalendarFeed", feedID, campaignID, userEmail)}
+}
+
+func (_c *MockCalendarService_RevokeCalendarFeed_Call) Run(run func(feedID uint, campaignID string, userEmail string)) *MockCalendarService_RevokeCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_RevokeCalendarFeed_Call) Return(_a0 error) *MockCalendarService_RevokeCalendarFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCalendarService_RevokeCalendarFeed_Call) RunAndReturn(run func(uint, string, string) error) *MockCalendarService_RevokeCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCalendarService creates a new instance of MockCalendarService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarService {
+	mock := &MockCalendarService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,619 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignAccessService is an autogenerated mock type for the CampaignAccessService type
+type MockCampaignAccessService struct {
+	mock.Mock
+}
+
+type MockCampaignAccessService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignAccessService) EXPECT() *MockCampaignAccessService_Expecter {
+	return &MockCampaignAccessService_Expecter{mock: &_m.Mock}
+}
+
+// CreateOwnerKey provides a mock function with given fields: campaign
+func (_m *MockCampaignAccessService) CreateOwnerKey(campaign *models.Campaign) error {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOwnerKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Campaign) error); ok {
+		r0 = rf(campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_CreateOwnerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOwnerKey'
+type MockCampaignAccessService_CreateOwnerKey_Call struct {
+	*mock.Call
+}
+
+// CreateOwnerKey is a helper method to define mock.On call
+//   - campaign *models.Campaign
+func (_e *MockCampaignAccessService_Expecter) CreateOwnerKey(campaign interface{}) *MockCampaignAccessService_CreateOwnerKey_Call {
+	return &MockCampaignAccessService_CreateOwnerKey_Call{Call: _e.mock.On("CreateOwnerKey", campaign)}
+}
+
+func (_c *MockCampaignAccessService_CreateOwnerKey_Call) Run(run func(campaign *models.Campaign)) *MockCampaignAccessService_CreateOwnerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_CreateOwnerKey_Call) Return(_a0 error) *MockCampaignAccessService_CreateOwnerKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_CreateOwnerKey_Call) RunAndReturn(run func(*models.Campaign) error) *MockCampaignAccessService_CreateOwnerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvitationTokenHash provides a mock function with given fields: token
+func (_m *MockCampaignAccessService) InvitationTokenHash(token string) string {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvitationTokenHash")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_InvitationTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvitationTokenHash'
+type MockCampaignAccessService_InvitationTokenHash_Call struct {
+	*mock.Call
+}
+
+// InvitationTokenHash is a helper method to define mock.On call
+//   - token string
+func (_e *MockCampaignAccessService_Expecter) InvitationTokenHash(token interface{}) *MockCampaignAccessService_InvitationTokenHash_Call {
+	return &MockCampaignAccessService_InvitationTokenHash_Call{Call: _e.mock.On("InvitationTokenHash", token)}
+}
+
+func (_c *MockCampaignAccessService_InvitationTokenHash_Call) Run(run func(token string)) *MockCampaignAccessService_InvitationTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_InvitationTokenHash_Call) Return(_a0 string) *MockCampaignAccessService_InvitationTokenHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_InvitationTokenHash_Call) RunAndReturn(run func(string) string) *MockCampaignAccessService_InvitationTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueInvitation provides a mock function with given fields: contributor
+func (_m *MockCampaignAccessService) IssueInvitation(contributor *models.Contributor) {
+	_m.Called(contributor)
+}
+
+// MockCampaignAccessService_IssueInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueInvitation'
+type MockCampaignAccessService_IssueInvitation_Call struct {
+	*mock.Call
+}
+
+// IssueInvitation is a helper method to define mock.On call
+//   - contributor *models.Contributor
+func (_e *MockCampaignAccessService_Expecter) IssueInvitation(contributor interface{}) *MockCampaignAccessService_IssueInvitation_Call {
+	return &MockCampaignAccessService_IssueInvitation_Call{Call: _e.mock.On("IssueInvitation", contributor)}
+}
+
+func (_c *MockCampaignAccessService_IssueInvitation_Call) Run(run func(contributor *models.Contributor)) *MockCampaignAccessService_IssueInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_IssueInvitation_Call) Return() *MockCampaignAccessService_IssueInvitation_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCampaignAccessService_IssueInvitation_Call) RunAndReturn(run func(*models.Contributor)) *MockCampaignAccessService_IssueInvitation_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IssueMemberKey provides a mock function with given fields: campaignID, campaignKey, email
+func (_m *MockCampaignAccessService) IssueMemberKey(campaignID string, campaignKey string, email string) (string, error) {
+	ret := _m.Called(campaignID, campaignKey, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueMemberKey")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return rf(campaignID, campaignKey, email)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(campaignID, campaignKey, email)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, campaignKey, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessService_IssueMemberKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueMemberKey'
+type MockCampaignAccessService_IssueMemberKey_Call struct {
+	*mock.Call
+}
+
+// IssueMemberKey is a helper method to define mock.On call
+//   - campaignID string
+//   - campaignKey string
+//   - email string
+func (_e *MockCampaignAccessService_Expecter) IssueMemberKey(campaignID interface{}, campaignKey interface{}, email interface{}) *MockCampaignAccessService_IssueMemberKey_Call {
+	return &MockCampaignAccessService_IssueMemberKey_Call{Call: _e.mock.On("IssueMemberKey", campaignID, campaignKey, email)}
+}
+
+func (_c *MockCampaignAccessService_IssueMemberKey_Call) Run(run func(campaignID string, campaignKey string, email string)) *MockCampaignAccessService_IssueMemberKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_IssueMemberKey_Call) Return(memberKey string, err error) *MockCampaignAccessService_IssueMemberKey_Call {
+	_c.Call.Return(memberKey, err)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_IssueMemberKey_Call) RunAndReturn(run func(string, string, string) (string, error)) *MockCampaignAccessService_IssueMemberKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrepareCampaignKeys provides a mock function with given fields: campaign
+func (_m *MockCampaignAccessService) PrepareCampaignKeys(campaign *models.Campaign) error {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareCampaignKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Campaign) error); ok {
+		r0 = rf(campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_PrepareCampaignKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareCampaignKeys'
+type MockCampaignAccessService_PrepareCampaignKeys_Call struct {
+	*mock.Call
+}
+
+// PrepareCampaignKeys is a helper method to define mock.On call
+//   - campaign *models.Campaign
+func (_e *MockCampaignAccessService_Expecter) PrepareCampaignKeys(campaign interface{}) *MockCampaignAccessService_PrepareCampaignKeys_Call {
+	return &MockCampaignAccessService_PrepareCampaignKeys_Call{Call: _e.mock.On("PrepareCampaignKeys", campaign)}
+}
+
+func (_c *MockCampaignAccessService_PrepareCampaignKeys_Call) Run(run func(campaign *models.Campaign)) *MockCampaignAccessService_PrepareCampaignKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareCampaignKeys_Call) Return(_a0 error) *MockCampaignAccessService_PrepareCampaignKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareCampaignKeys_Call) RunAndReturn(run func(*models.Campaign) error) *MockCampaignAccessService_PrepareCampaignKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrepareMemberKey provides a mock function with given fields: campaignID, campaignKey, contributor
+func (_m *MockCampaignAccessService) PrepareMemberKey(campaignID string, campaignKey string, contributor *models.Contributor) (*models.CampaignAccessKey, error) {
+	ret := _m.Called(campaignID, campaignKey, contributor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareMemberKey")
+	}
+
+	var r0 *models.CampaignAccessKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *models.Contributor) (*models.CampaignAccessKey, error)); ok {
+		return rf(campaignID, campaignKey, contributor)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *models.Contributor) *models.CampaignAccessKey); ok {
+		r0 = rf(campaignID, campaignKey, contributor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignAccessKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *models.Contributor) error); ok {
+		r1 = rf(campaignID, campaignKey, contributor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessService_PrepareMemberKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareMemberKey'
+type MockCampaignAccessService_PrepareMemberKey_Call struct {
+	*mock.Call
+}
+
+// PrepareMemberKey is a helper method to define mock.On call
+//   - campaignID string
+//   - campaignKey string
+//   - contributor *models.Contributor
+func (_e *MockCampaignAccessService_Expecter) PrepareMemberKey(campaignID interface{}, campaignKey interface{}, contributor interface{}) *MockCampaignAccessService_PrepareMemberKey_Call {
+	return &MockCampaignAccessService_PrepareMemberKey_Call{Call: _e.mock.On("PrepareMemberKey", campaignID, campaignKey, contributor)}
+}
+
+func (_c *MockCampaignAccessService_PrepareMemberKey_Call) Run(run func(campaignID string, campaignKey string, contributor *models.Contributor)) *MockCampaignAccessService_PrepareMemberKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareMemberKey_Call) Return(_a0 *models.CampaignAccessKey, _a1 error) *MockCampaignAccessService_PrepareMemberKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareMemberKey_Call) RunAndReturn(run func(string, string, *models.Contributor) (*models.CampaignAccessKey, error)) *MockCampaignAccessService_PrepareMemberKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrepareOwnerKeyTransfer provides a mock function with given fields: campaign, campaignKey, newOwnerEmail
+func (_m *MockCampaignAccessService) PrepareOwnerKeyTransfer(campaign *models.Campaign, campaignKey string, newOwnerEmail string) (*models.OwnerKeyTransfer, error) {
+	ret := _m.Called(campaign, campaignKey, newOwnerEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareOwnerKeyTransfer")
+	}
+
+	var r0 *models.OwnerKeyTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.Campaign, string, string) (*models.OwnerKeyTransfer, error)); ok {
+		return rf(campaign, campaignKey, newOwnerEmail)
+	}
+	if rf, ok := ret.Get(0).(func(*models.Campaign, string, string) *models.OwnerKeyTransfer); ok {
+		r0 = rf(campaign, campaignKey, newOwnerEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OwnerKeyTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.Campaign, string, string) error); ok {
+		r1 = rf(campaign, campaignKey, newOwnerEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessService_PrepareOwnerKeyTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareOwnerKeyTransfer'
+type MockCampaignAccessService_PrepareOwnerKeyTransfer_Call struct {
+	*mock.Call
+}
+
+// PrepareOwnerKeyTransfer is a helper method to define mock.On call
+//   - campaign *models.Campaign
+//   - campaignKey string
+//   - newOwnerEmail string
+func (_e *MockCampaignAccessService_Expecter) PrepareOwnerKeyTransfer(campaign interface{}, campaignKey interface{}, newOwnerEmail interface{}) *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call {
+	return &MockCampaignAccessService_PrepareOwnerKeyTransfer_Call{Call: _e.mock.On("PrepareOwnerKeyTransfer", campaign, campaignKey, newOwnerEmail)}
+}
+
+func (_c *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call) Run(run func(campaign *models.Campaign, campaignKey string, newOwnerEmail string)) *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call) Return(_a0 *models.OwnerKeyTransfer, _a1 error) *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call) RunAndReturn(run func(*models.Campaign, string, string) (*models.OwnerKeyTransfer, error)) *MockCampaignAccessService_PrepareOwnerKeyTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReissueContributorKey provides a mock function with given fields: campaignID, contributorID, key, userHandle
+func (_m *MockCampaignAccessService) ReissueContributorKey(campaignID string, contributorID uint, key string, userHandle string) error {
+	ret := _m.Called(campaignID, contributorID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReissueContributorKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, string, string) error); ok {
+		r0 = rf(campaignID, contributorID, key, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_ReissueContributorKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReissueContributorKey'
+type MockCampaignAccessService_ReissueContributorKey_Call struct {
+	*mock.Call
+}
+
+// ReissueContributorKey is a helper method to define mock.On call
+//   - campaignID string
+//   - contributorID uint
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignAccessService_Expecter) ReissueContributorKey(campaignID interface{}, contributorID interface{}, key interface{}, userHandle interface{}) *MockCampaignAccessService_ReissueContributorKey_Call {
+	return &MockCampaignAccessService_ReissueContributorKey_Call{Call: _e.mock.On("ReissueContributorKey", campaignID, contributorID, key, userHandle)}
+}
+
+func (_c *MockCampaignAccessService_ReissueContributorKey_Call) Run(run func(campaignID string, contributorID uint, key string, userHandle string)) *MockCampaignAccessService_ReissueContributorKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_ReissueContributorKey_Call) Return(_a0 error) *MockCampaignAccessService_ReissueContributorKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_ReissueContributorKey_Call) RunAndReturn(run func(string, uint, string, string) error) *MockCampaignAccessService_ReissueContributorKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreInvitationToken provides a mock function with given fields: contributor
+func (_m *MockCampaignAccessService) RestoreInvitationToken(contributor *models.Contributor) {
+	_m.Called(contributor)
+}
+
+// MockCampaignAccessService_RestoreInvitationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreInvitationToken'
+type MockCampaignAccessService_RestoreInvitationToken_Call struct {
+	*mock.Call
+}
+
+// RestoreInvitationToken is a helper method to define mock.On call
+//   - contributor *models.Contributor
+func (_e *MockCampaignAccessService_Expecter) RestoreInvitationToken(contributor interface{}) *MockCampaignAccessService_RestoreInvitationToken_Call {
+	return &MockCampaignAccessService_RestoreInvitationToken_Call{Call: _e.mock.On("RestoreInvitationToken", contributor)}
+}
+
+func (_c *MockCampaignAccessService_RestoreInvitationToken_Call) Run(run func(contributor *models.Contributor)) *MockCampaignAccessService_RestoreInvitationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RestoreInvitationToken_Call) Return() *MockCampaignAccessService_RestoreInvitationToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RestoreInvitationToken_Call) RunAndReturn(run func(*models.Contributor)) *MockCampaignAccessService_RestoreInvitationToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RevokeContributorKey provides a mock function with given fields: campaignID, contributorID, userHandle
+func (_m *MockCampaignAccessService) RevokeContributorKey(campaignID string, contributorID uint, userHandle string) error {
+	ret := _m.Called(campaignID, contributorID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeContributorKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, string) error); ok {
+		r0 = rf(campaignID, contributorID, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_RevokeContributorKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeContributorKey'
+type MockCampaignAccessService_RevokeContributorKey_Call struct {
+	*mock.Call
+}
+
+// RevokeContributorKey is a helper method to define mock.On call
+//   - campaignID string
+//   - contributorID uint
+//   - userHandle string
+func (_e *MockCampaignAccessService_Expecter) RevokeContributorKey(campaignID interface{}, contributorID interface{}, userHandle interface{}) *MockCampaignAccessService_RevokeContributorKey_Call {
+	return &MockCampaignAccessService_RevokeContributorKey_Call{Call: _e.mock.On("RevokeContributorKey", campaignID, contributorID, userHandle)}
+}
+
+func (_c *MockCampaignAccessService_RevokeContributorKey_Call) Run(run func(campaignID string, contributorID uint, userHandle string)) *MockCampaignAccessService_RevokeContributorKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RevokeContributorKey_Call) Return(_a0 error) *MockCampaignAccessService_RevokeContributorKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RevokeContributorKey_Call) RunAndReturn(run func(string, uint, string) error) *MockCampaignAccessService_RevokeContributorKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeMemberKeys provides a mock function with given fields: campaignID, email
+func (_m *MockCampaignAccessService) RevokeMemberKeys(campaignID string, email string) error {
+	ret := _m.Called(campaignID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeMemberKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(campaignID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCampaignAccessService_RevokeMemberKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeMemberKeys'
+type MockCampaignAccessService_RevokeMemberKeys_Call struct {
+	*mock.Call
+}
+
+// RevokeMemberKeys is a helper method to define mock.On call
+//   - campaignID string
+//   - email string
+func (_e *MockCampaignAccessService_Expecter) RevokeMemberKeys(campaignID interface{}, email interface{}) *MockCampaignAccessService_RevokeMemberKeys_Call {
+	return &MockCampaignAccessService_RevokeMemberKeys_Call{Call: _e.mock.On("RevokeMemberKeys", campaignID, email)}
+}
+
+func (_c *MockCampaignAccessService_RevokeMemberKeys_Call) Run(run func(campaignID string, email string)) *MockCampaignAccessService_RevokeMemberKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RevokeMemberKeys_Call) Return(_a0 error) *MockCampaignAccessService_RevokeMemberKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_RevokeMemberKeys_Call) RunAndReturn(run func(string, string) error) *MockCampaignAccessService_RevokeMemberKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyCampaignKey provides a mock function with given fields: campaignID, key, userEmail
+func (_m *MockCampaignAccessService) VerifyCampaignKey(campaignID string, key string, userEmail string) (string, error) {
+	ret := _m.Called(campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCampaignKey")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return rf(campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(campaignID, key, userEmail)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignAccessService_VerifyCampaignKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyCampaignKey'
+type MockCampaignAccessService_VerifyCampaignKey_Call struct {
+	*mock.Call
+}
+
+// VerifyCampaignKey is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockCampaignAccessService_Expecter) VerifyCampaignKey(campaignID interface{}, key interface{}, userEmail interface{}) *MockCampaignAccessService_VerifyCampaignKey_Call {
+	return &MockCampaignAccessService_VerifyCampaignKey_Call{Call: _e.mock.On("VerifyCampaignKey", campaignID, key, userEmail)}
+}
+
+func (_c *MockCampaignAccessService_VerifyCampaignKey_Call) Run(run func(campaignID string, key string, userEmail string)) *MockCampaignAccessService_VerifyCampaignKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignAccessService_VerifyCampaignKey_Call) Return(campaignKey string, err error) *MockCampaignAccessService_VerifyCampaignKey_Call {
+	_c.Call.Return(campaignKey, err)
+	return _c
+}
+
+func (_c *MockCampaignAccessService_VerifyCampaignKey_Call) RunAndReturn(run func(string, string, string) (string, error)) *MockCampaignAccessService_VerifyCampaignKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignAccessService creates a new instance of MockCampaignAccessService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignAccessService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignAccessService {
+	mock := &MockCampaignAccessService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,220 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	time "time"
+
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignDeadlineService is an autogenerated mock type for the CampaignDeadlineService type
+type MockCampaignDeadlineService struct {
+	mock.Mock
+}
+
+type MockCampaignDeadlineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignDeadlineService) EXPECT() *MockCampaignDeadlineService_Expecter {
+	return &MockCampaignDeadlineService_Expecter{mock: &_m.Mock}
+}
+
+// CloseCampaignEarly provides a mock function with given fields: campaignID, key, userHandle
+func (_m *MockCampaignDeadlineService) CloseCampaignEarly(campaignID string, key string, userHandle string) (*models.Campaign, error) {
+	ret := _m.Called(campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseCampaignEarly")
+	}
+
+	var r0 *models.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*models.Campaign, error)); ok {
+		return rf(campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *models.Campaign); ok {
+		r0 = rf(campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignDeadlineService_CloseCampaignEarly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseCampaignEarly'
+type MockCampaignDeadlineService_CloseCampaignEarly_Call struct {
+	*mock.Call
+}
+
+// CloseCampaignEarly is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignDeadlineService_Expecter) CloseCampaignEarly(campaignID interface{}, key interface{}, userHandle interface{}) *MockCampaignDeadlineService_CloseCampaignEarly_Call {
+	return &MockCampaignDeadlineService_CloseCampaignEarly_Call{Call: _e.mock.On("CloseCampaignEarly", campaignID, key, userHandle)}
+}
+
+func (_c *MockCampaignDeadlineService_CloseCampaignEarly_Call) Run(run func(campaignID string, key string, userHandle string)) *MockCampaignDeadlineService_CloseCampaignEarly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_CloseCampaignEarly_Call) Return(_a0 *models.Campaign, _a1 error) *MockCampaignDeadlineService_CloseCampaignEarly_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_CloseCampaignEarly_Call) RunAndReturn(run func(string, string, string) (*models.Campaign, error)) *MockCampaignDeadlineService_CloseCampaignEarly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtendCampaign provides a mock function with given fields: endDate, campaignID, key, userHandle
+func (_m *MockCampaignDeadlineService) ExtendCampaign(endDate time.Time, campaignID string, key string, userHandle string) (*models.CampaignExtension, error) {
+	ret := _m.Called(endDate, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtendCampaign")
+	}
+
+	var r0 *models.CampaignExtension
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, string, string, string) (*models.CampaignExtension, error)); ok {
+		return rf(endDate, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, string, string, string) *models.CampaignExtension); ok {
+		r0 = rf(endDate, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignExtension)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, string, string, string) error); ok {
+		r1 = rf(endDate, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignDeadlineService_ExtendCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtendCampaign'
+type MockCampaignDeadlineService_ExtendCampaign_Call struct {
+	*mock.Call
+}
+
+// ExtendCampaign is a helper method to define mock.On call
+//   - endDate time.Time
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignDeadlineService_Expecter) ExtendCampaign(endDate interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockCampaignDeadlineService_ExtendCampaign_Call {
+	return &MockCampaignDeadlineService_ExtendCampaign_Call{Call: _e.mock.On("ExtendCampaign", endDate, campaignID, key, userHandle)}
+}
+
+func (_c *MockCampaignDeadlineService_ExtendCampaign_Call) Run(run func(endDate time.Time, campaignID string, key string, userHandle string)) *MockCampaignDeadlineService_ExtendCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_ExtendCampaign_Call) Return(_a0 *models.CampaignExtension, _a1 error) *MockCampaignDeadlineService_ExtendCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_ExtendCampaign_Call) RunAndReturn(run func(time.Time, string, string, string) (*models.CampaignExtension, error)) *MockCampaignDeadlineService_ExtendCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoteOnExtension provides a mock function with given fields: extensionID, approve, campaignID, key, userEmail
+func (_m *MockCampaignDeadlineService) VoteOnExtension(extensionID uint, approve bool, campaignID string, key string, userEmail string) (*models.CampaignExtension, error) {
+	ret := _m.Called(extensionID, approve, campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteOnExtension")
+	}
+
+	var r0 *models.CampaignExtension
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, string, string, string) (*models.CampaignExtension, error)); ok {
+		return rf(extensionID, approve, campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, string, string, string) *models.CampaignExtension); ok {
+		r0 = rf(extensionID, approve, campaignID, key, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignExtension)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, string, string, string) error); ok {
+		r1 = rf(extensionID, approve, campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignDeadlineService_VoteOnExtension_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteOnExtension'
+type MockCampaignDeadlineService_VoteOnExtension_Call struct {
+	*mock.Call
+}
+
+// VoteOnExtension is a helper method to define mock.On call
+//   - extensionID uint
+//   - approve bool
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockCampaignDeadlineService_Expecter) VoteOnExtension(extensionID interface{}, approve interface{}, campaignID interface{}, key interface{}, userEmail interface{}) *MockCampaignDeadlineService_VoteOnExtension_Call {
+	return &MockCampaignDeadlineService_VoteOnExtension_Call{Call: _e.mock.On("VoteOnExtension", extensionID, approve, campaignID, key, userEmail)}
+}
+
+func (_c *MockCampaignDeadlineService_VoteOnExtension_Call) Run(run func(extensionID uint, approve bool, campaignID string, key string, userEmail string)) *MockCampaignDeadlineService_VoteOnExtension_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(bool), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_VoteOnExtension_Call) Return(_a0 *models.CampaignExtension, _a1 error) *MockCampaignDeadlineService_VoteOnExtension_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignDeadlineService_VoteOnExtension_Call) RunAndReturn(run func(uint, bool, string, string, string) (*models.CampaignExtension, error)) *MockCampaignDeadlineService_VoteOnExtension_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignDeadlineService creates a new instance of MockCampaignDeadlineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignDeadlineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignDeadlineService {
+	mock := &MockCampaignDeadlineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
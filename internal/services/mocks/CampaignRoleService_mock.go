@@ -0,0 +1,215 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCampaignRoleService is an autogenerated mock type for the CampaignRoleService type
+type MockCampaignRoleService struct {
+	mock.Mock
+}
+
+type MockCampaignRoleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignRoleService) EXPECT() *MockCampaignRoleService_Expecter {
+	return &MockCampaignRoleService_Expecter{mock: &_m.Mock}
+}
+
+// AssignRole provides a mock function with given fields: email, role, campaignID, key, userHandle
+func (_m *MockCampaignRoleService) AssignRole(email string, role models.CampaignRole, campaignID string, key string, userHandle string) (*models.CampaignUserRole, error) {
+	ret := _m.Called(email, role, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignRole")
+	}
+
+	var r0 *models.CampaignUserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.CampaignRole, string, string, string) (*models.CampaignUserRole, error)); ok {
+		return rf(email, role, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.CampaignRole, string, string, string) *models.CampaignUserRole); ok {
+		r0 = rf(email, role, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignUserRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.CampaignRole, string, string, string) error); ok {
+		r1 = rf(email, role, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRoleService_AssignRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignRole'
+type MockCampaignRoleService_AssignRole_Call struct {
+	*mock.Call
+}
+
+// AssignRole is a helper method to define mock.On call
+//   - email string
+//   - role models.CampaignRole
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignRoleService_Expecter) AssignRole(email interface{}, role interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockCampaignRoleService_AssignRole_Call {
+	return &MockCampaignRoleService_AssignRole_Call{Call: _e.mock.On("AssignRole", email, role, campaignID, key, userHandle)}
+}
+
+func (_c *MockCampaignRoleService_AssignRole_Call) Run(run func(email string, role models.CampaignRole, campaignID string, key string, userHandle string)) *MockCampaignRoleService_AssignRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(models.CampaignRole), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleService_AssignRole_Call) Return(_a0 *models.CampaignUserRole, _a1 error) *MockCampaignRoleService_AssignRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRoleService_AssignRole_Call) RunAndReturn(run func(string, models.CampaignRole, string, string, string) (*models.CampaignUserRole, error)) *MockCampaignRoleService_AssignRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignRoles provides a mock function with given fields: campaignID, key
+func (_m *MockCampaignRoleService) GetCampaignRoles(campaignID string, key string) ([]models.CampaignUserRole, error) {
+	ret := _m.Called(campaignID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignRoles")
+	}
+
+	var r0 []models.CampaignUserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.CampaignUserRole, error)); ok {
+		return rf(campaignID, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.CampaignUserRole); ok {
+		r0 = rf(campaignID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignUserRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRoleService_GetCampaignRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignRoles'
+type MockCampaignRoleService_GetCampaignRoles_Call struct {
+	*mock.Call
+}
+
+// GetCampaignRoles is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+func (_e *MockCampaignRoleService_Expecter) GetCampaignRoles(campaignID interface{}, key interface{}) *MockCampaignRoleService_GetCampaignRoles_Call {
+	return &MockCampaignRoleService_GetCampaignRoles_Call{Call: _e.mock.On("GetCampaignRoles", campaignID, key)}
+}
+
+func (_c *MockCampaignRoleService_GetCampaignRoles_Call) Run(run func(campaignID string, key string)) *MockCampaignRoleService_GetCampaignRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleService_GetCampaignRoles_Call) Return(_a0 []models.CampaignUserRole, _a1 error) *MockCampaignRoleService_GetCampaignRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRoleService_GetCampaignRoles_Call) RunAndReturn(run func(string, string) ([]models.CampaignUserRole, error)) *MockCampaignRoleService_GetCampaignRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferOwnership provides a mock function with given fields: email, campaignID, key, userHandle
+func (_m *MockCampaignRoleService) TransferOwnership(email string, campaignID string, key string, userHandle string) (string, error) {
+	ret := _m.Called(email, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwnership")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (string, error)); ok {
+		return rf(email, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) string); ok {
+		r0 = rf(email, campaignID, key, userHandle)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(email, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRoleService_TransferOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferOwnership'
+type MockCampaignRoleService_TransferOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferOwnership is a helper method to define mock.On call
+//   - email string
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignRoleService_Expecter) TransferOwnership(email interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockCampaignRoleService_TransferOwnership_Call {
+	return &MockCampaignRoleService_TransferOwnership_Call{Call: _e.mock.On("TransferOwnership", email, campaignID, key, userHandle)}
+}
+
+func (_c *MockCampaignRoleService_TransferOwnership_Call) Run(run func(email string, campaignID string, key string, userHandle string)) *MockCampaignRoleService_TransferOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRoleService_TransferOwnership_Call) Return(previousOwnerKey string, err error) *MockCampaignRoleService_TransferOwnership_Call {
+	_c.Call.Return(previousOwnerKey, err)
+	return _c
+}
+
+func (_c *MockCampaignRoleService_TransferOwnership_Call) RunAndReturn(run func(string, string, string, string) (string, error)) *MockCampaignRoleService_TransferOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignRoleService creates a new instance of MockCampaignRoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignRoleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignRoleService {
+	mock := &MockCampaignRoleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,6 +23,39 @@ func (_m *MockCampaignService) EXPECT() *MockCampaignService_Expecter {
 	return &MockCampaignService_Expecter{mock: &_m.Mock}
 }
 
+// CheckMilestones provides a mock function with given fields: campaignID
+func (_m *MockCampaignService) CheckMilestones(campaignID string) {
+	_m.Called(campaignID)
+}
+
+// MockCampaignService_CheckMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckMilestones'
+type MockCampaignService_CheckMilestones_Call struct {
+	*mock.Call
+}
+
+// CheckMilestones is a helper method to define mock.On call
+//   - campaignID string
+func (_e *MockCampaignService_Expecter) CheckMilestones(campaignID interface{}) *MockCampaignService_CheckMilestones_Call {
+	return &MockCampaignService_CheckMilestones_Call{Call: _e.mock.On("CheckMilestones", campaignID)}
+}
+
+func (_c *MockCampaignService_CheckMilestones_Call) Run(run func(campaignID string)) *MockCampaignService_CheckMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_CheckMilestones_Call) Return() *MockCampaignService_CheckMilestones_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCampaignService_CheckMilestones_Call) RunAndReturn(run func(string)) *MockCampaignService_CheckMilestones_Call {
+	_c.Run(run)
+	return _c
+}
+
 // CreateCampaign provides a mock function with given fields: campaign, userHandle
 func (_m *MockCampaignService) CreateCampaign(campaign *models.Campaign, userHandle string) (models.Campaign, error) {
 	ret := _m.Called(campaign, userHandle)
@@ -472,6 +505,120 @@ func (_c *MockCampaignService_GetNearEndCampaigns_Call) RunAndReturn(run func()
 	return _c
 }
 
+// GetPublicCampaignBySlug provides a mock function with given fields: slug
+func (_m *MockCampaignService) GetPublicCampaignBySlug(slug string) (*models.Campaign, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicCampaignBySlug")
+	}
+
+	var r0 *models.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Campaign, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Campaign); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_GetPublicCampaignBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicCampaignBySlug'
+type MockCampaignService_GetPublicCampaignBySlug_Call struct {
+	*mock.Call
+}
+
+// GetPublicCampaignBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MockCampaignService_Expecter) GetPublicCampaignBySlug(slug interface{}) *MockCampaignService_GetPublicCampaignBySlug_Call {
+	return &MockCampaignService_GetPublicCampaignBySlug_Call{Call: _e.mock.On("GetPublicCampaignBySlug", slug)}
+}
+
+func (_c *MockCampaignService_GetPublicCampaignBySlug_Call) Run(run func(slug string)) *MockCampaignService_GetPublicCampaignBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_GetPublicCampaignBySlug_Call) Return(_a0 *models.Campaign, _a1 error) *MockCampaignService_GetPublicCampaignBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_GetPublicCampaignBySlug_Call) RunAndReturn(run func(string) (*models.Campaign, error)) *MockCampaignService_GetPublicCampaignBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasCampaign provides a mock function with given fields: userHandle
+func (_m *MockCampaignService) HasCampaign(userHandle string) (bool, error) {
+	ret := _m.Called(userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasCampaign")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(userHandle)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_HasCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasCampaign'
+type MockCampaignService_HasCampaign_Call struct {
+	*mock.Call
+}
+
+// HasCampaign is a helper method to define mock.On call
+//   - userHandle string
+func (_e *MockCampaignService_Expecter) HasCampaign(userHandle interface{}) *MockCampaignService_HasCampaign_Call {
+	return &MockCampaignService_HasCampaign_Call{Call: _e.mock.On("HasCampaign", userHandle)}
+}
+
+func (_c *MockCampaignService_HasCampaign_Call) Run(run func(userHandle string)) *MockCampaignService_HasCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_HasCampaign_Call) Return(_a0 bool, _a1 error) *MockCampaignService_HasCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_HasCampaign_Call) RunAndReturn(run func(string) (bool, error)) *MockCampaignService_HasCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecalculateTargetAmount provides a mock function with given fields: campaignID
 func (_m *MockCampaignService) RecalculateTargetAmount(campaignID string) {
 	_m.Called(campaignID)
@@ -566,6 +713,67 @@ func (_c *MockCampaignService_UpdateCampaign_Call) RunAndReturn(run func(dto.Cam
 	return _c
 }
 
+// UpdateCampaignVisibility provides a mock function with given fields: visibility, campaignID, key, userHandle
+func (_m *MockCampaignService) UpdateCampaignVisibility(visibility models.CampaignVisibility, campaignID string, key string, userHandle string) (*models.Campaign, error) {
+	ret := _m.Called(visibility, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampaignVisibility")
+	}
+
+	var r0 *models.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.CampaignVisibility, string, string, string) (*models.Campaign, error)); ok {
+		return rf(visibility, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(models.CampaignVisibility, string, string, string) *models.Campaign); ok {
+		r0 = rf(visibility, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CampaignVisibility, string, string, string) error); ok {
+		r1 = rf(visibility, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_UpdateCampaignVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCampaignVisibility'
+type MockCampaignService_UpdateCampaignVisibility_Call struct {
+	*mock.Call
+}
+
+// UpdateCampaignVisibility is a helper method to define mock.On call
+//   - visibility models.CampaignVisibility
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockCampaignService_Expecter) UpdateCampaignVisibility(visibility interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockCampaignService_UpdateCampaignVisibility_Call {
+	return &MockCampaignService_UpdateCampaignVisibility_Call{Call: _e.mock.On("UpdateCampaignVisibility", visibility, campaignID, key, userHandle)}
+}
+
+func (_c *MockCampaignService_UpdateCampaignVisibility_Call) Run(run func(visibility models.CampaignVisibility, campaignID string, key string, userHandle string)) *MockCampaignService_UpdateCampaignVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.CampaignVisibility), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_UpdateCampaignVisibility_Call) Return(_a0 *models.Campaign, _a1 error) *MockCampaignService_UpdateCampaignVisibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_UpdateCampaignVisibility_Call) RunAndReturn(run func(models.CampaignVisibility, string, string, string) (*models.Campaign, error)) *MockCampaignService_UpdateCampaignVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCampaignService creates a new instance of MockCampaignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCampaignService(t interface {
@@ -0,0 +1,256 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockChatService is an autogenerated mock type for the ChatService type
+type MockChatService struct {
+	mock.Mock
+}
+
+type MockChatService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChatService) EXPECT() *MockChatService_Expecter {
+	return &MockChatService_Expecter{mock: &_m.Mock}
+}
+
+// GetHistory provides a mock function with given fields: campaignID, key, userEmail, before, after, limit
+func (_m *MockChatService) GetHistory(campaignID string, key string, userEmail string, before string, after string, limit int) ([]models.ChatMessage, error) {
+	ret := _m.Called(campaignID, key, userEmail, before, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []models.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int) ([]models.ChatMessage, error)); ok {
+		return rf(campaignID, key, userEmail, before, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int) []models.ChatMessage); ok {
+		r0 = rf(campaignID, key, userEmail, before, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, int) error); ok {
+		r1 = rf(campaignID, key, userEmail, before, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatService_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockChatService_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+//   - before string
+//   - after string
+//   - limit int
+func (_e *MockChatService_Expecter) GetHistory(campaignID interface{}, key interface{}, userEmail interface{}, before interface{}, after interface{}, limit interface{}) *MockChatService_GetHistory_Call {
+	return &MockChatService_GetHistory_Call{Call: _e.mock.On("GetHistory", campaignID, key, userEmail, before, after, limit)}
+}
+
+func (_c *MockChatService_GetHistory_Call) Run(run func(campaignID string, key string, userEmail string, before string, after string, limit int)) *MockChatService_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockChatService_GetHistory_Call) Return(_a0 []models.ChatMessage, _a1 error) *MockChatService_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatService_GetHistory_Call) RunAndReturn(run func(string, string, string, string, string, int) ([]models.ChatMessage, error)) *MockChatService_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordReceipt provides a mock function with given fields: campaignID, userHandle, messageID, status
+func (_m *MockChatService) RecordReceipt(campaignID string, userHandle string, messageID string, status models.ChatReceiptStatus) (*models.ChatReceipt, error) {
+	ret := _m.Called(campaignID, userHandle, messageID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordReceipt")
+	}
+
+	var r0 *models.ChatReceipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, models.ChatReceiptStatus) (*models.ChatReceipt, error)); ok {
+		return rf(campaignID, userHandle, messageID, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, models.ChatReceiptStatus) *models.ChatReceipt); ok {
+		r0 = rf(campaignID, userHandle, messageID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChatReceipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, models.ChatReceiptStatus) error); ok {
+		r1 = rf(campaignID, userHandle, messageID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatService_RecordReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordReceipt'
+type MockChatService_RecordReceipt_Call struct {
+	*mock.Call
+}
+
+// RecordReceipt is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+//   - messageID string
+//   - status models.ChatReceiptStatus
+func (_e *MockChatService_Expecter) RecordReceipt(campaignID interface{}, userHandle interface{}, messageID interface{}, status interface{}) *MockChatService_RecordReceipt_Call {
+	return &MockChatService_RecordReceipt_Call{Call: _e.mock.On("RecordReceipt", campaignID, userHandle, messageID, status)}
+}
+
+func (_c *MockChatService_RecordReceipt_Call) Run(run func(campaignID string, userHandle string, messageID string, status models.ChatReceiptStatus)) *MockChatService_RecordReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(models.ChatReceiptStatus))
+	})
+	return _c
+}
+
+func (_c *MockChatService_RecordReceipt_Call) Return(_a0 *models.ChatReceipt, _a1 error) *MockChatService_RecordReceipt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatService_RecordReceipt_Call) RunAndReturn(run func(string, string, string, models.ChatReceiptStatus) (*models.ChatReceipt, error)) *MockChatService_RecordReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function with given fields: campaignID, key, userHandle, content, clientID
+func (_m *MockChatService) SendMessage(campaignID string, key string, userHandle string, content string, clientID string) (*models.ChatMessage, error) {
+	ret := _m.Called(campaignID, key, userHandle, content, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 *models.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string) (*models.ChatMessage, error)); ok {
+		return rf(campaignID, key, userHandle, content, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string) *models.ChatMessage); ok {
+		r0 = rf(campaignID, key, userHandle, content, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle, content, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChatService_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockChatService_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - content string
+//   - clientID string
+func (_e *MockChatService_Expecter) SendMessage(campaignID interface{}, key interface{}, userHandle interface{}, content interface{}, clientID interface{}) *MockChatService_SendMessage_Call {
+	return &MockChatService_SendMessage_Call{Call: _e.mock.On("SendMessage", campaignID, key, userHandle, content, clientID)}
+}
+
+func (_c *MockChatService_SendMessage_Call) Run(run func(campaignID string, key string, userHandle string, content string, clientID string)) *MockChatService_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockChatService_SendMessage_Call) Return(_a0 *models.ChatMessage, _a1 error) *MockChatService_SendMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChatService_SendMessage_Call) RunAndReturn(run func(string, string, string, string, string) (*models.ChatMessage, error)) *MockChatService_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTyping provides a mock function with given fields: campaignID, userHandle, typing
+func (_m *MockChatService) SetTyping(campaignID string, userHandle string, typing bool) {
+	_m.Called(campaignID, userHandle, typing)
+}
+
+// MockChatService_SetTyping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTyping'
+type MockChatService_SetTyping_Call struct {
+	*mock.Call
+}
+
+// SetTyping is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+//   - typing bool
+func (_e *MockChatService_Expecter) SetTyping(campaignID interface{}, userHandle interface{}, typing interface{}) *MockChatService_SetTyping_Call {
+	return &MockChatService_SetTyping_Call{Call: _e.mock.On("SetTyping", campaignID, userHandle, typing)}
+}
+
+func (_c *MockChatService_SetTyping_Call) Run(run func(campaignID string, userHandle string, typing bool)) *MockChatService_SetTyping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockChatService_SetTyping_Call) Return() *MockChatService_SetTyping_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockChatService_SetTyping_Call) RunAndReturn(run func(string, string, bool)) *MockChatService_SetTyping_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockChatService creates a new instance of MockChatService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChatService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChatService {
+	mock := &MockChatService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
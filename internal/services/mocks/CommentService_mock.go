@@ -20,6 +20,67 @@ func (_m *MockCommentService) EXPECT() *MockCommentService_Expecter {
 	return &MockCommentService_Expecter{mock: &_m.Mock}
 }
 
+// AddReaction provides a mock function with given fields: commentID, campaignID, userHandle, emoji
+func (_m *MockCommentService) AddReaction(commentID string, campaignID string, userHandle string, emoji string) (*models.Comment, error) {
+	ret := _m.Called(commentID, campaignID, userHandle, emoji)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.Comment, error)); ok {
+		return rf(commentID, campaignID, userHandle, emoji)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Comment); ok {
+		r0 = rf(commentID, campaignID, userHandle, emoji)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(commentID, campaignID, userHandle, emoji)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type MockCommentService_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - commentID string
+//   - campaignID string
+//   - userHandle string
+//   - emoji string
+func (_e *MockCommentService_Expecter) AddReaction(commentID interface{}, campaignID interface{}, userHandle interface{}, emoji interface{}) *MockCommentService_AddReaction_Call {
+	return &MockCommentService_AddReaction_Call{Call: _e.mock.On("AddReaction", commentID, campaignID, userHandle, emoji)}
+}
+
+func (_c *MockCommentService_AddReaction_Call) Run(run func(commentID string, campaignID string, userHandle string, emoji string)) *MockCommentService_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_AddReaction_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentService_AddReaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_AddReaction_Call) RunAndReturn(run func(string, string, string, string) (*models.Comment, error)) *MockCommentService_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateComment provides a mock function with given fields: comment, campaignID, activityID, userHandle
 func (_m *MockCommentService) CreateComment(comment *models.Comment, campaignID string, activityID uint, userHandle string) error {
 	ret := _m.Called(comment, campaignID, activityID, userHandle)
@@ -69,17 +130,17 @@ func (_c *MockCommentService_CreateComment_Call) RunAndReturn(run func(*models.C
 	return _c
 }
 
-// DeleteComment provides a mock function with given fields: commentID, userHandle
-func (_m *MockCommentService) DeleteComment(commentID string, userHandle string) error {
-	ret := _m.Called(commentID, userHandle)
+// DeleteComment provides a mock function with given fields: commentID, campaignID, userHandle
+func (_m *MockCommentService) DeleteComment(commentID string, campaignID string, userHandle string) error {
+	ret := _m.Called(commentID, campaignID, userHandle)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteComment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(commentID, userHandle)
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(commentID, campaignID, userHandle)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -94,14 +155,15 @@ type MockCommentService_DeleteComment_Call struct {
 
 // DeleteComment is a helper method to define mock.On call
 //   - commentID string
+//   - campaignID string
 //   - userHandle string
-func (_e *MockCommentService_Expecter) DeleteComment(commentID interface{}, userHandle interface{}) *MockCommentService_DeleteComment_Call {
-	return &MockCommentService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", commentID, userHandle)}
+func (_e *MockCommentService_Expecter) DeleteComment(commentID interface{}, campaignID interface{}, userHandle interface{}) *MockCommentService_DeleteComment_Call {
+	return &MockCommentService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", commentID, campaignID, userHandle)}
 }
 
-func (_c *MockCommentService_DeleteComment_Call) Run(run func(commentID string, userHandle string)) *MockCommentService_DeleteComment_Call {
+func (_c *MockCommentService_DeleteComment_Call) Run(run func(commentID string, campaignID string, userHandle string)) *MockCommentService_DeleteComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -111,34 +173,32 @@ func (_c *MockCommentService_DeleteComment_Call) Return(_a0 error) *MockCommentS
 	return _c
 }
 
-func (_c *MockCommentService_DeleteComment_Call) RunAndReturn(run func(string, string) error) *MockCommentService_DeleteComment_Call {
+func (_c *MockCommentService_DeleteComment_Call) RunAndReturn(run func(string, string, string) error) *MockCommentService_DeleteComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetActivityComments provides a mock function with given fields: activityID
-func (_m *MockCommentService) GetActivityComments(activityID uint) ([]models.Comment, error) {
-	ret := _m.Called(activityID)
+// GetActivityComments provides a mock function with given fields: activityID, cursor, limit
+func (_m *MockCommentService) GetActivityComments(activityID uint, cursor string, limit int) (models.CommentPage, error) {
+	ret := _m.Called(activityID, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetActivityComments")
 	}
 
-	var r0 []models.Comment
+	var r0 models.CommentPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint) ([]models.Comment, error)); ok {
-		return rf(activityID)
+	if rf, ok := ret.Get(0).(func(uint, string, int) (models.CommentPage, error)); ok {
+		return rf(activityID, cursor, limit)
 	}
-	if rf, ok := ret.Get(0).(func(uint) []models.Comment); ok {
-		r0 = rf(activityID)
+	if rf, ok := ret.Get(0).(func(uint, string, int) models.CommentPage); ok {
+		r0 = rf(activityID, cursor, limit)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Comment)
-		}
+		r0 = ret.Get(0).(models.CommentPage)
 	}
 
-	if rf, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = rf(activityID)
+	if rf, ok := ret.Get(1).(func(uint, string, int) error); ok {
+		r1 = rf(activityID, cursor, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,35 +213,212 @@ type MockCommentService_GetActivityComments_Call struct {
 
 // GetActivityComments is a helper method to define mock.On call
 //   - activityID uint
-func (_e *MockCommentService_Expecter) GetActivityComments(activityID interface{}) *MockCommentService_GetActivityComments_Call {
-	return &MockCommentService_GetActivityComments_Call{Call: _e.mock.On("GetActivityComments", activityID)}
+//   - cursor string
+//   - limit int
+func (_e *MockCommentService_Expecter) GetActivityComments(activityID interface{}, cursor interface{}, limit interface{}) *MockCommentService_GetActivityComments_Call {
+	return &MockCommentService_GetActivityComments_Call{Call: _e.mock.On("GetActivityComments", activityID, cursor, limit)}
 }
 
-func (_c *MockCommentService_GetActivityComments_Call) Run(run func(activityID uint)) *MockCommentService_GetActivityComments_Call {
+func (_c *MockCommentService_GetActivityComments_Call) Run(run func(activityID uint, cursor string, limit int)) *MockCommentService_GetActivityComments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(string), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockCommentService_GetActivityComments_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentService_GetActivityComments_Call {
+func (_c *MockCommentService_GetActivityComments_Call) Return(_a0 models.CommentPage, _a1 error) *MockCommentService_GetActivityComments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentService_GetActivityComments_Call) RunAndReturn(run func(uint) ([]models.Comment, error)) *MockCommentService_GetActivityComments_Call {
+func (_c *MockCommentService_GetActivityComments_Call) RunAndReturn(run func(uint, string, int) (models.CommentPage, error)) *MockCommentService_GetActivityComments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCommentReplies provides a mock function with given fields: commentID
-func (_m *MockCommentService) GetCommentReplies(commentID string) ([]models.Comment, error) {
-	ret := _m.Called(commentID)
+// GetCommentReplies provides a mock function with given fields: commentID, cursor, limit
+func (_m *MockCommentService) GetCommentReplies(commentID string, cursor string, limit int) (models.CommentPage, error) {
+	ret := _m.Called(commentID, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCommentReplies")
 	}
 
+	var r0 models.CommentPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int) (models.CommentPage, error)); ok {
+		return rf(commentID, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) models.CommentPage); ok {
+		r0 = rf(commentID, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(models.CommentPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(commentID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_GetCommentReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentReplies'
+type MockCommentService_GetCommentReplies_Call struct {
+	*mock.Call
+}
+
+// GetCommentReplies is a helper method to define mock.On call
+//   - commentID string
+//   - cursor string
+//   - limit int
+func (_e *MockCommentService_Expecter) GetCommentReplies(commentID interface{}, cursor interface{}, limit interface{}) *MockCommentService_GetCommentReplies_Call {
+	return &MockCommentService_GetCommentReplies_Call{Call: _e.mock.On("GetCommentReplies", commentID, cursor, limit)}
+}
+
+func (_c *MockCommentService_GetCommentReplies_Call) Run(run func(commentID string, cursor string, limit int)) *MockCommentService_GetCommentReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentReplies_Call) Return(_a0 models.CommentPage, _a1 error) *MockCommentService_GetCommentReplies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentReplies_Call) RunAndReturn(run func(string, string, int) (models.CommentPage, error)) *MockCommentService_GetCommentReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentReports provides a mock function with given fields: campaignID, userHandle
+func (_m *MockCommentService) GetCommentReports(campaignID string, userHandle string) ([]models.CommentReport, error) {
+	ret := _m.Called(campaignID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentReports")
+	}
+
+	var r0 []models.CommentReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.CommentReport, error)); ok {
+		return rf(campaignID, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.CommentReport); ok {
+		r0 = rf(campaignID, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_GetCommentReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentReports'
+type MockCommentService_GetCommentReports_Call struct {
+	*mock.Call
+}
+
+// GetCommentReports is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+func (_e *MockCommentService_Expecter) GetCommentReports(campaignID interface{}, userHandle interface{}) *MockCommentService_GetCommentReports_Call {
+	return &MockCommentService_GetCommentReports_Call{Call: _e.mock.On("GetCommentReports", campaignID, userHandle)}
+}
+
+func (_c *MockCommentService_GetCommentReports_Call) Run(run func(campaignID string, userHandle string)) *MockCommentService_GetCommentReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentReports_Call) Return(_a0 []models.CommentReport, _a1 error) *MockCommentService_GetCommentReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentReports_Call) RunAndReturn(run func(string, string) ([]models.CommentReport, error)) *MockCommentService_GetCommentReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentRevisions provides a mock function with given fields: commentID
+func (_m *MockCommentService) GetCommentRevisions(commentID string) ([]models.CommentRevision, error) {
+	ret := _m.Called(commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentRevisions")
+	}
+
+	var r0 []models.CommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.CommentRevision, error)); ok {
+		return rf(commentID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.CommentRevision); ok {
+		r0 = rf(commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_GetCommentRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentRevisions'
+type MockCommentService_GetCommentRevisions_Call struct {
+	*mock.Call
+}
+
+// GetCommentRevisions is a helper method to define mock.On call
+//   - commentID string
+func (_e *MockCommentService_Expecter) GetCommentRevisions(commentID interface{}) *MockCommentService_GetCommentRevisions_Call {
+	return &MockCommentService_GetCommentRevisions_Call{Call: _e.mock.On("GetCommentRevisions", commentID)}
+}
+
+func (_c *MockCommentService_GetCommentRevisions_Call) Run(run func(commentID string)) *MockCommentService_GetCommentRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentRevisions_Call) Return(_a0 []models.CommentRevision, _a1 error) *MockCommentService_GetCommentRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_GetCommentRevisions_Call) RunAndReturn(run func(string) ([]models.CommentRevision, error)) *MockCommentService_GetCommentRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommentThread provides a mock function with given fields: commentID
+func (_m *MockCommentService) GetCommentThread(commentID string) ([]models.Comment, error) {
+	ret := _m.Called(commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentThread")
+	}
+
 	var r0 []models.Comment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) ([]models.Comment, error)); ok {
@@ -204,45 +441,163 @@ func (_m *MockCommentService) GetCommentReplies(commentID string) ([]models.Comm
 	return r0, r1
 }
 
-// MockCommentService_GetCommentReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentReplies'
-type MockCommentService_GetCommentReplies_Call struct {
+// MockCommentService_GetCommentThread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentThread'
+type MockCommentService_GetCommentThread_Call struct {
 	*mock.Call
 }
 
-// GetCommentReplies is a helper method to define mock.On call
+// GetCommentThread is a helper method to define mock.On call
 //   - commentID string
-func (_e *MockCommentService_Expecter) GetCommentReplies(commentID interface{}) *MockCommentService_GetCommentReplies_Call {
-	return &MockCommentService_GetCommentReplies_Call{Call: _e.mock.On("GetCommentReplies", commentID)}
+func (_e *MockCommentService_Expecter) GetCommentThread(commentID interface{}) *MockCommentService_GetCommentThread_Call {
+	return &MockCommentService_GetCommentThread_Call{Call: _e.mock.On("GetCommentThread", commentID)}
 }
 
-func (_c *MockCommentService_GetCommentReplies_Call) Run(run func(commentID string)) *MockCommentService_GetCommentReplies_Call {
+func (_c *MockCommentService_GetCommentThread_Call) Run(run func(commentID string)) *MockCommentService_GetCommentThread_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockCommentService_GetCommentReplies_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentService_GetCommentReplies_Call {
+func (_c *MockCommentService_GetCommentThread_Call) Return(_a0 []models.Comment, _a1 error) *MockCommentService_GetCommentThread_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentService_GetCommentReplies_Call) RunAndReturn(run func(string) ([]models.Comment, error)) *MockCommentService_GetCommentReplies_Call {
+func (_c *MockCommentService_GetCommentThread_Call) RunAndReturn(run func(string) ([]models.Comment, error)) *MockCommentService_GetCommentThread_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateComment provides a mock function with given fields: comment, userHandle
-func (_m *MockCommentService) UpdateComment(comment models.Comment, userHandle string) error {
-	ret := _m.Called(comment, userHandle)
+// GetModerationLog provides a mock function with given fields: campaignID, userHandle
+func (_m *MockCommentService) GetModerationLog(campaignID string, userHandle string) ([]models.CommentModerationLog, error) {
+	ret := _m.Called(campaignID, userHandle)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateComment")
+		panic("no return value specified for GetModerationLog")
+	}
+
+	var r0 []models.CommentModerationLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]models.CommentModerationLog, error)); ok {
+		return rf(campaignID, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []models.CommentModerationLog); ok {
+		r0 = rf(campaignID, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentModerationLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_GetModerationLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModerationLog'
+type MockCommentService_GetModerationLog_Call struct {
+	*mock.Call
+}
+
+// GetModerationLog is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+func (_e *MockCommentService_Expecter) GetModerationLog(campaignID interface{}, userHandle interface{}) *MockCommentService_GetModerationLog_Call {
+	return &MockCommentService_GetModerationLog_Call{Call: _e.mock.On("GetModerationLog", campaignID, userHandle)}
+}
+
+func (_c *MockCommentService_GetModerationLog_Call) Run(run func(campaignID string, userHandle string)) *MockCommentService_GetModerationLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetModerationLog_Call) Return(_a0 []models.CommentModerationLog, _a1 error) *MockCommentService_GetModerationLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_GetModerationLog_Call) RunAndReturn(run func(string, string) ([]models.CommentModerationLog, error)) *MockCommentService_GetModerationLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnreadSummary provides a mock function with given fields: campaignID, userHandle
+func (_m *MockCommentService) GetUnreadSummary(campaignID string, userHandle string) (*models.CampaignUnreadComments, error) {
+	ret := _m.Called(campaignID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnreadSummary")
+	}
+
+	var r0 *models.CampaignUnreadComments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.CampaignUnreadComments, error)); ok {
+		return rf(campaignID, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.CampaignUnreadComments); ok {
+		r0 = rf(campaignID, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignUnreadComments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_GetUnreadSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnreadSummary'
+type MockCommentService_GetUnreadSummary_Call struct {
+	*mock.Call
+}
+
+// GetUnreadSummary is a helper method to define mock.On call
+//   - campaignID string
+//   - userHandle string
+func (_e *MockCommentService_Expecter) GetUnreadSummary(campaignID interface{}, userHandle interface{}) *MockCommentService_GetUnreadSummary_Call {
+	return &MockCommentService_GetUnreadSummary_Call{Call: _e.mock.On("GetUnreadSummary", campaignID, userHandle)}
+}
+
+func (_c *MockCommentService_GetUnreadSummary_Call) Run(run func(campaignID string, userHandle string)) *MockCommentService_GetUnreadSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_GetUnreadSummary_Call) Return(_a0 *models.CampaignUnreadComments, _a1 error) *MockCommentService_GetUnreadSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_GetUnreadSummary_Call) RunAndReturn(run func(string, string) (*models.CampaignUnreadComments, error)) *MockCommentService_GetUnreadSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCommentsRead provides a mock function with given fields: activityID, campaignID, userHandle
+func (_m *MockCommentService) MarkCommentsRead(activityID uint, campaignID string, userHandle string) error {
+	ret := _m.Called(activityID, campaignID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCommentsRead")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Comment, string) error); ok {
-		r0 = rf(comment, userHandle)
+	if rf, ok := ret.Get(0).(func(uint, string, string) error); ok {
+		r0 = rf(activityID, campaignID, userHandle)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -250,6 +605,250 @@ func (_m *MockCommentService) UpdateComment(comment models.Comment, userHandle s
 	return r0
 }
 
+// MockCommentService_MarkCommentsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCommentsRead'
+type MockCommentService_MarkCommentsRead_Call struct {
+	*mock.Call
+}
+
+// MarkCommentsRead is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - userHandle string
+func (_e *MockCommentService_Expecter) MarkCommentsRead(activityID interface{}, campaignID interface{}, userHandle interface{}) *MockCommentService_MarkCommentsRead_Call {
+	return &MockCommentService_MarkCommentsRead_Call{Call: _e.mock.On("MarkCommentsRead", activityID, campaignID, userHandle)}
+}
+
+func (_c *MockCommentService_MarkCommentsRead_Call) Run(run func(activityID uint, campaignID string, userHandle string)) *MockCommentService_MarkCommentsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_MarkCommentsRead_Call) Return(_a0 error) *MockCommentService_MarkCommentsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentService_MarkCommentsRead_Call) RunAndReturn(run func(uint, string, string) error) *MockCommentService_MarkCommentsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ModerateComment provides a mock function with given fields: commentID, campaignID, userHandle, action, reason
+func (_m *MockCommentService) ModerateComment(commentID string, campaignID string, userHandle string, action models.CommentModerationAction, reason string) (*models.Comment, error) {
+	ret := _m.Called(commentID, campaignID, userHandle, action, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModerateComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, models.CommentModerationAction, string) (*models.Comment, error)); ok {
+		return rf(commentID, campaignID, userHandle, action, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, models.CommentModerationAction, string) *models.Comment); ok {
+		r0 = rf(commentID, campaignID, userHandle, action, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, models.CommentModerationAction, string) error); ok {
+		r1 = rf(commentID, campaignID, userHandle, action, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_ModerateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModerateComment'
+type MockCommentService_ModerateComment_Call struct {
+	*mock.Call
+}
+
+// ModerateComment is a helper method to define mock.On call
+//   - commentID string
+//   - campaignID string
+//   - userHandle string
+//   - action models.CommentModerationAction
+//   - reason string
+func (_e *MockCommentService_Expecter) ModerateComment(commentID interface{}, campaignID interface{}, userHandle interface{}, action interface{}, reason interface{}) *MockCommentService_ModerateComment_Call {
+	return &MockCommentService_ModerateComment_Call{Call: _e.mock.On("ModerateComment", commentID, campaignID, userHandle, action, reason)}
+}
+
+func (_c *MockCommentService_ModerateComment_Call) Run(run func(commentID string, campaignID string, userHandle string, action models.CommentModerationAction, reason string)) *MockCommentService_ModerateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(models.CommentModerationAction), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_ModerateComment_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentService_ModerateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_ModerateComment_Call) RunAndReturn(run func(string, string, string, models.CommentModerationAction, string) (*models.Comment, error)) *MockCommentService_ModerateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: commentID, campaignID, userHandle, emoji
+func (_m *MockCommentService) RemoveReaction(commentID string, campaignID string, userHandle string, emoji string) (*models.Comment, error) {
+	ret := _m.Called(commentID, campaignID, userHandle, emoji)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.Comment, error)); ok {
+		return rf(commentID, campaignID, userHandle, emoji)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Comment); ok {
+		r0 = rf(commentID, campaignID, userHandle, emoji)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(commentID, campaignID, userHandle, emoji)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type MockCommentService_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - commentID string
+//   - campaignID string
+//   - userHandle string
+//   - emoji string
+func (_e *MockCommentService_Expecter) RemoveReaction(commentID interface{}, campaignID interface{}, userHandle interface{}, emoji interface{}) *MockCommentService_RemoveReaction_Call {
+	return &MockCommentService_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", commentID, campaignID, userHandle, emoji)}
+}
+
+func (_c *MockCommentService_RemoveReaction_Call) Run(run func(commentID string, campaignID string, userHandle string, emoji string)) *MockCommentService_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_RemoveReaction_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentService_RemoveReaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_RemoveReaction_Call) RunAndReturn(run func(string, string, string, string) (*models.Comment, error)) *MockCommentService_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportComment provides a mock function with given fields: commentID, campaignID, userHandle, reason
+func (_m *MockCommentService) ReportComment(commentID string, campaignID string, userHandle string, reason string) (*models.CommentReport, error) {
+	ret := _m.Called(commentID, campaignID, userHandle, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportComment")
+	}
+
+	var r0 *models.CommentReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.CommentReport, error)); ok {
+		return rf(commentID, campaignID, userHandle, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.CommentReport); ok {
+		r0 = rf(commentID, campaignID, userHandle, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CommentReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(commentID, campaignID, userHandle, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentService_ReportComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportComment'
+type MockCommentService_ReportComment_Call struct {
+	*mock.Call
+}
+
+// ReportComment is a helper method to define mock.On call
+//   - commentID string
+//   - campaignID string
+//   - userHandle string
+//   - reason string
+func (_e *MockCommentService_Expecter) ReportComment(commentID interface{}, campaignID interface{}, userHandle interface{}, reason interface{}) *MockCommentService_ReportComment_Call {
+	return &MockCommentService_ReportComment_Call{Call: _e.mock.On("ReportComment", commentID, campaignID, userHandle, reason)}
+}
+
+func (_c *MockCommentService_ReportComment_Call) Run(run func(commentID string, campaignID string, userHandle string, reason string)) *MockCommentService_ReportComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCommentService_ReportComment_Call) Return(_a0 *models.CommentReport, _a1 error) *MockCommentService_ReportComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentService_ReportComment_Call) RunAndReturn(run func(string, string, string, string) (*models.CommentReport, error)) *MockCommentService_ReportComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: comment, campaignID, userHandle
+func (_m *MockCommentService) UpdateComment(comment models.Comment, campaignID string, userHandle string) (*models.Comment, error) {
+	ret := _m.Called(comment, campaignID, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Comment, string, string) (*models.Comment, error)); ok {
+		return rf(comment, campaignID, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(models.Comment, string, string) *models.Comment); ok {
+		r0 = rf(comment, campaignID, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Comment, string, string) error); ok {
+		r1 = rf(comment, campaignID, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MockCommentService_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
 type MockCommentService_UpdateComment_Call struct {
 	*mock.Call
@@ -257,24 +856,25 @@ type MockCommentService_UpdateComment_Call struct {
 
 // UpdateComment is a helper method to define mock.On call
 //   - comment models.Comment
+//   - campaignID string
 //   - userHandle string
-func (_e *MockCommentService_Expecter) UpdateComment(comment interface{}, userHandle interface{}) *MockCommentService_UpdateComment_Call {
-	return &MockCommentService_UpdateComment_Call{Call: _e.mock.On("UpdateComment", comment, userHandle)}
+func (_e *MockCommentService_Expecter) UpdateComment(comment interface{}, campaignID interface{}, userHandle interface{}) *MockCommentService_UpdateComment_Call {
+	return &MockCommentService_UpdateComment_Call{Call: _e.mock.On("UpdateComment", comment, campaignID, userHandle)}
 }
 
-func (_c *MockCommentService_UpdateComment_Call) Run(run func(comment models.Comment, userHandle string)) *MockCommentService_UpdateComment_Call {
+func (_c *MockCommentService_UpdateComment_Call) Run(run func(comment models.Comment, campaignID string, userHandle string)) *MockCommentService_UpdateComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Comment), args[1].(string))
+		run(args[0].(models.Comment), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockCommentService_UpdateComment_Call) Return(_a0 error) *MockCommentService_UpdateComment_Call {
-	_c.Call.Return(_a0)
+func (_c *MockCommentService_UpdateComment_Call) Return(_a0 *models.Comment, _a1 error) *MockCommentService_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCommentService_UpdateComment_Call) RunAndReturn(run func(models.Comment, string) error) *MockCommentService_UpdateComment_Call {
+func (_c *MockCommentService_UpdateComment_Call) RunAndReturn(run func(models.Comment, string, string) (*models.Comment, error)) *MockCommentService_UpdateComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockContentFilter is an autogenerated mock type for the ContentFilter type
+type MockContentFilter struct {
+	mock.Mock
+}
+
+type MockContentFilter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContentFilter) EXPECT() *MockContentFilter_Expecter {
+	return &MockContentFilter_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: content
+func (_m *MockContentFilter) Check(content string) (models.ContentCheck, error) {
+	ret := _m.Called(content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 models.ContentCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.ContentCheck, error)); ok {
+		return rf(content)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.ContentCheck); ok {
+		r0 = rf(content)
+	} else {
+		r0 = ret.Get(0).(models.ContentCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContentFilter_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockContentFilter_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - content string
+func (_e *MockContentFilter_Expecter) Check(content interface{}) *MockContentFilter_Check_Call {
+	return &MockContentFilter_Check_Call{Call: _e.mock.On("Check", content)}
+}
+
+func (_c *MockContentFilter_Check_Call) Run(run func(content string)) *MockContentFilter_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContentFilter_Check_Call) Return(_a0 models.ContentCheck, _a1 error) *MockContentFilter_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContentFilter_Check_Call) RunAndReturn(run func(string) (models.ContentCheck, error)) *MockContentFilter_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockContentFilter creates a new instance of MockContentFilter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContentFilter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContentFilter {
+	mock := &MockContentFilter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,344 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockContributorRequestService is an autogenerated mock type for the ContributorRequestService type
+type MockContributorRequestService struct {
+	mock.Mock
+}
+
+type MockContributorRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContributorRequestService) EXPECT() *MockContributorRequestService_Expecter {
+	return &MockContributorRequestService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveContributorRequest provides a mock function with given fields: requestID, campaignID, key, userHandle, note, refundIssued
+func (_m *MockContributorRequestService) ApproveContributorRequest(requestID uint, campaignID string, key string, userHandle string, note string, refundIssued bool) (*models.ContributorRequest, error) {
+	ret := _m.Called(requestID, campaignID, key, userHandle, note, refundIssued)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveContributorRequest")
+	}
+
+	var r0 *models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string, bool) (*models.ContributorRequest, error)); ok {
+		return rf(requestID, campaignID, key, userHandle, note, refundIssued)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string, bool) *models.ContributorRequest); ok {
+		r0 = rf(requestID, campaignID, key, userHandle, note, refundIssued)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string, bool) error); ok {
+		r1 = rf(requestID, campaignID, key, userHandle, note, refundIssued)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestService_ApproveContributorRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveContributorRequest'
+type MockContributorRequestService_ApproveContributorRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveContributorRequest is a helper method to define mock.On call
+//   - requestID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - note string
+//   - refundIssued bool
+func (_e *MockContributorRequestService_Expecter) ApproveContributorRequest(requestID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, note interface{}, refundIssued interface{}) *MockContributorRequestService_ApproveContributorRequest_Call {
+	return &MockContributorRequestService_ApproveContributorRequest_Call{Call: _e.mock.On("ApproveContributorRequest", requestID, campaignID, key, userHandle, note, refundIssued)}
+}
+
+func (_c *MockContributorRequestService_ApproveContributorRequest_Call) Run(run func(requestID uint, campaignID string, key string, userHandle string, note string, refundIssued bool)) *MockContributorRequestService_ApproveContributorRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestService_ApproveContributorRequest_Call) Return(_a0 *models.ContributorRequest, _a1 error) *MockContributorRequestService_ApproveContributorRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestService_ApproveContributorRequest_Call) RunAndReturn(run func(uint, string, string, string, string, bool) (*models.ContributorRequest, error)) *MockContributorRequestService_ApproveContributorRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContributorRequests provides a mock function with given fields: campaignID, key, userHandle, userEmail
+func (_m *MockContributorRequestService) GetContributorRequests(campaignID string, key string, userHandle string, userEmail string) ([]models.ContributorRequest, error) {
+	ret := _m.Called(campaignID, key, userHandle, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContributorRequests")
+	}
+
+	var r0 []models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) ([]models.ContributorRequest, error)); ok {
+		return rf(campaignID, key, userHandle, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) []models.ContributorRequest); ok {
+		r0 = rf(campaignID, key, userHandle, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestService_GetContributorRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContributorRequests'
+type MockContributorRequestService_GetContributorRequests_Call struct {
+	*mock.Call
+}
+
+// GetContributorRequests is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - userEmail string
+func (_e *MockContributorRequestService_Expecter) GetContributorRequests(campaignID interface{}, key interface{}, userHandle interface{}, userEmail interface{}) *MockContributorRequestService_GetContributorRequests_Call {
+	return &MockContributorRequestService_GetContributorRequests_Call{Call: _e.mock.On("GetContributorRequests", campaignID, key, userHandle, userEmail)}
+}
+
+func (_c *MockContributorRequestService_GetContributorRequests_Call) Run(run func(campaignID string, key string, userHandle string, userEmail string)) *MockContributorRequestService_GetContributorRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestService_GetContributorRequests_Call) Return(_a0 []models.ContributorRequest, _a1 error) *MockContributorRequestService_GetContributorRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestService_GetContributorRequests_Call) RunAndReturn(run func(string, string, string, string) ([]models.ContributorRequest, error)) *MockContributorRequestService_GetContributorRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectContributorRequest provides a mock function with given fields: requestID, campaignID, key, userHandle, note
+func (_m *MockContributorRequestService) RejectContributorRequest(requestID uint, campaignID string, key string, userHandle string, note string) (*models.ContributorRequest, error) {
+	ret := _m.Called(requestID, campaignID, key, userHandle, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectContributorRequest")
+	}
+
+	var r0 *models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) (*models.ContributorRequest, error)); ok {
+		return rf(requestID, campaignID, key, userHandle, note)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) *models.ContributorRequest); ok {
+		r0 = rf(requestID, campaignID, key, userHandle, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string) error); ok {
+		r1 = rf(requestID, campaignID, key, userHandle, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestService_RejectContributorRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectContributorRequest'
+type MockContributorRequestService_RejectContributorRequest_Call struct {
+	*mock.Call
+}
+
+// RejectContributorRequest is a helper method to define mock.On call
+//   - requestID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - note string
+func (_e *MockContributorRequestService_Expecter) RejectContributorRequest(requestID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, note interface{}) *MockContributorRequestService_RejectContributorRequest_Call {
+	return &MockContributorRequestService_RejectContributorRequest_Call{Call: _e.mock.On("RejectContributorRequest", requestID, campaignID, key, userHandle, note)}
+}
+
+func (_c *MockContributorRequestService_RejectContributorRequest_Call) Run(run func(requestID uint, campaignID string, key string, userHandle string, note string)) *MockContributorRequestService_RejectContributorRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestService_RejectContributorRequest_Call) Return(_a0 *models.ContributorRequest, _a1 error) *MockContributorRequestService_RejectContributorRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestService_RejectContributorRequest_Call) RunAndReturn(run func(uint, string, string, string, string) (*models.ContributorRequest, error)) *MockContributorRequestService_RejectContributorRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestAmountChange provides a mock function with given fields: campaignID, key, userEmail, amount, reason
+func (_m *MockContributorRequestService) RequestAmountChange(campaignID string, key string, userEmail string, amount float64, reason string) (*models.ContributorRequest, error) {
+	ret := _m.Called(campaignID, key, userEmail, amount, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestAmountChange")
+	}
+
+	var r0 *models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, float64, string) (*models.ContributorRequest, error)); ok {
+		return rf(campaignID, key, userEmail, amount, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, float64, string) *models.ContributorRequest); ok {
+		r0 = rf(campaignID, key, userEmail, amount, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, float64, string) error); ok {
+		r1 = rf(campaignID, key, userEmail, amount, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestService_RequestAmountChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestAmountChange'
+type MockContributorRequestService_RequestAmountChange_Call struct {
+	*mock.Call
+}
+
+// RequestAmountChange is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+//   - amount float64
+//   - reason string
+func (_e *MockContributorRequestService_Expecter) RequestAmountChange(campaignID interface{}, key interface{}, userEmail interface{}, amount interface{}, reason interface{}) *MockContributorRequestService_RequestAmountChange_Call {
+	return &MockContributorRequestService_RequestAmountChange_Call{Call: _e.mock.On("RequestAmountChange", campaignID, key, userEmail, amount, reason)}
+}
+
+func (_c *MockContributorRequestService_RequestAmountChange_Call) Run(run func(campaignID string, key string, userEmail string, amount float64, reason string)) *MockContributorRequestService_RequestAmountChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(float64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestService_RequestAmountChange_Call) Return(_a0 *models.ContributorRequest, _a1 error) *MockContributorRequestService_RequestAmountChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestService_RequestAmountChange_Call) RunAndReturn(run func(string, string, string, float64, string) (*models.ContributorRequest, error)) *MockContributorRequestService_RequestAmountChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestToLeave provides a mock function with given fields: campaignID, key, userEmail, reason
+func (_m *MockContributorRequestService) RequestToLeave(campaignID string, key string, userEmail string, reason string) (*models.ContributorRequest, error) {
+	ret := _m.Called(campaignID, key, userEmail, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestToLeave")
+	}
+
+	var r0 *models.ContributorRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.ContributorRequest, error)); ok {
+		return rf(campaignID, key, userEmail, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.ContributorRequest); ok {
+		r0 = rf(campaignID, key, userEmail, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ContributorRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(campaignID, key, userEmail, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorRequestService_RequestToLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestToLeave'
+type MockContributorRequestService_RequestToLeave_Call struct {
+	*mock.Call
+}
+
+// RequestToLeave is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+//   - reason string
+func (_e *MockContributorRequestService_Expecter) RequestToLeave(campaignID interface{}, key interface{}, userEmail interface{}, reason interface{}) *MockContributorRequestService_RequestToLeave_Call {
+	return &MockContributorRequestService_RequestToLeave_Call{Call: _e.mock.On("RequestToLeave", campaignID, key, userEmail, reason)}
+}
+
+func (_c *MockContributorRequestService_RequestToLeave_Call) Run(run func(campaignID string, key string, userEmail string, reason string)) *MockContributorRequestService_RequestToLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorRequestService_RequestToLeave_Call) Return(_a0 *models.ContributorRequest, _a1 error) *MockContributorRequestService_RequestToLeave_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorRequestService_RequestToLeave_Call) RunAndReturn(run func(string, string, string, string) (*models.ContributorRequest, error)) *MockContributorRequestService_RequestToLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockContributorRequestService creates a new instance of MockContributorRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContributorRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContributorRequestService {
+	mock := &MockContributorRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
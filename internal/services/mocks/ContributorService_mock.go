@@ -20,6 +20,64 @@ func (_m *MockContributorService) EXPECT() *MockContributorService_Expecter {
 	return &MockContributorService_Expecter{mock: &_m.Mock}
 }
 
+// AcceptInvitation provides a mock function with given fields: token
+func (_m *MockContributorService) AcceptInvitation(token string) (*models.Contributor, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 *models.Contributor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Contributor, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Contributor); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contributor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorService_AcceptInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvitation'
+type MockContributorService_AcceptInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptInvitation is a helper method to define mock.On call
+//   - token string
+func (_e *MockContributorService_Expecter) AcceptInvitation(token interface{}) *MockContributorService_AcceptInvitation_Call {
+	return &MockContributorService_AcceptInvitation_Call{Call: _e.mock.On("AcceptInvitation", token)}
+}
+
+func (_c *MockContributorService_AcceptInvitation_Call) Run(run func(token string)) *MockContributorService_AcceptInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorService_AcceptInvitation_Call) Return(_a0 *models.Contributor, _a1 error) *MockContributorService_AcceptInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorService_AcceptInvitation_Call) RunAndReturn(run func(string) (*models.Contributor, error)) *MockContributorService_AcceptInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddContributorToCampaign provides a mock function with given fields: contribution, campaignId, campaignKey, userHandle
 func (_m *MockContributorService) AddContributorToCampaign(contribution *models.Contributor, campaignId string, campaignKey string, userHandle string) error {
 	ret := _m.Called(contribution, campaignId, campaignKey, userHandle)
@@ -69,6 +127,52 @@ func (_c *MockContributorService_AddContributorToCampaign_Call) RunAndReturn(run
 	return _c
 }
 
+// DeclineInvitation provides a mock function with given fields: token
+func (_m *MockContributorService) DeclineInvitation(token string) error {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeclineInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorService_DeclineInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineInvitation'
+type MockContributorService_DeclineInvitation_Call struct {
+	*mock.Call
+}
+
+// DeclineInvitation is a helper method to define mock.On call
+//   - token string
+func (_e *MockContributorService_Expecter) DeclineInvitation(token interface{}) *MockContributorService_DeclineInvitation_Call {
+	return &MockContributorService_DeclineInvitation_Call{Call: _e.mock.On("DeclineInvitation", token)}
+}
+
+func (_c *MockContributorService_DeclineInvitation_Call) Run(run func(token string)) *MockContributorService_DeclineInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorService_DeclineInvitation_Call) Return(_a0 error) *MockContributorService_DeclineInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorService_DeclineInvitation_Call) RunAndReturn(run func(string) error) *MockContributorService_DeclineInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetContributorByID provides a mock function with given fields: contributorID
 func (_m *MockContributorService) GetContributorByID(contributorID uint) (models.Contributor, error) {
 	ret := _m.Called(contributorID)
@@ -183,6 +287,114 @@ func (_c *MockContributorService_GetContributorsByCampaignID_Call) RunAndReturn(
 	return _c
 }
 
+// ImportContributors provides a mock function with given fields: contributors, campaignId, campaignKey, userHandle
+func (_m *MockContributorService) ImportContributors(contributors []models.Contributor, campaignId string, campaignKey string, userHandle string) ([]models.Contributor, error) {
+	ret := _m.Called(contributors, campaignId, campaignKey, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportContributors")
+	}
+
+	var r0 []models.Contributor
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.Contributor, string, string, string) ([]models.Contributor, error)); ok {
+		return rf(contributors, campaignId, campaignKey, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Contributor, string, string, string) []models.Contributor); ok {
+		r0 = rf(contributors, campaignId, campaignKey, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Contributor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Contributor, string, string, string) error); ok {
+		r1 = rf(contributors, campaignId, campaignKey, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContributorService_ImportContributors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportContributors'
+type MockContributorService_ImportContributors_Call struct {
+	*mock.Call
+}
+
+// ImportContributors is a helper method to define mock.On call
+//   - contributors []models.Contributor
+//   - campaignId string
+//   - campaignKey string
+//   - userHandle string
+func (_e *MockContributorService_Expecter) ImportContributors(contributors interface{}, campaignId interface{}, campaignKey interface{}, userHandle interface{}) *MockContributorService_ImportContributors_Call {
+	return &MockContributorService_ImportContributors_Call{Call: _e.mock.On("ImportContributors", contributors, campaignId, campaignKey, userHandle)}
+}
+
+func (_c *MockContributorService_ImportContributors_Call) Run(run func(contributors []models.Contributor, campaignId string, campaignKey string, userHandle string)) *MockContributorService_ImportContributors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Contributor), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockContributorService_ImportContributors_Call) Return(_a0 []models.Contributor, _a1 error) *MockContributorService_ImportContributors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContributorService_ImportContributors_Call) RunAndReturn(run func([]models.Contributor, string, string, string) ([]models.Contributor, error)) *MockContributorService_ImportContributors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveCampaign provides a mock function with given fields: contributor, refunded
+func (_m *MockContributorService) LeaveCampaign(contributor *models.Contributor, refunded bool) error {
+	ret := _m.Called(contributor, refunded)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor, bool) error); ok {
+		r0 = rf(contributor, refunded)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContributorService_LeaveCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveCampaign'
+type MockContributorService_LeaveCampaign_Call struct {
+	*mock.Call
+}
+
+// LeaveCampaign is a helper method to define mock.On call
+//   - contributor *models.Contributor
+//   - refunded bool
+func (_e *MockContributorService_Expecter) LeaveCampaign(contributor interface{}, refunded interface{}) *MockContributorService_LeaveCampaign_Call {
+	return &MockContributorService_LeaveCampaign_Call{Call: _e.mock.On("LeaveCampaign", contributor, refunded)}
+}
+
+func (_c *MockContributorService_LeaveCampaign_Call) Run(run func(contributor *models.Contributor, refunded bool)) *MockContributorService_LeaveCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockContributorService_LeaveCampaign_Call) Return(_a0 error) *MockContributorService_LeaveCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorService_LeaveCampaign_Call) RunAndReturn(run func(*models.Contributor, bool) error) *MockContributorService_LeaveCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveContributorFromCampaign provides a mock function with given fields: contributorId, campaignId, userHandle, key
 func (_m *MockContributorService) RemoveContributorFromCampaign(contributorId uint, campaignId string, userHandle string, key string) error {
 	ret := _m.Called(contributorId, campaignId, userHandle, key)
@@ -232,6 +444,38 @@ func (_c *MockContributorService_RemoveContributorFromCampaign_Call) RunAndRetur
 	return _c
 }
 
+// SendInvitationReminders provides a mock function with no fields
+func (_m *MockContributorService) SendInvitationReminders() {
+	_m.Called()
+}
+
+// MockContributorService_SendInvitationReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInvitationReminders'
+type MockContributorService_SendInvitationReminders_Call struct {
+	*mock.Call
+}
+
+// SendInvitationReminders is a helper method to define mock.On call
+func (_e *MockContributorService_Expecter) SendInvitationReminders() *MockContributorService_SendInvitationReminders_Call {
+	return &MockContributorService_SendInvitationReminders_Call{Call: _e.mock.On("SendInvitationReminders")}
+}
+
+func (_c *MockContributorService_SendInvitationReminders_Call) Run(run func()) *MockContributorService_SendInvitationReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContributorService_SendInvitationReminders_Call) Return() *MockContributorService_SendInvitationReminders_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContributorService_SendInvitationReminders_Call) RunAndReturn(run func()) *MockContributorService_SendInvitationReminders_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateContributor provides a mock function with given fields: contributor
 func (_m *MockContributorService) UpdateContributor(contributor *models.Contributor) error {
 	ret := _m.Called(contributor)
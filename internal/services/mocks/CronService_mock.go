@@ -2,7 +2,10 @@
 
 package interfaces
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockCronService is an autogenerated mock type for the CronService type
 type MockCronService struct {
@@ -17,6 +20,39 @@ func (_m *MockCronService) EXPECT() *MockCronService_Expecter {
 	return &MockCronService_Expecter{mock: &_m.Mock}
 }
 
+// RescheduleCampaignDeadline provides a mock function with given fields: campaign
+func (_m *MockCronService) RescheduleCampaignDeadline(campaign *models.Campaign) {
+	_m.Called(campaign)
+}
+
+// MockCronService_RescheduleCampaignDeadline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleCampaignDeadline'
+type MockCronService_RescheduleCampaignDeadline_Call struct {
+	*mock.Call
+}
+
+// RescheduleCampaignDeadline is a helper method to define mock.On call
+//   - campaign *models.Campaign
+func (_e *MockCronService_Expecter) RescheduleCampaignDeadline(campaign interface{}) *MockCronService_RescheduleCampaignDeadline_Call {
+	return &MockCronService_RescheduleCampaignDeadline_Call{Call: _e.mock.On("RescheduleCampaignDeadline", campaign)}
+}
+
+func (_c *MockCronService_RescheduleCampaignDeadline_Call) Run(run func(campaign *models.Campaign)) *MockCronService_RescheduleCampaignDeadline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCronService_RescheduleCampaignDeadline_Call) Return() *MockCronService_RescheduleCampaignDeadline_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCronService_RescheduleCampaignDeadline_Call) RunAndReturn(run func(*models.Campaign)) *MockCronService_RescheduleCampaignDeadline_Call {
+	_c.Run(run)
+	return _c
+}
+
 // StartCronJobs provides a mock function with no fields
 func (_m *MockCronService) StartCronJobs() error {
 	ret := _m.Called()
@@ -55,6 +55,41 @@ func (_c *MockEventBroadcaster_NewEvent_Call) RunAndReturn(run func(string, webs
 	return _c
 }
 
+// SendTransient provides a mock function with given fields: campaignID, eventType, data
+func (_m *MockEventBroadcaster) SendTransient(campaignID string, eventType websocket.EventType, data interface{}) {
+	_m.Called(campaignID, eventType, data)
+}
+
+// MockEventBroadcaster_SendTransient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTransient'
+type MockEventBroadcaster_SendTransient_Call struct {
+	*mock.Call
+}
+
+// SendTransient is a helper method to define mock.On call
+//   - campaignID string
+//   - eventType websocket.EventType
+//   - data interface{}
+func (_e *MockEventBroadcaster_Expecter) SendTransient(campaignID interface{}, eventType interface{}, data interface{}) *MockEventBroadcaster_SendTransient_Call {
+	return &MockEventBroadcaster_SendTransient_Call{Call: _e.mock.On("SendTransient", campaignID, eventType, data)}
+}
+
+func (_c *MockEventBroadcaster_SendTransient_Call) Run(run func(campaignID string, eventType websocket.EventType, data interface{})) *MockEventBroadcaster_SendTransient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(websocket.EventType), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockEventBroadcaster_SendTransient_Call) Return() *MockEventBroadcaster_SendTransient_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEventBroadcaster_SendTransient_Call) RunAndReturn(run func(string, websocket.EventType, interface{})) *MockEventBroadcaster_SendTransient_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockEventBroadcaster creates a new instance of MockEventBroadcaster. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockEventBroadcaster(t interface {
@@ -0,0 +1,398 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExpenseService is an autogenerated mock type for the ExpenseService type
+type MockExpenseService struct {
+	mock.Mock
+}
+
+type MockExpenseService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpenseService) EXPECT() *MockExpenseService_Expecter {
+	return &MockExpenseService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpense provides a mock function with given fields: expenseID, activityID, campaignID, key, userHandle
+func (_m *MockExpenseService) DeleteExpense(expenseID uint, activityID uint, campaignID string, key string, userHandle string) error {
+	ret := _m.Called(expenseID, activityID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpense")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string, string) error); ok {
+		r0 = rf(expenseID, activityID, campaignID, key, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExpenseService_DeleteExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpense'
+type MockExpenseService_DeleteExpense_Call struct {
+	*mock.Call
+}
+
+// DeleteExpense is a helper method to define mock.On call
+//   - expenseID uint
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockExpenseService_Expecter) DeleteExpense(expenseID interface{}, activityID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockExpenseService_DeleteExpense_Call {
+	return &MockExpenseService_DeleteExpense_Call{Call: _e.mock.On("DeleteExpense", expenseID, activityID, campaignID, key, userHandle)}
+}
+
+func (_c *MockExpenseService_DeleteExpense_Call) Run(run func(expenseID uint, activityID uint, campaignID string, key string, userHandle string)) *MockExpenseService_DeleteExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_DeleteExpense_Call) Return(_a0 error) *MockExpenseService_DeleteExpense_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExpenseService_DeleteExpense_Call) RunAndReturn(run func(uint, uint, string, string, string) error) *MockExpenseService_DeleteExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivityBudget provides a mock function with given fields: activityID, campaignID, key, userEmail
+func (_m *MockExpenseService) GetActivityBudget(activityID uint, campaignID string, key string, userEmail string) (*models.ActivityBudgetReport, error) {
+	ret := _m.Called(activityID, campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivityBudget")
+	}
+
+	var r0 *models.ActivityBudgetReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) (*models.ActivityBudgetReport, error)); ok {
+		return rf(activityID, campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) *models.ActivityBudgetReport); ok {
+		r0 = rf(activityID, campaignID, key, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActivityBudgetReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string) error); ok {
+		r1 = rf(activityID, campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseService_GetActivityBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivityBudget'
+type MockExpenseService_GetActivityBudget_Call struct {
+	*mock.Call
+}
+
+// GetActivityBudget is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockExpenseService_Expecter) GetActivityBudget(activityID interface{}, campaignID interface{}, key interface{}, userEmail interface{}) *MockExpenseService_GetActivityBudget_Call {
+	return &MockExpenseService_GetActivityBudget_Call{Call: _e.mock.On("GetActivityBudget", activityID, campaignID, key, userEmail)}
+}
+
+func (_c *MockExpenseService_GetActivityBudget_Call) Run(run func(activityID uint, campaignID string, key string, userEmail string)) *MockExpenseService_GetActivityBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_GetActivityBudget_Call) Return(_a0 *models.ActivityBudgetReport, _a1 error) *MockExpenseService_GetActivityBudget_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseService_GetActivityBudget_Call) RunAndReturn(run func(uint, string, string, string) (*models.ActivityBudgetReport, error)) *MockExpenseService_GetActivityBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignBudget provides a mock function with given fields: campaignID, key, userEmail
+func (_m *MockExpenseService) GetCampaignBudget(campaignID string, key string, userEmail string) (*models.CampaignBudgetReport, error) {
+	ret := _m.Called(campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignBudget")
+	}
+
+	var r0 *models.CampaignBudgetReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*models.CampaignBudgetReport, error)); ok {
+		return rf(campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *models.CampaignBudgetReport); ok {
+		r0 = rf(campaignID, key, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignBudgetReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseService_GetCampaignBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignBudget'
+type MockExpenseService_GetCampaignBudget_Call struct {
+	*mock.Call
+}
+
+// GetCampaignBudget is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockExpenseService_Expecter) GetCampaignBudget(campaignID interface{}, key interface{}, userEmail interface{}) *MockExpenseService_GetCampaignBudget_Call {
+	return &MockExpenseService_GetCampaignBudget_Call{Call: _e.mock.On("GetCampaignBudget", campaignID, key, userEmail)}
+}
+
+func (_c *MockExpenseService_GetCampaignBudget_Call) Run(run func(campaignID string, key string, userEmail string)) *MockExpenseService_GetCampaignBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_GetCampaignBudget_Call) Return(_a0 *models.CampaignBudgetReport, _a1 error) *MockExpenseService_GetCampaignBudget_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseService_GetCampaignBudget_Call) RunAndReturn(run func(string, string, string) (*models.CampaignBudgetReport, error)) *MockExpenseService_GetCampaignBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpenseReceipt provides a mock function with given fields: expenseID, activityID, campaignID, key, userEmail
+func (_m *MockExpenseService) GetExpenseReceipt(expenseID uint, activityID uint, campaignID string, key string, userEmail string) (*models.DocumentLink, error) {
+	ret := _m.Called(expenseID, activityID, campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseReceipt")
+	}
+
+	var r0 *models.DocumentLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string, string) (*models.DocumentLink, error)); ok {
+		return rf(expenseID, activityID, campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string, string) *models.DocumentLink); ok {
+		r0 = rf(expenseID, activityID, campaignID, key, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DocumentLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, string, string) error); ok {
+		r1 = rf(expenseID, activityID, campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseService_GetExpenseReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseReceipt'
+type MockExpenseService_GetExpenseReceipt_Call struct {
+	*mock.Call
+}
+
+// GetExpenseReceipt is a helper method to define mock.On call
+//   - expenseID uint
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockExpenseService_Expecter) GetExpenseReceipt(expenseID interface{}, activityID interface{}, campaignID interface{}, key interface{}, userEmail interface{}) *MockExpenseService_GetExpenseReceipt_Call {
+	return &MockExpenseService_GetExpenseReceipt_Call{Call: _e.mock.On("GetExpenseReceipt", expenseID, activityID, campaignID, key, userEmail)}
+}
+
+func (_c *MockExpenseService_GetExpenseReceipt_Call) Run(run func(expenseID uint, activityID uint, campaignID string, key string, userEmail string)) *MockExpenseService_GetExpenseReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_GetExpenseReceipt_Call) Return(_a0 *models.DocumentLink, _a1 error) *MockExpenseService_GetExpenseReceipt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseService_GetExpenseReceipt_Call) RunAndReturn(run func(uint, uint, string, string, string) (*models.DocumentLink, error)) *MockExpenseService_GetExpenseReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileActivity provides a mock function with given fields: activityID, campaignID, key, userHandle, resolution, targetActivityID, note
+func (_m *MockExpenseService) ReconcileActivity(activityID uint, campaignID string, key string, userHandle string, resolution models.ReconciliationResolution, targetActivityID *uint, note string) (*models.ActivityReconciliation, error) {
+	ret := _m.Called(activityID, campaignID, key, userHandle, resolution, targetActivityID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileActivity")
+	}
+
+	var r0 *models.ActivityReconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.ReconciliationResolution, *uint, string) (*models.ActivityReconciliation, error)); ok {
+		return rf(activityID, campaignID, key, userHandle, resolution, targetActivityID, note)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.ReconciliationResolution, *uint, string) *models.ActivityReconciliation); ok {
+		r0 = rf(activityID, campaignID, key, userHandle, resolution, targetActivityID, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActivityReconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, models.ReconciliationResolution, *uint, string) error); ok {
+		r1 = rf(activityID, campaignID, key, userHandle, resolution, targetActivityID, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseService_ReconcileActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileActivity'
+type MockExpenseService_ReconcileActivity_Call struct {
+	*mock.Call
+}
+
+// ReconcileActivity is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - resolution models.ReconciliationResolution
+//   - targetActivityID *uint
+//   - note string
+func (_e *MockExpenseService_Expecter) ReconcileActivity(activityID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, resolution interface{}, targetActivityID interface{}, note interface{}) *MockExpenseService_ReconcileActivity_Call {
+	return &MockExpenseService_ReconcileActivity_Call{Call: _e.mock.On("ReconcileActivity", activityID, campaignID, key, userHandle, resolution, targetActivityID, note)}
+}
+
+func (_c *MockExpenseService_ReconcileActivity_Call) Run(run func(activityID uint, campaignID string, key string, userHandle string, resolution models.ReconciliationResolution, targetActivityID *uint, note string)) *MockExpenseService_ReconcileActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(models.ReconciliationResolution), args[5].(*uint), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_ReconcileActivity_Call) Return(_a0 *models.ActivityReconciliation, _a1 error) *MockExpenseService_ReconcileActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseService_ReconcileActivity_Call) RunAndReturn(run func(uint, string, string, string, models.ReconciliationResolution, *uint, string) (*models.ActivityReconciliation, error)) *MockExpenseService_ReconcileActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordExpense provides a mock function with given fields: activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt
+func (_m *MockExpenseService) RecordExpense(activityID uint, campaignID string, key string, userHandle string, userEmail string, amount float64, paidByEmail string, note string, receipt string) (*models.ActivityExpense, error) {
+	ret := _m.Called(activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordExpense")
+	}
+
+	var r0 *models.ActivityExpense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string, float64, string, string, string) (*models.ActivityExpense, error)); ok {
+		return rf(activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string, float64, string, string, string) *models.ActivityExpense); ok {
+		r0 = rf(activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActivityExpense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string, float64, string, string, string) error); ok {
+		r1 = rf(activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpenseService_RecordExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordExpense'
+type MockExpenseService_RecordExpense_Call struct {
+	*mock.Call
+}
+
+// RecordExpense is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - userEmail string
+//   - amount float64
+//   - paidByEmail string
+//   - note string
+//   - receipt string
+func (_e *MockExpenseService_Expecter) RecordExpense(activityID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, userEmail interface{}, amount interface{}, paidByEmail interface{}, note interface{}, receipt interface{}) *MockExpenseService_RecordExpense_Call {
+	return &MockExpenseService_RecordExpense_Call{Call: _e.mock.On("RecordExpense", activityID, campaignID, key, userHandle, userEmail, amount, paidByEmail, note, receipt)}
+}
+
+func (_c *MockExpenseService_RecordExpense_Call) Run(run func(activityID uint, campaignID string, key string, userHandle string, userEmail string, amount float64, paidByEmail string, note string, receipt string)) *MockExpenseService_RecordExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(float64), args[6].(string), args[7].(string), args[8].(string))
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_RecordExpense_Call) Return(_a0 *models.ActivityExpense, _a1 error) *MockExpenseService_RecordExpense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpenseService_RecordExpense_Call) RunAndReturn(run func(uint, string, string, string, string, float64, string, string, string) (*models.ActivityExpense, error)) *MockExpenseService_RecordExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpenseService creates a new instance of MockExpenseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpenseService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpenseService {
+	mock := &MockExpenseService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
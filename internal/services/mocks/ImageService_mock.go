@@ -0,0 +1,268 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockImageService is an autogenerated mock type for the ImageService type
+type MockImageService struct {
+	mock.Mock
+}
+
+type MockImageService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImageService) EXPECT() *MockImageService_Expecter {
+	return &MockImageService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteActivityImage provides a mock function with given fields: activityID, campaignID, key, userHandle
+func (_m *MockImageService) DeleteActivityImage(activityID uint, campaignID string, key string, userHandle string) (*models.Activity, error) {
+	ret := _m.Called(activityID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteActivityImage")
+	}
+
+	var r0 *models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) (*models.Activity, error)); ok {
+		return rf(activityID, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) *models.Activity); ok {
+		r0 = rf(activityID, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string) error); ok {
+		r1 = rf(activityID, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImageService_DeleteActivityImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteActivityImage'
+type MockImageService_DeleteActivityImage_Call struct {
+	*mock.Call
+}
+
+// DeleteActivityImage is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockImageService_Expecter) DeleteActivityImage(activityID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockImageService_DeleteActivityImage_Call {
+	return &MockImageService_DeleteActivityImage_Call{Call: _e.mock.On("DeleteActivityImage", activityID, campaignID, key, userHandle)}
+}
+
+func (_c *MockImageService_DeleteActivityImage_Call) Run(run func(activityID uint, campaignID string, key string, userHandle string)) *MockImageService_DeleteActivityImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_DeleteActivityImage_Call) Return(_a0 *models.Activity, _a1 error) *MockImageService_DeleteActivityImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImageService_DeleteActivityImage_Call) RunAndReturn(run func(uint, string, string, string) (*models.Activity, error)) *MockImageService_DeleteActivityImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCampaignImage provides a mock function with given fields: imageID, campaignID, key, userHandle
+func (_m *MockImageService) DeleteCampaignImage(imageID uint, campaignID string, key string, userHandle string) error {
+	ret := _m.Called(imageID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCampaignImage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) error); ok {
+		r0 = rf(imageID, campaignID, key, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockImageService_DeleteCampaignImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCampaignImage'
+type MockImageService_DeleteCampaignImage_Call struct {
+	*mock.Call
+}
+
+// DeleteCampaignImage is a helper method to define mock.On call
+//   - imageID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockImageService_Expecter) DeleteCampaignImage(imageID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockImageService_DeleteCampaignImage_Call {
+	return &MockImageService_DeleteCampaignImage_Call{Call: _e.mock.On("DeleteCampaignImage", imageID, campaignID, key, userHandle)}
+}
+
+func (_c *MockImageService_DeleteCampaignImage_Call) Run(run func(imageID uint, campaignID string, key string, userHandle string)) *MockImageService_DeleteCampaignImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_DeleteCampaignImage_Call) Return(_a0 error) *MockImageService_DeleteCampaignImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockImageService_DeleteCampaignImage_Call) RunAndReturn(run func(uint, string, string, string) error) *MockImageService_DeleteCampaignImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadActivityImage provides a mock function with given fields: activityID, campaignID, key, userHandle, file
+func (_m *MockImageService) UploadActivityImage(activityID uint, campaignID string, key string, userHandle string, file string) (*models.Activity, error) {
+	ret := _m.Called(activityID, campaignID, key, userHandle, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadActivityImage")
+	}
+
+	var r0 *models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) (*models.Activity, error)); ok {
+		return rf(activityID, campaignID, key, userHandle, file)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) *models.Activity); ok {
+		r0 = rf(activityID, campaignID, key, userHandle, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string) error); ok {
+		r1 = rf(activityID, campaignID, key, userHandle, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImageService_UploadActivityImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadActivityImage'
+type MockImageService_UploadActivityImage_Call struct {
+	*mock.Call
+}
+
+// UploadActivityImage is a helper method to define mock.On call
+//   - activityID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - file string
+func (_e *MockImageService_Expecter) UploadActivityImage(activityID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, file interface{}) *MockImageService_UploadActivityImage_Call {
+	return &MockImageService_UploadActivityImage_Call{Call: _e.mock.On("UploadActivityImage", activityID, campaignID, key, userHandle, file)}
+}
+
+func (_c *MockImageService_UploadActivityImage_Call) Run(run func(activityID uint, campaignID string, key string, userHandle string, file string)) *MockImageService_UploadActivityImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_UploadActivityImage_Call) Return(_a0 *models.Activity, _a1 error) *MockImageService_UploadActivityImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImageService_UploadActivityImage_Call) RunAndReturn(run func(uint, string, string, string, string) (*models.Activity, error)) *MockImageService_UploadActivityImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadCampaignImage provides a mock function with given fields: campaignID, key, userHandle, file
+func (_m *MockImageService) UploadCampaignImage(campaignID string, key string, userHandle string, file string) (*models.CampaignImage, error) {
+	ret := _m.Called(campaignID, key, userHandle, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadCampaignImage")
+	}
+
+	var r0 *models.CampaignImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.CampaignImage, error)); ok {
+		return rf(campaignID, key, userHandle, file)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.CampaignImage); ok {
+		r0 = rf(campaignID, key, userHandle, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CampaignImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImageService_UploadCampaignImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadCampaignImage'
+type MockImageService_UploadCampaignImage_Call struct {
+	*mock.Call
+}
+
+// UploadCampaignImage is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - file string
+func (_e *MockImageService_Expecter) UploadCampaignImage(campaignID interface{}, key interface{}, userHandle interface{}, file interface{}) *MockImageService_UploadCampaignImage_Call {
+	return &MockImageService_UploadCampaignImage_Call{Call: _e.mock.On("UploadCampaignImage", campaignID, key, userHandle, file)}
+}
+
+func (_c *MockImageService_UploadCampaignImage_Call) Run(run func(campaignID string, key string, userHandle string, file string)) *MockImageService_UploadCampaignImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockImageService_UploadCampaignImage_Call) Return(_a0 *models.CampaignImage, _a1 error) *MockImageService_UploadCampaignImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImageService_UploadCampaignImage_Call) RunAndReturn(run func(string, string, string, string) (*models.CampaignImage, error)) *MockImageService_UploadCampaignImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImageService creates a new instance of MockImageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImageService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImageService {
+	mock := &MockImageService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
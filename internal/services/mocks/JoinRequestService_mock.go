@@ -0,0 +1,253 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockJoinRequestService is an autogenerated mock type for the JoinRequestService type
+type MockJoinRequestService struct {
+	mock.Mock
+}
+
+type MockJoinRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJoinRequestService) EXPECT() *MockJoinRequestService_Expecter {
+	return &MockJoinRequestService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveJoinRequest provides a mock function with given fields: requestID, campaignID, key, userHandle
+func (_m *MockJoinRequestService) ApproveJoinRequest(requestID uint, campaignID string, key string, userHandle string) (*models.Contributor, error) {
+	ret := _m.Called(requestID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveJoinRequest")
+	}
+
+	var r0 *models.Contributor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) (*models.Contributor, error)); ok {
+		return rf(requestID, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) *models.Contributor); ok {
+		r0 = rf(requestID, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contributor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string) error); ok {
+		r1 = rf(requestID, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJoinRequestService_ApproveJoinRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveJoinRequest'
+type MockJoinRequestService_ApproveJoinRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveJoinRequest is a helper method to define mock.On call
+//   - requestID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockJoinRequestService_Expecter) ApproveJoinRequest(requestID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockJoinRequestService_ApproveJoinRequest_Call {
+	return &MockJoinRequestService_ApproveJoinRequest_Call{Call: _e.mock.On("ApproveJoinRequest", requestID, campaignID, key, userHandle)}
+}
+
+func (_c *MockJoinRequestService_ApproveJoinRequest_Call) Run(run func(requestID uint, campaignID string, key string, userHandle string)) *MockJoinRequestService_ApproveJoinRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestService_ApproveJoinRequest_Call) Return(_a0 *models.Contributor, _a1 error) *MockJoinRequestService_ApproveJoinRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJoinRequestService_ApproveJoinRequest_Call) RunAndReturn(run func(uint, string, string, string) (*models.Contributor, error)) *MockJoinRequestService_ApproveJoinRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJoinRequests provides a mock function with given fields: campaignID, key, userHandle
+func (_m *MockJoinRequestService) GetJoinRequests(campaignID string, key string, userHandle string) ([]models.JoinRequest, error) {
+	ret := _m.Called(campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinRequests")
+	}
+
+	var r0 []models.JoinRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]models.JoinRequest, error)); ok {
+		return rf(campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []models.JoinRequest); ok {
+		r0 = rf(campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JoinRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJoinRequestService_GetJoinRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJoinRequests'
+type MockJoinRequestService_GetJoinRequests_Call struct {
+	*mock.Call
+}
+
+// GetJoinRequests is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockJoinRequestService_Expecter) GetJoinRequests(campaignID interface{}, key interface{}, userHandle interface{}) *MockJoinRequestService_GetJoinRequests_Call {
+	return &MockJoinRequestService_GetJoinRequests_Call{Call: _e.mock.On("GetJoinRequests", campaignID, key, userHandle)}
+}
+
+func (_c *MockJoinRequestService_GetJoinRequests_Call) Run(run func(campaignID string, key string, userHandle string)) *MockJoinRequestService_GetJoinRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestService_GetJoinRequests_Call) Return(_a0 []models.JoinRequest, _a1 error) *MockJoinRequestService_GetJoinRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJoinRequestService_GetJoinRequests_Call) RunAndReturn(run func(string, string, string) ([]models.JoinRequest, error)) *MockJoinRequestService_GetJoinRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectJoinRequest provides a mock function with given fields: requestID, campaignID, key, userHandle
+func (_m *MockJoinRequestService) RejectJoinRequest(requestID uint, campaignID string, key string, userHandle string) error {
+	ret := _m.Called(requestID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectJoinRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) error); ok {
+		r0 = rf(requestID, campaignID, key, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJoinRequestService_RejectJoinRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectJoinRequest'
+type MockJoinRequestService_RejectJoinRequest_Call struct {
+	*mock.Call
+}
+
+// RejectJoinRequest is a helper method to define mock.On call
+//   - requestID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockJoinRequestService_Expecter) RejectJoinRequest(requestID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockJoinRequestService_RejectJoinRequest_Call {
+	return &MockJoinRequestService_RejectJoinRequest_Call{Call: _e.mock.On("RejectJoinRequest", requestID, campaignID, key, userHandle)}
+}
+
+func (_c *MockJoinRequestService_RejectJoinRequest_Call) Run(run func(requestID uint, campaignID string, key string, userHandle string)) *MockJoinRequestService_RejectJoinRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestService_RejectJoinRequest_Call) Return(_a0 error) *MockJoinRequestService_RejectJoinRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJoinRequestService_RejectJoinRequest_Call) RunAndReturn(run func(uint, string, string, string) error) *MockJoinRequestService_RejectJoinRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestToJoin provides a mock function with given fields: request, slug, userEmail
+func (_m *MockJoinRequestService) RequestToJoin(request *models.JoinRequest, slug string, userEmail string) error {
+	ret := _m.Called(request, slug, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestToJoin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JoinRequest, string, string) error); ok {
+		r0 = rf(request, slug, userEmail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJoinRequestService_RequestToJoin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestToJoin'
+type MockJoinRequestService_RequestToJoin_Call struct {
+	*mock.Call
+}
+
+// RequestToJoin is a helper method to define mock.On call
+//   - request *models.JoinRequest
+//   - slug string
+//   - userEmail string
+func (_e *MockJoinRequestService_Expecter) RequestToJoin(request interface{}, slug interface{}, userEmail interface{}) *MockJoinRequestService_RequestToJoin_Call {
+	return &MockJoinRequestService_RequestToJoin_Call{Call: _e.mock.On("RequestToJoin", request, slug, userEmail)}
+}
+
+func (_c *MockJoinRequestService_RequestToJoin_Call) Run(run func(request *models.JoinRequest, slug string, userEmail string)) *MockJoinRequestService_RequestToJoin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.JoinRequest), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockJoinRequestService_RequestToJoin_Call) Return(_a0 error) *MockJoinRequestService_RequestToJoin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJoinRequestService_RequestToJoin_Call) RunAndReturn(run func(*models.JoinRequest, string, string) error) *MockJoinRequestService_RequestToJoin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJoinRequestService creates a new instance of MockJoinRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJoinRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJoinRequestService {
+	mock := &MockJoinRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
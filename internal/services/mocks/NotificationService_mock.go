@@ -208,6 +208,55 @@ func (_c *MockNotificationService_NotifyActivityUpdate_Call) RunAndReturn(run fu
 	return _c
 }
 
+// NotifyCampaignChanged provides a mock function with given fields: campaign, updateType, from, to
+func (_m *MockNotificationService) NotifyCampaignChanged(campaign *models.Campaign, updateType string, from string, to string) error {
+	ret := _m.Called(campaign, updateType, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyCampaignChanged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Campaign, string, string, string) error); ok {
+		r0 = rf(campaign, updateType, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyCampaignChanged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyCampaignChanged'
+type MockNotificationService_NotifyCampaignChanged_Call struct {
+	*mock.Call
+}
+
+// NotifyCampaignChanged is a helper method to define mock.On call
+//   - campaign *models.Campaign
+//   - updateType string
+//   - from string
+//   - to string
+func (_e *MockNotificationService_Expecter) NotifyCampaignChanged(campaign interface{}, updateType interface{}, from interface{}, to interface{}) *MockNotificationService_NotifyCampaignChanged_Call {
+	return &MockNotificationService_NotifyCampaignChanged_Call{Call: _e.mock.On("NotifyCampaignChanged", campaign, updateType, from, to)}
+}
+
+func (_c *MockNotificationService_NotifyCampaignChanged_Call) Run(run func(campaign *models.Campaign, updateType string, from string, to string)) *MockNotificationService_NotifyCampaignChanged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyCampaignChanged_Call) Return(_a0 error) *MockNotificationService_NotifyCampaignChanged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyCampaignChanged_Call) RunAndReturn(run func(*models.Campaign, string, string, string) error) *MockNotificationService_NotifyCampaignChanged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyCampaignCleanUp provides a mock function with given fields: campaign, data
 func (_m *MockNotificationService) NotifyCampaignCleanUp(campaign *models.Campaign, data string) error {
 	ret := _m.Called(campaign, data)
@@ -488,6 +537,54 @@ func (_c *MockNotificationService_NotifyCommentAddition_Call) RunAndReturn(run f
 	return _c
 }
 
+// NotifyCommentMention provides a mock function with given fields: comment, activity, mentioned
+func (_m *MockNotificationService) NotifyCommentMention(comment *models.Comment, activity *models.Activity, mentioned []models.User) error {
+	ret := _m.Called(comment, activity, mentioned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyCommentMention")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Comment, *models.Activity, []models.User) error); ok {
+		r0 = rf(comment, activity, mentioned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyCommentMention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyCommentMention'
+type MockNotificationService_NotifyCommentMention_Call struct {
+	*mock.Call
+}
+
+// NotifyCommentMention is a helper method to define mock.On call
+//   - comment *models.Comment
+//   - activity *models.Activity
+//   - mentioned []models.User
+func (_e *MockNotificationService_Expecter) NotifyCommentMention(comment interface{}, activity interface{}, mentioned interface{}) *MockNotificationService_NotifyCommentMention_Call {
+	return &MockNotificationService_NotifyCommentMention_Call{Call: _e.mock.On("NotifyCommentMention", comment, activity, mentioned)}
+}
+
+func (_c *MockNotificationService_NotifyCommentMention_Call) Run(run func(comment *models.Comment, activity *models.Activity, mentioned []models.User)) *MockNotificationService_NotifyCommentMention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Comment), args[1].(*models.Activity), args[2].([]models.User))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyCommentMention_Call) Return(_a0 error) *MockNotificationService_NotifyCommentMention_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyCommentMention_Call) RunAndReturn(run func(*models.Comment, *models.Activity, []models.User) error) *MockNotificationService_NotifyCommentMention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyContributorAdded provides a mock function with given fields: contributor, campaign
 func (_m *MockNotificationService) NotifyContributorAdded(contributor *models.Contributor, campaign *models.Campaign) error {
 	ret := _m.Called(contributor, campaign)
@@ -535,6 +632,289 @@ func (_c *MockNotificationService_NotifyContributorAdded_Call) RunAndReturn(run
 	return _c
 }
 
+// NotifyContributorInvited provides a mock function with given fields: contributor, campaign
+func (_m *MockNotificationService) NotifyContributorInvited(contributor *models.Contributor, campaign *models.Campaign) error {
+	ret := _m.Called(contributor, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyContributorInvited")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor, *models.Campaign) error); ok {
+		r0 = rf(contributor, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyContributorInvited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyContributorInvited'
+type MockNotificationService_NotifyContributorInvited_Call struct {
+	*mock.Call
+}
+
+// NotifyContributorInvited is a helper method to define mock.On call
+//   - contributor *models.Contributor
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyContributorInvited(contributor interface{}, campaign interface{}) *MockNotificationService_NotifyContributorInvited_Call {
+	return &MockNotificationService_NotifyContributorInvited_Call{Call: _e.mock.On("NotifyContributorInvited", contributor, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyContributorInvited_Call) Run(run func(contributor *models.Contributor, campaign *models.Campaign)) *MockNotificationService_NotifyContributorInvited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor), args[1].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyContributorInvited_Call) Return(_a0 error) *MockNotificationService_NotifyContributorInvited_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyContributorInvited_Call) RunAndReturn(run func(*models.Contributor, *models.Campaign) error) *MockNotificationService_NotifyContributorInvited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyContributorsImported provides a mock function with given fields: contributors, campaign
+func (_m *MockNotificationService) NotifyContributorsImported(contributors []models.Contributor, campaign *models.Campaign) error {
+	ret := _m.Called(contributors, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyContributorsImported")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]models.Contributor, *models.Campaign) error); ok {
+		r0 = rf(contributors, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyContributorsImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyContributorsImported'
+type MockNotificationService_NotifyContributorsImported_Call struct {
+	*mock.Call
+}
+
+// NotifyContributorsImported is a helper method to define mock.On call
+//   - contributors []models.Contributor
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyContributorsImported(contributors interface{}, campaign interface{}) *MockNotificationService_NotifyContributorsImported_Call {
+	return &MockNotificationService_NotifyContributorsImported_Call{Call: _e.mock.On("NotifyContributorsImported", contributors, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyContributorsImported_Call) Run(run func(contributors []models.Contributor, campaign *models.Campaign)) *MockNotificationService_NotifyContributorsImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Contributor), args[1].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyContributorsImported_Call) Return(_a0 error) *MockNotificationService_NotifyContributorsImported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyContributorsImported_Call) RunAndReturn(run func([]models.Contributor, *models.Campaign) error) *MockNotificationService_NotifyContributorsImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyInvitationDeclined provides a mock function with given fields: contributor, campaign
+func (_m *MockNotificationService) NotifyInvitationDeclined(contributor *models.Contributor, campaign *models.Campaign) error {
+	ret := _m.Called(contributor, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyInvitationDeclined")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor, *models.Campaign) error); ok {
+		r0 = rf(contributor, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyInvitationDeclined_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyInvitationDeclined'
+type MockNotificationService_NotifyInvitationDeclined_Call struct {
+	*mock.Call
+}
+
+// NotifyInvitationDeclined is a helper method to define mock.On call
+//   - contributor *models.Contributor
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyInvitationDeclined(contributor interface{}, campaign interface{}) *MockNotificationService_NotifyInvitationDeclined_Call {
+	return &MockNotificationService_NotifyInvitationDeclined_Call{Call: _e.mock.On("NotifyInvitationDeclined", contributor, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyInvitationDeclined_Call) Run(run func(contributor *models.Contributor, campaign *models.Campaign)) *MockNotificationService_NotifyInvitationDeclined_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor), args[1].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyInvitationDeclined_Call) Return(_a0 error) *MockNotificationService_NotifyInvitationDeclined_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyInvitationDeclined_Call) RunAndReturn(run func(*models.Contributor, *models.Campaign) error) *MockNotificationService_NotifyInvitationDeclined_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyJoinRequestReceived provides a mock function with given fields: request, campaign
+func (_m *MockNotificationService) NotifyJoinRequestReceived(request *models.JoinRequest, campaign *models.Campaign) error {
+	ret := _m.Called(request, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyJoinRequestReceived")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JoinRequest, *models.Campaign) error); ok {
+		r0 = rf(request, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyJoinRequestReceived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyJoinRequestReceived'
+type MockNotificationService_NotifyJoinRequestReceived_Call struct {
+	*mock.Call
+}
+
+// NotifyJoinRequestReceived is a helper method to define mock.On call
+//   - request *models.JoinRequest
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyJoinRequestReceived(request interface{}, campaign interface{}) *MockNotificationService_NotifyJoinRequestReceived_Call {
+	return &MockNotificationService_NotifyJoinRequestReceived_Call{Call: _e.mock.On("NotifyJoinRequestReceived", request, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestReceived_Call) Run(run func(request *models.JoinRequest, campaign *models.Campaign)) *MockNotificationService_NotifyJoinRequestReceived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.JoinRequest), args[1].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestReceived_Call) Return(_a0 error) *MockNotificationService_NotifyJoinRequestReceived_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestReceived_Call) RunAndReturn(run func(*models.JoinRequest, *models.Campaign) error) *MockNotificationService_NotifyJoinRequestReceived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyJoinRequestRejected provides a mock function with given fields: request, campaign
+func (_m *MockNotificationService) NotifyJoinRequestRejected(request *models.JoinRequest, campaign *models.Campaign) error {
+	ret := _m.Called(request, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyJoinRequestRejected")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JoinRequest, *models.Campaign) error); ok {
+		r0 = rf(request, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyJoinRequestRejected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyJoinRequestRejected'
+type MockNotificationService_NotifyJoinRequestRejected_Call struct {
+	*mock.Call
+}
+
+// NotifyJoinRequestRejected is a helper method to define mock.On call
+//   - request *models.JoinRequest
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyJoinRequestRejected(request interface{}, campaign interface{}) *MockNotificationService_NotifyJoinRequestRejected_Call {
+	return &MockNotificationService_NotifyJoinRequestRejected_Call{Call: _e.mock.On("NotifyJoinRequestRejected", request, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestRejected_Call) Run(run func(request *models.JoinRequest, campaign *models.Campaign)) *MockNotificationService_NotifyJoinRequestRejected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.JoinRequest), args[1].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestRejected_Call) Return(_a0 error) *MockNotificationService_NotifyJoinRequestRejected_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyJoinRequestRejected_Call) RunAndReturn(run func(*models.JoinRequest, *models.Campaign) error) *MockNotificationService_NotifyJoinRequestRejected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyOwnershipTransferred provides a mock function with given fields: campaign, newOwner, previousOwner
+func (_m *MockNotificationService) NotifyOwnershipTransferred(campaign *models.Campaign, newOwner *models.User, previousOwner *models.User) error {
+	ret := _m.Called(campaign, newOwner, previousOwner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyOwnershipTransferred")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Campaign, *models.User, *models.User) error); ok {
+		r0 = rf(campaign, newOwner, previousOwner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyOwnershipTransferred_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyOwnershipTransferred'
+type MockNotificationService_NotifyOwnershipTransferred_Call struct {
+	*mock.Call
+}
+
+// NotifyOwnershipTransferred is a helper method to define mock.On call
+//   - campaign *models.Campaign
+//   - newOwner *models.User
+//   - previousOwner *models.User
+func (_e *MockNotificationService_Expecter) NotifyOwnershipTransferred(campaign interface{}, newOwner interface{}, previousOwner interface{}) *MockNotificationService_NotifyOwnershipTransferred_Call {
+	return &MockNotificationService_NotifyOwnershipTransferred_Call{Call: _e.mock.On("NotifyOwnershipTransferred", campaign, newOwner, previousOwner)}
+}
+
+func (_c *MockNotificationService_NotifyOwnershipTransferred_Call) Run(run func(campaign *models.Campaign, newOwner *models.User, previousOwner *models.User)) *MockNotificationService_NotifyOwnershipTransferred_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Campaign), args[1].(*models.User), args[2].(*models.User))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyOwnershipTransferred_Call) Return(_a0 error) *MockNotificationService_NotifyOwnershipTransferred_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyOwnershipTransferred_Call) RunAndReturn(run func(*models.Campaign, *models.User, *models.User) error) *MockNotificationService_NotifyOwnershipTransferred_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyPaymentReceived provides a mock function with given fields: contributor, campaign
 func (_m *MockNotificationService) NotifyPaymentReceived(contributor *models.Contributor, campaign *models.Campaign) error {
 	ret := _m.Called(contributor, campaign)
@@ -628,6 +1008,54 @@ func (_c *MockNotificationService_NotifyPayoutCollected_Call) RunAndReturn(run f
 	return _c
 }
 
+// NotifyWaitlistPromoted provides a mock function with given fields: contributor, activity, campaign
+func (_m *MockNotificationService) NotifyWaitlistPromoted(contributor *models.Contributor, activity *models.Activity, campaign *models.Campaign) error {
+	ret := _m.Called(contributor, activity, campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyWaitlistPromoted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor, *models.Activity, *models.Campaign) error); ok {
+		r0 = rf(contributor, activity, campaign)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_NotifyWaitlistPromoted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyWaitlistPromoted'
+type MockNotificationService_NotifyWaitlistPromoted_Call struct {
+	*mock.Call
+}
+
+// NotifyWaitlistPromoted is a helper method to define mock.On call
+//   - contributor *models.Contributor
+//   - activity *models.Activity
+//   - campaign *models.Campaign
+func (_e *MockNotificationService_Expecter) NotifyWaitlistPromoted(contributor interface{}, activity interface{}, campaign interface{}) *MockNotificationService_NotifyWaitlistPromoted_Call {
+	return &MockNotificationService_NotifyWaitlistPromoted_Call{Call: _e.mock.On("NotifyWaitlistPromoted", contributor, activity, campaign)}
+}
+
+func (_c *MockNotificationService_NotifyWaitlistPromoted_Call) Run(run func(contributor *models.Contributor, activity *models.Activity, campaign *models.Campaign)) *MockNotificationService_NotifyWaitlistPromoted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor), args[1].(*models.Activity), args[2].(*models.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyWaitlistPromoted_Call) Return(_a0 error) *MockNotificationService_NotifyWaitlistPromoted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_NotifyWaitlistPromoted_Call) RunAndReturn(run func(*models.Contributor, *models.Activity, *models.Campaign) error) *MockNotificationService_NotifyWaitlistPromoted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendContributionReminder provides a mock function with given fields: contributor, campaign
 func (_m *MockNotificationService) SendContributionReminder(contributor *models.Contributor, campaign *models.Campaign) error {
 	ret := _m.Called(contributor, campaign)
@@ -721,6 +1149,52 @@ func (_c *MockNotificationService_SendDeadlineReminder_Call) RunAndReturn(run fu
 	return _c
 }
 
+// SendInvitationReminder provides a mock function with given fields: contributor
+func (_m *MockNotificationService) SendInvitationReminder(contributor *models.Contributor) error {
+	ret := _m.Called(contributor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendInvitationReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contributor) error); ok {
+		r0 = rf(contributor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SendInvitationReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInvitationReminder'
+type MockNotificationService_SendInvitationReminder_Call struct {
+	*mock.Call
+}
+
+// SendInvitationReminder is a helper method to define mock.On call
+//   - contributor *models.Contributor
+func (_e *MockNotificationService_Expecter) SendInvitationReminder(contributor interface{}) *MockNotificationService_SendInvitationReminder_Call {
+	return &MockNotificationService_SendInvitationReminder_Call{Call: _e.mock.On("SendInvitationReminder", contributor)}
+}
+
+func (_c *MockNotificationService_SendInvitationReminder_Call) Run(run func(contributor *models.Contributor)) *MockNotificationService_SendInvitationReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.Contributor))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendInvitationReminder_Call) Return(_a0 error) *MockNotificationService_SendInvitationReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SendInvitationReminder_Call) RunAndReturn(run func(*models.Contributor) error) *MockNotificationService_SendInvitationReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendSystemNotification provides a mock function with given fields: notificationType, message
 func (_m *MockNotificationService) SendSystemNotification(notificationType string, message string) error {
 	ret := _m.Called(notificationType, message)
@@ -125,6 +125,67 @@ func (_c *MockPaymentService_GetPaymentByReference_Call) RunAndReturn(run func(s
 	return _c
 }
 
+// GetPaymentProof provides a mock function with given fields: reference, userEmail, userHandle, key
+func (_m *MockPaymentService) GetPaymentProof(reference string, userEmail string, userHandle string, key string) (*models.DocumentLink, error) {
+	ret := _m.Called(reference, userEmail, userHandle, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentProof")
+	}
+
+	var r0 *models.DocumentLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*models.DocumentLink, error)); ok {
+		return rf(reference, userEmail, userHandle, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.DocumentLink); ok {
+		r0 = rf(reference, userEmail, userHandle, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DocumentLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(reference, userEmail, userHandle, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentService_GetPaymentProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentProof'
+type MockPaymentService_GetPaymentProof_Call struct {
+	*mock.Call
+}
+
+// GetPaymentProof is a helper method to define mock.On call
+//   - reference string
+//   - userEmail string
+//   - userHandle string
+//   - key string
+func (_e *MockPaymentService_Expecter) GetPaymentProof(reference interface{}, userEmail interface{}, userHandle interface{}, key interface{}) *MockPaymentService_GetPaymentProof_Call {
+	return &MockPaymentService_GetPaymentProof_Call{Call: _e.mock.On("GetPaymentProof", reference, userEmail, userHandle, key)}
+}
+
+func (_c *MockPaymentService_GetPaymentProof_Call) Run(run func(reference string, userEmail string, userHandle string, key string)) *MockPaymentService_GetPaymentProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockPaymentService_GetPaymentProof_Call) Return(_a0 *models.DocumentLink, _a1 error) *MockPaymentService_GetPaymentProof_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentService_GetPaymentProof_Call) RunAndReturn(run func(string, string, string, string) (*models.DocumentLink, error)) *MockPaymentService_GetPaymentProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaymentsByCampaign provides a mock function with given fields: campaignID, limit, offset
 func (_m *MockPaymentService) GetPaymentsByCampaign(campaignID string, limit int, offset int) ([]*models.Payment, int64, error) {
 	ret := _m.Called(campaignID, limit, offset)
@@ -259,9 +320,9 @@ func (_c *MockPaymentService_GetPaymentsByContributor_Call) RunAndReturn(run fun
 	return _c
 }
 
-// InitializeManualPayment provides a mock function with given fields: contributorID, reference, userEmail, key
-func (_m *MockPaymentService) InitializeManualPayment(contributorID uint, reference string, userEmail string, key string) (*models.Payment, error) {
-	ret := _m.Called(contributorID, reference, userEmail, key)
+// InitializeManualPayment provides a mock function with given fields: contributorID, reference, userEmail, userHandle, key
+func (_m *MockPaymentService) InitializeManualPayment(contributorID uint, reference string, userEmail string, userHandle string, key string) (*models.Payment, error) {
+	ret := _m.Called(contributorID, reference, userEmail, userHandle, key)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InitializeManualPayment")
@@ -269,19 +330,19 @@ func (_m *MockPaymentService) InitializeManualPayment(contributorID uint, refere
 
 	var r0 *models.Payment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, string, string, string) (*models.Payment, error)); ok {
-		return rf(contributorID, reference, userEmail, key)
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) (*models.Payment, error)); ok {
+		return rf(contributorID, reference, userEmail, userHandle, key)
 	}
-	if rf, ok := ret.Get(0).(func(uint, string, string, string) *models.Payment); ok {
-		r0 = rf(contributorID, reference, userEmail, key)
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) *models.Payment); ok {
+		r0 = rf(contributorID, reference, userEmail, userHandle, key)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Payment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, string, string, string) error); ok {
-		r1 = rf(contributorID, reference, userEmail, key)
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string) error); ok {
+		r1 = rf(contributorID, reference, userEmail, userHandle, key)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,14 +359,15 @@ type MockPaymentService_InitializeManualPayment_Call struct {
 //   - contributorID uint
 //   - reference string
 //   - userEmail string
+//   - userHandle string
 //   - key string
-func (_e *MockPaymentService_Expecter) InitializeManualPayment(contributorID interface{}, reference interface{}, userEmail interface{}, key interface{}) *MockPaymentService_InitializeManualPayment_Call {
-	return &MockPaymentService_InitializeManualPayment_Call{Call: _e.mock.On("InitializeManualPayment", contributorID, reference, userEmail, key)}
+func (_e *MockPaymentService_Expecter) InitializeManualPayment(contributorID interface{}, reference interface{}, userEmail interface{}, userHandle interface{}, key interface{}) *MockPaymentService_InitializeManualPayment_Call {
+	return &MockPaymentService_InitializeManualPayment_Call{Call: _e.mock.On("InitializeManualPayment", contributorID, reference, userEmail, userHandle, key)}
 }
 
-func (_c *MockPaymentService_InitializeManualPayment_Call) Run(run func(contributorID uint, reference string, userEmail string, key string)) *MockPaymentService_InitializeManualPayment_Call {
+func (_c *MockPaymentService_InitializeManualPayment_Call) Run(run func(contributorID uint, reference string, userEmail string, userHandle string, key string)) *MockPaymentService_InitializeManualPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -315,7 +377,7 @@ func (_c *MockPaymentService_InitializeManualPayment_Call) Return(_a0 *models.Pa
 	return _c
 }
 
-func (_c *MockPaymentService_InitializeManualPayment_Call) RunAndReturn(run func(uint, string, string, string) (*models.Payment, error)) *MockPaymentService_InitializeManualPayment_Call {
+func (_c *MockPaymentService_InitializeManualPayment_Call) RunAndReturn(run func(uint, string, string, string, string) (*models.Payment, error)) *MockPaymentService_InitializeManualPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,284 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	time "time"
+
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPollService is an autogenerated mock type for the PollService type
+type MockPollService struct {
+	mock.Mock
+}
+
+type MockPollService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPollService) EXPECT() *MockPollService_Expecter {
+	return &MockPollService_Expecter{mock: &_m.Mock}
+}
+
+// ClosePoll provides a mock function with given fields: pollID, campaignID, key, userHandle
+func (_m *MockPollService) ClosePoll(pollID uint, campaignID string, key string, userHandle string) (*models.Poll, error) {
+	ret := _m.Called(pollID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePoll")
+	}
+
+	var r0 *models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) (*models.Poll, error)); ok {
+		return rf(pollID, campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) *models.Poll); ok {
+		r0 = rf(pollID, campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string) error); ok {
+		r1 = rf(pollID, campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollService_ClosePoll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClosePoll'
+type MockPollService_ClosePoll_Call struct {
+	*mock.Call
+}
+
+// ClosePoll is a helper method to define mock.On call
+//   - pollID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockPollService_Expecter) ClosePoll(pollID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockPollService_ClosePoll_Call {
+	return &MockPollService_ClosePoll_Call{Call: _e.mock.On("ClosePoll", pollID, campaignID, key, userHandle)}
+}
+
+func (_c *MockPollService_ClosePoll_Call) Run(run func(pollID uint, campaignID string, key string, userHandle string)) *MockPollService_ClosePoll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockPollService_ClosePoll_Call) Return(_a0 *models.Poll, _a1 error) *MockPollService_ClosePoll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollService_ClosePoll_Call) RunAndReturn(run func(uint, string, string, string) (*models.Poll, error)) *MockPollService_ClosePoll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePoll provides a mock function with given fields: campaignID, key, userHandle, question, options, multipleChoice, deadline
+func (_m *MockPollService) CreatePoll(campaignID string, key string, userHandle string, question string, options []string, multipleChoice bool, deadline *time.Time) (*models.Poll, error) {
+	ret := _m.Called(campaignID, key, userHandle, question, options, multipleChoice, deadline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePoll")
+	}
+
+	var r0 *models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []string, bool, *time.Time) (*models.Poll, error)); ok {
+		return rf(campaignID, key, userHandle, question, options, multipleChoice, deadline)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []string, bool, *time.Time) *models.Poll); ok {
+		r0 = rf(campaignID, key, userHandle, question, options, multipleChoice, deadline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, []string, bool, *time.Time) error); ok {
+		r1 = rf(campaignID, key, userHandle, question, options, multipleChoice, deadline)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollService_CreatePoll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePoll'
+type MockPollService_CreatePoll_Call struct {
+	*mock.Call
+}
+
+// CreatePoll is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - question string
+//   - options []string
+//   - multipleChoice bool
+//   - deadline *time.Time
+func (_e *MockPollService_Expecter) CreatePoll(campaignID interface{}, key interface{}, userHandle interface{}, question interface{}, options interface{}, multipleChoice interface{}, deadline interface{}) *MockPollService_CreatePoll_Call {
+	return &MockPollService_CreatePoll_Call{Call: _e.mock.On("CreatePoll", campaignID, key, userHandle, question, options, multipleChoice, deadline)}
+}
+
+func (_c *MockPollService_CreatePoll_Call) Run(run func(campaignID string, key string, userHandle string, question string, options []string, multipleChoice bool, deadline *time.Time)) *MockPollService_CreatePoll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].([]string), args[5].(bool), args[6].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPollService_CreatePoll_Call) Return(_a0 *models.Poll, _a1 error) *MockPollService_CreatePoll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollService_CreatePoll_Call) RunAndReturn(run func(string, string, string, string, []string, bool, *time.Time) (*models.Poll, error)) *MockPollService_CreatePoll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolls provides a mock function with given fields: campaignID, key, userEmail
+func (_m *MockPollService) GetPolls(campaignID string, key string, userEmail string) ([]models.Poll, error) {
+	ret := _m.Called(campaignID, key, userEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolls")
+	}
+
+	var r0 []models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]models.Poll, error)); ok {
+		return rf(campaignID, key, userEmail)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []models.Poll); ok {
+		r0 = rf(campaignID, key, userEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollService_GetPolls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolls'
+type MockPollService_GetPolls_Call struct {
+	*mock.Call
+}
+
+// GetPolls is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userEmail string
+func (_e *MockPollService_Expecter) GetPolls(campaignID interface{}, key interface{}, userEmail interface{}) *MockPollService_GetPolls_Call {
+	return &MockPollService_GetPolls_Call{Call: _e.mock.On("GetPolls", campaignID, key, userEmail)}
+}
+
+func (_c *MockPollService_GetPolls_Call) Run(run func(campaignID string, key string, userEmail string)) *MockPollService_GetPolls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPollService_GetPolls_Call) Return(_a0 []models.Poll, _a1 error) *MockPollService_GetPolls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollService_GetPolls_Call) RunAndReturn(run func(string, string, string) ([]models.Poll, error)) *MockPollService_GetPolls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoteOnPoll provides a mock function with given fields: pollID, campaignID, key, userEmail, optionIDs
+func (_m *MockPollService) VoteOnPoll(pollID uint, campaignID string, key string, userEmail string, optionIDs []uint) (*models.Poll, error) {
+	ret := _m.Called(pollID, campaignID, key, userEmail, optionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteOnPoll")
+	}
+
+	var r0 *models.Poll
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, []uint) (*models.Poll, error)); ok {
+		return rf(pollID, campaignID, key, userEmail, optionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, []uint) *models.Poll); ok {
+		r0 = rf(pollID, campaignID, key, userEmail, optionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Poll)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, []uint) error); ok {
+		r1 = rf(pollID, campaignID, key, userEmail, optionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPollService_VoteOnPoll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteOnPoll'
+type MockPollService_VoteOnPoll_Call struct {
+	*mock.Call
+}
+
+// VoteOnPoll is a helper method to define mock.On call
+//   - pollID uint
+//   - campaignID string
+//   - key string
+//   - userEmail string
+//   - optionIDs []uint
+func (_e *MockPollService_Expecter) VoteOnPoll(pollID interface{}, campaignID interface{}, key interface{}, userEmail interface{}, optionIDs interface{}) *MockPollService_VoteOnPoll_Call {
+	return &MockPollService_VoteOnPoll_Call{Call: _e.mock.On("VoteOnPoll", pollID, campaignID, key, userEmail, optionIDs)}
+}
+
+func (_c *MockPollService_VoteOnPoll_Call) Run(run func(pollID uint, campaignID string, key string, userEmail string, optionIDs []uint)) *MockPollService_VoteOnPoll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].([]uint))
+	})
+	return _c
+}
+
+func (_c *MockPollService_VoteOnPoll_Call) Return(_a0 *models.Poll, _a1 error) *MockPollService_VoteOnPoll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPollService_VoteOnPoll_Call) RunAndReturn(run func(uint, string, string, string, []uint) (*models.Poll, error)) *MockPollService_VoteOnPoll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPollService creates a new instance of MockPollService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPollService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPollService {
+	mock := &MockPollService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
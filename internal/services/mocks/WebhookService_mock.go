@@ -0,0 +1,472 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package interfaces
+
+import (
+	models "github.com/oyen-bright/goFundIt/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookService is an autogenerated mock type for the WebhookService type
+type MockWebhookService struct {
+	mock.Mock
+}
+
+type MockWebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookService) EXPECT() *MockWebhookService_Expecter {
+	return &MockWebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: campaignID, key, userHandle, url, eventTypes
+func (_m *MockWebhookService) CreateWebhook(campaignID string, key string, userHandle string, url string, eventTypes []string) (*models.Webhook, error) {
+	ret := _m.Called(campaignID, key, userHandle, url, eventTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []string) (*models.Webhook, error)); ok {
+		return rf(campaignID, key, userHandle, url, eventTypes)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []string) *models.Webhook); ok {
+		r0 = rf(campaignID, key, userHandle, url, eventTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, []string) error); ok {
+		r1 = rf(campaignID, key, userHandle, url, eventTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookService_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - url string
+//   - eventTypes []string
+func (_e *MockWebhookService_Expecter) CreateWebhook(campaignID interface{}, key interface{}, userHandle interface{}, url interface{}, eventTypes interface{}) *MockWebhookService_CreateWebhook_Call {
+	return &MockWebhookService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", campaignID, key, userHandle, url, eventTypes)}
+}
+
+func (_c *MockWebhookService_CreateWebhook_Call) Run(run func(campaignID string, key string, userHandle string, url string, eventTypes []string)) *MockWebhookService_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_CreateWebhook_Call) Return(_a0 *models.Webhook, _a1 error) *MockWebhookService_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookService_CreateWebhook_Call) RunAndReturn(run func(string, string, string, string, []string) (*models.Webhook, error)) *MockWebhookService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: webhookID, campaignID, key, userHandle
+func (_m *MockWebhookService) DeleteWebhook(webhookID uint, campaignID string, key string, userHandle string) error {
+	ret := _m.Called(webhookID, campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string) error); ok {
+		r0 = rf(webhookID, campaignID, key, userHandle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - webhookID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockWebhookService_Expecter) DeleteWebhook(webhookID interface{}, campaignID interface{}, key interface{}, userHandle interface{}) *MockWebhookService_DeleteWebhook_Call {
+	return &MockWebhookService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", webhookID, campaignID, key, userHandle)}
+}
+
+func (_c *MockWebhookService_DeleteWebhook_Call) Run(run func(webhookID uint, campaignID string, key string, userHandle string)) *MockWebhookService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_DeleteWebhook_Call) Return(_a0 error) *MockWebhookService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookService_DeleteWebhook_Call) RunAndReturn(run func(uint, string, string, string) error) *MockWebhookService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveries provides a mock function with given fields: webhookID, campaignID, key, userHandle, status, limit
+func (_m *MockWebhookService) GetDeliveries(webhookID uint, campaignID string, key string, userHandle string, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error) {
+	ret := _m.Called(webhookID, campaignID, key, userHandle, status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.WebhookDeliveryStatus, int) ([]models.WebhookDelivery, error)); ok {
+		return rf(webhookID, campaignID, key, userHandle, status, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, models.WebhookDeliveryStatus, int) []models.WebhookDelivery); ok {
+		r0 = rf(webhookID, campaignID, key, userHandle, status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, models.WebhookDeliveryStatus, int) error); ok {
+		r1 = rf(webhookID, campaignID, key, userHandle, status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookService_GetDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveries'
+type MockWebhookService_GetDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetDeliveries is a helper method to define mock.On call
+//   - webhookID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - status models.WebhookDeliveryStatus
+//   - limit int
+func (_e *MockWebhookService_Expecter) GetDeliveries(webhookID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, status interface{}, limit interface{}) *MockWebhookService_GetDeliveries_Call {
+	return &MockWebhookService_GetDeliveries_Call{Call: _e.mock.On("GetDeliveries", webhookID, campaignID, key, userHandle, status, limit)}
+}
+
+func (_c *MockWebhookService_GetDeliveries_Call) Run(run func(webhookID uint, campaignID string, key string, userHandle string, status models.WebhookDeliveryStatus, limit int)) *MockWebhookService_GetDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(models.WebhookDeliveryStatus), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_GetDeliveries_Call) Return(_a0 []models.WebhookDelivery, _a1 error) *MockWebhookService_GetDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookService_GetDeliveries_Call) RunAndReturn(run func(uint, string, string, string, models.WebhookDeliveryStatus, int) ([]models.WebhookDelivery, error)) *MockWebhookService_GetDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: campaignID, key, userHandle
+func (_m *MockWebhookService) GetWebhooks(campaignID string, key string, userHandle string) ([]models.Webhook, error) {
+	ret := _m.Called(campaignID, key, userHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]models.Webhook, error)); ok {
+		return rf(campaignID, key, userHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []models.Webhook); ok {
+		r0 = rf(campaignID, key, userHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(campaignID, key, userHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookService_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockWebhookService_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - campaignID string
+//   - key string
+//   - userHandle string
+func (_e *MockWebhookService_Expecter) GetWebhooks(campaignID interface{}, key interface{}, userHandle interface{}) *MockWebhookService_GetWebhooks_Call {
+	return &MockWebhookService_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", campaignID, key, userHandle)}
+}
+
+func (_c *MockWebhookService_GetWebhooks_Call) Run(run func(campaignID string, key string, userHandle string)) *MockWebhookService_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_GetWebhooks_Call) Return(_a0 []models.Webhook, _a1 error) *MockWebhookService_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookService_GetWebhooks_Call) RunAndReturn(run func(string, string, string) ([]models.Webhook, error)) *MockWebhookService_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDelivery provides a mock function with given fields: webhookID, campaignID, key, userHandle, deliveryID
+func (_m *MockWebhookService) ReplayDelivery(webhookID uint, campaignID string, key string, userHandle string, deliveryID string) (*models.WebhookDelivery, error) {
+	ret := _m.Called(webhookID, campaignID, key, userHandle, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDelivery")
+	}
+
+	var r0 *models.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) (*models.WebhookDelivery, error)); ok {
+		return rf(webhookID, campaignID, key, userHandle, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, string) *models.WebhookDelivery); ok {
+		r0 = rf(webhookID, campaignID, key, userHandle, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, string) error); ok {
+		r1 = rf(webhookID, campaignID, key, userHandle, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookService_ReplayDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDelivery'
+type MockWebhookService_ReplayDelivery_Call struct {
+	*mock.Call
+}
+
+// ReplayDelivery is a helper method to define mock.On call
+//   - webhookID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - deliveryID string
+func (_e *MockWebhookService_Expecter) ReplayDelivery(webhookID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, deliveryID interface{}) *MockWebhookService_ReplayDelivery_Call {
+	return &MockWebhookService_ReplayDelivery_Call{Call: _e.mock.On("ReplayDelivery", webhookID, campaignID, key, userHandle, deliveryID)}
+}
+
+func (_c *MockWebhookService_ReplayDelivery_Call) Run(run func(webhookID uint, campaignID string, key string, userHandle string, deliveryID string)) *MockWebhookService_ReplayDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_ReplayDelivery_Call) Return(_a0 *models.WebhookDelivery, _a1 error) *MockWebhookService_ReplayDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookService_ReplayDelivery_Call) RunAndReturn(run func(uint, string, string, string, string) (*models.WebhookDelivery, error)) *MockWebhookService_ReplayDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartDeliveries provides a mock function with no fields
+func (_m *MockWebhookService) StartDeliveries() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookService_StartDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDeliveries'
+type MockWebhookService_StartDeliveries_Call struct {
+	*mock.Call
+}
+
+// StartDeliveries is a helper method to define mock.On call
+func (_e *MockWebhookService_Expecter) StartDeliveries() *MockWebhookService_StartDeliveries_Call {
+	return &MockWebhookService_StartDeliveries_Call{Call: _e.mock.On("StartDeliveries")}
+}
+
+func (_c *MockWebhookService_StartDeliveries_Call) Run(run func()) *MockWebhookService_StartDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_StartDeliveries_Call) Return(_a0 error) *MockWebhookService_StartDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookService_StartDeliveries_Call) RunAndReturn(run func() error) *MockWebhookService_StartDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopDeliveries provides a mock function with no fields
+func (_m *MockWebhookService) StopDeliveries() {
+	_m.Called()
+}
+
+// MockWebhookService_StopDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopDeliveries'
+type MockWebhookService_StopDeliveries_Call struct {
+	*mock.Call
+}
+
+// StopDeliveries is a helper method to define mock.On call
+func (_e *MockWebhookService_Expecter) StopDeliveries() *MockWebhookService_StopDeliveries_Call {
+	return &MockWebhookService_StopDeliveries_Call{Call: _e.mock.On("StopDeliveries")}
+}
+
+func (_c *MockWebhookService_StopDeliveries_Call) Run(run func()) *MockWebhookService_StopDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_StopDeliveries_Call) Return() *MockWebhookService_StopDeliveries_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWebhookService_StopDeliveries_Call) RunAndReturn(run func()) *MockWebhookService_StopDeliveries_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: webhookID, campaignID, key, userHandle, url, eventTypes, enabled
+func (_m *MockWebhookService) UpdateWebhook(webhookID uint, campaignID string, key string, userHandle string, url *string, eventTypes []string, enabled *bool) (*models.Webhook, error) {
+	ret := _m.Called(webhookID, campaignID, key, userHandle, url, eventTypes, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 *models.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, *string, []string, *bool) (*models.Webhook, error)); ok {
+		return rf(webhookID, campaignID, key, userHandle, url, eventTypes, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, string, *string, []string, *bool) *models.Webhook); ok {
+		r0 = rf(webhookID, campaignID, key, userHandle, url, eventTypes, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, string, *string, []string, *bool) error); ok {
+		r1 = rf(webhookID, campaignID, key, userHandle, url, eventTypes, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookService_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockWebhookService_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - webhookID uint
+//   - campaignID string
+//   - key string
+//   - userHandle string
+//   - url *string
+//   - eventTypes []string
+//   - enabled *bool
+func (_e *MockWebhookService_Expecter) UpdateWebhook(webhookID interface{}, campaignID interface{}, key interface{}, userHandle interface{}, url interface{}, eventTypes interface{}, enabled interface{}) *MockWebhookService_UpdateWebhook_Call {
+	return &MockWebhookService_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", webhookID, campaignID, key, userHandle, url, eventTypes, enabled)}
+}
+
+func (_c *MockWebhookService_UpdateWebhook_Call) Run(run func(webhookID uint, campaignID string, key string, userHandle string, url *string, eventTypes []string, enabled *bool)) *MockWebhookService_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(string), args[2].(string), args[3].(string), args[4].(*string), args[5].([]string), args[6].(*bool))
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_UpdateWebhook_Call) Return(_a0 *models.Webhook, _a1 error) *MockWebhookService_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookService_UpdateWebhook_Call) RunAndReturn(run func(uint, string, string, string, *string, []string, *bool) (*models.Webhook, error)) *MockWebhookService_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookService creates a new instance of MockWebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookService {
+	mock := &MockWebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
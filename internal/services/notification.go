@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
@@ -14,6 +16,9 @@ import (
 
 //TODO:FCM for contributors
 
+// emailBatchSize is how many personal emails are sent at the same time
+const emailBatchSize = 10
+
 type emailNotifier struct {
 	client email.Emailer
 	logger logger.Logger
@@ -28,15 +33,18 @@ type notificationService struct {
 	emailer     emailNotifier
 	fcmNotifier fcmNotifier
 	authService services.AuthService
-	logger      logger.Logger
+	// appURL is the base URL of the app, links in emails point to it
+	appURL string
+	logger logger.Logger
 }
 
 // notificationService implements interfaces.NotificationService.
-func NewNotificationService(emailer email.Emailer, authService services.AuthService, fcmClient fcm.FCM, logger logger.Logger) services.NotificationService {
+func NewNotificationService(emailer email.Emailer, authService services.AuthService, fcmClient fcm.FCM, appURL string, logger logger.Logger) services.NotificationService {
 	return &notificationService{
 		emailer:     emailNotifier{client: emailer, logger: logger},
 		fcmNotifier: fcmNotifier{client: fcmClient, logger: logger},
 		authService: authService,
+		appURL:      strings.TrimSuffix(appURL, "/"),
 		logger:      logger,
 	}
 }
@@ -49,6 +57,32 @@ func (e *emailNotifier) send(template *email.EmailTemplate) error {
 	return nil
 }
 
+// sendInBatches sends personal emails a batch at a time and returns the first error
+func (e *emailNotifier) sendInBatches(templates []*email.EmailTemplate) error {
+	var (
+		firstErr error
+		mu       sync.Mutex
+	)
+	for start := 0; start < len(templates); start += emailBatchSize {
+		var wg sync.WaitGroup
+		for _, template := range templates[start:min(start+emailBatchSize, len(templates))] {
+			wg.Add(1)
+			go func(template *email.EmailTemplate) {
+				defer wg.Done()
+				if err := e.send(template); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}(template)
+		}
+		wg.Wait()
+	}
+	return firstErr
+}
+
 func (f *fcmNotifier) send(data fcm.NotificationData, tokens []string) error {
 	ctx := context.Background()
 	if len(tokens) == 0 {
@@ -75,7 +109,7 @@ func (f *fcmNotifier) send(data fcm.NotificationData, tokens []string) error {
 
 // NotifyActivityAddition sends an email to all activities of a campaign when a new activity is added.
 func (n *notificationService) NotifyActivityAddition(activity *models.Activity, campaign *models.Campaign) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	activityAdded := emailTemplates.ActivityAddedGeneral(contributorsEmails, campaign.ID, activity.Title, activity.Subtitle, activity.Cost)
 
 	return n.emailer.send(activityAdded)
@@ -83,7 +117,7 @@ func (n *notificationService) NotifyActivityAddition(activity *models.Activity,
 
 // NotifyActivityApproval implements interfaces.NotificationService.
 func (n *notificationService) NotifyActivityApproved(activity *models.Activity, campaign *models.Campaign) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	activityApprovedTemplate := emailTemplates.ActivityApprovedGeneral(contributorsEmails, campaign.ID, activity.Title, activity.Subtitle, campaign.CreatedBy.Email, activity.UpdatedAt)
 	return n.emailer.send(activityApprovedTemplate)
 }
@@ -101,9 +135,15 @@ func (n *notificationService) NotifyActivityApprovalRequest(activity *models.Act
 	return n.emailer.send(activityApprovalRequest)
 }
 
+// NotifyWaitlistPromoted implements interfaces.NotificationService.
+func (n *notificationService) NotifyWaitlistPromoted(contributor *models.Contributor, activity *models.Activity, campaign *models.Campaign) error {
+	waitlistPromotedTemplate := emailTemplates.WaitlistPromoted([]string{contributor.Email}, contributor.Name, campaign.ID, activity.Title, activity.ShareOf(contributor.ID))
+	return n.emailer.send(waitlistPromotedTemplate)
+}
+
 // NotifyActivityUpdate implements interfaces.NotificationService.
 func (n *notificationService) NotifyActivityUpdate(activity *models.Activity, campaign *models.Campaign) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	activityUpdate := emailTemplates.ActivityUpdateGeneral(contributorsEmails, campaign.ID, activity.Title, "details updated")
 	return n.emailer.send(activityUpdate)
 }
@@ -119,19 +159,28 @@ func (n *notificationService) NotifyCampaignCreation(campaign *models.Campaign)
 	campaignCreatedCampaignCreator := emailTemplates.CampaignCreated([]string{campaign.CreatedBy.Email}, campaign.Title, campaign.Description, campaign.ID, campaign.Key, contributorsNameEmail, activitiesTitleSubtitle)
 	err := n.emailer.send(campaignCreatedCampaignCreator)
 
-	//send email to campaign contributors
-	for _, contributor := range contributorsNameEmail {
-		go func(contributor map[string]string) {
-			contributorAddedTemplate := emailTemplates.ContributorAdded([]string{contributor["email"]}, contributor["name"], campaign.Title, campaign.ID, campaign.Key)
-			err = n.emailer.send(contributorAddedTemplate)
-		}(contributor)
+	//send email to campaign contributors, invited contributors get their invitation
+	if batchErr := n.emailer.sendInBatches(n.contributorWelcomeTemplates(campaign.Contributors, campaign)); batchErr != nil {
+		return batchErr
 	}
 	return err
 }
 
+// NotifyOwnershipTransferred implements interfaces.NotificationService.
+func (n *notificationService) NotifyOwnershipTransferred(campaign *models.Campaign, newOwner *models.User, previousOwner *models.User) error {
+	ownershipTemplate := emailTemplates.OwnershipTransferred([]string{newOwner.Email}, campaign.Title, campaign.ID, campaign.Key, previousOwner.Email)
+	if err := n.emailer.send(ownershipTemplate); err != nil {
+		return err
+	}
+
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
+	campaignUpdatedTemplate := emailTemplates.CampaignUpdatedGeneral(contributorsEmails, campaign.Title, "Campaign owner changed", previousOwner.Email, newOwner.Email)
+	return n.emailer.send(campaignUpdatedTemplate)
+}
+
 // NotifyCampaignMilestone implements interfaces.NotificationService.
 func (n *notificationService) NotifyCampaignMilestone(campaign *models.Campaign, milestoneType string) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	contributorsEmails = append(contributorsEmails, campaign.CreatedBy.Email)
 
 	userFCMToken := campaign.CreatedBy.FCMToken
@@ -148,10 +197,19 @@ func (n *notificationService) NotifyCampaignMilestone(campaign *models.Campaign,
 
 // NotifyCampaignUpdate implements interfaces.NotificationService.
 func (n *notificationService) NotifyCampaignUpdate(campaign *models.Campaign, updateType string) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	contributorsEmails = append(contributorsEmails, campaign.CreatedBy.Email)
 
-	campaignUpdateTemplate := emailTemplates.CampaignUpdatedGeneral(contributorsEmails, campaign.ID, updateType)
+	campaignUpdateTemplate := emailTemplates.CampaignUpdatedGeneral(contributorsEmails, campaign.ID, updateType, "", "")
+	return n.emailer.send(campaignUpdateTemplate)
+}
+
+// NotifyCampaignChanged implements interfaces.NotificationService.
+func (n *notificationService) NotifyCampaignChanged(campaign *models.Campaign, updateType, from, to string) error {
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
+	contributorsEmails = append(contributorsEmails, campaign.CreatedBy.Email)
+
+	campaignUpdateTemplate := emailTemplates.CampaignUpdatedGeneral(contributorsEmails, campaign.Title, updateType, from, to)
 	return n.emailer.send(campaignUpdateTemplate)
 }
 
@@ -159,8 +217,8 @@ func (n *notificationService) NotifyCampaignUpdate(campaign *models.Campaign, up
 
 // NotifyContributorAdded implements interfaces.NotificationService.
 func (n *notificationService) NotifyContributorAdded(contributor *models.Contributor, campaign *models.Campaign) error {
-	contributorEmails := getContributorEmails(campaign.Contributors)
-	contributorAddedTemplate := emailTemplates.ContributorAdded([]string{contributor.Email}, contributor.Name, campaign.Title, campaign.ID, campaign.Key)
+	contributorEmails := getContributorEmails(campaign.AcceptedContributors())
+	contributorAddedTemplate := emailTemplates.ContributorAdded([]string{contributor.Email}, contributor.Name, campaign.Title, campaign.ID, contributor.AccessKey)
 	err := n.emailer.send(contributorAddedTemplate)
 	if err != nil {
 		return err
@@ -170,6 +228,52 @@ func (n *notificationService) NotifyContributorAdded(contributor *models.Contrib
 	return err
 }
 
+// NotifyContributorInvited implements interfaces.NotificationService.
+func (n *notificationService) NotifyContributorInvited(contributor *models.Contributor, campaign *models.Campaign) error {
+	return n.emailer.send(n.contributorInvitedTemplate(contributor, campaign))
+}
+
+// NotifyContributorsImported implements interfaces.NotificationService.
+func (n *notificationService) NotifyContributorsImported(contributors []models.Contributor, campaign *models.Campaign) error {
+	return n.emailer.sendInBatches(n.contributorWelcomeTemplates(contributors, campaign))
+}
+
+// NotifyInvitationDeclined implements interfaces.NotificationService.
+func (n *notificationService) NotifyInvitationDeclined(contributor *models.Contributor, campaign *models.Campaign) error {
+	invitationDeclinedTemplate := emailTemplates.InvitationDeclined([]string{campaign.CreatedBy.Email}, contributor.Name, contributor.Email, campaign.ID)
+
+	userFCMToken := campaign.CreatedBy.FCMToken
+	if userFCMToken != nil {
+		n.fcmNotifier.send(fcm.NotificationData{
+			Title: "Campaign Invitation Declined",
+			Body:  fmt.Sprintf("%s declined the invitation to campaign %s", contributor.Email, campaign.ID),
+		}, []string{*userFCMToken})
+	}
+	return n.emailer.send(invitationDeclinedTemplate)
+}
+
+// ====== Join Request Notifications ======
+
+// NotifyJoinRequestReceived implements interfaces.NotificationService.
+func (n *notificationService) NotifyJoinRequestReceived(request *models.JoinRequest, campaign *models.Campaign) error {
+	joinRequestTemplate := emailTemplates.JoinRequestReceived([]string{campaign.CreatedBy.Email}, campaign.Title, request.Name, request.Email, request.Amount, request.Message)
+
+	userFCMToken := campaign.CreatedBy.FCMToken
+	if userFCMToken != nil {
+		n.fcmNotifier.send(fcm.NotificationData{
+			Title: "New Request to Join",
+			Body:  fmt.Sprintf("%s has requested to join %s", request.Email, campaign.Title),
+		}, []string{*userFCMToken})
+	}
+	return n.emailer.send(joinRequestTemplate)
+}
+
+// NotifyJoinRequestRejected implements interfaces.NotificationService.
+func (n *notificationService) NotifyJoinRequestRejected(request *models.JoinRequest, campaign *models.Campaign) error {
+	joinRequestTemplate := emailTemplates.JoinRequestRejected([]string{request.Email}, request.Name, campaign.Title)
+	return n.emailer.send(joinRequestTemplate)
+}
+
 // ====== Payment and Payout Notifications ======
 
 // NotifyPaymentReceived implements interfaces.NotificationService.
@@ -188,7 +292,7 @@ func (n *notificationService) NotifyPaymentReceived(contributor *models.Contribu
 
 // NotifyPayoutCollected implements interfaces.NotificationService.
 func (n *notificationService) NotifyPayoutCollected(campaign *models.Campaign) error {
-	contributorsEmails := getContributorEmails(campaign.Contributors)
+	contributorsEmails := getContributorEmails(campaign.AcceptedContributors())
 	contributorsEmails = append(contributorsEmails, campaign.CreatedBy.Email)
 
 	payoutCollectedTemplate := emailTemplates.PayoutCollected(contributorsEmails, campaign.ID, campaign.CreatedBy.Email, campaign.GetPayoutAmount(), campaign.Payout.UpdatedAt)
@@ -223,6 +327,14 @@ func (n *notificationService) SendContributionReminder(contributor *models.Contr
 	return n.emailer.send(contributionReminder)
 }
 
+// SendInvitationReminder implements interfaces.NotificationService.
+func (n *notificationService) SendInvitationReminder(contributor *models.Contributor) error {
+	invitation := contributor.Invitation
+	invitationReminder := emailTemplates.InvitationReminder([]string{contributor.Email}, contributor.Name, contributor.CampaignID,
+		n.invitationURL(invitation.Token, "accept"), n.invitationURL(invitation.Token, "decline"), *invitation.ExpiresAt)
+	return n.emailer.send(invitationReminder)
+}
+
 // ====== System and Cleanup Notifications ======
 
 // SendSystemNotification implements interfaces.NotificationService.
@@ -269,6 +381,60 @@ func (n *notificationService) NotifyCommentAddition(comment *models.Comment, act
 	return n.emailer.send(commentAddedTemplate)
 }
 
+// NotifyCommentMention sends an email, and a push notification when possible, to each member mentioned in a comment
+func (n *notificationService) NotifyCommentMention(comment *models.Comment, activity *models.Activity, mentioned []models.User) error {
+	if len(mentioned) == 0 {
+		return nil
+	}
+
+	templates := make([]*email.EmailTemplate, len(mentioned))
+	var tokens []string
+	for i, user := range mentioned {
+		name := user.Handle
+		if user.Name != nil && *user.Name != "" {
+			name = *user.Name
+		}
+		templates[i] = emailTemplates.CommentMention([]string{user.Email}, name, comment.CreatedBy.Handle, comment.Content, activity.CampaignID, activity.Title)
+
+		if user.FCMToken != nil {
+			tokens = append(tokens, *user.FCMToken)
+		}
+	}
+
+	n.fcmNotifier.send(fcm.NotificationData{
+		Title: "You were mentioned in a comment",
+		Body:  fmt.Sprintf("%s mentioned you on %s", comment.CreatedBy.Handle, activity.Title),
+	}, tokens)
+	return n.emailer.sendInBatches(templates)
+}
+
+// Helper Methods --------------------------------------------------
+
+func (n *notificationService) contributorInvitedTemplate(contributor *models.Contributor, campaign *models.Campaign) *email.EmailTemplate {
+	invitation := contributor.Invitation
+	return emailTemplates.ContributorInvited([]string{contributor.Email}, contributor.Name, campaign.Title, campaign.ID, contributor.AccessKey, contributor.Amount,
+		n.invitationURL(invitation.Token, "accept"), n.invitationURL(invitation.Token, "decline"), *invitation.ExpiresAt)
+}
+
+// contributorWelcomeTemplates returns the email each new contributor gets, invited contributors get their invitation
+func (n *notificationService) contributorWelcomeTemplates(contributors []models.Contributor, campaign *models.Campaign) []*email.EmailTemplate {
+	templates := make([]*email.EmailTemplate, len(contributors))
+	for i := range contributors {
+		contributor := &contributors[i]
+		if contributor.Invitation.IsPending() {
+			templates[i] = n.contributorInvitedTemplate(contributor, campaign)
+			continue
+		}
+		templates[i] = emailTemplates.ContributorAdded([]string{contributor.Email}, contributor.Name, campaign.Title, campaign.ID, contributor.AccessKey)
+	}
+	return templates
+}
+
+// invitationURL links to the app page that answers an invitation
+func (n *notificationService) invitationURL(token, response string) string {
+	return fmt.Sprintf("%s/invitations/%s?response=%s", n.appURL, token, response)
+}
+
 // Helper Functions --------------------------------------------------
 
 // getContributorEmails returns a list of emails from a list of contributors
@@ -1,11 +1,13 @@
 package services
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	mockAuth "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/email"
 	mockEmailer "github.com/oyen-bright/goFundIt/pkg/email/mocks"
 	mockFCM "github.com/oyen-bright/goFundIt/pkg/fcm/mocks"
 
@@ -24,6 +26,7 @@ func setupTest(t *testing.T) (*notificationService, *mockEmailer.MockEmailer, *m
 		mockEmailer,
 		mockAuthService,
 		mockFCMClient,
+		"https://app.example.com",
 		mockLogger,
 	)
 
@@ -141,8 +144,6 @@ func TestNotifyCampaignCreation(t *testing.T) {
 	err := service.NotifyCampaignCreation(campaign)
 
 	assert.NoError(t, err)
-	// Wait for goroutine to complete
-	time.Sleep(100 * time.Millisecond)
 	mockEmailer.AssertExpectations(t)
 }
 
@@ -192,3 +193,184 @@ func TestNotifyCommentAddition(t *testing.T) {
 	assert.NoError(t, err)
 	mockEmailer.AssertExpectations(t)
 }
+
+func TestNotifyCommentMention(t *testing.T) {
+	service, mockEmailer, mockFCM, _ := setupTest(t)
+
+	fcmToken := "mentioned-token"
+	comment := &models.Comment{
+		Content:   "@MEMBER can you book the venue?",
+		CreatedBy: models.User{Handle: "AUTHOR"},
+	}
+	activity := &models.Activity{Title: "Venue", CampaignID: "campaign123"}
+	mentioned := []models.User{
+		{Handle: "MEMBER", Email: "member@example.com", FCMToken: &fcmToken},
+		{Handle: "OTHER", Email: "other@example.com"},
+	}
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 1 && template.Data["commenterName"] == "AUTHOR"
+	})).Return(nil).Times(2)
+	mockFCM.On("SendNotification", mock.Anything, fcmToken, mock.AnythingOfType("fcm.NotificationData")).Return(nil)
+
+	err := service.NotifyCommentMention(comment, activity, mentioned)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+	mockFCM.AssertExpectations(t)
+}
+
+func TestNotifyCampaignChanged(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	campaign := &models.Campaign{
+		ID:    "campaign123",
+		Title: "Test Campaign",
+		CreatedBy: models.User{
+			Email: "creator@example.com",
+		},
+		Contributors: []models.Contributor{
+			{Email: "test1@example.com"},
+		},
+	}
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 2 &&
+			template.Data["fromValue"] == "Jan 1, 2025" &&
+			template.Data["toValue"] == "Jan 3, 2025"
+	})).Return(nil)
+
+	err := service.NotifyCampaignChanged(campaign, "End date extended", "Jan 1, 2025", "Jan 3, 2025")
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestNotifyContributorInvited(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	campaign := &models.Campaign{ID: "campaign123", Title: "Test Campaign"}
+	contributor := &models.Contributor{Email: "invited@example.com", Amount: 50, AccessKey: "GM-member-key"}
+	contributor.Invitation.Issue("secret")
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return template.To[0] == "invited@example.com" &&
+			template.Data["key"] == "GM-member-key" &&
+			template.Data["acceptURL"] == "https://app.example.com/invitations/"+contributor.Invitation.Token+"?response=accept" &&
+			template.Data["declineURL"] == "https://app.example.com/invitations/"+contributor.Invitation.Token+"?response=decline"
+	})).Return(nil).Once()
+
+	err := service.NotifyContributorInvited(contributor, campaign)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestNotifyContributorsImported(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	campaign := &models.Campaign{ID: "campaign123", Title: "Test Campaign"}
+	contributors := make([]models.Contributor, emailBatchSize*2+1)
+	for i := range contributors {
+		contributors[i] = models.Contributor{Email: fmt.Sprintf("invited%d@example.com", i), Amount: 50}
+		contributors[i].Invitation.Issue("secret")
+	}
+	contributors[0].Invitation.Accept()
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return template.To[0] == "invited0@example.com" && template.Data["acceptURL"] == nil
+	})).Return(nil).Once()
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return template.To[0] != "invited0@example.com" && template.Data["acceptURL"] != nil
+	})).Return(nil).Times(len(contributors) - 1)
+
+	err := service.NotifyContributorsImported(contributors, campaign)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestNotifyInvitationDeclined(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	campaign := &models.Campaign{ID: "campaign123", CreatedBy: models.User{Email: "creator@example.com"}}
+	contributor := &models.Contributor{Name: "Invited", Email: "invited@example.com"}
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 1 && template.To[0] == "creator@example.com" &&
+			template.Data["email"] == "invited@example.com"
+	})).Return(nil).Once()
+
+	err := service.NotifyInvitationDeclined(contributor, campaign)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestSendInvitationReminder(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	contributor := &models.Contributor{CampaignID: "campaign123", Email: "invited@example.com"}
+	contributor.Invitation.Issue("secret")
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return template.To[0] == "invited@example.com" &&
+			template.Data["campaignId"] == "campaign123" &&
+			template.Data["acceptURL"] == "https://app.example.com/invitations/"+contributor.Invitation.Token+"?response=accept"
+	})).Return(nil).Once()
+
+	err := service.SendInvitationReminder(contributor)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestNotifyOwnershipTransferred(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	campaign := &models.Campaign{
+		ID:    "campaign123",
+		Key:   "campaign-key",
+		Title: "Test Campaign",
+		Contributors: []models.Contributor{
+			{Email: "new@example.com"},
+			{Email: "test1@example.com"},
+		},
+	}
+	newOwner := &models.User{Email: "new@example.com"}
+	previousOwner := &models.User{Email: "creator@example.com"}
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 1 && template.To[0] == "new@example.com" &&
+			template.Data["key"] == "campaign-key" &&
+			template.Data["previousOwner"] == "creator@example.com"
+	})).Return(nil).Once()
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 2 && template.Data["toValue"] == "new@example.com"
+	})).Return(nil).Once()
+
+	err := service.NotifyOwnershipTransferred(campaign, newOwner, previousOwner)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
+
+func TestNotifyWaitlistPromoted(t *testing.T) {
+	service, mockEmailer, _, _ := setupTest(t)
+
+	contributor := models.Contributor{ID: 1, Name: "Promoted", Email: "promoted@example.com"}
+	campaign := &models.Campaign{ID: "campaign123"}
+	activity := &models.Activity{ID: 1, Title: "Boat Tour", Cost: 120, SplitStrategy: models.SplitStrategyEqual,
+		Contributors: []models.Contributor{contributor, {ID: 2}}}
+
+	mockEmailer.On("SendEmailTemplate", mock.MatchedBy(func(template email.EmailTemplate) bool {
+		return len(template.To) == 1 && template.To[0] == "promoted@example.com" &&
+			template.Data["activityTitle"] == "Boat Tour" &&
+			template.Data["share"] == float64(60)
+	})).Return(nil).Once()
+
+	err := service.NotifyWaitlistPromoted(&contributor, activity, campaign)
+
+	assert.NoError(t, err)
+	mockEmailer.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/oyen-bright/goFundIt/internal/models"
 	repos "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
@@ -15,6 +16,9 @@ import (
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 )
 
+// PaymentProofLinkExpiry is how long a signed link to a payment proof works
+const PaymentProofLinkExpiry = 15 * time.Minute
+
 type paymentService struct {
 	repo                repos.PaymentRepository
 	paystack            paystack.PaystackClient
@@ -58,7 +62,7 @@ func NewPaymentService(
 	}
 }
 
-func (p *paymentService) InitializeManualPayment(contributorID uint, reference, userEmail, key string) (*models.Payment, error) {
+func (p *paymentService) InitializeManualPayment(contributorID uint, reference, userEmail, userHandle, key string) (*models.Payment, error) {
 
 	// validate contributor
 	contributor, err := p.contributorService.GetContributorByID(contributorID)
@@ -78,7 +82,7 @@ func (p *paymentService) InitializeManualPayment(contributorID uint, reference,
 
 		if campaign, err := p.campaignService.GetCampaignByID(contributor.CampaignID, key); err != nil {
 			return nil, err
-		} else if campaign.CreatedBy.Email != userEmail {
+		} else if !can(userHandle, campaign, models.CampaignActionApprovePayment) {
 			return nil, errs.BadRequest("You are not authorized to perform this action", nil)
 		} else {
 			payment.SetPaymentStatusToSuccess()
@@ -89,16 +93,17 @@ func (p *paymentService) InitializeManualPayment(contributorID uint, reference,
 		}
 	}
 
-	// Upload and update reference to payment proof
-	url, id, err := p.storage.UploadFile(reference, "payment/reference")
-	if err != nil {
-		return nil, errs.InternalServerError(err).Log(p.logger)
-	}
+	// Upload the payment proof as a private document, it is only reachable through signed links
+	if reference != "" {
+		id, err := p.storage.UploadPrivateFile(reference, "payment/reference")
+		if err != nil {
+			return nil, errs.InternalServerError(err).Log(p.logger)
+		}
 
-	payment.UpdateManualPaymentProof(&models.ManualPaymentProof{
-		DocumentURL: url,
-		DocumentID:  id,
-	})
+		payment.UpdateManualPaymentProof(&models.ManualPaymentProof{
+			DocumentID: id,
+		})
+	}
 
 	// Save Payment
 	err = p.repo.Create(payment)
@@ -111,6 +116,13 @@ func (p *paymentService) InitializeManualPayment(contributorID uint, reference,
 		p.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
 	})
 
+	// Payments recorded by the campaign treasurer are successful straight away
+	if payment.PaymentStatus == models.PaymentStatusSucceeded {
+		p.runAsync(func() {
+			p.campaignService.CheckMilestones(contributor.CampaignID)
+		})
+	}
+
 	return payment, nil
 
 }
@@ -148,9 +160,15 @@ func (p *paymentService) VerifyPayment(reference string) error {
 		// Update the contributor
 		contributor := payment.Contributor
 		contributor.Payment = payment
-		go p.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
-
-		go p.notificationService.NotifyPaymentReceived(&contributor, &payment.Campaign)
+		p.runAsync(func() {
+			p.broadcaster.NewEvent(contributor.CampaignID, websocket.EventTypeContributorUpdated, contributor)
+		})
+		p.runAsync(func() {
+			p.notificationService.NotifyPaymentReceived(&contributor, &payment.Campaign)
+		})
+		p.runAsync(func() {
+			p.campaignService.CheckMilestones(contributor.CampaignID)
+		})
 		return nil
 
 	}
@@ -179,8 +197,8 @@ func (p *paymentService) VerifyManualPayment(reference, userHandle, key string)
 	if err != nil {
 		return err
 	}
-	if campaign.CreatedBy.Handle != userHandle {
-		return errs.BadRequest("Unauthorized: Only campaign creator can verify manual payments", nil)
+	if !can(userHandle, campaign, models.CampaignActionApprovePayment) {
+		return errs.BadRequest("Unauthorized: Only the campaign treasurer can verify manual payments", nil)
 	}
 
 	// Update payment status
@@ -202,6 +220,9 @@ func (p *paymentService) VerifyManualPayment(reference, userHandle, key string)
 	p.runAsync(func() {
 		p.analyticsService.GetCurrentData().UpdatePaymentStats(payment.PaymentMethod, string(*campaign.FiatCurrency), payment.Amount)
 	})
+	p.runAsync(func() {
+		p.campaignService.CheckMilestones(payment.CampaignID)
+	})
 
 	return nil
 
@@ -298,6 +319,45 @@ func (p *paymentService) ProcessPaystackWebhook(event paystack.PaystackWebhookEv
 	}
 }
 
+// GetPaymentProof returns a signed link to the proof of a manual payment, only the contributor and whoever approves payments can see it
+func (p *paymentService) GetPaymentProof(reference, userEmail, userHandle, key string) (*models.DocumentLink, error) {
+	payment, err := p.repo.GetByReference(reference)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Payment not found")
+		}
+		return nil, errs.InternalServerError(err).Log(p.logger)
+	}
+
+	if payment.Contributor.Email != userEmail {
+		campaign, err := p.campaignService.GetCampaignByID(payment.CampaignID, key)
+		if err != nil {
+			return nil, err
+		}
+		if !can(userHandle, campaign, models.CampaignActionApprovePayment) {
+			return nil, errs.Forbidden("Only the contributor and the campaign treasurer can view the payment proof")
+		}
+	}
+
+	proof := payment.PaymentProof
+	if proof == nil || proof.DocumentID == "" {
+		return nil, errs.NotFound("Payment has no proof")
+	}
+
+	// Proofs uploaded before they were private keep their public link
+	if !storage.IsPrivate(proof.DocumentID) {
+		return &models.DocumentLink{URL: proof.DocumentURL}, nil
+	}
+
+	url, err := p.storage.SignedURL(proof.DocumentID, PaymentProofLinkExpiry)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(p.logger)
+	}
+	expiresAt := time.Now().Add(PaymentProofLinkExpiry)
+
+	return &models.DocumentLink{URL: url, ExpiresAt: &expiresAt}, nil
+}
+
 // DeletePayment implements interfaces.PaymentService.
 func (p *paymentService) DeletePayment(payment models.Payment) error {
 	panic("unimplemented")
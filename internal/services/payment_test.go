@@ -1,6 +1,7 @@
 package services
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/oyen-bright/goFundIt/pkg/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func TestInitializeManualPayment(t *testing.T) {
@@ -32,6 +34,7 @@ func TestInitializeManualPayment(t *testing.T) {
 		contributorID uint
 		reference     string
 		userEmail     string
+		userHandle    string
 		setupMocks    func()
 		campaignKey   string
 		expectedError bool
@@ -51,7 +54,7 @@ func TestInitializeManualPayment(t *testing.T) {
 				}
 				mockContribService.On("GetContributorByID", uint(1)).Return(contributor, nil)
 
-				mockStorage.On("UploadFile", "ref123", "payment/reference").Return("url", "id", nil)
+				mockStorage.On("UploadPrivateFile", "ref123", "payment/reference").Return("private/payment/reference/id", nil)
 
 				mockRepo.On("Create", mock.AnythingOfType("*models.Payment")).Return(nil)
 
@@ -63,6 +66,62 @@ func TestInitializeManualPayment(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:          "Treasurer records payment for contributor",
+			contributorID: 3,
+			reference:     "ref789",
+			userEmail:     "treasurer@test.com",
+			userHandle:    "treasurer",
+			setupMocks: func() {
+				contributor := models.Contributor{
+					ID:         3,
+					Email:      "user3@test.com",
+					CampaignID: "campaign1",
+					Amount:     100,
+				}
+				campaign := &models.Campaign{
+					ID:        "campaign1",
+					CreatedBy: models.User{Handle: "owner", Email: "owner@test.com"},
+					Roles: []models.CampaignUserRole{
+						{CampaignID: "campaign1", UserHandle: "treasurer", Email: "treasurer@test.com", Role: models.CampaignRoleTreasurer},
+					},
+				}
+				mockContribService.On("GetContributorByID", uint(3)).Return(contributor, nil)
+				mockCampaignService.On("GetCampaignByID", "campaign1", mock.Anything).Return(campaign, nil)
+				mockStorage.On("UploadPrivateFile", "ref789", "payment/reference").Return("private/payment/reference/id", nil)
+				mockRepo.On("Create", mock.MatchedBy(func(p *models.Payment) bool {
+					return p.PaymentStatus == models.PaymentStatusSucceeded
+				})).Return(nil)
+				mockBroadcaster.On("NewEvent", "campaign1", websocket.EventTypeContributorUpdated, mock.AnythingOfType("models.Contributor")).Return()
+				mockCampaignService.On("CheckMilestones", "campaign1").Return()
+			},
+			expectedError: false,
+		},
+		{
+			name:          "Owner cannot record payment once a treasurer is assigned",
+			contributorID: 3,
+			reference:     "ref789",
+			userEmail:     "owner@test.com",
+			userHandle:    "owner",
+			setupMocks: func() {
+				contributor := models.Contributor{
+					ID:         3,
+					Email:      "user3@test.com",
+					CampaignID: "campaign1",
+					Amount:     100,
+				}
+				campaign := &models.Campaign{
+					ID:        "campaign1",
+					CreatedBy: models.User{Handle: "owner", Email: "owner@test.com"},
+					Roles: []models.CampaignUserRole{
+						{CampaignID: "campaign1", UserHandle: "treasurer", Email: "treasurer@test.com", Role: models.CampaignRoleTreasurer},
+					},
+				}
+				mockContribService.On("GetContributorByID", uint(3)).Return(contributor, nil)
+				mockCampaignService.On("GetCampaignByID", "campaign1", mock.Anything).Return(campaign, nil)
+			},
+			expectedError: true,
+		},
 		{
 			name:          "Already paid contributor",
 			contributorID: 2,
@@ -107,7 +166,7 @@ func TestInitializeManualPayment(t *testing.T) {
 			}
 
 			// Execute test
-			payment, err := svc.InitializeManualPayment(tt.contributorID, tt.reference, tt.userEmail, tt.campaignKey)
+			payment, err := svc.InitializeManualPayment(tt.contributorID, tt.reference, tt.userEmail, tt.userHandle, tt.campaignKey)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -172,6 +231,9 @@ func TestVerifyPayment(t *testing.T) {
 					}{Status: "success", GatewayResponse: "Successful"},
 				}, nil)
 				mockRepo.On("Update", mock.AnythingOfType("*models.Payment")).Return(nil)
+				mockBroadcaster.On("NewEvent", "123", websocket.EventTypeContributorUpdated, mock.AnythingOfType("models.Contributor")).Return()
+				mockNotificationService.On("NotifyPaymentReceived", mock.AnythingOfType("*models.Contributor"), mock.AnythingOfType("*models.Campaign")).Return(nil)
+				mockCampaignService.On("CheckMilestones", "123").Return()
 			},
 			expectedError: false,
 		},
@@ -181,17 +243,18 @@ func TestVerifyPayment(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks()
 
-			svc := NewPaymentService(
-				mockRepo,
-				mockContribService,
-				mockAnalytics,
-				mockCampaignService,
-				mockNotificationService,
-				mockPaystack,
-				mockStorage,
-				mockBroadcaster,
-				mockLogger,
-			)
+			svc := &paymentService{
+				repo:                mockRepo,
+				contributorService:  mockContribService,
+				analyticsService:    mockAnalytics,
+				campaignService:     mockCampaignService,
+				notificationService: mockNotificationService,
+				storage:             mockStorage,
+				paystack:            mockPaystack,
+				broadcaster:         mockBroadcaster,
+				logger:              mockLogger,
+				runAsync:            func(f func()) { f() },
+			}
 
 			err := svc.VerifyPayment(tt.reference)
 
@@ -256,7 +319,7 @@ func TestVerifyManualPayment(t *testing.T) {
 
 				// Set up mock expectations
 				mockRepo.On("GetByReference", "ref123").Return(payment, nil)
-				mockCampaignService.On("GetCampaignByID", "campaign1").Return(campaign, nil)
+				mockCampaignService.On("GetCampaignByID", "campaign1", mock.Anything).Return(campaign, nil)
 				mockRepo.On("Update", mock.AnythingOfType("*models.Payment")).Return(nil)
 
 				// Mock broadcaster with exact campaign ID
@@ -276,6 +339,7 @@ func TestVerifyManualPayment(t *testing.T) {
 				).Return(nil)
 
 				mockAnalytics.On("GetCurrentData").Return(&models.PlatformAnalytics{})
+				mockCampaignService.On("CheckMilestones", "campaign1").Return()
 
 			},
 			expectedError: false,
@@ -352,7 +416,7 @@ func TestInitializePayment(t *testing.T) {
 					EndDate:       time.Now().Add(24 * time.Hour),
 					PaymentMethod: models.PaymentMethodFiat,
 				}
-				mockCampaignService.On("GetCampaignByID", "campaign1").Return(campaign, nil)
+				mockCampaignService.On("GetCampaignByID", "campaign1", mock.Anything).Return(campaign, nil)
 				mockPaystack.On("InitiateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(&paystack.TransactionResponse{
 					Data: struct {
 						AuthorizationURL string "json:\"authorization_url\""
@@ -396,3 +460,118 @@ func TestInitializePayment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPaymentProof(t *testing.T) {
+	campaign := &models.Campaign{
+		ID:        "campaign1",
+		CreatedBy: models.User{Handle: "owner", Email: "owner@test.com"},
+		Roles: []models.CampaignUserRole{
+			{CampaignID: "campaign1", UserHandle: "treasurer", Email: "treasurer@test.com", Role: models.CampaignRoleTreasurer},
+		},
+	}
+	newPayment := func(proof *models.ManualPaymentProof) *models.Payment {
+		return &models.Payment{
+			Reference:    "ref123",
+			CampaignID:   "campaign1",
+			PaymentProof: proof,
+			Contributor:  models.Contributor{Email: "user@test.com", CampaignID: "campaign1"},
+		}
+	}
+	privateProof := &models.ManualPaymentProof{DocumentID: "private/payment/reference/proof.png"}
+
+	tests := []struct {
+		name          string
+		userEmail     string
+		userHandle    string
+		setupMocks    func(*mockRepos.MockPaymentRepository, *mockServices.MockCampaignService, *storageMock.MockStorage)
+		expectedURL   string
+		expectExpiry  bool
+		expectedError int
+	}{
+		{
+			name:      "Contributor gets a signed link",
+			userEmail: "user@test.com",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(newPayment(privateProof), nil)
+				store.On("SignedURL", "private/payment/reference/proof.png", PaymentProofLinkExpiry).Return("https://files.example.com/signed", nil)
+			},
+			expectedURL:  "https://files.example.com/signed",
+			expectExpiry: true,
+		},
+		{
+			name:       "Treasurer gets a signed link",
+			userEmail:  "treasurer@test.com",
+			userHandle: "treasurer",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(newPayment(privateProof), nil)
+				cs.On("GetCampaignByID", "campaign1", "key").Return(campaign, nil)
+				store.On("SignedURL", "private/payment/reference/proof.png", PaymentProofLinkExpiry).Return("https://files.example.com/signed", nil)
+			},
+			expectedURL:  "https://files.example.com/signed",
+			expectExpiry: true,
+		},
+		{
+			name:       "Proofs uploaded before they were private keep their link",
+			userEmail:  "user@test.com",
+			userHandle: "user",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(newPayment(&models.ManualPaymentProof{DocumentID: "payment/reference/proof", DocumentURL: "https://public.example.com/proof"}), nil)
+			},
+			expectedURL: "https://public.example.com/proof",
+		},
+		{
+			name:       "Other members can't see the proof",
+			userEmail:  "owner@test.com",
+			userHandle: "owner",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(newPayment(privateProof), nil)
+				cs.On("GetCampaignByID", "campaign1", "key").Return(campaign, nil)
+			},
+			expectedError: http.StatusForbidden,
+		},
+		{
+			name:      "Payment without proof",
+			userEmail: "user@test.com",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(newPayment(nil), nil)
+			},
+			expectedError: http.StatusNotFound,
+		},
+		{
+			name:      "Payment not found",
+			userEmail: "user@test.com",
+			setupMocks: func(repo *mockRepos.MockPaymentRepository, cs *mockServices.MockCampaignService, store *storageMock.MockStorage) {
+				repo.On("GetByReference", "ref123").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedError: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mockRepos.NewMockPaymentRepository(t)
+			mockCampaignService := mockServices.NewMockCampaignService(t)
+			mockStorage := storageMock.NewMockStorage(t)
+			tt.setupMocks(mockRepo, mockCampaignService, mockStorage)
+
+			svc := &paymentService{
+				repo:            mockRepo,
+				campaignService: mockCampaignService,
+				storage:         mockStorage,
+				logger:          loggerMock.NewMockLogger(t),
+				runAsync:        func(f func()) { f() },
+			}
+
+			link, err := svc.GetPaymentProof("ref123", tt.userEmail, tt.userHandle, "key")
+
+			if tt.expectedError != 0 {
+				assertErrorCode(t, err, tt.expectedError)
+				assert.Nil(t, link)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedURL, link.URL)
+			assert.Equal(t, tt.expectExpiry, link.ExpiresAt != nil)
+		})
+	}
+}
@@ -49,7 +49,7 @@ func (p *payoutService) InitializeManualPayout(campaignID string, userHandle str
 	if err != nil {
 		return nil, err
 	}
-	if campaign.CreatedBy.Handle != userHandle {
+	if !can(userHandle, campaign, models.CampaignActionManagePayout) {
 		return nil, errs.BadRequest("You are not authorized to perform this action", nil)
 	}
 
@@ -94,7 +94,7 @@ func (p *payoutService) InitializePayout(campaignID string, userHandle string, r
 	if err != nil {
 		return nil, err
 	}
-	if campaign.CreatedBy.Handle != userHandle {
+	if !can(userHandle, campaign, models.CampaignActionManagePayout) {
 		return nil, errs.BadRequest("You are not authorized to perform this action", nil)
 	}
 
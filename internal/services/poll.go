@@ -0,0 +1,182 @@
+package services
+
+import (
+	"slices"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+)
+
+type pollService struct {
+	repo            repositories.PollRepository
+	campaignService services.CampaignService
+	broadcaster     services.EventBroadcaster
+	logger          logger.Logger
+	runAsync        func(func())
+}
+
+func NewPollService(
+	repo repositories.PollRepository,
+	campaignService services.CampaignService,
+	broadcaster services.EventBroadcaster,
+	logger logger.Logger,
+) services.PollService {
+	return &pollService{
+		repo:            repo,
+		campaignService: campaignService,
+		broadcaster:     broadcaster,
+		logger:          logger,
+		runAsync:        func(f func()) { go f() },
+	}
+}
+
+// CreatePoll asks the contributors of a campaign a question, only organisers can create polls
+func (s *pollService) CreatePoll(campaignID, key, userHandle, question string, options []string, multipleChoice bool, deadline *time.Time) (*models.Poll, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.Forbidden("Only campaign organisers can create polls")
+	}
+	if campaign.HasEnded() {
+		return nil, errs.BadRequest("Cannot create polls: Campaign has ended", nil)
+	}
+	if deadline != nil && !deadline.After(time.Now()) {
+		return nil, errs.BadRequest("Poll deadline must be in the future", deadline)
+	}
+	for i, option := range options {
+		if slices.Contains(options[:i], option) {
+			return nil, errs.BadRequest("Poll options must be different", option)
+		}
+	}
+
+	poll := models.NewPoll(campaignID, question, options, multipleChoice, deadline, userHandle)
+	if err := s.repo.Create(poll); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	poll.Tally()
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(campaignID, websocket.EventTypePollCreated, *poll)
+	})
+
+	return poll, nil
+}
+
+// GetPolls fetches the polls of a campaign with their results
+func (s *pollService) GetPolls(campaignID, key, userEmail string) ([]models.Poll, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.EmailIsPartOfCampaign(userEmail) {
+		return nil, errs.Forbidden("Only campaign members can view polls")
+	}
+
+	polls, err := s.repo.GetByCampaignID(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	for i := range polls {
+		polls[i].Tally()
+	}
+	return polls, nil
+}
+
+// VoteOnPoll records a contributor's choice on an open poll and streams the new results to the campaign
+func (s *pollService) VoteOnPoll(pollID uint, campaignID, key, userEmail string, optionIDs []uint) (*models.Poll, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	contributor := campaign.GetContributorByEmail(userEmail)
+	if contributor == nil || !contributor.Invitation.IsAccepted() {
+		return nil, errs.Forbidden("Only campaign contributors can vote on polls")
+	}
+
+	poll, err := s.getPoll(pollID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if !poll.IsOpen() {
+		return nil, errs.BadRequest("Poll is closed", nil)
+	}
+	if poll.HasVoted(contributor.ID) {
+		return nil, errs.BadRequest("You have already voted on this poll", nil)
+	}
+
+	votes, err := poll.Vote(contributor.ID, optionIDs)
+	if err != nil {
+		return nil, errs.BadRequest("Cannot vote: "+err.Error(), nil)
+	}
+	if err := s.repo.AddVotes(votes); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.broadcastResults(poll)
+	return poll, nil
+}
+
+// ClosePoll stops a poll from accepting votes before its deadline, only organisers can close polls
+func (s *pollService) ClosePoll(pollID uint, campaignID, key, userHandle string) (*models.Poll, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !can(userHandle, campaign, models.CampaignActionUpdate) {
+		return nil, errs.Forbidden("Only campaign organisers can close polls")
+	}
+
+	poll, err := s.getPoll(pollID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if !poll.IsOpen() {
+		return nil, errs.BadRequest("Poll is already closed", nil)
+	}
+
+	poll.Close()
+	if err := s.repo.Update(poll); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	s.broadcastResults(poll)
+	return poll, nil
+}
+
+// Helper Methods --------------------------------------------------------
+
+func (s *pollService) getPoll(pollID uint, campaignID string) (*models.Poll, error) {
+	poll, err := s.repo.GetByID(pollID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Poll not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	if poll.CampaignID != campaignID {
+		return nil, errs.NotFound("Poll not found")
+	}
+	return &poll, nil
+}
+
+func (s *pollService) broadcastResults(poll *models.Poll) {
+	poll.Tally()
+	results := *poll
+	s.runAsync(func() {
+		s.broadcaster.NewEvent(results.CampaignID, websocket.EventTypePollUpdated, results)
+	})
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func setupPollTest(t *testing.T) (
+	*pollService,
+	*mockRepo.MockPollRepository,
+	*mockService.MockCampaignService,
+	*mockService.MockEventBroadcaster,
+) {
+	repo := mockRepo.NewMockPollRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	broadcaster := mockService.NewMockEventBroadcaster(t)
+
+	service := &pollService{
+		repo:            repo,
+		campaignService: campaignService,
+		broadcaster:     broadcaster,
+		logger:          mockLogger.NewMockLogger(t),
+		runAsync:        func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, broadcaster
+}
+
+func newPollTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:        "campaign-123",
+		EndDate:   time.Now().Add(24 * time.Hour),
+		CreatedBy: models.User{Handle: "creator", Email: "creator@example.com"},
+		Contributors: []models.Contributor{
+			{ID: 1, CampaignID: "campaign-123", Email: "creator@example.com"},
+			{ID: 2, CampaignID: "campaign-123", Email: "member@example.com"},
+		},
+	}
+}
+
+func newTestPoll(multipleChoice bool) models.Poll {
+	return models.Poll{
+		ID:             1,
+		CampaignID:     "campaign-123",
+		Question:       "Where should we eat?",
+		MultipleChoice: multipleChoice,
+		Options:        []models.PollOption{{ID: 10, Text: "Pizza"}, {ID: 11, Text: "Sushi"}},
+	}
+}
+
+func TestCreatePoll(t *testing.T) {
+	service, repo, campaignService, broadcaster := setupPollTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().Create(mock.MatchedBy(func(p *models.Poll) bool {
+			return p.Question == "Where should we eat?" && len(p.Options) == 2 && p.Options[1].Position == 1
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypePollCreated, mock.AnythingOfType("models.Poll")).Once()
+
+		poll, err := service.CreatePoll("campaign-123", "key", "creator", "Where should we eat?", []string{"Pizza", "Sushi"}, false, nil)
+		assert.NoError(t, err)
+		assert.True(t, poll.IsOpen())
+	})
+
+	t.Run("only organisers can create polls", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+
+		_, err := service.CreatePoll("campaign-123", "key", "member", "Where should we eat?", []string{"Pizza", "Sushi"}, false, nil)
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("deadline in the past", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		deadline := time.Now().Add(-time.Hour)
+
+		_, err := service.CreatePoll("campaign-123", "key", "creator", "Where should we eat?", []string{"Pizza", "Sushi"}, false, &deadline)
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("duplicate options", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+
+		_, err := service.CreatePoll("campaign-123", "key", "creator", "Where should we eat?", []string{"Pizza", "Pizza"}, false, nil)
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestVoteOnPoll(t *testing.T) {
+	service, repo, campaignService, broadcaster := setupPollTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(newTestPoll(true), nil).Once()
+		repo.EXPECT().AddVotes(mock.MatchedBy(func(votes []models.PollVote) bool {
+			return len(votes) == 2 && votes[0].ContributorID == 2
+		})).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypePollUpdated, mock.MatchedBy(func(p models.Poll) bool {
+			return p.TotalVoters == 1 && p.Options[0].Votes == 1 && p.Options[1].Votes == 1
+		})).Once()
+
+		poll, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{10, 11})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, poll.TotalVoters)
+	})
+
+	t.Run("single choice polls take one option", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(newTestPoll(false), nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{10, 11})
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("unknown option", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(newTestPoll(false), nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{99})
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("already voted", func(t *testing.T) {
+		poll := newTestPoll(false)
+		poll.Votes = []models.PollVote{{OptionID: 10, ContributorID: 2}}
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(poll, nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{11})
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("deadline passed", func(t *testing.T) {
+		poll := newTestPoll(false)
+		deadline := time.Now().Add(-time.Minute)
+		poll.Deadline = &deadline
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(poll, nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{10})
+		assertErrorCode(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("not a contributor", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "stranger@example.com", []uint{10})
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("poll of another campaign", func(t *testing.T) {
+		poll := newTestPoll(false)
+		poll.CampaignID = "campaign-456"
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(poll, nil).Once()
+
+		_, err := service.VoteOnPoll(1, "campaign-123", "key", "member@example.com", []uint{10})
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
+
+func TestClosePoll(t *testing.T) {
+	service, repo, campaignService, broadcaster := setupPollTest(t)
+
+	t.Run("success", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(1)).Return(newTestPoll(false), nil).Once()
+		repo.EXPECT().Update(mock.MatchedBy(func(p *models.Poll) bool { return !p.IsOpen() })).Return(nil).Once()
+		broadcaster.EXPECT().NewEvent("campaign-123", websocket.EventTypePollUpdated, mock.AnythingOfType("models.Poll")).Once()
+
+		poll, err := service.ClosePoll(1, "campaign-123", "key", "creator")
+		assert.NoError(t, err)
+		assert.NotNil(t, poll.ClosedAt)
+	})
+
+	t.Run("only organisers can close polls", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+
+		_, err := service.ClosePoll(1, "campaign-123", "key", "member")
+		assertErrorCode(t, err, http.StatusForbidden)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newPollTestCampaign(), nil).Once()
+		repo.EXPECT().GetByID(uint(2)).Return(models.Poll{}, gorm.ErrRecordNotFound).Once()
+
+		_, err := service.ClosePoll(2, "campaign-123", "key", "creator")
+		assertErrorCode(t, err, http.StatusNotFound)
+	})
+}
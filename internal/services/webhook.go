@@ -0,0 +1,453 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	repositories "github.com/oyen-bright/goFundIt/internal/repositories/interfaces"
+	services "github.com/oyen-bright/goFundIt/internal/services/interfaces"
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+	"github.com/oyen-bright/goFundIt/pkg/webhook"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// webhookDeliveryLease is how long other instances leave a delivery alone while it is being sent
+	webhookDeliveryLease = 2 * time.Minute
+	// webhookDeliveryBatch is the most due deliveries sent each time the worker runs
+	webhookDeliveryBatch = 50
+)
+
+// webhookService delivers campaign events to the endpoints campaign creators register.
+// Every instance receives the events on the bus, the delivery ID is derived from the event so only one of them delivers it.
+// Endpoints get the payload campaign members see, payment details are never sent
+type webhookService struct {
+	repo            repositories.WebhookRepository
+	campaignService services.CampaignService
+	client          *webhook.Client
+	encryptor       encryption.Encryptor
+	secret          string
+	allowInsecure   bool
+	logger          logger.Logger
+	cron            *cron.Cron
+	runAsync        func(func())
+}
+
+func NewWebhookService(
+	repo repositories.WebhookRepository,
+	campaignService services.CampaignService,
+	bus eventbus.Bus,
+	client *webhook.Client,
+	encryptor encryption.Encryptor,
+	secret string,
+	allowInsecure bool,
+	logger logger.Logger,
+) services.WebhookService {
+	s := &webhookService{
+		repo:            repo,
+		campaignService: campaignService,
+		client:          client,
+		encryptor:       encryptor,
+		secret:          secret,
+		allowInsecure:   allowInsecure,
+		logger:          logger,
+		runAsync:        func(f func()) { go f() },
+	}
+	bus.Subscribe(s.enqueue)
+	return s
+}
+
+// CreateWebhook registers an endpoint for the selected campaign events, only the campaign owner can.
+// The signing secret is only returned here
+func (s *webhookService) CreateWebhook(campaignID, key, userHandle, url string, eventTypes []string) (*models.Webhook, error) {
+	if _, err := s.getManagedCampaign(campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	if err := webhook.ValidateURL(url, s.allowInsecure); err != nil {
+		return nil, errs.BadRequest("Webhook URL must be an https URL", url)
+	}
+	eventTypes, err := parseWebhookEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.repo.GetByCampaignID(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if len(webhooks) >= models.MaxWebhooksPerCampaign {
+		return nil, errs.BadRequest(fmt.Sprintf("A campaign can have at most %d webhooks", models.MaxWebhooksPerCampaign), nil)
+	}
+
+	hook, err := models.NewWebhook(s.encryptor, s.secret, campaignID, url, userHandle, eventTypes)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if err := s.repo.Create(hook); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return hook, nil
+}
+
+// GetWebhooks fetches the webhooks of a campaign, only the campaign owner can
+func (s *webhookService) GetWebhooks(campaignID, key, userHandle string) ([]models.Webhook, error) {
+	if _, err := s.getManagedCampaign(campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.repo.GetByCampaignID(campaignID)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook changes the URL or events of a webhook and turns it on or off.
+// Turning a webhook off fails its pending deliveries, turning it on clears its failure count
+func (s *webhookService) UpdateWebhook(webhookID uint, campaignID, key, userHandle string, url *string, eventTypes []string, enabled *bool) (*models.Webhook, error) {
+	hook, err := s.getWebhook(webhookID, campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	if url != nil {
+		if err := webhook.ValidateURL(*url, s.allowInsecure); err != nil {
+			return nil, errs.BadRequest("Webhook URL must be an https URL", *url)
+		}
+		hook.URL = *url
+	}
+	if eventTypes != nil {
+		if hook.EventTypes, err = parseWebhookEventTypes(eventTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	disabling := enabled != nil && !*enabled && !hook.IsDisabled()
+	if enabled != nil {
+		if *enabled {
+			hook.Enable()
+		} else if disabling {
+			hook.Disable()
+		}
+	}
+
+	if err := s.repo.Update(hook); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if disabling {
+		if err := s.repo.AbandonPendingDeliveries(hook.ID, "Webhook was disabled"); err != nil {
+			s.logger.Error(err, "Error failing the pending deliveries of a disabled webhook", map[string]interface{}{"webhookId": hook.ID})
+		}
+	}
+	return hook, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery log
+func (s *webhookService) DeleteWebhook(webhookID uint, campaignID, key, userHandle string) error {
+	hook, err := s.getWebhook(webhookID, campaignID, key, userHandle)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(hook); err != nil {
+		return errs.InternalServerError(err).Log(s.logger)
+	}
+	return nil
+}
+
+// GetDeliveries fetches the latest deliveries of a webhook with their payloads, all statuses are fetched when status is empty
+func (s *webhookService) GetDeliveries(webhookID uint, campaignID, key, userHandle string, status models.WebhookDeliveryStatus, limit int) ([]models.WebhookDelivery, error) {
+	hook, err := s.getWebhook(webhookID, campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+	if status != "" && !status.IsValid() {
+		return nil, errs.BadRequest("Invalid delivery status", status)
+	}
+
+	deliveries, err := s.repo.GetDeliveries(hook.ID, status, models.WebhookDeliveriesSize(limit))
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	secret, err := hook.SigningSecret(s.encryptor, s.secret)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	for i := range deliveries {
+		if err := deliveries[i].Decrypt(s.encryptor, secret); err != nil {
+			return nil, errs.InternalServerError(err).Log(s.logger)
+		}
+	}
+	return deliveries, nil
+}
+
+// ReplayDelivery sends the event of a delivery again as a new delivery, the event keeps its ID so the endpoint can tell it is a repeat
+func (s *webhookService) ReplayDelivery(webhookID uint, campaignID, key, userHandle, deliveryID string) (*models.WebhookDelivery, error) {
+	hook, err := s.getWebhook(webhookID, campaignID, key, userHandle)
+	if err != nil {
+		return nil, err
+	}
+	if hook.IsDisabled() {
+		return nil, errs.BadRequest("Enable the webhook before replaying its deliveries", nil)
+	}
+
+	delivery, err := s.repo.GetDelivery(hook.ID, deliveryID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Webhook delivery not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	secret, err := hook.SigningSecret(s.encryptor, s.secret)
+	if err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if err := delivery.Decrypt(s.encryptor, secret); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	replay := delivery.Replay()
+	if _, err := s.storeDelivery(replay, secret); err != nil {
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+
+	// The replay is sent in the background, the caller gets it as it was stored
+	stored := *replay
+	s.runAsync(func() {
+		s.attempt(hook, replay, secret)
+	})
+	return &stored, nil
+}
+
+// StartDeliveries starts retrying the deliveries that are due, the ones that failed and the ones an instance stopped sending
+func (s *webhookService) StartDeliveries() error {
+	s.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+
+	_, err := s.cron.AddFunc("@every 15s", s.sendDueDeliveries)
+	if err != nil {
+		return fmt.Errorf("failed to schedule webhook deliveries: %w", err)
+	}
+
+	s.cron.Start()
+	s.logger.Info("Webhook deliveries scheduled - running every 15 seconds", nil)
+	return nil
+}
+
+// StopDeliveries stops retrying deliveries and waits for the running ones
+func (s *webhookService) StopDeliveries() {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+}
+
+// Helper Functions ----------------------------------------------
+
+// getManagedCampaign fetches the campaign if the user can manage its webhooks
+func (s *webhookService) getManagedCampaign(campaignID, key, userHandle string) (*models.Campaign, error) {
+	campaign, err := s.campaignService.GetCampaignByID(campaignID, key)
+	if err != nil {
+		return nil, err
+	}
+	if !can(userHandle, campaign, models.CampaignActionManageWebhooks) {
+		return nil, errs.Forbidden("Only the campaign owner can manage webhooks")
+	}
+	return campaign, nil
+}
+
+// getWebhook fetches a webhook of the campaign if the user can manage it
+func (s *webhookService) getWebhook(webhookID uint, campaignID, key, userHandle string) (*models.Webhook, error) {
+	if _, err := s.getManagedCampaign(campaignID, key, userHandle); err != nil {
+		return nil, err
+	}
+
+	hook, err := s.repo.GetByID(webhookID)
+	if err != nil {
+		if database.Error(err).IsNotfound() {
+			return nil, errs.NotFound("Webhook not found")
+		}
+		return nil, errs.InternalServerError(err).Log(s.logger)
+	}
+	if hook.CampaignID != campaignID {
+		return nil, errs.NotFound("Webhook not found")
+	}
+	return hook, nil
+}
+
+// enqueue creates the deliveries of an event from the bus, it returns straight away as the bus waits for its subscribers
+func (s *webhookService) enqueue(event eventbus.Event) {
+	if !websocket.EventType(event.Type).IsBroadcast() {
+		return
+	}
+	s.runAsync(func() {
+		s.createDeliveries(event)
+	})
+}
+
+// createDeliveries stores a delivery of the event for each webhook subscribed to it and sends the ones this instance created
+func (s *webhookService) createDeliveries(event eventbus.Event) {
+	webhooks, err := s.repo.GetActiveByCampaignID(event.CampaignID)
+	if err != nil {
+		s.logger.Error(err, "Error fetching campaign webhooks", map[string]interface{}{"campaignId": event.CampaignID, "eventId": event.ID})
+		return
+	}
+
+	for i := range webhooks {
+		hook := &webhooks[i]
+		if !hook.Subscribes(event.Type) {
+			continue
+		}
+
+		body, err := json.Marshal(webhook.Event{
+			ID:         event.ID,
+			CampaignID: event.CampaignID,
+			Type:       event.Type,
+			CreatedAt:  time.Now().UTC(),
+			Data:       event.Data,
+		})
+		if err != nil {
+			s.logger.Error(err, "Error encoding webhook event", map[string]interface{}{"webhookId": hook.ID, "eventId": event.ID})
+			continue
+		}
+
+		secret, err := hook.SigningSecret(s.encryptor, s.secret)
+		if err != nil {
+			s.logger.Error(err, "Error unsealing webhook secret", map[string]interface{}{"webhookId": hook.ID})
+			continue
+		}
+
+		delivery := models.NewWebhookDelivery(hook.ID, event.ID, event.Type, string(body))
+		created, err := s.storeDelivery(delivery, secret)
+		if err != nil {
+			s.logger.Error(err, "Error storing webhook delivery", map[string]interface{}{"webhookId": hook.ID, "eventId": event.ID})
+			continue
+		}
+		if created {
+			s.attempt(hook, delivery, secret)
+		}
+	}
+}
+
+// storeDelivery stores the delivery with its payload encrypted, held back from the worker as it is sent straight away.
+// created is false when another instance already stored it
+func (s *webhookService) storeDelivery(delivery *models.WebhookDelivery, secret string) (created bool, err error) {
+	held := time.Now().Add(webhookDeliveryLease)
+	delivery.NextAttemptAt = &held
+
+	stored := *delivery
+	if err := stored.Encrypt(s.encryptor, secret); err != nil {
+		return false, err
+	}
+	return s.repo.CreateDelivery(&stored)
+}
+
+// sendDueDeliveries sends the deliveries due by now in parallel, another instance skips the ones claimed here
+func (s *webhookService) sendDueDeliveries() {
+	deliveries, err := s.repo.ClaimDueDeliveries(time.Now(), webhookDeliveryLease, webhookDeliveryBatch)
+	if err != nil {
+		s.logger.Error(err, "Error fetching due webhook deliveries", nil)
+		return
+	}
+
+	webhooks := make(map[uint]*models.Webhook)
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		hook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			if hook, err = s.repo.GetByID(delivery.WebhookID); err != nil {
+				s.logger.Error(err, "Error fetching webhook of a delivery", map[string]interface{}{"webhookId": delivery.WebhookID, "deliveryId": delivery.ID})
+				continue
+			}
+			webhooks[delivery.WebhookID] = hook
+		}
+
+		if hook.IsDisabled() {
+			delivery.Abandon("Webhook was disabled")
+			s.updateDelivery(delivery)
+			continue
+		}
+
+		secret, err := hook.SigningSecret(s.encryptor, s.secret)
+		if err == nil {
+			err = delivery.Decrypt(s.encryptor, secret)
+		}
+		if err != nil {
+			s.logger.Error(err, "Error decrypting webhook delivery", map[string]interface{}{"webhookId": hook.ID, "deliveryId": delivery.ID})
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.attempt(hook, delivery, secret)
+		}()
+	}
+	wg.Wait()
+}
+
+// attempt sends a delivery, schedules its retry when it fails and disables the webhook after too many failures in a row
+func (s *webhookService) attempt(hook *models.Webhook, delivery *models.WebhookDelivery, secret string) {
+	response, err := s.client.Send(webhook.Request{
+		URL:        hook.URL,
+		Secret:     secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Body:       []byte(delivery.Payload),
+	})
+	if err != nil {
+		delivery.RecordFailure(response.StatusCode, response.Body, err)
+	} else {
+		delivery.RecordSuccess(response.StatusCode, response.Body)
+	}
+	s.updateDelivery(delivery)
+
+	disabled, err := s.repo.RecordDeliveryAttempt(hook.ID, err == nil, models.WebhookFailureLimit)
+	if err != nil {
+		s.logger.Error(err, "Error recording webhook delivery attempt", map[string]interface{}{"webhookId": hook.ID})
+		return
+	}
+	if disabled {
+		s.logger.Info("Webhook disabled after failed deliveries", map[string]interface{}{"webhookId": hook.ID, "campaignId": hook.CampaignID})
+		if err := s.repo.AbandonPendingDeliveries(hook.ID, "Webhook was disabled after too many failed deliveries"); err != nil {
+			s.logger.Error(err, "Error failing the pending deliveries of a disabled webhook", map[string]interface{}{"webhookId": hook.ID})
+		}
+	}
+}
+
+func (s *webhookService) updateDelivery(delivery *models.WebhookDelivery) {
+	if err := s.repo.UpdateDelivery(delivery); err != nil {
+		s.logger.Error(err, "Error saving webhook delivery", map[string]interface{}{"webhookId": delivery.WebhookID, "deliveryId": delivery.ID})
+	}
+}
+
+// parseWebhookEventTypes checks the event types are ones the websocket sends and drops repeats
+func parseWebhookEventTypes(eventTypes []string) ([]string, error) {
+	var parsed []string
+	for _, name := range eventTypes {
+		name = strings.TrimSpace(name)
+		if !websocket.EventType(name).IsBroadcast() {
+			return nil, errs.BadRequest(fmt.Sprintf("Unknown event type %s", name), name)
+		}
+		if !slices.Contains(parsed, name) {
+			parsed = append(parsed, name)
+		}
+	}
+	if len(parsed) == 0 {
+		return nil, errs.BadRequest("Webhooks must receive at least one event type", nil)
+	}
+	return parsed, nil
+}
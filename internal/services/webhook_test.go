@@ -0,0 +1,358 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/internal/models"
+	mockRepo "github.com/oyen-bright/goFundIt/internal/repositories/mocks"
+	mockService "github.com/oyen-bright/goFundIt/internal/services/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/encryption"
+	"github.com/oyen-bright/goFundIt/pkg/errs"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus/memory"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/oyen-bright/goFundIt/pkg/webhook"
+	"github.com/oyen-bright/goFundIt/pkg/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupWebhookTest(t *testing.T) (
+	*webhookService,
+	*mockRepo.MockWebhookRepository,
+	*mockService.MockCampaignService,
+	*mockLogger.MockLogger,
+) {
+	repo := mockRepo.NewMockWebhookRepository(t)
+	campaignService := mockService.NewMockCampaignService(t)
+	logger := mockLogger.NewMockLogger(t)
+
+	service := &webhookService{
+		repo:            repo,
+		campaignService: campaignService,
+		client:          webhook.NewClient(time.Second, true),
+		encryptor:       encryption.New([]string{"test-key"}),
+		secret:          testCampaignKeySecret,
+		logger:          logger,
+		runAsync:        func(f func()) { f() },
+	}
+
+	return service, repo, campaignService, logger
+}
+
+func newWebhookTestCampaign() *models.Campaign {
+	return &models.Campaign{
+		ID:              "campaign-123",
+		CreatedByHandle: "creator",
+		CreatedBy:       models.User{Handle: "creator", Email: "creator@example.com"},
+	}
+}
+
+// newTestEndpoint starts an endpoint that answers deliveries with status and hands on the ones with a valid signature
+func newTestEndpoint(t *testing.T, secret string, status int) (*httptest.Server, <-chan webhook.Delivery) {
+	received := make(chan webhook.Delivery, 10)
+	receiver := webhook.NewReceiver(secret, func(delivery webhook.Delivery) { received <- delivery })
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusNoContent {
+			w.WriteHeader(status)
+			return
+		}
+		receiver.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func newTestWebhook(t *testing.T, service *webhookService, url string) *models.Webhook {
+	hook, err := models.NewWebhook(service.encryptor, service.secret, "campaign-123", url, "creator", []string{string(websocket.EventTypeCampaignUpdated)})
+	require.NoError(t, err)
+	hook.ID = 1
+	return hook
+}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	t.Run("creates a webhook with a signing secret", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByCampaignID("campaign-123").Return(nil, nil)
+		repo.EXPECT().Create(mock.AnythingOfType("*models.Webhook")).Return(nil)
+
+		hook, err := service.CreateWebhook("campaign-123", "key", "creator", "https://example.com/hooks",
+			[]string{"campaign_updated", "comment_created", "campaign_updated"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"campaign_updated", "comment_created"}, hook.EventTypes)
+		assert.NotEmpty(t, hook.Secret)
+
+		secret, err := hook.SigningSecret(service.encryptor, service.secret)
+		assert.NoError(t, err)
+		assert.Equal(t, hook.Secret, secret)
+	})
+
+	t.Run("only the owner can create webhooks", func(t *testing.T) {
+		service, _, campaignService, _ := setupWebhookTest(t)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+
+		_, err := service.CreateWebhook("campaign-123", "key", "member", "https://example.com/hooks", []string{"campaign_updated"})
+		assert.Equal(t, http.StatusForbidden, err.(errs.Error).Code())
+	})
+
+	t.Run("rejects invalid webhooks", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			url        string
+			eventTypes []string
+			message    string
+		}{
+			{name: "plain http", url: "http://example.com/hooks", eventTypes: []string{"campaign_updated"}, message: "Webhook URL must be an https URL"},
+			{name: "unknown event type", url: "https://example.com/hooks", eventTypes: []string{"everything"}, message: "Unknown event type everything"},
+			{name: "error events", url: "https://example.com/hooks", eventTypes: []string{"error"}, message: "Unknown event type error"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service, _, campaignService, _ := setupWebhookTest(t)
+				campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+
+				_, err := service.CreateWebhook("campaign-123", "key", "creator", tt.url, tt.eventTypes)
+				assert.Equal(t, http.StatusBadRequest, err.(errs.Error).Code())
+				assert.Equal(t, tt.message, err.Error())
+			})
+		}
+	})
+
+	t.Run("a campaign has a limited number of webhooks", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByCampaignID("campaign-123").Return(make([]models.Webhook, models.MaxWebhooksPerCampaign), nil)
+
+		_, err := service.CreateWebhook("campaign-123", "key", "creator", "https://example.com/hooks", []string{"campaign_updated"})
+		assert.Equal(t, http.StatusBadRequest, err.(errs.Error).Code())
+	})
+}
+
+func TestWebhookService_UpdateWebhook(t *testing.T) {
+	t.Run("disabling a webhook fails its pending deliveries", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "https://example.com/hooks")
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+		repo.EXPECT().Update(hook).Return(nil)
+		repo.EXPECT().AbandonPendingDeliveries(uint(1), "Webhook was disabled").Return(nil)
+
+		enabled := false
+		updated, err := service.UpdateWebhook(1, "campaign-123", "key", "creator", nil, nil, &enabled)
+		assert.NoError(t, err)
+		assert.True(t, updated.IsDisabled())
+	})
+
+	t.Run("enabling a webhook clears its failures", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "https://example.com/hooks")
+		hook.ConsecutiveFailures = models.WebhookFailureLimit
+		hook.Disable()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+		repo.EXPECT().Update(hook).Return(nil)
+
+		enabled := true
+		events := []string{"payout_updated"}
+		updated, err := service.UpdateWebhook(1, "campaign-123", "key", "creator", nil, events, &enabled)
+		assert.NoError(t, err)
+		assert.False(t, updated.IsDisabled())
+		assert.Zero(t, updated.ConsecutiveFailures)
+		assert.Equal(t, events, updated.EventTypes)
+	})
+
+	t.Run("webhooks of another campaign are not found", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "https://example.com/hooks")
+		hook.CampaignID = "campaign-456"
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+
+		_, err := service.UpdateWebhook(1, "campaign-123", "key", "creator", nil, nil, nil)
+		assert.Equal(t, http.StatusNotFound, err.(errs.Error).Code())
+	})
+}
+
+func TestWebhookService_Deliveries(t *testing.T) {
+	event, err := eventbus.NewEvent("campaign-123", string(websocket.EventTypeCampaignUpdated), map[string]string{"title": "Beach Trip"}, map[string]string{"secret": "payment"})
+	require.NoError(t, err)
+
+	t.Run("events are delivered signed with the member payload", func(t *testing.T) {
+		service, repo, _, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "")
+		server, received := newTestEndpoint(t, hook.Secret, http.StatusNoContent)
+		hook.URL = server.URL
+
+		var stored *models.WebhookDelivery
+		repo.EXPECT().GetActiveByCampaignID("campaign-123").Return([]models.Webhook{*hook}, nil)
+		repo.EXPECT().CreateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).RunAndReturn(func(delivery *models.WebhookDelivery) (bool, error) {
+			stored = delivery
+			return true, nil
+		})
+		repo.EXPECT().UpdateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).RunAndReturn(func(delivery *models.WebhookDelivery) error {
+			assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+			assert.Equal(t, http.StatusNoContent, delivery.ResponseStatus)
+			return nil
+		})
+		repo.EXPECT().RecordDeliveryAttempt(uint(1), true, models.WebhookFailureLimit).Return(false, nil)
+
+		bus := memory.NewMemory()
+		bus.Subscribe(service.enqueue)
+		require.NoError(t, bus.Publish(event))
+
+		delivery := <-received
+		assert.Equal(t, stored.ID, delivery.ID)
+		assert.Equal(t, event.ID, delivery.Event.ID)
+		assert.Equal(t, "campaign_updated", delivery.Event.Type)
+		assert.JSONEq(t, `{"title":"Beach Trip"}`, string(delivery.Event.Data))
+
+		assert.NotContains(t, stored.Payload, "Beach Trip", "expected the stored payload to be encrypted")
+	})
+
+	t.Run("events delivered by another instance are not sent again", func(t *testing.T) {
+		service, repo, _, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "")
+		server, received := newTestEndpoint(t, hook.Secret, http.StatusNoContent)
+		hook.URL = server.URL
+
+		repo.EXPECT().GetActiveByCampaignID("campaign-123").Return([]models.Webhook{*hook}, nil)
+		repo.EXPECT().CreateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).Return(false, nil)
+
+		service.enqueue(event)
+		assert.Empty(t, received)
+	})
+
+	t.Run("events the webhook doesn't receive are skipped", func(t *testing.T) {
+		service, repo, _, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "https://example.com/hooks")
+		hook.EventTypes = []string{"comment_created"}
+		repo.EXPECT().GetActiveByCampaignID("campaign-123").Return([]models.Webhook{*hook}, nil)
+
+		service.enqueue(event)
+	})
+
+	t.Run("failed deliveries are retried and the webhook is disabled after too many", func(t *testing.T) {
+		service, repo, _, logger := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "")
+		server, _ := newTestEndpoint(t, hook.Secret, http.StatusInternalServerError)
+		hook.URL = server.URL
+
+		repo.EXPECT().GetActiveByCampaignID("campaign-123").Return([]models.Webhook{*hook}, nil)
+		repo.EXPECT().CreateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).Return(true, nil)
+		repo.EXPECT().UpdateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).RunAndReturn(func(delivery *models.WebhookDelivery) error {
+			assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.Equal(t, http.StatusInternalServerError, delivery.ResponseStatus)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), *delivery.NextAttemptAt, 5*time.Second)
+			return nil
+		})
+		repo.EXPECT().RecordDeliveryAttempt(uint(1), false, models.WebhookFailureLimit).Return(true, nil)
+		repo.EXPECT().AbandonPendingDeliveries(uint(1), mock.Anything).Return(nil)
+		logger.EXPECT().Info("Webhook disabled after failed deliveries", mock.Anything).Return()
+
+		service.enqueue(event)
+	})
+}
+
+func TestWebhookService_SendDueDeliveries(t *testing.T) {
+	service, repo, _, _ := setupWebhookTest(t)
+	hook := newTestWebhook(t, service, "")
+	server, received := newTestEndpoint(t, hook.Secret, http.StatusNoContent)
+	hook.URL = server.URL
+
+	disabled := newTestWebhook(t, service, "https://example.com/hooks")
+	disabled.ID = 2
+	disabled.Disable()
+
+	newStoredDelivery := func(webhookID uint, eventID string) models.WebhookDelivery {
+		body, err := json.Marshal(webhook.Event{ID: eventID, CampaignID: "campaign-123", Type: "campaign_updated", Data: json.RawMessage(`{}`)})
+		require.NoError(t, err)
+		delivery := models.NewWebhookDelivery(webhookID, eventID, "campaign_updated", string(body))
+		require.NoError(t, delivery.Encrypt(service.encryptor, hook.Secret))
+		return *delivery
+	}
+	due := newStoredDelivery(1, "evt-1")
+	abandoned := newStoredDelivery(2, "evt-2")
+
+	var updates atomic.Int32
+	repo.EXPECT().ClaimDueDeliveries(mock.AnythingOfType("time.Time"), webhookDeliveryLease, webhookDeliveryBatch).Return([]models.WebhookDelivery{due, abandoned}, nil)
+	repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+	repo.EXPECT().GetByID(uint(2)).Return(disabled, nil)
+	repo.EXPECT().UpdateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).RunAndReturn(func(delivery *models.WebhookDelivery) error {
+		updates.Add(1)
+		if delivery.WebhookID == 2 {
+			assert.Equal(t, models.WebhookDeliveryFailed, delivery.Status)
+		} else {
+			assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+		}
+		return nil
+	})
+	repo.EXPECT().RecordDeliveryAttempt(uint(1), true, models.WebhookFailureLimit).Return(false, nil)
+
+	service.sendDueDeliveries()
+
+	assert.Equal(t, int32(2), updates.Load())
+	delivery := <-received
+	assert.Equal(t, due.ID, delivery.ID)
+	assert.Equal(t, "evt-1", delivery.Event.ID)
+}
+
+func TestWebhookService_ReplayDelivery(t *testing.T) {
+	t.Run("sends the event again as a new delivery", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "")
+		server, received := newTestEndpoint(t, hook.Secret, http.StatusNoContent)
+		hook.URL = server.URL
+
+		original := models.NewWebhookDelivery(1, "evt-1", "campaign_updated", `{"id":"evt-1","type":"campaign_updated","data":{}}`)
+		original.RecordFailure(http.StatusInternalServerError, "", assert.AnError)
+		require.NoError(t, original.Encrypt(service.encryptor, hook.Secret))
+
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+		repo.EXPECT().GetDelivery(uint(1), original.ID).Return(original, nil)
+		repo.EXPECT().CreateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).Return(true, nil)
+		repo.EXPECT().UpdateDelivery(mock.AnythingOfType("*models.WebhookDelivery")).Return(nil)
+		repo.EXPECT().RecordDeliveryAttempt(uint(1), true, models.WebhookFailureLimit).Return(false, nil)
+
+		replay, err := service.ReplayDelivery(1, "campaign-123", "key", "creator", original.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, original.ID, replay.ID)
+		assert.Equal(t, original.ID, *replay.ReplayOf)
+		assert.Equal(t, "evt-1", replay.EventID)
+
+		delivery := <-received
+		assert.Equal(t, replay.ID, delivery.ID)
+		assert.Equal(t, "evt-1", delivery.Event.ID)
+	})
+
+	t.Run("delivery not found", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(newTestWebhook(t, service, "https://example.com/hooks"), nil)
+		repo.EXPECT().GetDelivery(uint(1), "WHD1").Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := service.ReplayDelivery(1, "campaign-123", "key", "creator", "WHD1")
+		assert.Equal(t, http.StatusNotFound, err.(errs.Error).Code())
+	})
+
+	t.Run("disabled webhooks can't replay deliveries", func(t *testing.T) {
+		service, repo, campaignService, _ := setupWebhookTest(t)
+		hook := newTestWebhook(t, service, "https://example.com/hooks")
+		hook.Disable()
+		campaignService.EXPECT().GetCampaignByID("campaign-123", "key").Return(newWebhookTestCampaign(), nil)
+		repo.EXPECT().GetByID(uint(1)).Return(hook, nil)
+
+		_, err := service.ReplayDelivery(1, "campaign-123", "key", "creator", "WHD1")
+		assert.Equal(t, http.StatusBadRequest, err.(errs.Error).Code())
+	})
+}
@@ -18,11 +18,37 @@ func Migrate(db *gorm.DB) error {
 
 		&models.Campaign{},
 		&models.CampaignImage{},
+		&models.CampaignAccessKey{},
+		&models.JoinRequest{},
+		&models.ContributorRequest{},
+		&models.CampaignExtension{},
+		&models.CampaignExtensionVote{},
+		&models.CampaignMilestone{},
+		&models.CampaignUserRole{},
+		&models.CalendarFeed{},
+		&models.ActivityVote{},
+		&models.Poll{},
+		&models.PollOption{},
+		&models.PollVote{},
+		&models.ActivityExpense{},
+		&models.ActivityReconciliation{},
+		&models.ReconciliationAllocation{},
+		&models.CommentRevision{},
+		&models.CommentReaction{},
+		&models.CommentReadMarker{},
+		&models.ChatMessage{},
+		&models.ChatReceipt{},
+		&models.CommentReport{},
+		&models.CommentModerationLog{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
 
 		&models.Payout{},
 		&models.Contributor{},
 		&models.Comment{},
 		&models.Activity{},
+		&models.ActivityShare{},
+		&models.ActivityWaitlistEntry{},
 		&models.Payment{},
 	)
 	if err != nil {
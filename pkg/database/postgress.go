@@ -1,6 +1,8 @@
 package database
 
 import (
+	"fmt"
+
 	"github.com/oyen-bright/goFundIt/pkg/database/migrations"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -14,12 +16,17 @@ type Config struct {
 	Port     int
 }
 
+// DSN returns the connection string of the Postgres database
+func (c Config) DSN() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
+		c.Host, c.User, c.Password, c.DBName, c.Port,
+	)
+}
+
 func Init(cfg Config, isDevelopment bool) (*gorm.DB, error) {
 
 	// --- PostgreSQL connection (commented out) ---
-	// dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
-	// 	cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port,
-	// )
+	// dsn := cfg.DSN()
 	// logMode := logger.Warn
 	// if isDevelopment {
 	// 	logMode = logger.Info
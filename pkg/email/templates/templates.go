@@ -116,6 +116,122 @@ func ContributorAdded(to []string, name, campaignTitle, campaignID, campaignKey
 	}
 }
 
+func OwnershipTransferred(to []string, campaignTitle, campaignID, campaignKey, previousOwner string) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "Campaign Ownership Transferred - GoFund It",
+		Path:    generateFile("personal/ownership_transferred.html"),
+		Data: map[string]interface{}{
+			"title":         campaignTitle,
+			"id":            campaignID,
+			"key":           campaignKey,
+			"previousOwner": previousOwner,
+		},
+	}
+}
+
+func ContributorInvited(to []string, name, campaignTitle, campaignID, campaignKey string, amount float64, acceptURL, declineURL string, expiresAt time.Time) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "You're Invited to a Campaign - GoFund It",
+		Path:    generateFile("personal/contributor_invited.html"),
+		Data: map[string]interface{}{
+			"name":       name,
+			"title":      campaignTitle,
+			"id":         campaignID,
+			"key":        campaignKey,
+			"amount":     amount,
+			"acceptURL":  acceptURL,
+			"declineURL": declineURL,
+			"expiresAt":  expiresAt.Format("January 2, 2006"),
+		},
+	}
+}
+
+func InvitationReminder(to []string, name, campaignID, acceptURL, declineURL string, expiresAt time.Time) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "Campaign Invitation Reminder - GoFund It",
+		Path:    generateFile("personal/invitation_reminder.html"),
+		Data: map[string]interface{}{
+			"name":       name,
+			"campaignId": campaignID,
+			"acceptURL":  acceptURL,
+			"declineURL": declineURL,
+			"expiresAt":  expiresAt.Format("January 2, 2006"),
+		},
+	}
+}
+
+func InvitationDeclined(to []string, name, contributorEmail, campaignID string) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "Campaign Invitation Declined - GoFund It",
+		Path:    generateFile("personal/invitation_declined.html"),
+		Data: map[string]interface{}{
+			"name":       name,
+			"email":      contributorEmail,
+			"campaignId": campaignID,
+		},
+	}
+}
+
+func JoinRequestReceived(to []string, campaignTitle, name, requesterEmail string, amount float64, message string) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "New Request to Join Your Campaign - GoFund It",
+		Path:    generateFile("personal/join_request_received.html"),
+		Data: map[string]interface{}{
+			"campaignTitle": campaignTitle,
+			"name":          name,
+			"email":         requesterEmail,
+			"amount":        amount,
+			"message":       message,
+		},
+	}
+}
+
+func JoinRequestRejected(to []string, name, campaignTitle string) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "Join Request Declined - GoFund It",
+		Path:    generateFile("personal/join_request_rejected.html"),
+		Data: map[string]interface{}{
+			"name":          name,
+			"campaignTitle": campaignTitle,
+		},
+	}
+}
+
+func WaitlistPromoted(to []string, name, campaignID, activityTitle string, share float64) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "You Got a Seat - GoFund It",
+		Path:    generateFile("personal/waitlist_promoted.html"),
+		Data: map[string]interface{}{
+			"name":          name,
+			"campaignId":    campaignID,
+			"activityTitle": activityTitle,
+			"share":         share,
+		},
+	}
+}
+
+func CommentMention(to []string, name, commenterName, commentContent, campaignID, activityTitle string) *email.EmailTemplate {
+	return &email.EmailTemplate{
+		To:      to,
+		Subject: "You Were Mentioned in a Comment - GoFund It",
+		Path:    generateFile("personal/comment_mention.html"),
+		Data: map[string]interface{}{
+			"name":           name,
+			"commenterName":  commenterName,
+			"commentContent": commentContent,
+			"campaignId":     campaignID,
+			"activityTitle":  activityTitle,
+		},
+	}
+}
+
 func ContributionReminder(to []string, name, campaignTitle string, dueDate time.Time) *email.EmailTemplate {
 	return &email.EmailTemplate{
 		To:      to,
@@ -227,7 +343,7 @@ func CampaignMilestoneGeneral(to []string, campaignTitle, milestoneType string)
 	}
 }
 
-func CampaignUpdatedGeneral(to []string, campaignTitle, updateType string) *email.EmailTemplate {
+func CampaignUpdatedGeneral(to []string, campaignTitle, updateType, fromValue, toValue string) *email.EmailTemplate {
 	return &email.EmailTemplate{
 		To:      to,
 		Subject: "Campaign Updated - GoFund It",
@@ -235,6 +351,8 @@ func CampaignUpdatedGeneral(to []string, campaignTitle, updateType string) *emai
 		Data: map[string]interface{}{
 			"title":      campaignTitle,
 			"updateType": updateType,
+			"fromValue":  fromValue,
+			"toValue":    toValue,
 		},
 	}
 }
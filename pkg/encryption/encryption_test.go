@@ -104,3 +104,17 @@ func TestDecryptWithWrongKey(t *testing.T) {
 		t.Fatalf("Expected error for decrypting with the wrong key, but got none")
 	}
 }
+
+func TestHashKey(t *testing.T) {
+	hash := HashKey("secret", "GC-ABCDE")
+
+	if !CompareHash(hash, HashKey("secret", "GC-ABCDE")) {
+		t.Fatalf("Expected hashes of the same key to match")
+	}
+	if CompareHash(hash, HashKey("secret", "GC-ABCDF")) {
+		t.Fatalf("Expected hashes of different keys not to match")
+	}
+	if CompareHash(hash, HashKey("other-secret", "GC-ABCDE")) {
+		t.Fatalf("Expected hashes with different secrets not to match")
+	}
+}
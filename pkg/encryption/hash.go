@@ -0,0 +1,19 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashKey returns the hex encoded HMAC-SHA256 of value keyed with secret
+func HashKey(secret, value string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CompareHash reports whether two hex encoded hashes are equal in constant time
+func CompareHash(expected, actual string) bool {
+	return hmac.Equal([]byte(expected), []byte(actual))
+}
@@ -10,6 +10,11 @@ func BadRequest(message string, errors interface{}) Error {
 	return &appError{message: message, code: http.StatusBadRequest}
 }
 
+// ValidationFailed is a bad request that sends the validation errors of the request in the response
+func ValidationFailed(message string, errors interface{}) Error {
+	return &appError{message: message, code: http.StatusBadRequest, errors: errors}
+}
+
 func Unauthorized(err Error) Error {
 	return &appError{message: "Unauthorized", err: err, code: http.StatusUnauthorized}
 }
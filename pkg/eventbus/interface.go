@@ -0,0 +1,99 @@
+// Package eventbus carries campaign events between API instances, each instance delivers them to its own websocket clients
+package eventbus
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+)
+
+// Event is a campaign event published on the bus, Data is the JSON encoded payload.
+// PrivilegedData is the payload for members allowed to see payment details, it is empty when everyone gets Data.
+// Seq numbers the event in its campaign, the bus sets it when the event is published
+type Event struct {
+	ID             string          `json:"id"`
+	Seq            uint64          `json:"seq,omitempty"`
+	CampaignID     string          `json:"campaignId"`
+	Type           string          `json:"type"`
+	Data           json.RawMessage `json:"data"`
+	PrivilegedData json.RawMessage `json:"privilegedData,omitempty"`
+}
+
+// Handler is called for every event published on the bus
+type Handler func(Event)
+
+// Bus delivers published events to the subscribers of every instance, including the publishing one.
+// Publish numbers the event in its campaign from a counter every instance shares, so all instances deliver it with the same Seq.
+// An event can be delivered more than once so subscribers should skip the IDs they have already handled
+type Bus interface {
+	Publish(event Event) error
+	Subscribe(handler Handler)
+	Close() error
+}
+
+// NewEvent encodes the payloads and gives the event a random ID, privilegedData is left out when it is nil
+func NewEvent(campaignID, eventType string, data, privilegedData interface{}) (Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var privilegedPayload json.RawMessage
+	if privilegedData != nil {
+		if privilegedPayload, err = json.Marshal(privilegedData); err != nil {
+			return Event{}, err
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		ID:             hex.EncodeToString(id),
+		CampaignID:     campaignID,
+		Type:           eventType,
+		Data:           payload,
+		PrivilegedData: privilegedPayload,
+	}, nil
+}
+
+// Deduplicator remembers the latest event IDs so a redelivered event is only handled once
+type Deduplicator struct {
+	size  int
+	seen  map[string]struct{}
+	order []string
+	next  int
+	mutex sync.Mutex
+}
+
+// NewDeduplicator remembers up to size event IDs, the oldest is forgotten first
+func NewDeduplicator(size int) *Deduplicator {
+	return &Deduplicator{
+		size:  size,
+		seen:  make(map[string]struct{}, size),
+		order: make([]string, 0, size),
+	}
+}
+
+// Seen reports whether the event ID was seen before and remembers it otherwise
+func (d *Deduplicator) Seen(id string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, ok := d.seen[id]; ok {
+		return true
+	}
+
+	if len(d.order) < d.size {
+		d.order = append(d.order, id)
+	} else {
+		delete(d.seen, d.order[d.next])
+		d.order[d.next] = id
+		d.next = (d.next + 1) % d.size
+	}
+	d.seen[id] = struct{}{}
+	return false
+}
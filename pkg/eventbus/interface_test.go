@@ -0,0 +1,44 @@
+package eventbus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	event, err := NewEvent("campaign1", "comment_created", map[string]string{"id": "CMT1"}, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, event.ID, 32)
+	assert.Equal(t, "campaign1", event.CampaignID)
+	assert.Equal(t, "comment_created", event.Type)
+	assert.JSONEq(t, `{"id": "CMT1"}`, string(event.Data))
+	assert.Nil(t, event.PrivilegedData)
+
+	other, err := NewEvent("campaign1", "contributor_updated", map[string]string{"status": "paid"}, map[string]string{"email": "ada@example.com"})
+	require.NoError(t, err)
+	assert.NotEqual(t, event.ID, other.ID)
+	assert.JSONEq(t, `{"email": "ada@example.com"}`, string(other.PrivilegedData))
+
+	_, err = NewEvent("campaign1", "comment_created", make(chan int), nil)
+	assert.Error(t, err)
+
+	_, err = NewEvent("campaign1", "comment_created", nil, make(chan int))
+	assert.Error(t, err)
+}
+
+func TestDeduplicator(t *testing.T) {
+	d := NewDeduplicator(3)
+
+	assert.False(t, d.Seen("a"))
+	assert.True(t, d.Seen("a"), "expected a redelivered event to be seen")
+
+	for i := range 3 {
+		assert.False(t, d.Seen(fmt.Sprintf("event%d", i)))
+	}
+	assert.False(t, d.Seen("a"), "expected the oldest ID to be forgotten")
+	assert.True(t, d.Seen("event2"))
+}
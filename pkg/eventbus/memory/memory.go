@@ -0,0 +1,47 @@
+// Package memory delivers events within the process, it suits a single API instance and tests
+package memory
+
+import (
+	"sync"
+
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+)
+
+// Memory calls its subscribers as soon as an event is published, events are numbered per campaign in memory
+type Memory struct {
+	handlers []eventbus.Handler
+	seqs     map[string]uint64
+	mutex    sync.RWMutex
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Publish numbers the event and calls every subscriber with it before returning
+func (m *Memory) Publish(event eventbus.Event) error {
+	m.mutex.Lock()
+	if m.seqs == nil {
+		m.seqs = make(map[string]uint64)
+	}
+	m.seqs[event.CampaignID]++
+	event.Seq = m.seqs[event.CampaignID]
+	handlers := m.handlers
+	m.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+	return nil
+}
+
+func (m *Memory) Subscribe(handler eventbus.Handler) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.handlers = append(m.handlers[:len(m.handlers):len(m.handlers)], handler)
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
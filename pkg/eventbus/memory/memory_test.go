@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	bus := NewMemory()
+	defer bus.Close()
+
+	var first, second []eventbus.Event
+	bus.Subscribe(func(event eventbus.Event) { first = append(first, event) })
+	bus.Subscribe(func(event eventbus.Event) { second = append(second, event) })
+
+	event := eventbus.Event{ID: "1", CampaignID: "campaign1", Type: "campaign_updated"}
+	assert.NoError(t, bus.Publish(event))
+	assert.NoError(t, bus.Publish(eventbus.Event{ID: "2", CampaignID: "campaign1", Type: "comment_created"}))
+	assert.NoError(t, bus.Publish(eventbus.Event{ID: "3", CampaignID: "campaign2", Type: "campaign_updated"}))
+
+	event.Seq = 1
+	assert.Equal(t, event, first[0])
+	assert.Equal(t, first, second)
+
+	// Events are numbered per campaign
+	var seqs []uint64
+	for _, event := range first {
+		seqs = append(seqs, event.Seq)
+	}
+	assert.Equal(t, []uint64{1, 2, 1}, seqs)
+}
@@ -0,0 +1,242 @@
+// Package postgres shares events between API instances with Postgres LISTEN/NOTIFY
+package postgres
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	"github.com/oyen-bright/goFundIt/pkg/logger"
+)
+
+const (
+	// maxPayloadSize is the largest notification payload Postgres accepts
+	maxPayloadSize = 7999
+	// compressedPrefix marks payloads that were compressed to fit in a notification
+	compressedPrefix = "z:"
+	// maxReconnectWait caps the wait between attempts to listen again after the connection is lost
+	maxReconnectWait = 30 * time.Second
+
+	createSequencesQuery = `CREATE TABLE IF NOT EXISTS campaign_event_sequences (
+		campaign_id text PRIMARY KEY,
+		last_seq bigint NOT NULL
+	)`
+	nextSeqQuery = `INSERT INTO campaign_event_sequences (campaign_id, last_seq) VALUES ($1, 1)
+		ON CONFLICT (campaign_id) DO UPDATE SET last_seq = campaign_event_sequences.last_seq + 1
+		RETURNING last_seq`
+)
+
+var ErrEventTooLarge = errors.New("event is too large for a postgres notification")
+
+// Postgres publishes events as notifications on a channel every instance listens on, events are numbered per campaign
+// in the campaign_event_sequences table. Notifications aren't kept, events published while an instance is reconnecting don't reach it
+type Postgres struct {
+	dsn      string
+	channel  string
+	pool     *pgxpool.Pool
+	logger   logger.Logger
+	handlers []eventbus.Handler
+	mutex    sync.RWMutex
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewPostgres connects to the database and starts listening on channel
+func NewPostgres(dsn, channel string, logger logger.Logger) (*Postgres, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if _, err := pool.Exec(ctx, createSequencesQuery); err != nil {
+		pool.Close()
+		cancel()
+		return nil, err
+	}
+
+	p := &Postgres{
+		dsn:     dsn,
+		channel: channel,
+		pool:    pool,
+		logger:  logger,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	// Listening straight away reports a bad config at start up
+	listener, err := p.listen(ctx)
+	if err != nil {
+		pool.Close()
+		cancel()
+		return nil, err
+	}
+	go p.run(ctx, listener)
+
+	return p, nil
+}
+
+// Publish numbers the event and sends it to every listening instance, large events are compressed to fit in a notification.
+// The number is taken in the transaction that sends the notification, the row lock makes the events of a campaign
+// reach the instances in the order they were numbered
+func (p *Postgres) Publish(event eventbus.Event) error {
+	ctx := context.Background()
+	return pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var seq int64
+		if err := tx.QueryRow(ctx, nextSeqQuery, event.CampaignID).Scan(&seq); err != nil {
+			return err
+		}
+		event.Seq = uint64(seq)
+
+		payload, err := encode(event)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, "SELECT pg_notify($1, $2)", p.channel, payload)
+		return err
+	})
+}
+
+func (p *Postgres) Subscribe(handler eventbus.Handler) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.handlers = append(p.handlers[:len(p.handlers):len(p.handlers)], handler)
+}
+
+// Close stops listening and closes the connections
+func (p *Postgres) Close() error {
+	p.cancel()
+	<-p.done
+	p.pool.Close()
+	return nil
+}
+
+// Helper functions ------------------------------------------------------
+
+// listen opens the connection notifications are received on
+func (p *Postgres) listen(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.Connect(ctx, p.dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{p.channel}.Sanitize()); err != nil {
+		conn.Close(context.Background())
+		return nil, err
+	}
+	return conn, nil
+}
+
+// run receives notifications until the bus is closed, listening again with a growing wait when the connection is lost
+func (p *Postgres) run(ctx context.Context, conn *pgx.Conn) {
+	defer close(p.done)
+
+	for {
+		err := p.receive(ctx, conn)
+		conn.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+		p.logger.Error(err, "Lost the event bus connection, reconnecting", map[string]interface{}{"channel": p.channel})
+
+		wait := time.Second
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+
+			if conn, err = p.listen(ctx); err == nil {
+				break
+			}
+			p.logger.Error(err, "Error reconnecting to the event bus", map[string]interface{}{"channel": p.channel})
+			wait = min(wait*2, maxReconnectWait)
+		}
+	}
+}
+
+// receive hands every notification to the subscribers until the connection fails
+func (p *Postgres) receive(ctx context.Context, conn *pgx.Conn) error {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event, err := decode(notification.Payload)
+		if err != nil {
+			p.logger.Error(err, "Error decoding event from the event bus", map[string]interface{}{"channel": p.channel})
+			continue
+		}
+
+		p.mutex.RLock()
+		handlers := p.handlers
+		p.mutex.RUnlock()
+
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+}
+
+// encode turns the event into a notification payload, it is compressed when the JSON doesn't fit
+func encode(event eventbus.Event) (string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) <= maxPayloadSize {
+		return string(payload), nil
+	}
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(payload); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	encoded := compressedPrefix + base64.StdEncoding.EncodeToString(compressed.Bytes())
+	if len(encoded) > maxPayloadSize {
+		return "", ErrEventTooLarge
+	}
+	return encoded, nil
+}
+
+// decode reads an event from a notification payload
+func decode(payload string) (eventbus.Event, error) {
+	var event eventbus.Event
+
+	data := []byte(payload)
+	if compressed, ok := strings.CutPrefix(payload, compressedPrefix); ok {
+		raw, err := base64.StdEncoding.DecodeString(compressed)
+		if err != nil {
+			return event, err
+		}
+		reader, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return event, err
+		}
+		if data, err = io.ReadAll(reader); err != nil {
+			return event, err
+		}
+	}
+
+	err := json.Unmarshal(data, &event)
+	return event, err
+}
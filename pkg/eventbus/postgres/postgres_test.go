@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/database"
+	"github.com/oyen-bright/goFundIt/pkg/eventbus"
+	mockLogger "github.com/oyen-bright/goFundIt/pkg/logger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent(t *testing.T, data interface{}) eventbus.Event {
+	event, err := eventbus.NewEvent("campaign1", "campaign_updated", data, nil)
+	require.NoError(t, err)
+	return event
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("small events are sent as JSON", func(t *testing.T) {
+		event := testEvent(t, map[string]string{"title": "Road trip"})
+
+		payload, err := encode(event)
+		require.NoError(t, err)
+		assert.True(t, json.Valid([]byte(payload)))
+
+		decoded, err := decode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, event, decoded)
+	})
+
+	t.Run("large events are compressed", func(t *testing.T) {
+		event := testEvent(t, map[string]string{"description": strings.Repeat("Road trip to the coast. ", 1000)})
+
+		payload, err := encode(event)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(payload, compressedPrefix))
+		assert.LessOrEqual(t, len(payload), maxPayloadSize)
+
+		decoded, err := decode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, event.ID, decoded.ID)
+		assert.JSONEq(t, string(event.Data), string(decoded.Data))
+	})
+
+	t.Run("events that don't compress enough are rejected", func(t *testing.T) {
+		random := make([]byte, maxPayloadSize)
+		_, err := rand.Read(random)
+		require.NoError(t, err)
+
+		_, err = encode(testEvent(t, hex.EncodeToString(random)))
+		assert.ErrorIs(t, err, ErrEventTooLarge)
+	})
+
+	t.Run("invalid payloads fail to decode", func(t *testing.T) {
+		_, err := decode(compressedPrefix + "not base64!")
+		assert.Error(t, err)
+	})
+}
+
+func TestPostgres(t *testing.T) {
+	// Skip if not in CI/Test environment
+	if os.Getenv("TEST_DB") != "true" {
+		t.Skip("Skipping database tests. Set TEST_DB=true to run")
+	}
+
+	dsn := database.Config{
+		Host:     "localhost",
+		User:     "test_user",
+		Password: "test_password",
+		DBName:   "test_db",
+		Port:     5432,
+	}.DSN()
+
+	// Two buses on the same channel stand in for two API instances
+	publisher, err := NewPostgres(dsn, "test_campaign_events", mockLogger.NewMockLogger(t))
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	subscriber, err := NewPostgres(dsn, "test_campaign_events", mockLogger.NewMockLogger(t))
+	require.NoError(t, err)
+	defer subscriber.Close()
+
+	received := make(chan eventbus.Event, 2)
+	subscriber.Subscribe(func(event eventbus.Event) { received <- event })
+
+	// Both instances number the events of a campaign from the same counter
+	event := testEvent(t, map[string]string{"title": "Road trip"})
+	event.CampaignID = "campaign-" + event.ID
+	require.NoError(t, publisher.Publish(event))
+	next := testEvent(t, map[string]string{"title": "Road trip"})
+	next.CampaignID = event.CampaignID
+	require.NoError(t, subscriber.Publish(next))
+
+	for _, expected := range []eventbus.Event{event, next} {
+		select {
+		case got := <-received:
+			assert.Equal(t, expected.ID, got.ID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the event to reach the other instance")
+		}
+	}
+}
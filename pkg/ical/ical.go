@@ -0,0 +1,118 @@
+// Package ical writes iCalendar (RFC 5545) feeds that calendar apps can subscribe to
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ContentType is the media type of an iCalendar feed
+	ContentType = "text/calendar; charset=utf-8"
+
+	productID     = "-//GoFundIt//Campaign Calendar//EN"
+	dateTimeUTC   = "20060102T150405Z"
+	maxLineLength = 75
+)
+
+// Calendar is a named list of events
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Event is a single calendar entry, events without an end time end when they start
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Latitude    *float64
+	Longitude   *float64
+	Start       time.Time
+	End         *time.Time
+	UpdatedAt   time.Time
+}
+
+// New creates an empty calendar
+func New(name string) *Calendar {
+	return &Calendar{Name: name}
+}
+
+// AddEvent adds an event to the calendar
+func (c *Calendar) AddEvent(event Event) {
+	c.Events = append(c.Events, event)
+}
+
+// String renders the calendar as an iCalendar document
+func (c *Calendar) String() string {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+productID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:"+escapeText(c.Name))
+
+	for _, event := range c.Events {
+		event.write(&b)
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func (e Event) write(b *strings.Builder) {
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+escapeText(e.UID))
+	writeLine(b, "DTSTAMP:"+formatTime(e.UpdatedAt))
+	writeLine(b, "DTSTART:"+formatTime(e.Start))
+	if e.End != nil {
+		writeLine(b, "DTEND:"+formatTime(*e.End))
+	}
+	writeLine(b, "SUMMARY:"+escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(b, "LOCATION:"+escapeText(e.Location))
+	}
+	if e.Latitude != nil && e.Longitude != nil {
+		writeLine(b, fmt.Sprintf("GEO:%f;%f", *e.Latitude, *e.Longitude))
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+// escapeText escapes the characters that have a meaning in iCalendar text values
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeLine writes a content line ending in CRLF, lines longer than 75 octets are folded
+// onto continuation lines starting with a space without splitting multi-byte characters
+func writeLine(b *strings.Builder, line string) {
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,65 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarString(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 30, 0, 0, time.FixedZone("WAT", 3600))
+	end := start.Add(2 * time.Hour)
+	latitude, longitude := 6.4541, 3.3947
+
+	calendar := New("Lagos Trip")
+	calendar.AddEvent(Event{
+		UID:         "activity-1@gofundit",
+		Summary:     "Boat tour, Lekki; bring water",
+		Description: "Meet at the jetty\nLife jackets provided",
+		Location:    "Lekki Jetty",
+		Latitude:    &latitude,
+		Longitude:   &longitude,
+		Start:       start,
+		End:         &end,
+		UpdatedAt:   start,
+	})
+
+	ics := calendar.String()
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "X-WR-CALNAME:Lagos Trip\r\n")
+	assert.Contains(t, ics, "DTSTART:20250601T083000Z\r\n")
+	assert.Contains(t, ics, "DTEND:20250601T103000Z\r\n")
+	assert.Contains(t, ics, `SUMMARY:Boat tour\, Lekki\; bring water`+"\r\n")
+	assert.Contains(t, ics, `DESCRIPTION:Meet at the jetty\nLife jackets provided`+"\r\n")
+	assert.Contains(t, ics, "GEO:6.454100;3.394700\r\n")
+}
+
+func TestCalendarStringWithoutEndTime(t *testing.T) {
+	calendar := New("Campaign")
+	calendar.AddEvent(Event{UID: "activity-2@gofundit", Summary: "Dinner", Start: time.Now()})
+
+	ics := calendar.String()
+
+	assert.Contains(t, ics, "DTSTART:")
+	assert.NotContains(t, ics, "DTEND:")
+	assert.NotContains(t, ics, "LOCATION:")
+}
+
+func TestWriteLineFoldsLongLines(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "DESCRIPTION:"+strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineLength)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 60), strings.ReplaceAll(strings.Join(lines, "\r\n"), "\r\n ", ""))
+}
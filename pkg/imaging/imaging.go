@@ -0,0 +1,209 @@
+// Package imaging validates uploaded images, strips their metadata and generates thumbnails
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+)
+
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+
+	jpegQuality = 90
+)
+
+var (
+	ErrTooLarge           = errors.New("image is too large")
+	ErrUnsupportedType    = errors.New("only JPEG and PNG images are supported")
+	ErrInvalidImage       = errors.New("image could not be read")
+	ErrDimensionsTooLarge = errors.New("image dimensions are too large")
+)
+
+// Options limits the images that are accepted and sets the size of thumbnails
+type Options struct {
+	// MaxBytes is the largest file accepted
+	MaxBytes int64
+	// MaxDimension is the widest or tallest image accepted, it guards against decompression bombs
+	MaxDimension int
+	// ThumbnailSize is the longest side of a thumbnail
+	ThumbnailSize int
+}
+
+// DefaultOptions accepts images up to 5MB and 8000 pixels on a side and makes 320 pixel thumbnails
+var DefaultOptions = Options{
+	MaxBytes:      5 << 20,
+	MaxDimension:  8000,
+	ThumbnailSize: 320,
+}
+
+// Image is a processed upload, Path and ThumbnailPath are temporary files removed with Remove
+type Image struct {
+	Path          string
+	ThumbnailPath string
+	ContentType   string
+	Width         int
+	Height        int
+}
+
+// Remove deletes the temporary files of the image
+func (i *Image) Remove() {
+	os.Remove(i.Path)
+	os.Remove(i.ThumbnailPath)
+}
+
+// Process validates the image at path and writes a copy without metadata and a thumbnail to temporary files.
+// Re-encoding drops EXIF data such as the location a photo was taken at, the EXIF orientation is applied first
+func Process(path string, opts Options) (*Image, error) {
+	data, err := readFile(path, opts.MaxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := http.DetectContentType(data)
+	if contentType != ContentTypeJPEG && contentType != ContentTypePNG {
+		return nil, ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if config.Width > opts.MaxDimension || config.Height > opts.MaxDimension {
+		return nil, ErrDimensionsTooLarge
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	img := toNRGBA(decoded)
+	if contentType == ContentTypeJPEG {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	result := &Image{
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+	}
+
+	if result.Path, err = writeTemp(img, contentType); err != nil {
+		return nil, err
+	}
+	if result.ThumbnailPath, err = writeTemp(Thumbnail(img, opts.ThumbnailSize), contentType); err != nil {
+		result.Remove()
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Thumbnail scales the image down so its longest side is at most size, smaller images are returned as they are
+func Thumbnail(img *image.NRGBA, size int) *image.NRGBA {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if size <= 0 || (width <= size && height <= size) {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*size/width)
+		width = size
+	} else {
+		width = max(1, width*size/height)
+		height = size
+	}
+	return resize(img, width, height)
+}
+
+// Helper functions
+
+func readFile(path string, maxBytes int64) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+func writeTemp(img image.Image, contentType string) (string, error) {
+	pattern := "image-*.png"
+	if contentType == ContentTypeJPEG {
+		pattern = "image-*.jpg"
+	}
+
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if contentType == ContentTypeJPEG {
+		err = jpeg.Encode(file, img, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(file, img)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("encoding image: %w", err)
+	}
+	return file.Name(), nil
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	return dst
+}
+
+// resize scales the image down by averaging the source pixels each destination pixel covers
+func resize(src *image.NRGBA, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcHeight/height, max((y+1)*srcHeight/height, y*srcHeight/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcWidth/width, max((x+1)*srcWidth/width, x*srcWidth/width+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[offset])
+					g += int(src.Pix[offset+1])
+					b += int(src.Pix[offset+2])
+					a += int(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,153 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestImage(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+	return img
+}
+
+// withExifOrientation inserts an APP1 EXIF segment with an orientation tag after the start of image marker
+func withExifOrientation(jpegData []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	return append(append(append([]byte{}, jpegData[:2]...), segment...), jpegData[2:]...)
+}
+
+func writeTestFile(t *testing.T, data []byte) string {
+	file, err := os.CreateTemp(t.TempDir(), "upload-*")
+	require.NoError(t, err)
+	_, err = file.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	return file.Name()
+}
+
+func TestProcessJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, newTestImage(600, 400), nil))
+	data := withExifOrientation(buf.Bytes(), 6)
+	assert.Equal(t, 6, jpegOrientation(data))
+
+	result, err := Process(writeTestFile(t, data), DefaultOptions)
+	require.NoError(t, err)
+	defer result.Remove()
+
+	assert.Equal(t, ContentTypeJPEG, result.ContentType)
+	// Orientation 6 is turned a quarter so the stored image is upright
+	assert.Equal(t, 400, result.Width)
+	assert.Equal(t, 600, result.Height)
+
+	stripped, err := os.ReadFile(result.Path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stripped), "Exif")
+	assert.Equal(t, 1, jpegOrientation(stripped))
+
+	thumbnail, err := os.Open(result.ThumbnailPath)
+	require.NoError(t, err)
+	defer thumbnail.Close()
+	config, err := jpeg.DecodeConfig(thumbnail)
+	require.NoError(t, err)
+	assert.Equal(t, 213, config.Width)
+	assert.Equal(t, 320, config.Height)
+}
+
+func TestProcessPNG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, newTestImage(100, 50)))
+
+	result, err := Process(writeTestFile(t, buf.Bytes()), DefaultOptions)
+	require.NoError(t, err)
+	defer result.Remove()
+
+	assert.Equal(t, ContentTypePNG, result.ContentType)
+
+	// Images smaller than a thumbnail keep their size
+	thumbnail, err := os.Open(result.ThumbnailPath)
+	require.NoError(t, err)
+	defer thumbnail.Close()
+	config, err := png.DecodeConfig(thumbnail)
+	require.NoError(t, err)
+	assert.Equal(t, 100, config.Width)
+	assert.Equal(t, 50, config.Height)
+}
+
+func TestProcessRejectsInvalidImages(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, newTestImage(100, 50)))
+	valid := buf.Bytes()
+
+	tests := []struct {
+		name    string
+		data    []byte
+		opts    Options
+		wantErr error
+	}{
+		{name: "unsupported type", data: []byte("GIF89a not really an image"), opts: DefaultOptions, wantErr: ErrUnsupportedType},
+		{name: "plain text", data: []byte("hello"), opts: DefaultOptions, wantErr: ErrUnsupportedType},
+		{name: "too large", data: valid, opts: Options{MaxBytes: 10, MaxDimension: 8000, ThumbnailSize: 320}, wantErr: ErrTooLarge},
+		{name: "dimensions too large", data: valid, opts: Options{MaxBytes: 5 << 20, MaxDimension: 64, ThumbnailSize: 320}, wantErr: ErrDimensionsTooLarge},
+		{name: "truncated", data: valid[:40], opts: DefaultOptions, wantErr: ErrInvalidImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Process(writeTestFile(t, tt.data), tt.opts)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestThumbnailAveragesPixels(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		img.Set(x, 0, color.NRGBA{R: 200, A: 255})
+		img.Set(x, 1, color.NRGBA{R: 100, A: 255})
+	}
+
+	thumbnail := Thumbnail(img, 2)
+	assert.Equal(t, 2, thumbnail.Bounds().Dx())
+	assert.Equal(t, 1, thumbnail.Bounds().Dy())
+	assert.Equal(t, color.NRGBA{R: 150, A: 255}, thumbnail.NRGBAAt(0, 0))
+}
+
+func TestOrient(t *testing.T) {
+	img := newTestImage(3, 2)
+	corner := img.NRGBAAt(0, 0)
+
+	rotated := orient(img, 6)
+	assert.Equal(t, image.Rect(0, 0, 2, 3), rotated.Bounds())
+	// Turning clockwise moves the top left corner to the top right
+	assert.Equal(t, corner, rotated.NRGBAAt(1, 0))
+
+	assert.Equal(t, corner, orient(img, 3).NRGBAAt(2, 1))
+	assert.Same(t, img, orient(img, 1))
+}
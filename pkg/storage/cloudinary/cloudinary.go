@@ -2,9 +2,14 @@ package cloudinary
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	client "github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/oyen-bright/goFundIt/pkg/storage"
 )
@@ -12,6 +17,7 @@ import (
 type cloudinary struct {
 	cloudinary *client.Cloudinary
 	URL        string
+	now        func() time.Time
 }
 
 func (c *cloudinary) UploadFile(filePath, folderPath string) (string, string, error) {
@@ -34,11 +40,46 @@ func (c *cloudinary) UploadFile(filePath, folderPath string) (string, string, er
 	return uploadResult.SecureURL, uploadResult.PublicID, nil
 }
 
+func (c *cloudinary) UploadPrivateFile(filePath, folderPath string) (string, error) {
+	ctx := context.Background()
+
+	// Private assets are only delivered through signed download links
+	uploadResult, err := c.cloudinary.Upload.Upload(ctx, filePath, uploader.UploadParams{
+		Folder: storage.PrivateFolder(folderPath) + "/",
+		Type:   api.Private,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return uploadResult.PublicID, nil
+}
+
+// SignedURL builds a private download link for the asset, Cloudinary rejects it after expiry
+func (c *cloudinary) SignedURL(publicID string, expiry time.Duration) (string, error) {
+	cloud := c.cloudinary.Config.Cloud
+
+	params := url.Values{}
+	params.Set("public_id", publicID)
+	params.Set("type", deliveryType(publicID))
+	params.Set("expires_at", strconv.FormatInt(c.now().Add(expiry).Unix(), 10))
+	params.Set("timestamp", strconv.FormatInt(c.now().Unix(), 10))
+
+	signature, err := api.SignParameters(params, cloud.APISecret)
+	if err != nil {
+		return "", err
+	}
+	params.Set("signature", signature)
+	params.Set("api_key", cloud.APIKey)
+
+	return fmt.Sprintf("%s/v1_1/%s/image/download?%s", c.cloudinary.Config.API.UploadPrefix, cloud.CloudName, params.Encode()), nil
+}
+
 func (c *cloudinary) DeleteFile(publicID string) error {
 	ctx := context.Background()
 
 	// Delete the file from Cloudinary using its public ID
-	_, err := c.cloudinary.Upload.Destroy(ctx, uploader.DestroyParams{PublicID: publicID})
+	_, err := c.cloudinary.Upload.Destroy(ctx, uploader.DestroyParams{PublicID: publicID, Type: deliveryType(publicID)})
 	if err != nil {
 		return err
 	}
@@ -56,5 +97,15 @@ func NewCloudinary(cloudinaryURL string) (storage.Storage, error) {
 	return &cloudinary{
 		cloudinary: cld,
 		URL:        cloudinaryURL,
+		now:        time.Now,
 	}, nil
 }
+
+// Helper functions
+
+func deliveryType(publicID string) string {
+	if storage.IsPrivate(publicID) {
+		return api.Private
+	}
+	return string(api.Upload)
+}
@@ -1,6 +1,53 @@
 package storage
 
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PrivatePrefix starts the ID of every private file, backends use it to tell private files apart
+const PrivatePrefix = "private/"
+
+var (
+	ErrInvalidSignature = errors.New("invalid download signature")
+	ErrLinkExpired      = errors.New("download link has expired")
+)
+
 type Storage interface {
 	UploadFile(file, folderPath string) (url, id string, err error)
+	// UploadPrivateFile stores a file that can only be downloaded through a signed URL
+	UploadPrivateFile(file, folderPath string) (id string, err error)
+	// SignedURL returns a link to a stored file that stops working after expiry
+	SignedURL(id string, expiry time.Duration) (string, error)
 	DeleteFile(id string) error
 }
+
+// FileServer is implemented by storages that serve their files through the API's download route
+type FileServer interface {
+	// Resolve checks the signature of a download link and returns the path of the file on disk
+	Resolve(id, expires, signature string) (string, error)
+}
+
+// IsPrivate reports whether the file ID belongs to a private file
+func IsPrivate(id string) bool {
+	return strings.HasPrefix(id, PrivatePrefix)
+}
+
+// PrivateFolder returns the folder private files for folderPath are stored in
+func PrivateFolder(folderPath string) string {
+	return PrivatePrefix + strings.Trim(folderPath, "/")
+}
+
+// ObjectKey returns a new random key in folderPath for the file, keeping its extension
+func ObjectKey(folderPath, file string) (string, error) {
+	name := make([]byte, 16)
+	if _, err := rand.Read(name); err != nil {
+		return "", err
+	}
+	return path.Join(strings.Trim(folderPath, "/"), hex.EncodeToString(name)+strings.ToLower(filepath.Ext(file))), nil
+}
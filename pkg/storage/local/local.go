@@ -0,0 +1,147 @@
+// Package local stores files on disk, the API serves them through a signed download route
+package local
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+)
+
+// DownloadPath is the route local files are served from
+const DownloadPath = "/files/"
+
+// Local keeps files in a directory on disk
+type Local struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocal stores files under dir, links to them start with baseURL and are signed with secret
+func NewLocal(dir, baseURL, secret string) (*Local, error) {
+	if secret == "" {
+		return nil, errors.New("local storage needs a signing secret")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating storage directory: %w", err)
+	}
+
+	return &Local{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  []byte(secret),
+		now:     time.Now,
+	}, nil
+}
+
+// UploadFile copies the file into the storage directory, the returned link does not expire
+func (l *Local) UploadFile(filePath, folderPath string) (string, string, error) {
+	id, err := l.store(filePath, folderPath)
+	if err != nil {
+		return "", "", err
+	}
+	return l.link(id, 0), id, nil
+}
+
+func (l *Local) UploadPrivateFile(filePath, folderPath string) (string, error) {
+	return l.store(filePath, storage.PrivateFolder(folderPath))
+}
+
+func (l *Local) SignedURL(id string, expiry time.Duration) (string, error) {
+	if !filepath.IsLocal(id) {
+		return "", fmt.Errorf("invalid file id %q", id)
+	}
+	return l.link(id, l.now().Add(expiry).Unix()), nil
+}
+
+func (l *Local) DeleteFile(id string) error {
+	if !filepath.IsLocal(id) {
+		return fmt.Errorf("invalid file id %q", id)
+	}
+
+	err := os.Remove(filepath.Join(l.dir, filepath.FromSlash(id)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Resolve checks a download link, links to private files must expire
+func (l *Local) Resolve(id, expires, signature string) (string, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !filepath.IsLocal(id) {
+		return "", storage.ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(l.sign(id, expiresAt))) {
+		return "", storage.ErrInvalidSignature
+	}
+	if expiresAt == 0 && storage.IsPrivate(id) {
+		return "", storage.ErrInvalidSignature
+	}
+	if expiresAt != 0 && l.now().Unix() > expiresAt {
+		return "", storage.ErrLinkExpired
+	}
+
+	return filepath.Join(l.dir, filepath.FromSlash(id)), nil
+}
+
+// Helper functions
+
+func (l *Local) store(filePath, folderPath string) (string, error) {
+	id, err := storage.ObjectKey(folderPath, filePath)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst := filepath.Join(l.dir, filepath.FromSlash(id))
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return "", err
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(out, src); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return "", err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(dst)
+		return "", err
+	}
+	return id, nil
+}
+
+// link builds a download link, an expiry of zero never expires
+func (l *Local) link(id string, expiresAt int64) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt, 10))
+	query.Set("signature", l.sign(id, expiresAt))
+	return l.baseURL + DownloadPath + id + "?" + query.Encode()
+}
+
+func (l *Local) sign(id string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, l.secret)
+	fmt.Fprintf(mac, "%s\n%d", id, expiresAt)
+	return hex.EncodeToString(mac.Sum(nil))
+}
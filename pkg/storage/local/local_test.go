@@ -0,0 +1,114 @@
+package local
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorage(t *testing.T) *Local {
+	store, err := NewLocal(t.TempDir(), "http://localhost:8080/", "secret")
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return store
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// resolveLink resolves a download link the way the download route does
+func resolveLink(t *testing.T, store *Local, link string) (string, error) {
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	id := strings.TrimPrefix(parsed.Path, DownloadPath)
+	return store.Resolve(id, parsed.Query().Get("expires"), parsed.Query().Get("signature"))
+}
+
+func TestNewLocalRequiresSecret(t *testing.T) {
+	_, err := NewLocal(t.TempDir(), "http://localhost:8080", "")
+	assert.Error(t, err)
+}
+
+func TestUploadFile(t *testing.T) {
+	store := newTestStorage(t)
+
+	link, id, err := store.UploadFile(writeTestFile(t, "photo.JPG", "image"), "campaign/images")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(id, "campaign/images/"))
+	assert.True(t, strings.HasSuffix(id, ".jpg"))
+	assert.True(t, strings.HasPrefix(link, "http://localhost:8080/files/"+id+"?"))
+
+	path, err := resolveLink(t, store, link)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "image", string(content))
+
+	// Public links keep working
+	store.now = func() time.Time { return time.Unix(1900000000, 0) }
+	_, err = resolveLink(t, store, link)
+	assert.NoError(t, err)
+
+	require.NoError(t, store.DeleteFile(id))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, store.DeleteFile(id), "deleting a missing file is not an error")
+}
+
+func TestPrivateFiles(t *testing.T) {
+	store := newTestStorage(t)
+
+	id, err := store.UploadPrivateFile(writeTestFile(t, "proof.pdf", "proof"), "payment/reference")
+	require.NoError(t, err)
+	assert.True(t, storage.IsPrivate(id))
+
+	link, err := store.SignedURL(id, 15*time.Minute)
+	require.NoError(t, err)
+	_, err = resolveLink(t, store, link)
+	assert.NoError(t, err)
+
+	// Links stop working once they expire
+	store.now = func() time.Time { return time.Unix(1700000000, 0).Add(16 * time.Minute) }
+	_, err = resolveLink(t, store, link)
+	assert.ErrorIs(t, err, storage.ErrLinkExpired)
+
+	// Private files are never served without an expiry
+	_, err = store.Resolve(id, "0", store.sign(id, 0))
+	assert.ErrorIs(t, err, storage.ErrInvalidSignature)
+}
+
+func TestResolveRejectsInvalidLinks(t *testing.T) {
+	store := newTestStorage(t)
+	_, id, err := store.UploadFile(writeTestFile(t, "photo.png", "image"), "campaign/images")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		id        string
+		expires   string
+		signature string
+	}{
+		{name: "wrong signature", id: id, expires: "0", signature: "bad"},
+		{name: "changed expiry", id: id, expires: "1900000000", signature: store.sign(id, 0)},
+		{name: "invalid expiry", id: id, expires: "soon", signature: store.sign(id, 0)},
+		{name: "path traversal", id: "../secret.txt", expires: "0", signature: store.sign("../secret.txt", 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.Resolve(tt.id, tt.expires, tt.signature)
+			assert.ErrorIs(t, err, storage.ErrInvalidSignature)
+		})
+	}
+}
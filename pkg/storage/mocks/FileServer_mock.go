@@ -0,0 +1,90 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package storage
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockFileServer is an autogenerated mock type for the FileServer type
+type MockFileServer struct {
+	mock.Mock
+}
+
+type MockFileServer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFileServer) EXPECT() *MockFileServer_Expecter {
+	return &MockFileServer_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function with given fields: id, expires, signature
+func (_m *MockFileServer) Resolve(id string, expires string, signature string) (string, error) {
+	ret := _m.Called(id, expires, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return rf(id, expires, signature)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(id, expires, signature)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(id, expires, signature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileServer_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockFileServer_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - id string
+//   - expires string
+//   - signature string
+func (_e *MockFileServer_Expecter) Resolve(id interface{}, expires interface{}, signature interface{}) *MockFileServer_Resolve_Call {
+	return &MockFileServer_Resolve_Call{Call: _e.mock.On("Resolve", id, expires, signature)}
+}
+
+func (_c *MockFileServer_Resolve_Call) Run(run func(id string, expires string, signature string)) *MockFileServer_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileServer_Resolve_Call) Return(_a0 string, _a1 error) *MockFileServer_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileServer_Resolve_Call) RunAndReturn(run func(string, string, string) (string, error)) *MockFileServer_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFileServer creates a new instance of MockFileServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFileServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFileServer {
+	mock := &MockFileServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
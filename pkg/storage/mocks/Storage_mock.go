@@ -2,7 +2,11 @@
 
 package storage
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // MockStorage is an autogenerated mock type for the Storage type
 type MockStorage struct {
@@ -63,6 +67,63 @@ func (_c *MockStorage_DeleteFile_Call) RunAndReturn(run func(string) error) *Moc
 	return _c
 }
 
+// SignedURL provides a mock function with given fields: id, expiry
+func (_m *MockStorage) SignedURL(id string, expiry time.Duration) (string, error) {
+	ret := _m.Called(id, expiry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignedURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Duration) (string, error)); ok {
+		return rf(id, expiry)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Duration) string); ok {
+		r0 = rf(id, expiry)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Duration) error); ok {
+		r1 = rf(id, expiry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorage_SignedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignedURL'
+type MockStorage_SignedURL_Call struct {
+	*mock.Call
+}
+
+// SignedURL is a helper method to define mock.On call
+//   - id string
+//   - expiry time.Duration
+func (_e *MockStorage_Expecter) SignedURL(id interface{}, expiry interface{}) *MockStorage_SignedURL_Call {
+	return &MockStorage_SignedURL_Call{Call: _e.mock.On("SignedURL", id, expiry)}
+}
+
+func (_c *MockStorage_SignedURL_Call) Run(run func(id string, expiry time.Duration)) *MockStorage_SignedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockStorage_SignedURL_Call) Return(_a0 string, _a1 error) *MockStorage_SignedURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStorage_SignedURL_Call) RunAndReturn(run func(string, time.Duration) (string, error)) *MockStorage_SignedURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UploadFile provides a mock function with given fields: file, folderPath
 func (_m *MockStorage) UploadFile(file string, folderPath string) (string, string, error) {
 	ret := _m.Called(file, folderPath)
@@ -127,6 +188,63 @@ func (_c *MockStorage_UploadFile_Call) RunAndReturn(run func(string, string) (st
 	return _c
 }
 
+// UploadPrivateFile provides a mock function with given fields: file, folderPath
+func (_m *MockStorage) UploadPrivateFile(file string, folderPath string) (string, error) {
+	ret := _m.Called(file, folderPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadPrivateFile")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(file, folderPath)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(file, folderPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(file, folderPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStorage_UploadPrivateFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadPrivateFile'
+type MockStorage_UploadPrivateFile_Call struct {
+	*mock.Call
+}
+
+// UploadPrivateFile is a helper method to define mock.On call
+//   - file string
+//   - folderPath string
+func (_e *MockStorage_Expecter) UploadPrivateFile(file interface{}, folderPath interface{}) *MockStorage_UploadPrivateFile_Call {
+	return &MockStorage_UploadPrivateFile_Call{Call: _e.mock.On("UploadPrivateFile", file, folderPath)}
+}
+
+func (_c *MockStorage_UploadPrivateFile_Call) Run(run func(file string, folderPath string)) *MockStorage_UploadPrivateFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStorage_UploadPrivateFile_Call) Return(id string, err error) *MockStorage_UploadPrivateFile_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockStorage_UploadPrivateFile_Call) RunAndReturn(run func(string, string) (string, error)) *MockStorage_UploadPrivateFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStorage creates a new instance of MockStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStorage(t interface {
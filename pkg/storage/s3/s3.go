@@ -0,0 +1,270 @@
+// Package s3 stores files in an S3 compatible bucket such as AWS S3 or MinIO
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oyen-bright/goFundIt/pkg/storage"
+)
+
+const (
+	algorithm       = "AWS4-HMAC-SHA256"
+	timeFormat      = "20060102T150405Z"
+	dateFormat      = "20060102"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// maxExpiry is the longest a presigned link can last
+	maxExpiry = 7 * 24 * time.Hour
+)
+
+// Config points the storage at a bucket
+type Config struct {
+	// Endpoint is the address of the S3 API, for example https://s3.amazonaws.com or http://localhost:9000 for MinIO
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle puts the bucket in the path instead of the host name, MinIO needs it
+	PathStyle bool
+	// PublicURL replaces the bucket address in links to public files, for example a CDN
+	PublicURL string
+}
+
+type s3 struct {
+	config   Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3 returns a storage for the bucket, public files need a bucket policy allowing anonymous reads outside private/
+func NewS3(config Config) (storage.Storage, error) {
+	if config.Bucket == "" || config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("s3 storage needs a bucket, access key and secret key")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+
+	return &s3{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 60 * time.Second},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *s3) UploadFile(filePath, folderPath string) (string, string, error) {
+	key, err := s.put(filePath, folderPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	if s.config.PublicURL != "" {
+		return strings.TrimSuffix(s.config.PublicURL, "/") + "/" + encodePath(key), key, nil
+	}
+	return s.objectURL(key).String(), key, nil
+}
+
+func (s *s3) UploadPrivateFile(filePath, folderPath string) (string, error) {
+	return s.put(filePath, storage.PrivateFolder(folderPath))
+}
+
+// SignedURL presigns a GET request for the object
+func (s *s3) SignedURL(key string, expiry time.Duration) (string, error) {
+	if expiry <= 0 || expiry > maxExpiry {
+		return "", fmt.Errorf("s3 links must expire within %s", maxExpiry)
+	}
+
+	now := s.now().UTC()
+	link := s.objectURL(key)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", s.config.AccessKey+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(timeFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	link.RawQuery = encodeQuery(query)
+
+	signature := s.signature(now, canonicalRequest(http.MethodGet, link, http.Header{}, []string{"host"}, unsignedPayload))
+	link.RawQuery += "&X-Amz-Signature=" + signature
+	return link.String(), nil
+}
+
+func (s *s3) DeleteFile(key string) error {
+	return s.do(http.MethodDelete, key, nil, "")
+}
+
+// Helper functions
+
+func (s *s3) put(filePath, folderPath string) (string, error) {
+	key, err := storage.ObjectKey(folderPath, filePath)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.do(http.MethodPut, key, body, http.DetectContentType(body)); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *s3) do(method, key string, body []byte, contentType string) error {
+	req, err := http.NewRequest(method, s.objectURL(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, body)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("s3 %s %s failed with status %d: %s", method, key, res.StatusCode, message)
+	}
+	return nil
+}
+
+// sign adds a signature version 4 Authorization header to the request
+func (s *s3) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	payloadHash := hashHex(body)
+
+	req.Header.Set("X-Amz-Date", now.Format(timeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = append([]string{"content-type"}, signedHeaders...)
+	}
+
+	signature := s.signature(now, canonicalRequest(req.Method, req.URL, req.Header, signedHeaders, payloadHash))
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, s.config.AccessKey, s.scope(now), strings.Join(signedHeaders, ";"), signature))
+}
+
+func (s *s3) signature(now time.Time, canonical string) string {
+	stringToSign := strings.Join([]string{algorithm, now.Format(timeFormat), s.scope(now), hashHex([]byte(canonical))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretKey), now.Format(dateFormat))
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func (s *s3) scope(now time.Time) string {
+	return now.Format(dateFormat) + "/" + s.config.Region + "/s3/aws4_request"
+}
+
+func (s *s3) objectURL(key string) *url.URL {
+	link := *s.endpoint
+	if s.config.PathStyle {
+		link.Path = "/" + s.config.Bucket + "/" + key
+	} else {
+		link.Host = s.config.Bucket + "." + link.Host
+		link.Path = "/" + key
+	}
+	link.RawPath = encodePath(link.Path)
+	return &link
+}
+
+func canonicalRequest(method string, link *url.URL, header http.Header, signedHeaders []string, payloadHash string) string {
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		value := header.Get(name)
+		if name == "host" {
+			value = link.Host
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	return strings.Join([]string{
+		method,
+		encodePath(link.Path),
+		encodeQuery(link.Query()),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// encodeQuery sorts and escapes the query the way signature version 4 expects
+func encodeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func encodePath(path string) string {
+	return uriEncode(path, false)
+}
+
+// uriEncode escapes everything except unreserved characters, slashes are kept in paths
+func uriEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(value) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,22 @@
+package utils
+
+import "strings"
+
+// Slugify converts s to a lowercase, hyphen separated string that is safe to use in a URL.
+func Slugify(s string) string {
+	var b strings.Builder
+	hyphen := false
+
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+			hyphen = false
+		case b.Len() > 0 && !hyphen:
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "-")
+}
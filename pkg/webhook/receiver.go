@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// maxRequestBody is the largest delivery the receiver reads
+const maxRequestBody = 1 << 20
+
+// Delivery is a delivery the receiver checked the signature of
+type Delivery struct {
+	ID    string
+	Event Event
+}
+
+// Receiver is an http.Handler that checks the signature of deliveries before handing them on, for testing webhooks locally.
+// Endpoints written in Go can use it as well
+type Receiver struct {
+	secret string
+	handle func(Delivery)
+}
+
+// NewReceiver creates a receiver for the deliveries of the webhook with secret
+func NewReceiver(secret string, handle func(Delivery)) *Receiver {
+	return &Receiver{secret: secret, handle: handle}
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRequestBody))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !Verify(r.secret, body, req.Header.Get(SignatureHeader)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.handle(Delivery{ID: req.Header.Get(DeliveryHeader), Event: event})
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,150 @@
+// Package webhook signs campaign events and sends them to the endpoints campaign creators register
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA512 of the body, the same scheme Paystack signs its webhooks with
+	SignatureHeader = "X-GoFundIt-Signature"
+	// EventHeader holds the type of the event
+	EventHeader = "X-GoFundIt-Event"
+	// DeliveryHeader holds the ID of the delivery, a replayed event is sent with a new one
+	DeliveryHeader = "X-GoFundIt-Delivery"
+
+	// maxResponseBody is how much of the endpoint's response is kept for the delivery log
+	maxResponseBody = 1024
+)
+
+var (
+	ErrInvalidURL       = errors.New("webhook URL must be an absolute https URL")
+	ErrPrivateAddress   = errors.New("webhook address is not publicly reachable")
+	ErrUnexpectedStatus = errors.New("webhook endpoint did not respond with a 2xx status")
+)
+
+// Event is the body sent to webhook endpoints, ID stays the same when a delivery is replayed so endpoints can skip events they have handled
+type Event struct {
+	ID         string          `json:"id"`
+	CampaignID string          `json:"campaignId"`
+	Type       string          `json:"type"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Request is a delivery of an event to an endpoint
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Body       []byte
+}
+
+// Response is what the endpoint answered, Body is cut to the first kilobyte
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// Sign returns the hex encoded HMAC-SHA512 of the body keyed with the webhook secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery in constant time
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// ValidateURL checks that a webhook URL can be registered, plain http is only accepted when allowInsecure is set
+func ValidateURL(rawURL string, allowInsecure bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || u.User != nil {
+		return ErrInvalidURL
+	}
+	if u.Scheme == "https" || (allowInsecure && u.Scheme == "http") {
+		return nil
+	}
+	return ErrInvalidURL
+}
+
+// Client sends deliveries to webhook endpoints
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a client that gives up on an endpoint after timeout.
+// Deliveries can't reach loopback or private addresses unless allowPrivate is set, which the local test receiver needs
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = rejectPrivateAddress
+	}
+
+	return &Client{
+		http: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{DialContext: dialer.DialContext},
+			// Redirects aren't followed, the endpoint registered is the one that is called
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts the signed delivery, an error is returned when the endpoint can't be reached or doesn't respond with a 2xx status
+func (c *Client) Send(request Request) (Response, error) {
+	req, err := http.NewRequest(http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GoFundIt-Webhook/1.0")
+	req.Header.Set(SignatureHeader, Sign(request.Secret, request.Body))
+	req.Header.Set(EventHeader, request.EventType)
+	req.Header.Set(DeliveryHeader, request.DeliveryID)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	response := Response{StatusCode: res.StatusCode, Body: string(body)}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return response, fmt.Errorf("%w: %d", ErrUnexpectedStatus, res.StatusCode)
+	}
+	return response, nil
+}
+
+// Helper functions ------------------------------------------------------
+
+// rejectPrivateAddress stops connections to addresses inside our network, it runs after the host is resolved so DNS can't be used to get around it
+func rejectPrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return ErrPrivateAddress
+	}
+	return nil
+}
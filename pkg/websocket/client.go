@@ -1,6 +1,22 @@
 package websocket
 
-import "github.com/gorilla/websocket"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// writeWait is how long a write to the connection may take
+	writeWait = 10 * time.Second
+	// maxMessageSize is the largest message a client can send, in bytes
+	maxMessageSize = 8 * 1024
+)
+
+// MessageHandler handles a message a client sent over the connection
+type MessageHandler func(client *Client, message IncomingMessage)
 
 type Client struct {
 	Hub        *Hub
@@ -8,26 +24,97 @@ type Client struct {
 	send       chan Message
 	campaignID string
 	userHandle string
+	onMessage  MessageHandler
+
+	// privileged clients receive the privileged data of events, eventTypes is guarded by the hub's mutex
+	privileged bool
+	eventTypes map[EventType]bool
 }
 
 func NewClient(hub *Hub, conn *websocket.Conn, campaignID, userHandle string) *Client {
 	return &Client{
 		Hub:        hub,
 		conn:       conn,
-		send:       make(chan Message),
+		send:       make(chan Message, hub.sendBufferSize),
 		campaignID: campaignID,
 		userHandle: userHandle,
 	}
 }
 
+// OnMessage sets the handler of the messages the client sends, messages are ignored without one
+func (c *Client) OnMessage(handler MessageHandler) {
+	c.onMessage = handler
+}
+
+// SetPrivileged lets the client receive the privileged data of events, it must be called before the client is registered
+func (c *Client) SetPrivileged(privileged bool) {
+	c.privileged = privileged
+}
+
+// CampaignID returns the campaign the client is connected to
+func (c *Client) CampaignID() string {
+	return c.campaignID
+}
+
+// UserHandle returns the handle of the connected user
+func (c *Client) UserHandle() string {
+	return c.userHandle
+}
+
+// Send queues a message for this client only, it returns false if the client is gone or too slow
+func (c *Client) Send(message Message) bool {
+	return c.Hub.sendToClient(c, message)
+}
+
+// ReadPump reads the messages the client sends until the connection closes, then unregisters the client
+func (c *Client) ReadPump() {
+	defer func() {
+		c.Hub.Unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(c.Hub.pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(c.Hub.pongWait))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message IncomingMessage
+		if err := json.Unmarshal(data, &message); err != nil || message.Type == "" {
+			c.Send(NewErrorMessage("Messages must be JSON with a type"))
+			continue
+		}
+
+		if message.Type == EventTypeSubscribe {
+			c.subscribe(message.Data)
+			continue
+		}
+
+		if c.onMessage != nil {
+			c.onMessage(c, message)
+		}
+	}
+}
+
+// WritePump writes the queued messages to the connection and pings the client, it stops when the hub closes
+// the queue or a write fails
 func (c *Client) WritePump() {
+	ticker := time.NewTicker(c.Hub.pingPeriod)
 	defer func() {
+		ticker.Stop()
 		c.conn.Close()
 	}()
 
 	for {
 		select {
 		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if !ok {
 				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
@@ -36,6 +123,41 @@ func (c *Client) WritePump() {
 			if err := c.conn.WriteJSON(message); err != nil {
 				return
 			}
+
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// subscribe changes the event types the client receives
+func (c *Client) subscribe(data json.RawMessage) {
+	var subscription SubscribeData
+	if err := json.Unmarshal(data, &subscription); err != nil {
+		c.Send(NewErrorMessage("Invalid subscription"))
+		return
+	}
+	for _, eventType := range subscription.Types {
+		if !eventType.IsBroadcast() {
+			c.Send(NewErrorMessage(fmt.Sprintf("Unknown event type %s", eventType)))
+			return
 		}
 	}
+	c.Hub.SetEventTypes(c, subscription.Types)
+}
+
+// view returns the message as the client sees it, ok is false when the client didn't subscribe to its type.
+// It must be called with the hub's lock held
+func (c *Client) view(message Message) (Message, bool) {
+	if c.eventTypes != nil && message.Type.IsBroadcast() && !c.eventTypes[message.Type] {
+		return message, false
+	}
+	if c.privileged && message.PrivilegedData != nil {
+		message.Data = message.PrivilegedData
+	}
+	message.PrivilegedData = nil
+	return message, true
 }
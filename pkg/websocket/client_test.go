@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -44,3 +45,199 @@ func TestNewClient(t *testing.T) {
 		t.Fatalf("failed to dial: %v", err)
 	}
 }
+
+// startTestServer serves connections as clients of campaign1, the handler runs on every message a client sends
+func startTestServer(t *testing.T, hub *Hub, handler MessageHandler) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		client := NewClient(hub, conn, "campaign1", r.URL.Query().Get("user"))
+		client.OnMessage(handler)
+		hub.Register(client)
+
+		go client.WritePump()
+		go client.ReadPump()
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// waitFor polls the condition until it holds or the timeout passes
+func waitFor(t *testing.T, timeout time.Duration, condition func() bool) bool {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return condition()
+}
+
+func TestClientPumps(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	received := make(chan IncomingMessage, 1)
+	url := startTestServer(t, hub, func(client *Client, message IncomingMessage) {
+		received <- message
+		client.Send(Message{Type: EventTypeChatMessage, Data: "reply"})
+	})
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?user=user1", nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	if !waitFor(t, time.Second, func() bool { return hub.ClientCount("campaign1") == 1 }) {
+		t.Fatal("expected the client to be registered")
+	}
+
+	t.Run("broadcast is written to the connection", func(t *testing.T) {
+		hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated, Data: "update"})
+
+		var message Message
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if message.Type != EventTypeCampaignUpdated || message.Data != "update" {
+			t.Errorf("unexpected message %+v", message)
+		}
+	})
+
+	t.Run("client messages reach the handler", func(t *testing.T) {
+		if err := conn.WriteJSON(map[string]interface{}{"type": "chat_message", "data": map[string]string{"content": "hi"}}); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+
+		select {
+		case message := <-received:
+			if message.Type != EventTypeChatMessage {
+				t.Errorf("expected a chat message, got %q", message.Type)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for the handler")
+		}
+
+		var reply Message
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&reply); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if reply.Data != "reply" {
+			t.Errorf("expected the reply, got %+v", reply)
+		}
+	})
+
+	t.Run("invalid messages are rejected", func(t *testing.T) {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("not json")); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+
+		var message Message
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if message.Type != EventTypeError {
+			t.Errorf("expected an error message, got %q", message.Type)
+		}
+	})
+
+	t.Run("subscribe limits the events received", func(t *testing.T) {
+		subscribe := map[string]interface{}{"type": "subscribe", "data": SubscribeData{Types: []EventType{EventTypeCommentCreated}}}
+		if err := conn.WriteJSON(subscribe); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+		// Messages are read in order, the rejected one is answered after the subscription is applied
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("not json")); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+
+		var message Message
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&message); err != nil || message.Type != EventTypeError {
+			t.Fatalf("expected an error message, got %+v (%v)", message, err)
+		}
+
+		hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated, Data: "update"})
+		hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCommentCreated, Data: "comment"})
+
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if message.Type != EventTypeCommentCreated {
+			t.Errorf("expected only the subscribed event, got %q", message.Type)
+		}
+	})
+
+	t.Run("unknown event types are rejected", func(t *testing.T) {
+		subscribe := map[string]interface{}{"type": "subscribe", "data": map[string][]string{"types": {"everything"}}}
+		if err := conn.WriteJSON(subscribe); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+
+		var message Message
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if message.Type != EventTypeError {
+			t.Errorf("expected an error message, got %q", message.Type)
+		}
+	})
+
+	t.Run("closing the connection unregisters the client", func(t *testing.T) {
+		conn.Close()
+
+		if !waitFor(t, time.Second, func() bool { return hub.ClientCount("campaign1") == 0 }) {
+			t.Error("expected the client to be unregistered")
+		}
+	})
+}
+
+func TestHeartbeat(t *testing.T) {
+	hub := NewHub(WithHeartbeat(20*time.Millisecond, 100*time.Millisecond))
+	defer hub.Close()
+
+	url := startTestServer(t, hub, nil)
+
+	// Reading answers the pings, the connection that never reads doesn't
+	alive, _, err := websocket.DefaultDialer.Dial(url+"?user=alive", nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer alive.Close()
+	go func() {
+		for {
+			if _, _, err := alive.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	dead, _, err := websocket.DefaultDialer.Dial(url+"?user=dead", nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer dead.Close()
+
+	if !waitFor(t, time.Second, func() bool { return hub.ClientCount("campaign1") == 2 }) {
+		t.Fatal("expected both clients to be registered")
+	}
+	if !waitFor(t, 2*time.Second, func() bool { return hub.ClientCount("campaign1") == 1 }) {
+		t.Fatal("expected the client that doesn't answer pings to be dropped")
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	if hub.ClientCount("campaign1") != 1 {
+		t.Error("expected the client that answers pings to stay connected")
+	}
+}
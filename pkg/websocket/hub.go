@@ -2,118 +2,325 @@ package websocket
 
 import (
 	"sync"
+	"time"
 )
 
+const (
+	// defaultPongWait is how long a client has to answer a ping before the connection is considered dead
+	defaultPongWait = 60 * time.Second
+	// defaultPingPeriod is how often clients are pinged, it must be shorter than the pong wait
+	defaultPingPeriod = (defaultPongWait * 9) / 10
+	// defaultSendBufferSize is how many messages can wait for a client before it is too slow
+	defaultSendBufferSize = 32
+)
+
+// SlowClientPolicy decides what happens when a client's queue is full
+type SlowClientPolicy int
+
+const (
+	// DisconnectSlowClient drops the client, it reconnects and fetches what it missed
+	DisconnectSlowClient SlowClientPolicy = iota
+	// DropOldestMessage discards the oldest queued message to make room, the client stays connected
+	DropOldestMessage
+)
+
+// HubOption configures a Hub
+type HubOption func(*Hub)
+
+// WithSendBufferSize sets how many messages can be queued for each client
+func WithSendBufferSize(size int) HubOption {
+	return func(h *Hub) {
+		if size > 0 {
+			h.sendBufferSize = size
+		}
+	}
+}
+
+// WithSlowClientPolicy sets what happens when a client's queue is full
+func WithSlowClientPolicy(policy SlowClientPolicy) HubOption {
+	return func(h *Hub) {
+		h.slowClientPolicy = policy
+	}
+}
+
+// WithHeartbeat sets how often clients are pinged and how long they have to answer
+func WithHeartbeat(pingPeriod, pongWait time.Duration) HubOption {
+	return func(h *Hub) {
+		if pingPeriod > 0 && pongWait > pingPeriod {
+			h.pingPeriod = pingPeriod
+			h.pongWait = pongWait
+		}
+	}
+}
+
+// Hub keeps the connected clients of each campaign. Messages are queued on each client without blocking,
+// a client whose queue is full is handled by the slow client policy so it can't hold up the others.
+// Broadcasts carry the number the event bus gave them in their campaign, the latest are kept so reconnecting clients can catch up
 type Hub struct {
-	clients    map[string]map[*Client]bool
-	broadcast  chan Message
-	register   chan *Client
-	unregister chan *Client
-	mutex      sync.RWMutex
+	clients   map[string]map[*Client]bool
+	logs      map[string]*eventLog
+	lastSweep time.Time
+	closed    bool
+	mutex     sync.RWMutex
+
+	sendBufferSize   int
+	slowClientPolicy SlowClientPolicy
+	pingPeriod       time.Duration
+	pongWait         time.Duration
+	replayLogSize    int
+	replayRetention  time.Duration
 }
 
-func NewHub() *Hub {
-	return &Hub{
-		clients:    make(map[string]map[*Client]bool),
-		broadcast:  make(chan Message),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
+func NewHub(options ...HubOption) *Hub {
+	hub := &Hub{
+		clients:          make(map[string]map[*Client]bool),
+		logs:             make(map[string]*eventLog),
+		lastSweep:        time.Now(),
+		sendBufferSize:   defaultSendBufferSize,
+		slowClientPolicy: DisconnectSlowClient,
+		pingPeriod:       defaultPingPeriod,
+		pongWait:         defaultPongWait,
+		replayLogSize:    defaultReplayLogSize,
+		replayRetention:  defaultReplayRetention,
+	}
+	for _, option := range options {
+		option(hub)
 	}
+	return hub
 }
+
+// Register adds the client to its campaign, a client registered after the hub closed is closed straight away
 func (h *Hub) Register(client *Client) {
-	h.register <- client
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.addClient(client)
 }
 
-func (h *Hub) Broadcast(message Message) {
-	h.broadcast <- message
+// RegisterSince adds a reconnecting client to its campaign and queues the broadcasts it missed after seq.
+// The client is told to resync instead when the missed broadcasts are no longer kept or don't fit its queue
+func (h *Hub) RegisterSince(client *Client, seq uint64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if !h.addClient(client) {
+		return
+	}
+
+	log, ok := h.logs[client.campaignID]
+	if !ok {
+		// Nothing was broadcast since the hub started
+		if seq > 0 {
+			h.enqueue(client, NewResyncMessage(0))
+		}
+		return
+	}
+
+	log.prune(time.Now().Add(-h.replayRetention))
+	missed, ok := log.since(seq)
+	if !ok || len(missed) > h.sendBufferSize {
+		h.enqueue(client, NewResyncMessage(log.lastSeq))
+		return
+	}
+	for _, message := range missed {
+		if message, ok := client.view(message); ok {
+			h.enqueue(client, message)
+		}
+	}
 }
 
-// Add new method to broadcast to specific campaign
-// func (h *Hub) BroadcastToCampaign(campaignID string, message Message) {
-// 	h.mutex.RLock()
-// 	if clients, ok := h.clients[campaignID]; ok {
-// 		for client := range clients {
-// 			select {
-// 			case client.send <- message:
-// 			default:
-// 				close(client.send)
-// 				delete(clients, client)
-// 			}
-// 		}
-// 	}
-// 	h.mutex.RUnlock()
-// }
+// Unregister removes the client from its campaign and closes its send channel, it can be called more than once
+func (h *Hub) Unregister(client *Client) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.removeClient(client)
+}
 
+// BroadcastToCampaign keeps a numbered message for reconnecting clients and queues it for every client of the campaign.
+// The hub doesn't number messages itself, a message without a number is delivered but can't be replayed
 func (h *Hub) BroadcastToCampaign(campaignID string, message Message) {
+	h.mutex.Lock()
+	if message.Seq > 0 {
+		h.record(campaignID, message)
+	}
+	slow := h.enqueueMatching(campaignID, message, func(*Client) bool { return true })
+	h.mutex.Unlock()
+
+	h.disconnect(slow)
+}
+
+// SendToCampaign queues a transient message, such as a typing indicator, for every client of the campaign.
+// It isn't numbered or kept, so reconnecting clients don't get it
+func (h *Hub) SendToCampaign(campaignID string, message Message) {
 	h.mutex.RLock()
-	defer h.mutex.RUnlock()
+	slow := h.enqueueMatching(campaignID, message, func(*Client) bool { return true })
+	h.mutex.RUnlock()
 
-	if clients, ok := h.clients[campaignID]; ok {
-		for client := range clients {
-			select {
-			case client.send <- message:
-			default:
-				h.mutex.RUnlock()
-				h.unregister <- client
-				h.mutex.RLock()
-			}
-		}
+	h.disconnect(slow)
+}
+
+// SendToUser queues a message for every connection the user has open on the campaign, it isn't numbered or kept
+func (h *Hub) SendToUser(campaignID, userHandle string, message Message) {
+	h.mutex.RLock()
+	slow := h.enqueueMatching(campaignID, message, func(client *Client) bool { return client.userHandle == userHandle })
+	h.mutex.RUnlock()
+
+	h.disconnect(slow)
+}
+
+// SetEventTypes limits the events the client receives to types, no types sends every event
+func (h *Hub) SetEventTypes(client *Client, types []EventType) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	client.eventTypes = nil
+	if len(types) == 0 {
+		return
+	}
+	client.eventTypes = make(map[EventType]bool, len(types))
+	for _, eventType := range types {
+		client.eventTypes[eventType] = true
 	}
 }
 
-// Modify the Run method to handle campaign-specific broadcasts
-func (h *Hub) Run() {
-	for {
-		select {
-		case client := <-h.register:
-			if client == nil {
-				continue
-			}
-
-			h.mutex.Lock()
-			if _, ok := h.clients[client.campaignID]; !ok {
-				h.clients[client.campaignID] = make(map[*Client]bool)
-			}
-			h.clients[client.campaignID][client] = true
-			h.mutex.Unlock()
-
-		case client := <-h.unregister:
-
-			if client == nil {
-				continue
-			}
-			h.mutex.Lock()
-			if _, ok := h.clients[client.campaignID]; ok {
-				delete(h.clients[client.campaignID], client)
-				close(client.send)
-				if len(h.clients[client.campaignID]) == 0 {
-					delete(h.clients, client.campaignID)
-				}
-			}
-			h.mutex.Unlock()
-
-		case message := <-h.broadcast:
-			// Remove the old broadcast code and use BroadcastToCampaign instead
-			if campaignID, ok := message.Data.(string); ok {
-				h.BroadcastToCampaign(campaignID, message)
-			}
-		}
+// LatestSeq returns the highest number broadcast to the campaign
+func (h *Hub) LatestSeq(campaignID string) uint64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	if log, ok := h.logs[campaignID]; ok {
+		return log.lastSeq
 	}
+	return 0
 }
 
+// ClientCount returns the number of clients connected to the campaign
+func (h *Hub) ClientCount(campaignID string) int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return len(h.clients[campaignID])
+}
+
+// Close disconnects every client, the hub accepts no clients afterwards
 func (h *Hub) Close() {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
-	for campaignID, clients := range h.clients {
+	h.closed = true
+	for _, clients := range h.clients {
 		for client := range clients {
-			close(client.send)
-			delete(clients, client)
+			h.removeClient(client)
+		}
+	}
+}
+
+// Helper functions ------------------------------------------------------
+
+// addClient adds a client to its campaign, it must be called with the write lock held
+func (h *Hub) addClient(client *Client) bool {
+	if h.closed {
+		close(client.send)
+		return false
+	}
+	if _, ok := h.clients[client.campaignID]; !ok {
+		h.clients[client.campaignID] = make(map[*Client]bool)
+	}
+	h.clients[client.campaignID][client] = true
+	return true
+}
+
+// record keeps a broadcast in the campaign's replay log, expired broadcasts of every campaign
+// are dropped once per retention period. It must be called with the write lock held
+func (h *Hub) record(campaignID string, message Message) {
+	now := time.Now()
+	if now.Sub(h.lastSweep) > h.replayRetention {
+		for _, log := range h.logs {
+			log.prune(now.Add(-h.replayRetention))
+		}
+		h.lastSweep = now
+	}
+
+	log, ok := h.logs[campaignID]
+	if !ok {
+		log = &eventLog{}
+		h.logs[campaignID] = log
+	}
+	log.add(message, h.replayLogSize, now)
+}
+
+// enqueueMatching queues a message for the clients of the campaign that match and returns the clients that were too slow
+// for the slow client policy to disconnect, it must be called with the lock held
+func (h *Hub) enqueueMatching(campaignID string, message Message, match func(*Client) bool) []*Client {
+	var slow []*Client
+	for client := range h.clients[campaignID] {
+		if !match(client) {
+			continue
+		}
+		view, ok := client.view(message)
+		if ok && !h.enqueue(client, view) && h.slowClientPolicy == DisconnectSlowClient {
+			slow = append(slow, client)
 		}
-		delete(h.clients, campaignID)
 	}
+	return slow
+}
 
-	close(h.broadcast)
-	close(h.register)
-	close(h.unregister)
+// disconnect unregisters slow clients once the lock is released so the other clients aren't held up
+func (h *Hub) disconnect(clients []*Client) {
+	for _, client := range clients {
+		h.Unregister(client)
+	}
+}
+
+// sendToClient queues a message for a registered client, it returns false if the client is gone or too slow
+func (h *Hub) sendToClient(client *Client, message Message) bool {
+	h.mutex.RLock()
+	if !h.clients[client.campaignID][client] {
+		h.mutex.RUnlock()
+		return false
+	}
+	queued := h.enqueue(client, message)
+	h.mutex.RUnlock()
+
+	if !queued && h.slowClientPolicy == DisconnectSlowClient {
+		h.Unregister(client)
+	}
+	return queued
+}
+
+// enqueue queues a message without blocking, it must be called with the lock held so the queue isn't closed meanwhile
+func (h *Hub) enqueue(client *Client, message Message) bool {
+	select {
+	case client.send <- message:
+		return true
+	default:
+	}
+
+	if h.slowClientPolicy != DropOldestMessage {
+		return false
+	}
+	select {
+	case <-client.send:
+	default:
+	}
+	select {
+	case client.send <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+// removeClient removes a registered client and closes its queue, it must be called with the write lock held
+func (h *Hub) removeClient(client *Client) {
+	if !h.clients[client.campaignID][client] {
+		return
+	}
+
+	delete(h.clients[client.campaignID], client)
+	close(client.send)
+	if len(h.clients[client.campaignID]) == 0 {
+		delete(h.clients, client.campaignID)
+	}
 }
@@ -1,153 +1,370 @@
 package websocket
 
 import (
+	"fmt"
+	"sync"
 	"testing"
-	"time"
 )
 
+func newTestClient(hub *Hub, campaignID, userHandle string) *Client {
+	return &Client{
+		Hub:        hub,
+		send:       make(chan Message, hub.sendBufferSize),
+		campaignID: campaignID,
+		userHandle: userHandle,
+	}
+}
+
+// isClosed checks if the client's queue was closed after draining the queued messages
+func isClosed(client *Client) bool {
+	for {
+		select {
+		case _, ok := <-client.send:
+			if !ok {
+				return true
+			}
+		default:
+			return false
+		}
+	}
+}
+
 func TestNewHub(t *testing.T) {
 	hub := NewHub()
 	if hub.clients == nil {
 		t.Error("expected clients map to be initialized")
 	}
-	if hub.broadcast == nil {
-		t.Error("expected broadcast channel to be initialized")
+	if hub.sendBufferSize != defaultSendBufferSize {
+		t.Errorf("expected send buffer size %d, got %d", defaultSendBufferSize, hub.sendBufferSize)
 	}
-	if hub.register == nil {
-		t.Error("expected register channel to be initialized")
+	if hub.slowClientPolicy != DisconnectSlowClient {
+		t.Error("expected slow clients to be disconnected by default")
 	}
-	if hub.unregister == nil {
-		t.Error("expected unregister channel to be initialized")
+
+	hub = NewHub(WithSendBufferSize(4), WithSlowClientPolicy(DropOldestMessage), WithHeartbeat(defaultPongWait, defaultPingPeriod))
+	if hub.sendBufferSize != 4 {
+		t.Errorf("expected send buffer size 4, got %d", hub.sendBufferSize)
+	}
+	if hub.slowClientPolicy != DropOldestMessage {
+		t.Error("expected the oldest message to be dropped")
+	}
+	if hub.pingPeriod != defaultPingPeriod {
+		t.Error("expected a ping period longer than the pong wait to be ignored")
 	}
 }
 
 func TestHubRegisterAndUnregister(t *testing.T) {
 	hub := NewHub()
-	go hub.Run()
 	defer hub.Close()
 
-	// Create a test client
-	client := &Client{
-		Hub:        hub,
-		send:       make(chan Message),
-		campaignID: "test-campaign",
-	}
+	client := newTestClient(hub, "test-campaign", "user1")
 
-	// Test registration
 	hub.Register(client)
-	time.Sleep(100 * time.Millisecond) // Give time for the registration to process
-
-	hub.mutex.RLock()
-	if _, ok := hub.clients[client.campaignID]; !ok {
+	if hub.ClientCount("test-campaign") != 1 {
 		t.Error("expected client to be registered")
 	}
-	hub.mutex.RUnlock()
 
-	// Test unregistration
-	hub.unregister <- client
-	time.Sleep(100 * time.Millisecond) // Give time for the unregistration to process
+	hub.Unregister(client)
+	if hub.ClientCount("test-campaign") != 0 {
+		t.Error("expected client to be unregistered")
+	}
+	if !isClosed(client) {
+		t.Error("expected the client queue to be closed")
+	}
 
-	hub.mutex.RLock()
-	if _, ok := hub.clients[client.campaignID]; ok {
-		if len(hub.clients[client.campaignID]) > 0 {
-			t.Error("expected client to be unregistered")
+	// The read pump and a failed send can both unregister a client
+	hub.Unregister(client)
+}
+
+func TestBroadcastToCampaign(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	client := newTestClient(hub, "campaign1", "user1")
+	other := newTestClient(hub, "campaign2", "user2")
+	hub.Register(client)
+	hub.Register(other)
+
+	hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeActivityCreated, Data: "test message"})
+
+	select {
+	case received := <-client.send:
+		if received.Type != EventTypeActivityCreated {
+			t.Errorf("expected message type %q, got %q", EventTypeActivityCreated, received.Type)
+		}
+		if received.Data != "test message" {
+			t.Errorf("expected message data %q, got %v", "test message", received.Data)
 		}
+	default:
+		t.Fatal("expected the message to be queued")
+	}
+
+	select {
+	case <-other.send:
+		t.Error("expected clients of other campaigns not to receive the message")
+	default:
 	}
-	hub.mutex.RUnlock()
 }
 
-func TestBroadcastToCampaign(t *testing.T) {
+func TestSendToCampaign(t *testing.T) {
 	hub := NewHub()
-	go hub.Run()
 	defer hub.Close()
 
-	campaignID := "test-campaign"
-	messageType := EventTypeActivityCreated
-	messageData := "test message"
+	first := newTestClient(hub, "campaign1", "user1")
+	second := newTestClient(hub, "campaign1", "user2")
+	other := newTestClient(hub, "campaign2", "user1")
+	hub.Register(first)
+	hub.Register(second)
+	hub.Register(other)
 
-	testMessage := Message{
-		Type: messageType,
-		Data: messageData,
+	hub.SendToCampaign("campaign1", Message{Type: EventTypeChatTyping})
+
+	if len(first.send) != 1 || len(second.send) != 1 {
+		t.Fatal("expected every client of the campaign to receive the message")
+	}
+	if len(other.send) != 0 {
+		t.Error("expected clients of other campaigns not to receive the message")
+	}
+	if message := <-first.send; message.Seq != 0 {
+		t.Errorf("expected transient messages not to be numbered, got seq %d", message.Seq)
+	}
+	if seq := hub.LatestSeq("campaign1"); seq != 0 {
+		t.Errorf("expected transient messages not to be kept, got latest seq %d", seq)
 	}
 
-	client := &Client{
-		Hub:        hub,
-		send:       make(chan Message, 1), // Add buffer to prevent blocking
-		campaignID: campaignID,
+	// A reconnecting client isn't sent transient messages it missed
+	late := newTestClient(hub, "campaign1", "user3")
+	hub.RegisterSince(late, 0)
+	if len(late.send) != 0 {
+		t.Error("expected transient messages not to be replayed")
+	}
+}
+
+func TestSendToUser(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	first := newTestClient(hub, "campaign1", "user1")
+	second := newTestClient(hub, "campaign1", "user1")
+	other := newTestClient(hub, "campaign1", "user2")
+	hub.Register(first)
+	hub.Register(second)
+	hub.Register(other)
+
+	hub.SendToUser("campaign1", "user1", NewErrorMessage("test"))
+
+	if len(first.send) != 1 || len(second.send) != 1 {
+		t.Error("expected every connection of the user to receive the message")
 	}
+	if len(other.send) != 0 {
+		t.Error("expected other users not to receive the message")
+	}
+}
+
+func TestSlowClientIsDisconnected(t *testing.T) {
+	hub := NewHub(WithSendBufferSize(1))
+	defer hub.Close()
+
+	slow := newTestClient(hub, "campaign1", "slow")
+	fast := newTestClient(hub, "campaign1", "fast")
+	hub.Register(slow)
+	hub.Register(fast)
 
-	// Register client and wait for registration to complete
+	hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated, Data: 1})
+	<-fast.send
+	hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated, Data: 2})
+
+	if hub.ClientCount("campaign1") != 1 {
+		t.Fatalf("expected only the slow client to be disconnected, %d clients left", hub.ClientCount("campaign1"))
+	}
+	if !isClosed(slow) {
+		t.Error("expected the slow client queue to be closed")
+	}
+	if received := <-fast.send; received.Data != 2 {
+		t.Errorf("expected the fast client to receive the second message, got %v", received.Data)
+	}
+	if slow.Send(Message{Type: EventTypeCampaignUpdated}) {
+		t.Error("expected sending to a disconnected client to fail")
+	}
+}
+
+func TestDropOldestMessage(t *testing.T) {
+	hub := NewHub(WithSendBufferSize(2), WithSlowClientPolicy(DropOldestMessage))
+	defer hub.Close()
+
+	client := newTestClient(hub, "campaign1", "user1")
 	hub.Register(client)
-	time.Sleep(100 * time.Millisecond)
 
-	// Broadcast in a separate goroutine
-	go hub.BroadcastToCampaign(campaignID, testMessage)
+	for i := 1; i <= 3; i++ {
+		hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated, Data: i})
+	}
 
-	select {
-	case receivedMsg := <-client.send:
-		if receivedMsg.Type != messageType {
-			t.Errorf("expected message type %q, got %q", messageType, receivedMsg.Type)
-		}
-		if receivedMsg.Data != messageData {
-			t.Errorf("expected message data %q, got %v", messageData, receivedMsg.Data)
+	if hub.ClientCount("campaign1") != 1 {
+		t.Fatal("expected the client to stay connected")
+	}
+	for _, expected := range []int{2, 3} {
+		if received := <-client.send; received.Data != expected {
+			t.Errorf("expected message %d, got %v", expected, received.Data)
 		}
-	case <-time.After(2 * time.Second):
-		t.Fatal("timeout waiting for broadcast message")
 	}
 }
 
-func TestClose(t *testing.T) {
-	hub := NewHub()
-	go hub.Run()
+func TestConcurrentBroadcast(t *testing.T) {
+	hub := NewHub(WithSendBufferSize(4))
+	defer hub.Close()
 
-	// Register some test clients
-	client1 := &Client{
-		Hub:        hub,
-		send:       make(chan Message),
-		campaignID: "campaign1",
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+			client := newTestClient(hub, "campaign1", fmt.Sprintf("user%d", i))
+			hub.Register(client)
+			for range 20 {
+				client.Send(Message{Type: EventTypeChatTyping})
+			}
+			hub.Unregister(client)
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated})
+				hub.SendToUser("campaign1", "user1", Message{Type: EventTypeChatTyping})
+			}
+		}()
 	}
-	client2 := &Client{
-		Hub:        hub,
-		send:       make(chan Message),
-		campaignID: "campaign2",
+	wg.Wait()
+
+	if hub.ClientCount("campaign1") != 0 {
+		t.Error("expected every client to be unregistered")
 	}
+}
+
+func TestClose(t *testing.T) {
+	hub := NewHub()
 
+	client1 := newTestClient(hub, "campaign1", "user1")
+	client2 := newTestClient(hub, "campaign2", "user2")
 	hub.Register(client1)
 	hub.Register(client2)
-	time.Sleep(100 * time.Millisecond)
 
-	// Test closing
 	hub.Close()
 
-	// Verify channels are closed
-	select {
-	case _, ok := <-hub.broadcast:
-		if ok {
-			t.Error("broadcast channel should be closed")
-		}
-	default:
+	if !isClosed(client1) || !isClosed(client2) {
+		t.Error("expected every client queue to be closed")
+	}
+	hub.mutex.RLock()
+	if len(hub.clients) != 0 {
+		t.Error("clients map should be empty")
 	}
+	hub.mutex.RUnlock()
 
-	select {
-	case _, ok := <-hub.register:
-		if ok {
-			t.Error("register channel should be closed")
-		}
-	default:
+	// Clients connecting or disconnecting while the server shuts down must not panic
+	late := newTestClient(hub, "campaign1", "user3")
+	hub.Register(late)
+	if !isClosed(late) {
+		t.Error("expected a client registered after close to be closed")
 	}
+	hub.Unregister(client1)
+	hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated})
+}
 
-	select {
-	case _, ok := <-hub.unregister:
-		if ok {
-			t.Error("unregister channel should be closed")
+func TestStreamClient(t *testing.T) {
+	hub := NewHub()
+
+	client := NewStreamClient(hub, "campaign1", "user1")
+	hub.Register(client)
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 1, Type: EventTypeCampaignUpdated})
+
+	if received := <-client.Messages(); received.Seq != 1 {
+		t.Errorf("expected the stream to receive the broadcast, got seq %d", received.Seq)
+	}
+
+	hub.Close()
+	if _, ok := <-client.Messages(); ok {
+		t.Error("expected the stream to end when the hub closes")
+	}
+}
+
+func TestPrivilegedData(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	member := newTestClient(hub, "campaign1", "member")
+	owner := newTestClient(hub, "campaign1", "owner")
+	owner.SetPrivileged(true)
+	hub.Register(member)
+	hub.Register(owner)
+
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 1, Type: EventTypeContributorUpdated, Data: "status", PrivilegedData: "payment"})
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 2, Type: EventTypeCommentCreated, Data: "comment"})
+
+	for _, expected := range []string{"status", "comment"} {
+		if received := <-member.send; received.Data != expected || received.PrivilegedData != nil {
+			t.Errorf("expected the member to receive %q only, got %+v", expected, received)
+		}
+	}
+	for _, expected := range []string{"payment", "comment"} {
+		if received := <-owner.send; received.Data != expected || received.PrivilegedData != nil {
+			t.Errorf("expected the owner to receive %q, got %+v", expected, received)
 		}
-	default:
 	}
 
-	// Verify clients map is empty
-	hub.mutex.RLock()
-	if len(hub.clients) != 0 {
-		t.Error("clients map should be empty")
+	// Replayed events are redacted the same way
+	late := newTestClient(hub, "campaign1", "late")
+	hub.RegisterSince(late, 0)
+	if received := <-late.send; received.Data != "status" {
+		t.Errorf("expected the replayed event to be redacted, got %v", received.Data)
+	}
+}
+
+func TestEventTypeFilter(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	client := newTestClient(hub, "campaign1", "user1")
+	hub.SetEventTypes(client, []EventType{EventTypeCommentCreated, EventTypeCommentUpdated})
+	hub.Register(client)
+
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 1, Type: EventTypeCampaignUpdated})
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 2, Type: EventTypeCommentCreated})
+	hub.SendToUser("campaign1", "user1", NewErrorMessage("test"))
+
+	messages := drain(client)
+	if len(messages) != 2 || messages[0].Type != EventTypeCommentCreated || messages[1].Type != EventTypeError {
+		t.Fatalf("expected the subscribed event and the error, got %+v", messages)
+	}
+
+	// Replays skip the events the client didn't subscribe to
+	resumed := newTestClient(hub, "campaign1", "user2")
+	hub.SetEventTypes(resumed, []EventType{EventTypeCampaignUpdated})
+	hub.RegisterSince(resumed, 0)
+	if messages := drain(resumed); len(messages) != 1 || messages[0].Seq != 1 {
+		t.Errorf("expected only the campaign update to be replayed, got %+v", messages)
+	}
+
+	hub.SetEventTypes(client, nil)
+	hub.BroadcastToCampaign("campaign1", Message{Seq: 3, Type: EventTypeCampaignUpdated})
+	if messages := drain(client); len(messages) != 1 {
+		t.Error("expected an empty subscription to receive every event")
+	}
+}
+
+func TestParseEventTypes(t *testing.T) {
+	types, err := ParseEventTypes("comment_created, poll_updated,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(types) != 2 || types[0] != EventTypeCommentCreated || types[1] != EventTypePollUpdated {
+		t.Errorf("unexpected types %v", types)
+	}
+
+	if types, err := ParseEventTypes(""); err != nil || types != nil {
+		t.Errorf("expected no types, got %v (%v)", types, err)
+	}
+	if _, err := ParseEventTypes("comment_created,error"); err == nil {
+		t.Error("expected types clients can't subscribe to to be rejected")
 	}
-	hub.mutex.RUnlock()
 }
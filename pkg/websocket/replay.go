@@ -0,0 +1,96 @@
+package websocket
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// defaultReplayLogSize is how many broadcasts of each campaign are kept for reconnecting clients
+	defaultReplayLogSize = 256
+	// defaultReplayRetention is how long a broadcast is kept for reconnecting clients
+	defaultReplayRetention = time.Hour
+)
+
+// WithReplayLog sets how many broadcasts of each campaign are kept and for how long
+func WithReplayLog(size int, retention time.Duration) HubOption {
+	return func(h *Hub) {
+		if size > 0 && retention > 0 {
+			h.replayLogSize = size
+			h.replayRetention = retention
+		}
+	}
+}
+
+// ResyncData tells a reconnecting client that it missed too much to catch up and must reload the campaign
+type ResyncData struct {
+	LatestSeq uint64 `json:"latestSeq"`
+}
+
+// NewResyncMessage returns the message sent to a client that can't be caught up from the replay log
+func NewResyncMessage(latestSeq uint64) Message {
+	return Message{Type: EventTypeResyncRequired, Data: ResyncData{LatestSeq: latestSeq}}
+}
+
+// loggedEvent is a broadcast kept in the replay log
+type loggedEvent struct {
+	message Message
+	at      time.Time
+}
+
+// eventLog keeps the latest broadcasts of a campaign ordered by their sequence number.
+// The highest number is kept when the events expire so a client can tell it missed them
+type eventLog struct {
+	lastSeq uint64
+	events  []loggedEvent
+}
+
+// add keeps a numbered message, the lowest numbered event is dropped when the log is full.
+// Messages arriving out of order are put back in place and a number already kept is ignored
+func (l *eventLog) add(message Message, size int, now time.Time) {
+	i := len(l.events)
+	for i > 0 && l.events[i-1].message.Seq >= message.Seq {
+		if l.events[i-1].message.Seq == message.Seq {
+			return
+		}
+		i--
+	}
+	l.events = slices.Insert(l.events, i, loggedEvent{message: message, at: now})
+	l.lastSeq = max(l.lastSeq, message.Seq)
+
+	if len(l.events) > size {
+		l.events = append(l.events[:0], l.events[len(l.events)-size:]...)
+	}
+}
+
+// prune drops the events kept since before the cutoff
+func (l *eventLog) prune(cutoff time.Time) {
+	expired := 0
+	for expired < len(l.events) && l.events[expired].at.Before(cutoff) {
+		expired++
+	}
+	if expired > 0 {
+		l.events = append(l.events[:0], l.events[expired:]...)
+	}
+}
+
+// since returns the events after seq, ok is false when some of them are no longer kept, never reached this instance
+// or seq is ahead of the log, for example after a restart
+func (l *eventLog) since(seq uint64) (missed []Message, ok bool) {
+	if seq > l.lastSeq {
+		return nil, false
+	}
+
+	next := seq + 1
+	for _, event := range l.events {
+		if event.message.Seq < next {
+			continue
+		}
+		if event.message.Seq != next {
+			return nil, false
+		}
+		missed = append(missed, event.message)
+		next++
+	}
+	return missed, next-1 == l.lastSeq
+}
@@ -0,0 +1,201 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+)
+
+// drain returns the messages queued for the client
+func drain(client *Client) []Message {
+	var messages []Message
+	for {
+		select {
+		case message := <-client.send:
+			messages = append(messages, message)
+		default:
+			return messages
+		}
+	}
+}
+
+// broadcast sends count messages numbered after the latest one, like the event bus does
+func broadcast(hub *Hub, campaignID string, count int) {
+	latest := hub.LatestSeq(campaignID)
+	for i := 1; i <= count; i++ {
+		hub.BroadcastToCampaign(campaignID, Message{Seq: latest + uint64(i), Type: EventTypeCampaignUpdated, Data: i})
+	}
+}
+
+func TestBroadcastSequence(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	client := newTestClient(hub, "campaign1", "user1")
+	hub.Register(client)
+
+	broadcast(hub, "campaign1", 3)
+	broadcast(hub, "campaign2", 1)
+	hub.SendToUser("campaign1", "user1", NewErrorMessage("test"))
+
+	messages := drain(client)
+	if len(messages) != 4 {
+		t.Fatalf("expected 4 messages, got %d", len(messages))
+	}
+	for i, expected := range []uint64{1, 2, 3, 0} {
+		if messages[i].Seq != expected {
+			t.Errorf("expected message %d to have seq %d, got %d", i, expected, messages[i].Seq)
+		}
+	}
+	if hub.LatestSeq("campaign1") != 3 || hub.LatestSeq("campaign2") != 1 {
+		t.Error("expected each campaign to be numbered on its own")
+	}
+
+	// Messages without a number are delivered but not kept
+	hub.BroadcastToCampaign("campaign1", Message{Type: EventTypeCampaignUpdated})
+	if messages := drain(client); len(messages) != 1 || messages[0].Seq != 0 {
+		t.Errorf("expected the unnumbered message to be delivered as is, got %+v", messages)
+	}
+	if hub.LatestSeq("campaign1") != 3 {
+		t.Error("expected the unnumbered message not to be kept")
+	}
+}
+
+func TestRegisterSince(t *testing.T) {
+	hub := NewHub()
+	defer hub.Close()
+
+	broadcast(hub, "campaign1", 5)
+
+	client := newTestClient(hub, "campaign1", "user1")
+	hub.RegisterSince(client, 3)
+	broadcast(hub, "campaign1", 1)
+
+	messages := drain(client)
+	if len(messages) != 3 {
+		t.Fatalf("expected the 2 missed messages and the new one, got %d", len(messages))
+	}
+	for i, expected := range []uint64{4, 5, 6} {
+		if messages[i].Seq != expected {
+			t.Errorf("expected message %d to have seq %d, got %d", i, expected, messages[i].Seq)
+		}
+	}
+
+	upToDate := newTestClient(hub, "campaign1", "user2")
+	hub.RegisterSince(upToDate, 6)
+	if messages := drain(upToDate); len(messages) != 0 {
+		t.Errorf("expected an up to date client to receive nothing, got %d messages", len(messages))
+	}
+}
+
+func TestRegisterSinceResync(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   []HubOption
+		broadcast int
+		since     uint64
+		latestSeq uint64
+	}{
+		{name: "gap too large", options: []HubOption{WithReplayLog(3, time.Hour)}, broadcast: 10, since: 2, latestSeq: 10},
+		{name: "more than the queue holds", options: []HubOption{WithSendBufferSize(2)}, broadcast: 5, since: 1, latestSeq: 5},
+		{name: "ahead of the log", broadcast: 2, since: 7, latestSeq: 2},
+		{name: "nothing broadcast", since: 7, latestSeq: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := NewHub(tt.options...)
+			defer hub.Close()
+
+			broadcast(hub, "campaign1", tt.broadcast)
+
+			client := newTestClient(hub, "campaign1", "user1")
+			hub.RegisterSince(client, tt.since)
+
+			messages := drain(client)
+			if len(messages) != 1 || messages[0].Type != EventTypeResyncRequired {
+				t.Fatalf("expected a resync message, got %v", messages)
+			}
+			if data := messages[0].Data.(ResyncData); data.LatestSeq != tt.latestSeq {
+				t.Errorf("expected latest seq %d, got %d", tt.latestSeq, data.LatestSeq)
+			}
+			if hub.ClientCount("campaign1") != 1 {
+				t.Error("expected the client to stay connected")
+			}
+		})
+	}
+}
+
+func TestEventLog(t *testing.T) {
+	t.Run("keeps the latest events", func(t *testing.T) {
+		log := &eventLog{}
+		now := time.Now()
+		for seq := range uint64(5) {
+			log.add(Message{Seq: seq + 1, Type: EventTypeCampaignUpdated}, 3, now)
+		}
+
+		if len(log.events) != 3 || log.events[0].message.Seq != 3 {
+			t.Fatalf("expected events 3 to 5 to be kept, got %d events", len(log.events))
+		}
+		if _, ok := log.since(1); ok {
+			t.Error("expected dropped events not to be replayed")
+		}
+		if missed, ok := log.since(2); !ok || len(missed) != 3 {
+			t.Errorf("expected the 3 kept events to be replayed, got %d", len(missed))
+		}
+	})
+
+	t.Run("prunes expired events", func(t *testing.T) {
+		log := &eventLog{}
+		now := time.Now()
+		log.add(Message{Seq: 1, Type: EventTypeCampaignUpdated}, 10, now.Add(-2*time.Hour))
+		log.add(Message{Seq: 2, Type: EventTypeCampaignUpdated}, 10, now)
+
+		log.prune(now.Add(-time.Hour))
+
+		if len(log.events) != 1 || log.events[0].message.Seq != 2 {
+			t.Fatal("expected only the expired event to be dropped")
+		}
+		if _, ok := log.since(0); ok {
+			t.Error("expected expired events not to be replayed")
+		}
+	})
+
+	t.Run("keeps the numbers events were published with", func(t *testing.T) {
+		log := &eventLog{}
+		now := time.Now()
+		for _, seq := range []uint64{4, 6, 5, 6} {
+			log.add(Message{Seq: seq, Type: EventTypeCampaignUpdated}, 10, now)
+		}
+
+		if log.lastSeq != 6 || len(log.events) != 3 {
+			t.Fatalf("expected 3 events up to seq 6, got %d up to %d", len(log.events), log.lastSeq)
+		}
+		missed, ok := log.since(3)
+		if !ok || len(missed) != 3 {
+			t.Fatalf("expected events 4 to 6 to be replayed, got %d", len(missed))
+		}
+		for i, expected := range []uint64{4, 5, 6} {
+			if missed[i].Seq != expected {
+				t.Errorf("expected event %d to have seq %d, got %d", i, expected, missed[i].Seq)
+			}
+		}
+		if _, ok := log.since(2); ok {
+			t.Error("expected events that never reached the log not to be replayed")
+		}
+	})
+
+	t.Run("events missing in the middle can't be replayed", func(t *testing.T) {
+		log := &eventLog{}
+		now := time.Now()
+		for _, seq := range []uint64{1, 2, 4} {
+			log.add(Message{Seq: seq, Type: EventTypeCampaignUpdated}, 10, now)
+		}
+
+		if _, ok := log.since(1); ok {
+			t.Error("expected a gap to require a resync")
+		}
+		if missed, ok := log.since(3); !ok || len(missed) != 1 {
+			t.Errorf("expected the event after the gap to be replayed, got %d", len(missed))
+		}
+	})
+}
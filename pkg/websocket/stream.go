@@ -0,0 +1,19 @@
+package websocket
+
+import "time"
+
+// NewStreamClient returns a client without a websocket connection for streams that only receive, such as Server-Sent Events.
+// The stream reads the queued messages from Messages instead of running the pumps
+func NewStreamClient(hub *Hub, campaignID, userHandle string) *Client {
+	return NewClient(hub, nil, campaignID, userHandle)
+}
+
+// Messages returns the messages queued for the client, it is closed when the client is unregistered
+func (c *Client) Messages() <-chan Message {
+	return c.send
+}
+
+// PingPeriod returns how often idle connections should be kept alive
+func (h *Hub) PingPeriod() time.Duration {
+	return h.pingPeriod
+}
@@ -1,5 +1,11 @@
 package websocket
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 type EventType string
 
 const (
@@ -7,6 +13,7 @@ const (
 	EventTypeActivityUpdated     EventType = "activity_updated"
 	EventTypeActivityDeleted     EventType = "activity_deleted"
 	EventTypeCommentCreated      EventType = "comment_created"
+	EventTypeCommentUpdated      EventType = "comment_updated"
 	EventTypeCommentDeleted      EventType = "comment_deleted"
 	EventTypeContributionCreated EventType = "contribution_created"
 	EventTypeContributorUpdated  EventType = "contributor_updated"
@@ -14,9 +21,105 @@ const (
 	EventTypeCampaignUpdated     EventType = "campaign_updated"
 	EventTypePayoutCreated       EventType = "payout_created"
 	EventTypePayoutUpdated       EventType = "payout_updated"
+	EventTypeCampaignMilestone   EventType = "campaign_milestone_reached"
+	EventTypeCampaignRoleUpdated EventType = "campaign_role_updated"
+
+	EventTypeContributorRequestUpdated EventType = "contributor_request_updated"
+
+	EventTypeActivityVoteUpdated EventType = "activity_vote_updated"
+	EventTypePollCreated         EventType = "poll_created"
+	EventTypePollUpdated         EventType = "poll_updated"
+
+	EventTypeActivityBudgetUpdated EventType = "activity_budget_updated"
+
+	// Chat events are also sent by clients
+	EventTypeChatMessage EventType = "chat_message"
+	EventTypeChatTyping  EventType = "chat_typing"
+	EventTypeChatReceipt EventType = "chat_receipt"
+
+	// EventTypeSubscribe is sent by clients to choose the event types they receive
+	EventTypeSubscribe EventType = "subscribe"
+
+	EventTypeError EventType = "error"
+	// EventTypeResyncRequired tells a reconnecting client that it missed too much and must reload the campaign
+	EventTypeResyncRequired EventType = "resync_required"
 )
 
+// broadcastEventTypes are the campaign events clients can subscribe to
+var broadcastEventTypes = map[EventType]bool{
+	EventTypeActivityCreated:           true,
+	EventTypeActivityUpdated:           true,
+	EventTypeActivityDeleted:           true,
+	EventTypeCommentCreated:            true,
+	EventTypeCommentUpdated:            true,
+	EventTypeCommentDeleted:            true,
+	EventTypeContributionCreated:       true,
+	EventTypeContributorUpdated:        true,
+	EventTypeContributorDeleted:        true,
+	EventTypeCampaignUpdated:           true,
+	EventTypePayoutCreated:             true,
+	EventTypePayoutUpdated:             true,
+	EventTypeCampaignMilestone:         true,
+	EventTypeCampaignRoleUpdated:       true,
+	EventTypeContributorRequestUpdated: true,
+	EventTypeActivityVoteUpdated:       true,
+	EventTypePollCreated:               true,
+	EventTypePollUpdated:               true,
+	EventTypeActivityBudgetUpdated:     true,
+	EventTypeChatMessage:               true,
+	EventTypeChatTyping:                true,
+	EventTypeChatReceipt:               true,
+}
+
+// IsBroadcast checks if the event type is a campaign event clients can subscribe to
+func (t EventType) IsBroadcast() bool {
+	return broadcastEventTypes[t]
+}
+
+// ParseEventTypes parses a comma separated list of campaign event types
+func ParseEventTypes(list string) ([]EventType, error) {
+	var types []EventType
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		eventType := EventType(name)
+		if !eventType.IsBroadcast() {
+			return nil, fmt.Errorf("unknown event type %q", name)
+		}
+		types = append(types, eventType)
+	}
+	return types, nil
+}
+
+// Message is sent to clients, broadcasts to a campaign carry the sequence number the event bus gave them in the campaign
+// so a reconnecting client can ask for what it missed, and the event ID so a client can drop an event it already has.
+// PrivilegedData replaces Data for clients allowed to see payment details, it is nil when everyone gets the same data
 type Message struct {
-	Type EventType   `json:"type"`
-	Data interface{} `json:"data"`
+	ID             string      `json:"id,omitempty"`
+	Seq            uint64      `json:"seq,omitempty"`
+	Type           EventType   `json:"type"`
+	Data           interface{} `json:"data"`
+	PrivilegedData interface{} `json:"-"`
+}
+
+// SubscribeData lists the event types a client wants, an empty list subscribes to every event
+type SubscribeData struct {
+	Types []EventType `json:"types"`
+}
+
+// IncomingMessage is a message sent by a client, Data is decoded by the handler of its Type
+type IncomingMessage struct {
+	Type EventType       `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ErrorData describes why a client's message was rejected
+type ErrorData struct {
+	Message string `json:"message"`
+}
+
+// NewErrorMessage returns the message sent to a client whose message was rejected
+func NewErrorMessage(message string) Message {
+	return Message{Type: EventTypeError, Data: ErrorData{Message: message}}
 }